@@ -0,0 +1,380 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/moov-io/wire20022/pkg/base"
+	"github.com/moov-io/wire20022/pkg/messages"
+	"github.com/moov-io/wire20022/pkg/models"
+)
+
+// ConversionResult represents the result of converting a single file
+type ConversionResult struct {
+	File        string               `json:"file,omitempty"`
+	Output      string               `json:"output,omitempty"`
+	Success     bool                 `json:"success"`
+	MessageType messages.MessageType `json:"messageType"`
+	From        string               `json:"from,omitempty"`
+	To          string               `json:"to,omitempty"`
+	Dropped     []base.FieldChange   `json:"dropped,omitempty"`
+	Defaulted   []base.FieldChange   `json:"defaulted,omitempty"`
+	Warnings    []string             `json:"warnings,omitempty"`
+	Error       string               `json:"error,omitempty"`
+}
+
+// ConversionBatch represents the results of converting multiple files
+type ConversionBatch struct {
+	TotalFiles     int                `json:"totalFiles"`
+	ConvertedCount int                `json:"convertedCount"`
+	FailureCount   int                `json:"failureCount"`
+	TotalTime      time.Duration      `json:"totalTime"`
+	Results        []ConversionResult `json:"results"`
+}
+
+// conversionInput is a file to convert and its path below the -output directory
+type conversionInput struct {
+	path     string
+	relative string
+}
+
+func runConvert(args []string, stdout, stderr io.Writer) int {
+	var (
+		to     string
+		format string
+		output string
+	)
+
+	fs := flag.NewFlagSet("convert", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.StringVar(&to, "to", "", "Target version, e.g. 'pacs.008.001.05'; separate several with commas (default: keep each file's version)")
+	fs.StringVar(&format, "format", "xml", "Output format: 'xml' or 'json'")
+	fs.StringVar(&output, "output", "", "Output file, or directory when converting several files (default: stdout)")
+	fs.StringVar(&output, "o", "", "Output file or directory (shorthand)")
+	fs.BoolVar(&jsonOutput, "json", false, "Output the conversion summary in JSON format")
+	fs.BoolVar(&recursive, "recursive", false, "Recursively process directories")
+	fs.BoolVar(&recursive, "r", false, "Recursively process directories (shorthand)")
+	fs.StringVar(&pattern, "pattern", "*.xml", "File pattern to match (e.g., '*.xml', 'pacs.008*')")
+	fs.BoolVar(&structureAddresses, "structure-addresses", false, "Move what can be recognized in free-text address lines to structured address fields")
+	fs.Usage = func() {
+		fmt.Fprintln(stderr, "Usage: wire20022 convert [options] <file|directory> [<file|directory>...]")
+		fmt.Fprintln(stderr, "\nConverts messages to another version of their message definition, or to JSON,")
+		fmt.Fprintln(stderr, "and lists the fields the target version could not carry over.")
+		fmt.Fprintln(stderr, "\nOptions:")
+		fs.PrintDefaults()
+		fmt.Fprintln(stderr, "\nExamples:")
+		fmt.Fprintln(stderr, "  wire20022 convert -to pacs.008.001.05 payment.xml             # Write the converted message to stdout")
+		fmt.Fprintln(stderr, "  wire20022 convert -format json -o payment.json payment.xml   # Write the message model as JSON")
+		fmt.Fprintln(stderr, "  wire20022 convert -to pacs.008.001.12,pacs.004.001.12 -r -o migrated/ archive/")
+		fmt.Fprintln(stderr, "  wire20022 convert -structure-addresses -o structured.xml payment.xml")
+	}
+
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return 0
+		}
+		return 2
+	}
+	if fs.NArg() == 0 {
+		fs.Usage()
+		return 2
+	}
+
+	targets, err := parseTargets(to)
+	if err != nil {
+		printError(err.Error())
+		return 2
+	}
+	if format != "xml" && format != "json" {
+		printError(fmt.Sprintf("unknown format %q, expected xml or json", format))
+		return 2
+	}
+
+	// Collect the files to convert
+	var inputs []conversionInput
+	var results []ConversionResult
+	toDirectory := fs.NArg() > 1
+	for _, arg := range fs.Args() {
+		info, err := os.Stat(arg)
+		if err != nil {
+			results = append(results, ConversionResult{File: arg, Error: fmt.Sprintf("Cannot access %s: %v", arg, err)})
+			continue
+		}
+
+		if info.IsDir() {
+			toDirectory = true
+			walkFiles(arg, func(path string) bool {
+				relative, err := filepath.Rel(arg, path)
+				if err != nil {
+					relative = filepath.Base(path)
+				}
+				inputs = append(inputs, conversionInput{path: path, relative: relative})
+				return true
+			})
+		} else {
+			inputs = append(inputs, conversionInput{path: arg, relative: filepath.Base(arg)})
+		}
+	}
+
+	if toDirectory && output == "" {
+		printError("-output directory is required to convert more than one file")
+		return 2
+	}
+	if info, err := os.Stat(output); err == nil && info.IsDir() {
+		toDirectory = true
+	}
+
+	// Documents written to stdout keep the summary out of their way
+	summary := stdout
+	if output == "" {
+		summary = stderr
+	}
+
+	reader := messages.NewUniversalReader()
+	startTime := time.Now()
+	for _, input := range inputs {
+		result, data := convertFile(reader, input.path, targets, format)
+		if result.Success {
+			switch {
+			case output == "":
+				_, err = stdout.Write(data)
+			case toDirectory:
+				result.Output = filepath.Join(output, outputName(input.relative, format))
+				err = writeOutput(result.Output, data)
+			default:
+				result.Output = output
+				err = writeOutput(result.Output, data)
+			}
+			if err != nil {
+				result.Success = false
+				result.Error = fmt.Sprintf("Failed to write output: %v", err)
+			}
+		}
+		results = append(results, result)
+	}
+
+	batch := ConversionBatch{
+		TotalFiles: len(results),
+		TotalTime:  time.Since(startTime),
+		Results:    results,
+	}
+	for _, r := range results {
+		if r.Success {
+			batch.ConvertedCount++
+		} else {
+			batch.FailureCount++
+		}
+	}
+
+	if jsonOutput {
+		encoder := json.NewEncoder(summary)
+		encoder.SetIndent("", "  ")
+		encoder.Encode(batch)
+	} else {
+		outputConversionSummary(summary, batch)
+	}
+	if batch.FailureCount > 0 {
+		return 1
+	}
+	return 0
+}
+
+// parseTargets reads the -to versions, keyed by the message definition they apply to
+func parseTargets(to string) (map[string]string, error) {
+	targets := make(map[string]string)
+	if to == "" {
+		return targets, nil
+	}
+	for _, version := range strings.Split(to, ",") {
+		version = strings.ToLower(strings.TrimSpace(version))
+		parts := strings.Split(version, ".")
+		if len(parts) != 4 {
+			return nil, fmt.Errorf("invalid target version %q, expected a message identifier such as pacs.008.001.05", version)
+		}
+		name := parts[0] + "." + parts[1]
+		if existing, exists := targets[name]; exists {
+			return nil, fmt.Errorf("target versions %s and %s are for the same message", existing, version)
+		}
+		targets[name] = version
+	}
+	return targets, nil
+}
+
+// convertFile reads a message and converts it to its target version, if any, returning the
+// document or JSON to write
+func convertFile(reader *messages.UniversalReader, path string, targets map[string]string, format string) (ConversionResult, []byte) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return ConversionResult{File: path, Error: fmt.Sprintf("Failed to open file: %v", err)}, nil
+	}
+
+	result, out, err := convertData(reader, data, targets, format)
+	result.File = path
+	if err != nil {
+		result.Error = err.Error()
+		return result, nil
+	}
+	result.Success = true
+	return result, out
+}
+
+// convertData converts the message in data like convertFile
+func convertData(reader *messages.UniversalReader, data []byte, targets map[string]string, format string) (ConversionResult, []byte, error) {
+	var result ConversionResult
+
+	parsed, err := reader.ReadBytes(data)
+	if err != nil {
+		return result, nil, fmt.Errorf("Failed to parse: %w", err)
+	}
+	result.MessageType = parsed.Type
+	result.From = parsed.Message.Version()
+
+	converted := parsed
+	if len(targets) > 0 {
+		target, ok := targets[parsed.Message.ISOMessageName()]
+		if !ok {
+			return result, nil, fmt.Errorf("No target version for %s", parsed.Message.ISOMessageName())
+		}
+		var report *base.ConversionReport
+		converted, report, err = reader.ConvertMessage(parsed, target)
+		if err != nil {
+			return result, nil, fmt.Errorf("Conversion failed: %w", err)
+		}
+		result.Dropped = report.Dropped
+		result.Defaulted = report.Defaulted
+	}
+	result.To = converted.Message.Version()
+
+	if structureAddresses {
+		if err := models.StructureAddresses(converted.Message); err != nil {
+			return result, nil, fmt.Errorf("Failed to structure addresses: %w", err)
+		}
+	}
+	if err := reader.ValidateMessage(converted); err != nil {
+		// Values Fedwire would refuse that the input already held, such as a routing number
+		// with a bad check digit, are reported rather than failing, so a message converts the
+		// way it was read
+		read := make(map[string]bool)
+		if values := models.ValidateValues(parsed.Message); values != nil {
+			for _, detail := range errorDetails(values) {
+				read[detail.Field] = true
+			}
+		}
+		for _, detail := range errorDetails(err) {
+			if detail.Field == "" || !read[detail.Field] {
+				return result, nil, fmt.Errorf("Validation failed: %w", err)
+			}
+			result.Warnings = append(result.Warnings, detail.Message)
+		}
+	}
+
+	var out []byte
+	if format == "json" {
+		out, err = json.MarshalIndent(converted.Message, "", "  ")
+		out = append(out, '\n')
+	} else {
+		var buf bytes.Buffer
+		if err = converted.Message.WriteDocument(&buf); err == nil {
+			out, err = messages.WrapEnvelope(converted.Envelope, buf.Bytes())
+		}
+	}
+	if err != nil {
+		return result, nil, fmt.Errorf("Failed to write %s: %w", format, err)
+	}
+	return result, out, nil
+}
+
+// outputName names the output of a file converted to format
+func outputName(name, format string) string {
+	if format != "json" {
+		return name
+	}
+	return strings.TrimSuffix(name, ".xml") + ".json"
+}
+
+func writeOutput(path string, data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o644)
+}
+
+func outputConversionSummary(w io.Writer, batch ConversionBatch) {
+	var converted, failed []ConversionResult
+	lossy := 0
+	for _, r := range batch.Results {
+		if !r.Success {
+			failed = append(failed, r)
+			continue
+		}
+		converted = append(converted, r)
+		if len(r.Dropped) > 0 {
+			lossy++
+		}
+	}
+
+	fmt.Fprintf(w, "\nConversion Summary\n")
+	fmt.Fprintf(w, "==================\n")
+	fmt.Fprintf(w, "Total files processed: %d\n", batch.TotalFiles)
+	fmt.Fprintf(w, "Converted: %d\n", batch.ConvertedCount)
+	fmt.Fprintf(w, "With dropped fields: %d\n", lossy)
+	fmt.Fprintf(w, "Failed: %d\n", batch.FailureCount)
+	fmt.Fprintf(w, "Total time: %s\n", batch.TotalTime)
+
+	// Fields the conversions changed
+	changed := 0
+	for _, r := range converted {
+		if len(r.Dropped) == 0 && len(r.Defaulted) == 0 && len(r.Warnings) == 0 {
+			continue
+		}
+		if changed == 0 {
+			fmt.Fprintf(w, "\nChanged Fields:\n")
+			fmt.Fprintf(w, "---------------\n")
+		}
+		changed++
+		fmt.Fprintf(w, "\n[%d] File: %s\n", changed, r.File)
+		fmt.Fprintf(w, "    Version: %s -> %s\n", r.From, r.To)
+		for _, field := range r.Dropped {
+			fmt.Fprintf(w, "    Dropped: %s = %q\n", field.Field, field.Value)
+		}
+		for _, field := range r.Defaulted {
+			if field.Value != "" {
+				fmt.Fprintf(w, "    Defaulted: %s = %q\n", field.Field, field.Value)
+			} else {
+				fmt.Fprintf(w, "    Defaulted: %s\n", field.Field)
+			}
+		}
+		for _, warning := range r.Warnings {
+			fmt.Fprintf(w, "    Warning: %s\n", warning)
+		}
+	}
+
+	// Failed conversions
+	if len(failed) > 0 {
+		fmt.Fprintf(w, "\nFailed Conversions:\n")
+		fmt.Fprintf(w, "-------------------\n")
+		for i, r := range failed {
+			fmt.Fprintf(w, "\n[%d] File: %s\n", i+1, r.File)
+			fmt.Fprintf(w, "    Error: %s\n", r.Error)
+			if r.MessageType != "" {
+				fmt.Fprintf(w, "    Detected Type: %s\n", r.MessageType)
+			}
+		}
+	}
+
+	if len(failed) == 0 && lossy == 0 && batch.TotalFiles > 0 {
+		fmt.Fprintf(w, "\n✓ All files converted without loss!\n")
+	}
+}
@@ -0,0 +1,138 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/moov-io/wire20022/pkg/messages"
+	"github.com/moov-io/wire20022/pkg/models"
+	"github.com/moov-io/wire20022/pkg/models/CustomerCreditTransfer"
+)
+
+const (
+	pacs008Sample = "../../pkg/models/CustomerCreditTransfer/swiftSample/CustomerCreditTransfer_Scenario1_Step1_pacs.008"
+	pacs004Sample = "../../pkg/models/PaymentReturn/swiftSample/PaymentReturn_Scenario1_Step4_pacs.004"
+)
+
+func TestRunConvert(t *testing.T) {
+	t.Run("to stdout", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+		code := runConvert([]string{"-to", "pacs.008.001.05", pacs008Sample}, &stdout, &stderr)
+		require.Equal(t, 0, code, stderr.String())
+
+		parsed, err := messages.NewUniversalReader().ReadBytes(stdout.Bytes())
+		require.NoError(t, err)
+		assert.Equal(t, "pacs.008.001.05", parsed.Message.Version())
+
+		// The summary lists what the older version could not carry
+		assert.Contains(t, stderr.String(), "Version: pacs.008.001.08 -> pacs.008.001.05")
+		assert.Contains(t, stderr.String(), `Dropped: Transactions[0].CreditorPostalAddress.Floor = "33"`)
+	})
+
+	t.Run("directory to JSON", func(t *testing.T) {
+		input := t.TempDir()
+		for _, sample := range []string{pacs008Sample, pacs004Sample} {
+			data, err := os.ReadFile(sample)
+			require.NoError(t, err)
+			require.NoError(t, os.MkdirAll(filepath.Join(input, "2025"), 0o755))
+			require.NoError(t, os.WriteFile(filepath.Join(input, "2025", filepath.Base(sample)+".xml"), data, 0o644))
+		}
+		output := t.TempDir()
+
+		var stdout, stderr bytes.Buffer
+		code := runConvert([]string{"-r", "-format", "json", "-json", "-o", output, input}, &stdout, &stderr)
+		require.Equal(t, 0, code, stdout.String())
+
+		var batch ConversionBatch
+		require.NoError(t, json.Unmarshal(stdout.Bytes(), &batch))
+		assert.Equal(t, 2, batch.ConvertedCount)
+
+		data, err := os.ReadFile(filepath.Join(output, "2025", filepath.Base(pacs008Sample)+".json"))
+		require.NoError(t, err)
+		var model map[string]any
+		require.NoError(t, json.Unmarshal(data, &model))
+		assert.Equal(t, "20250310B1QDRCQR000001", model["messageId"])
+	})
+
+	t.Run("structure addresses", func(t *testing.T) {
+		data, err := os.ReadFile(pacs008Sample)
+		require.NoError(t, err)
+		parsed, err := messages.NewUniversalReader().ReadBytes(data)
+		require.NoError(t, err)
+		payment := parsed.Message.(*CustomerCreditTransfer.MessageModel)
+		payment.Transactions[0].DebtorAddress = models.PostalAddress{AddressLine: []string{"167565 Avenue of the Fountains", "Fountain Hills, AZ 85268", "United States"}}
+		var document bytes.Buffer
+		require.NoError(t, payment.WriteXML(&document))
+		input := filepath.Join(t.TempDir(), "payment.xml")
+		require.NoError(t, os.WriteFile(input, document.Bytes(), 0o644))
+
+		var stdout, stderr bytes.Buffer
+		code := runConvert([]string{"-structure-addresses", input}, &stdout, &stderr)
+		require.Equal(t, 0, code, stderr.String())
+
+		converted, err := messages.NewUniversalReader().ReadBytes(stdout.Bytes())
+		require.NoError(t, err)
+		assert.Equal(t, models.PostalAddress{
+			StreetName:     "Avenue of the Fountains",
+			BuildingNumber: "167565",
+			PostalCode:     "85268",
+			TownName:       "Fountain Hills",
+			Subdivision:    "AZ",
+			Country:        "US",
+		}, converted.Message.(*CustomerCreditTransfer.MessageModel).Transactions[0].DebtorAddress)
+	})
+
+	t.Run("several targets", func(t *testing.T) {
+		output := t.TempDir()
+		var stdout, stderr bytes.Buffer
+		code := runConvert([]string{"-to", "pacs.008.001.12, PACS.004.001.12", "-pattern", "*", "-o", output, pacs008Sample, pacs004Sample}, &stdout, &stderr)
+		require.Equal(t, 0, code, stdout.String())
+
+		for _, sample := range []string{pacs008Sample, pacs004Sample} {
+			data, err := os.ReadFile(filepath.Join(output, filepath.Base(sample)))
+			require.NoError(t, err)
+			parsed, err := messages.NewUniversalReader().ReadBytes(data)
+			require.NoError(t, err)
+			assert.Equal(t, "001.12", parsed.Version, sample)
+		}
+	})
+
+	t.Run("invalid routing number", func(t *testing.T) {
+		// The sample's InstructedAgent fails the check digit, which Validate reports but writing does not
+		sample := "../../pkg/models/CustomerCreditTransfer/swiftSample/CustomerCreditTransfer_Scenario2_Step1_pacs.008"
+		var stdout, stderr bytes.Buffer
+		code := runConvert([]string{"-to", "pacs.008.001.12", sample}, &stdout, &stderr)
+		require.Equal(t, 0, code, stderr.String())
+		assert.Contains(t, stdout.String(), ">021040079</MmbId>")
+		assert.Contains(t, stderr.String(), `Warning: validation failed for field "Transactions[0].InstructedAgent.PaymentSysMemberId"`)
+	})
+
+	t.Run("no target for message", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+		code := runConvert([]string{"-to", "pacs.008.001.05", "-o", t.TempDir(), pacs004Sample}, &stdout, &stderr)
+		assert.Equal(t, 1, code)
+		assert.Contains(t, stdout.String(), "No target version for pacs.004")
+	})
+
+	t.Run("usage errors", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+		assert.Equal(t, 2, runConvert([]string{"-to", "pacs.008", pacs008Sample}, &stdout, &stderr))
+		assert.Equal(t, 2, runConvert([]string{"-format", "csv", pacs008Sample}, &stdout, &stderr))
+		assert.Equal(t, 2, runConvert([]string{pacs008Sample, pacs004Sample}, &stdout, &stderr))
+	})
+}
+
+func TestParseTargets(t *testing.T) {
+	targets, err := parseTargets("pacs.008.001.05,camt.052.001.08")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"pacs.008": "pacs.008.001.05", "camt.052": "camt.052.001.08"}, targets)
+
+	_, err = parseTargets("pacs.008.001.05,pacs.008.001.08")
+	assert.EqualError(t, err, "target versions pacs.008.001.05 and pacs.008.001.08 are for the same message")
+}
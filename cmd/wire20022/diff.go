@@ -0,0 +1,114 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/moov-io/wire20022/pkg/messages"
+)
+
+// DiffResult represents the comparison of two message files
+type DiffResult struct {
+	FileA string `json:"fileA"`
+	FileB string `json:"fileB"`
+	*messages.MessageDiff
+}
+
+func runDiff(args []string, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("diff", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.BoolVar(&jsonOutput, "json", false, "Output the differences in JSON format")
+	fs.Usage = func() {
+		fmt.Fprintln(stderr, "Usage: wire20022 diff [options] <a.xml> <b.xml>")
+		fmt.Fprintln(stderr, "\nCompares two messages of the same type field by field, ignoring formatting and")
+		fmt.Fprintln(stderr, "element order. The files may be different versions of the message definition.")
+		fmt.Fprintln(stderr, "Exits with status 0 when the messages are the same, 1 when they differ and 2 on error.")
+		fmt.Fprintln(stderr, "\nOptions:")
+		fs.PrintDefaults()
+		fmt.Fprintln(stderr, "\nExamples:")
+		fmt.Fprintln(stderr, "  wire20022 diff sent.xml resent.xml")
+		fmt.Fprintln(stderr, "  wire20022 diff -json sent.xml resent.xml")
+	}
+
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return 0
+		}
+		return 2
+	}
+	if fs.NArg() != 2 {
+		fs.Usage()
+		return 2
+	}
+
+	reader := messages.NewUniversalReader()
+	parsed := make([]*messages.ParsedMessage, 2)
+	for i, path := range fs.Args() {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			fmt.Fprintf(stderr, "Error: Failed to open file: %v\n", err)
+			return 2
+		}
+		if parsed[i], err = reader.ReadBytes(data); err != nil {
+			fmt.Fprintf(stderr, "Error: Failed to parse %s: %v\n", path, err)
+			return 2
+		}
+	}
+
+	diff, err := reader.DiffMessages(parsed[0], parsed[1])
+	if err != nil {
+		fmt.Fprintf(stderr, "Error: %v\n", err)
+		return 2
+	}
+	result := DiffResult{FileA: fs.Arg(0), FileB: fs.Arg(1), MessageDiff: diff}
+
+	if jsonOutput {
+		encoder := json.NewEncoder(stdout)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(result); err != nil {
+			fmt.Fprintf(stderr, "Error: Failed to encode JSON: %v\n", err)
+			return 2
+		}
+	} else {
+		outputDiff(stdout, result)
+	}
+
+	if diff.Equal() {
+		return 0
+	}
+	return 1
+}
+
+// outputDiff prints the differences one field per line, marked as changed (~), removed (-) or added (+)
+func outputDiff(w io.Writer, result DiffResult) {
+	fmt.Fprintf(w, "--- %s (%s %s)\n", result.FileA, result.Type, result.VersionA)
+	fmt.Fprintf(w, "+++ %s (%s %s)\n", result.FileB, result.Type, result.VersionB)
+
+	if result.Equal() {
+		fmt.Fprintln(w, "No differences")
+		return
+	}
+	for _, difference := range result.Differences {
+		switch difference.Kind() {
+		case "added":
+			fmt.Fprintf(w, "+ %s: %q\n", difference.Field, difference.B)
+		case "removed":
+			fmt.Fprintf(w, "- %s: %q\n", difference.Field, difference.A)
+		default:
+			fmt.Fprintf(w, "~ %s: %q -> %q\n", difference.Field, difference.A, difference.B)
+		}
+	}
+	if len(result.Differences) == 1 {
+		fmt.Fprintln(w, "\n1 difference")
+	} else {
+		fmt.Fprintf(w, "\n%d differences\n", len(result.Differences))
+	}
+}
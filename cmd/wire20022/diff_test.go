@@ -0,0 +1,78 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunDiff(t *testing.T) {
+	dir := t.TempDir()
+	sample, err := os.ReadFile(pacs008Sample)
+	require.NoError(t, err)
+
+	write := func(name string, data []byte) string {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.WriteFile(path, data, 0o644))
+		return path
+	}
+	reformatted := write("reformatted.xml", bytes.ReplaceAll(sample, []byte("\t"), []byte("  ")))
+	changed := write("changed.xml", bytes.Replace(sample, []byte(">510000.74<"), []byte(">510000.75<"), 1))
+
+	var converted, stderr bytes.Buffer
+	require.Equal(t, 0, runConvert([]string{"-to", "pacs.008.001.05", pacs008Sample}, &converted, &stderr), stderr.String())
+	older := write("older.xml", converted.Bytes())
+
+	t.Run("no differences", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+		require.Equal(t, 0, runDiff([]string{pacs008Sample, reformatted}, &stdout, &stderr), stderr.String())
+		assert.Contains(t, stdout.String(), "No differences")
+	})
+
+	t.Run("changed field", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+		require.Equal(t, 1, runDiff([]string{pacs008Sample, changed}, &stdout, &stderr), stderr.String())
+		assert.Contains(t, stdout.String(), "--- "+pacs008Sample+" (CustomerCreditTransfer pacs.008.001.08)")
+		assert.Contains(t, stdout.String(), `~ Transactions[0].InterBankSettAmount.Amount: "510000.74" -> "510000.75"`)
+		assert.Contains(t, stdout.String(), "1 difference")
+	})
+
+	t.Run("different versions", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+		require.Equal(t, 1, runDiff([]string{"-json", pacs008Sample, older}, &stdout, &stderr), stderr.String())
+
+		var result DiffResult
+		require.NoError(t, json.Unmarshal(stdout.Bytes(), &result))
+		assert.Equal(t, older, result.FileB)
+		assert.Equal(t, "pacs.008.001.08", result.VersionA)
+		assert.Equal(t, "pacs.008.001.05", result.VersionB)
+		assert.Contains(t, stdout.String(), `"field": "Transactions[0].CreditorPostalAddress.Floor"`)
+		for _, difference := range result.Differences {
+			assert.Equal(t, "removed", difference.Kind(), difference.Field)
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		tests := []struct {
+			name string
+			args []string
+		}{
+			{"different types", []string{pacs008Sample, pacs004Sample}},
+			{"missing file", []string{pacs008Sample, filepath.Join(dir, "missing.xml")}},
+			{"one file", []string{pacs008Sample}},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				var stdout, stderr bytes.Buffer
+				assert.Equal(t, 2, runDiff(tt.args, &stdout, &stderr))
+				assert.Empty(t, stdout.String())
+				assert.NotEmpty(t, stderr.String())
+			})
+		}
+	})
+}
@@ -0,0 +1,434 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/moov-io/wire20022/pkg/messages"
+	"github.com/moov-io/wire20022/pkg/models"
+	AccountReportingRequestModel "github.com/moov-io/wire20022/pkg/models/AccountReportingRequest"
+	ActivityReportModel "github.com/moov-io/wire20022/pkg/models/ActivityReport"
+	ConnectionCheckModel "github.com/moov-io/wire20022/pkg/models/ConnectionCheck"
+	CustomerCreditTransferModel "github.com/moov-io/wire20022/pkg/models/CustomerCreditTransfer"
+	DrawdownRequestModel "github.com/moov-io/wire20022/pkg/models/DrawdownRequest"
+	DrawdownResponseModel "github.com/moov-io/wire20022/pkg/models/DrawdownResponse"
+	EndpointDetailsReportModel "github.com/moov-io/wire20022/pkg/models/EndpointDetailsReport"
+	EndpointGapReportModel "github.com/moov-io/wire20022/pkg/models/EndpointGapReport"
+	EndpointTotalsReportModel "github.com/moov-io/wire20022/pkg/models/EndpointTotalsReport"
+	FICreditTransferModel "github.com/moov-io/wire20022/pkg/models/FICreditTransfer"
+	FedwireFundsAcknowledgementModel "github.com/moov-io/wire20022/pkg/models/FedwireFundsAcknowledgement"
+	FedwireFundsPaymentStatusModel "github.com/moov-io/wire20022/pkg/models/FedwireFundsPaymentStatus"
+	FedwireFundsSystemResponseModel "github.com/moov-io/wire20022/pkg/models/FedwireFundsSystemResponse"
+	InvestigationRequestModel "github.com/moov-io/wire20022/pkg/models/InvestigationRequest"
+	InvestigationResponseModel "github.com/moov-io/wire20022/pkg/models/InvestigationResponse"
+	MasterModel "github.com/moov-io/wire20022/pkg/models/Master"
+	MessageRejectModel "github.com/moov-io/wire20022/pkg/models/MessageReject"
+	PaymentReturnModel "github.com/moov-io/wire20022/pkg/models/PaymentReturn"
+	PaymentStatusRequestModel "github.com/moov-io/wire20022/pkg/models/PaymentStatusRequest"
+	RetrievalRequestModel "github.com/moov-io/wire20022/pkg/models/RetrievalRequest"
+	ReturnRequestModel "github.com/moov-io/wire20022/pkg/models/ReturnRequest"
+	ReturnRequestResponseModel "github.com/moov-io/wire20022/pkg/models/ReturnRequestResponse"
+)
+
+// generator builds the XML document of one message type from its model JSON
+type generator struct {
+	// generate decodes input, if any, over an empty model or the built-in sample model and
+	// writes the document for version, a message identifier such as "pacs.008.001.08"
+	generate func(input []byte, fromTemplate bool, version string) ([]byte, error)
+	// hasTemplate reports whether the type has a built-in sample model
+	hasTemplate bool
+}
+
+// generators holds the message types generate supports, keyed by type
+var generators = map[messages.MessageType]generator{
+	messages.TypeCustomerCreditTransfer:      newGenerator(messages.NewCustomerCreditTransfer().MessageWrapper, CustomerCreditTransferModel.VersionNameSpaceMap, CustomerCreditTransferModel.CustomerCreditTransferDataModel),
+	messages.TypeFICreditTransfer:            newGenerator(messages.NewFICreditTransfer().MessageWrapper, FICreditTransferModel.VersionNameSpaceMap, FICreditTransferModel.FICreditTransferDataModel),
+	messages.TypePaymentReturn:               newGenerator(messages.NewPaymentReturn().MessageWrapper, PaymentReturnModel.VersionNameSpaceMap, nil),
+	messages.TypePaymentStatusRequest:        newGenerator(messages.NewPaymentStatusRequest().MessageWrapper, PaymentStatusRequestModel.VersionNameSpaceMap, nil),
+	messages.TypeFedwireFundsPaymentStatus:   newGenerator(messages.NewFedwireFundsPaymentStatus().MessageWrapper, FedwireFundsPaymentStatusModel.VersionNameSpaceMap, nil),
+	messages.TypeDrawdownRequest:             newGenerator(messages.NewDrawdownRequest().MessageWrapper, DrawdownRequestModel.VersionNameSpaceMap, nil),
+	messages.TypeDrawdownResponse:            newGenerator(messages.NewDrawdownResponse().MessageWrapper, DrawdownResponseModel.VersionNameSpaceMap, nil),
+	messages.TypeAccountReportingRequest:     newGenerator(messages.NewAccountReportingRequest().MessageWrapper, AccountReportingRequestModel.VersionNameSpaceMap, nil),
+	messages.TypeActivityReport:              newGenerator(messages.NewActivityReport().MessageWrapper, ActivityReportModel.VersionNameSpaceMap, nil),
+	messages.TypeEndpointDetailsReport:       newGenerator(messages.NewEndpointDetailsReport().MessageWrapper, EndpointDetailsReportModel.VersionNameSpaceMap, nil),
+	messages.TypeEndpointGapReport:           newGenerator(messages.NewEndpointGapReport().MessageWrapper, EndpointGapReportModel.VersionNameSpaceMap, nil),
+	messages.TypeEndpointTotalsReport:        newGenerator(messages.NewEndpointTotalsReport().MessageWrapper, EndpointTotalsReportModel.VersionNameSpaceMap, nil),
+	messages.TypeReturnRequestResponse:       newGenerator(messages.NewReturnRequestResponse().MessageWrapper, ReturnRequestResponseModel.VersionNameSpaceMap, nil),
+	messages.TypeReturnRequest:               newGenerator(messages.NewReturnRequest().MessageWrapper, ReturnRequestModel.VersionNameSpaceMap, nil),
+	messages.TypeConnectionCheck:             newGenerator(messages.NewConnectionCheck().MessageWrapper, ConnectionCheckModel.VersionNameSpaceMap, nil),
+	messages.TypeFedwireFundsAcknowledgement: newGenerator(messages.NewFedwireFundsAcknowledgement().MessageWrapper, FedwireFundsAcknowledgementModel.VersionNameSpaceMap, nil),
+	messages.TypeFedwireFundsSystemResponse:  newGenerator(messages.NewFedwireFundsSystemResponse().MessageWrapper, FedwireFundsSystemResponseModel.VersionNameSpaceMap, nil),
+	messages.TypeMessageReject:               newGenerator(messages.NewMessageReject().MessageWrapper, MessageRejectModel.VersionNameSpaceMap, nil),
+	messages.TypeRetrievalRequest:            newGenerator(messages.NewRetrievalRequest().MessageWrapper, RetrievalRequestModel.VersionNameSpaceMap, nil),
+	messages.TypeInvestigationRequest:        newGenerator(messages.NewInvestigationRequest().MessageWrapper, InvestigationRequestModel.VersionNameSpaceMap, nil),
+	messages.TypeInvestigationResponse:       newGenerator(messages.NewInvestigationResponse().MessageWrapper, InvestigationResponseModel.VersionNameSpaceMap, nil),
+	messages.TypeMaster:                      newGenerator(messages.NewMaster().MessageWrapper, MasterModel.VersionNameSpaceMap, nil),
+}
+
+// newGenerator creates the generator of a message type. Fields of the input the model does
+// not have are reported rather than left out, and the model is checked against the rules of
+// the version as well as its required fields and values.
+func newGenerator[M interface{ ValidateForVersion(V) error }, V ~string](wrapper *messages.MessageWrapper[M, V], namespaces map[V]string, template func() M) generator {
+	versions := make([]string, 0, len(namespaces))
+	for _, namespace := range namespaces {
+		versions = append(versions, namespace[strings.LastIndex(namespace, ":")+1:])
+	}
+	sort.Strings(versions)
+
+	return generator{
+		hasTemplate: template != nil,
+		generate: func(input []byte, fromTemplate bool, version string) ([]byte, error) {
+			var ver V
+			found := false
+			for v, namespace := range namespaces {
+				if strings.HasSuffix(namespace, ":"+strings.ToLower(version)) {
+					ver, found = v, true
+					break
+				}
+			}
+			if !found {
+				return nil, fmt.Errorf("unsupported version %s, expected one of %s", version, strings.Join(versions, ", "))
+			}
+
+			var value any
+			if input != nil {
+				var err error
+				if value, err = decodeJSON(input); err != nil {
+					return nil, fmt.Errorf("reading input: %w", err)
+				}
+			}
+			if fromTemplate {
+				sample, err := json.Marshal(template())
+				if err != nil {
+					return nil, err
+				}
+				base, err := decodeJSON(sample)
+				if err != nil {
+					return nil, err
+				}
+				value = mergeJSON(base, value)
+			}
+
+			modelJSON, err := json.Marshal(value)
+			if err != nil {
+				return nil, err
+			}
+			var model M
+			if err := json.Unmarshal(modelJSON, &model); err != nil {
+				return nil, fmt.Errorf("reading input: %w", err)
+			}
+
+			// Fields the model does not have are not written back
+			written, err := json.Marshal(model)
+			if err != nil {
+				return nil, err
+			}
+			decoded, err := decodeJSON(written)
+			if err != nil {
+				return nil, err
+			}
+			if unknown := unknownFields(value, decoded, ""); len(unknown) > 0 {
+				return nil, fmt.Errorf("unknown fields: %s", strings.Join(unknown, ", "))
+			}
+
+			// Values Fedwire would refuse, such as a bad routing number or IBAN, are all reported
+			if err := models.ValidateValues(&model); err != nil {
+				return nil, err
+			}
+			document, err := wrapper.CreateDocument(modelJSON, ver)
+			if err != nil {
+				return nil, err
+			}
+			if err := model.ValidateForVersion(ver); err != nil {
+				return nil, err
+			}
+			return document, nil
+		},
+	}
+}
+
+func runGenerate(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	var (
+		msgType  string
+		version  string
+		template bool
+		output   string
+	)
+
+	fs := flag.NewFlagSet("generate", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.StringVar(&msgType, "type", "", "Message type, e.g. 'CustomerCreditTransfer'")
+	fs.StringVar(&version, "version", "", "Version to generate, e.g. 'pacs.008.001.08'")
+	fs.BoolVar(&template, "template", false, "Start from the built-in sample model of the type; the input then only needs the fields to change")
+	fs.StringVar(&output, "output", "", "Output file (default: stdout)")
+	fs.StringVar(&output, "o", "", "Output file (shorthand)")
+	fs.Usage = func() {
+		fmt.Fprintln(stderr, "Usage: wire20022 generate -type <type> -version <version> [options] [<input.json|input.yaml|->]")
+		fmt.Fprintln(stderr, "\nBuilds a message from its model in JSON or YAML, validates it and writes the XML")
+		fmt.Fprintln(stderr, "document. Field names are those of the model's JSON tags.")
+		fmt.Fprintln(stderr, "\nOptions:")
+		fs.PrintDefaults()
+		fmt.Fprintln(stderr, "\nExamples:")
+		fmt.Fprintln(stderr, "  wire20022 generate -type CustomerCreditTransfer -version pacs.008.001.08 payment.json")
+		fmt.Fprintln(stderr, "  wire20022 generate -type CustomerCreditTransfer -version pacs.008.001.08 -template overrides.yaml")
+		fmt.Fprintln(stderr, "  wire20022 generate -type FICreditTransfer -version pacs.009.001.08 -template -o transfer.xml")
+		fmt.Fprintln(stderr, "\nMessage types:")
+		for _, t := range generatorTypes() {
+			suffix := ""
+			if generators[t].hasTemplate {
+				suffix = " (template)"
+			}
+			fmt.Fprintf(stderr, "  %s%s\n", t, suffix)
+		}
+	}
+
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return 0
+		}
+		return 2
+	}
+
+	gen, ok := generators[messages.MessageType(msgType)]
+	switch {
+	case msgType == "" || version == "":
+		fs.Usage()
+		return 2
+	case !ok:
+		printError(fmt.Sprintf("unknown message type %q", msgType))
+		return 2
+	case template && !gen.hasTemplate:
+		printError(fmt.Sprintf("no built-in template for %s", msgType))
+		return 2
+	case fs.NArg() > 1 || (fs.NArg() == 0 && !template):
+		fs.Usage()
+		return 2
+	}
+
+	var input []byte
+	if fs.NArg() == 1 {
+		name := fs.Arg(0)
+		var data []byte
+		var err error
+		if name == "-" {
+			data, err = io.ReadAll(stdin)
+		} else {
+			data, err = os.ReadFile(name)
+		}
+		if err != nil {
+			printError(fmt.Sprintf("Cannot read %s: %v", name, err))
+			return 1
+		}
+		if input, err = modelJSON(name, data); err != nil {
+			printError(fmt.Sprintf("Cannot read %s: %v", name, err))
+			return 1
+		}
+	}
+
+	document, err := gen.generate(input, template, version)
+	if err != nil {
+		printError(fmt.Sprintf("Generating %s %s: %v", msgType, version, err))
+		return 1
+	}
+
+	out := append([]byte(xml.Header), document...)
+	out = append(out, '\n')
+	if output == "" {
+		_, err = stdout.Write(out)
+	} else {
+		err = writeOutput(output, out)
+	}
+	if err != nil {
+		printError(fmt.Sprintf("Failed to write output: %v", err))
+		return 1
+	}
+	return 0
+}
+
+// generatorTypes returns the message types generate supports, sorted
+func generatorTypes() []messages.MessageType {
+	types := make([]messages.MessageType, 0, len(generators))
+	for t := range generators {
+		types = append(types, t)
+	}
+	sort.Slice(types, func(i, j int) bool { return types[i] < types[j] })
+	return types
+}
+
+// modelJSON returns the model of an input file as JSON. Files named .yaml or .yml, and
+// input that does not start with a JSON object, are read as YAML.
+func modelJSON(name string, data []byte) ([]byte, error) {
+	ext := strings.ToLower(filepath.Ext(name))
+	if ext != ".yaml" && ext != ".yml" && (ext == ".json" || bytes.HasPrefix(bytes.TrimSpace(data), []byte("{"))) {
+		return data, nil
+	}
+
+	var node yaml.Node
+	if err := yaml.Unmarshal(data, &node); err != nil {
+		return nil, err
+	}
+	value, err := yamlValue(&node)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(value)
+}
+
+// decodeJSON decodes JSON keeping numbers as written
+func decodeJSON(data []byte) (any, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+// mergeJSON returns base with the values of overlay in place of its own. Objects are merged
+// field by field and lists element by element, so an input can change a single field of the
+// first transaction.
+func mergeJSON(base, overlay any) any {
+	switch overlay := overlay.(type) {
+	case nil:
+		return base
+	case map[string]any:
+		baseMap, ok := base.(map[string]any)
+		if !ok {
+			return overlay
+		}
+		merged := make(map[string]any, len(baseMap))
+		for key, value := range baseMap {
+			merged[key] = value
+		}
+		for key, value := range overlay {
+			merged[key] = mergeJSON(baseMap[key], value)
+		}
+		return merged
+	case []any:
+		baseList, _ := base.([]any)
+		merged := make([]any, len(overlay))
+		for i, value := range overlay {
+			if i < len(baseList) {
+				merged[i] = mergeJSON(baseList[i], value)
+			} else {
+				merged[i] = value
+			}
+		}
+		return merged
+	default:
+		return overlay
+	}
+}
+
+// unknownFields returns the paths of the input's fields, such as "transactions[0].debtorNme",
+// that are missing from the model decoded from it
+func unknownFields(input, model any, path string) []string {
+	var unknown []string
+	switch input := input.(type) {
+	case map[string]any:
+		modelMap, _ := model.(map[string]any)
+		for key, value := range input {
+			if value == nil {
+				continue
+			}
+			fieldPath := key
+			if path != "" {
+				fieldPath = path + "." + key
+			}
+			modelValue, ok := modelMap[key]
+			if !ok {
+				// encoding/json matches field names regardless of case
+				for name, v := range modelMap {
+					if strings.EqualFold(name, key) {
+						modelValue, ok = v, true
+						break
+					}
+				}
+			}
+			if !ok {
+				unknown = append(unknown, fieldPath)
+				continue
+			}
+			unknown = append(unknown, unknownFields(value, modelValue, fieldPath)...)
+		}
+	case []any:
+		modelList, _ := model.([]any)
+		for i, value := range input {
+			if i < len(modelList) {
+				unknown = append(unknown, unknownFields(value, modelList[i], fmt.Sprintf("%s[%d]", path, i))...)
+			}
+		}
+	}
+	sort.Strings(unknown)
+	return unknown
+}
+
+// yamlValue converts a YAML node to the value encoding/json writes for it. Scalars keep their
+// text, so dates and amounts reach the model as written.
+func yamlValue(node *yaml.Node) (any, error) {
+	switch node.Kind {
+	case yaml.DocumentNode:
+		if len(node.Content) == 0 {
+			return nil, nil
+		}
+		return yamlValue(node.Content[0])
+	case yaml.AliasNode:
+		return yamlValue(node.Alias)
+	case yaml.MappingNode:
+		mapping := make(map[string]any, len(node.Content)/2)
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			value, err := yamlValue(node.Content[i+1])
+			if err != nil {
+				return nil, err
+			}
+			mapping[node.Content[i].Value] = value
+		}
+		return mapping, nil
+	case yaml.SequenceNode:
+		sequence := make([]any, 0, len(node.Content))
+		for _, item := range node.Content {
+			value, err := yamlValue(item)
+			if err != nil {
+				return nil, err
+			}
+			sequence = append(sequence, value)
+		}
+		return sequence, nil
+	}
+
+	switch node.ShortTag() {
+	case "!!null":
+		return nil, nil
+	case "!!bool":
+		var b bool
+		err := node.Decode(&b)
+		return b, err
+	case "!!int", "!!float":
+		if json.Valid([]byte(node.Value)) {
+			return json.Number(node.Value), nil
+		}
+		var f float64
+		if err := node.Decode(&f); err != nil {
+			return nil, err
+		}
+		return f, nil
+	default:
+		return node.Value, nil
+	}
+}
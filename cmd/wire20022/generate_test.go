@@ -0,0 +1,138 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/moov-io/wire20022/pkg/messages"
+	CustomerCreditTransferModel "github.com/moov-io/wire20022/pkg/models/CustomerCreditTransfer"
+)
+
+func TestRunGenerate(t *testing.T) {
+	dir := t.TempDir()
+	sample, err := json.Marshal(CustomerCreditTransferModel.CustomerCreditTransferDataModel())
+	require.NoError(t, err)
+	input := filepath.Join(dir, "payment.json")
+	require.NoError(t, os.WriteFile(input, sample, 0o644))
+
+	// read checks the output is a valid message of the version asked for
+	read := func(t *testing.T, data []byte, version string) *messages.ParsedMessage {
+		t.Helper()
+		require.True(t, strings.HasPrefix(string(data), xml.Header))
+		parsed, err := messages.NewUniversalReader().ReadBytes(data)
+		require.NoError(t, err)
+		assert.Equal(t, version, parsed.Message.Version())
+		return parsed
+	}
+
+	t.Run("from JSON", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+		code := runGenerate([]string{"-type", "CustomerCreditTransfer", "-version", "pacs.008.001.08", input}, nil, &stdout, &stderr)
+		require.Equal(t, 0, code, stderr.String())
+		parsed := read(t, stdout.Bytes(), "pacs.008.001.08")
+		assert.Equal(t, "20250310B1QDRCQR000001", parsed.Message.GetMessageId())
+	})
+
+	t.Run("from YAML over the template", func(t *testing.T) {
+		yamlInput := "messageId: QA20250310000001\ntransactions:\n  - debtorName: QA Corporation\n    interBankSettAmount:\n      Currency: USD\n      Amount: 1000.10\n"
+		output := filepath.Join(dir, "out", "payment.xml")
+
+		var stdout, stderr bytes.Buffer
+		code := runGenerate([]string{"-type", "CustomerCreditTransfer", "-version", "pacs.008.001.05", "-template", "-o", output, "-"}, strings.NewReader(yamlInput), &stdout, &stderr)
+		require.Equal(t, 0, code, stderr.String())
+
+		data, err := os.ReadFile(output)
+		require.NoError(t, err)
+		message := read(t, data, "pacs.008.001.05").Message.(*CustomerCreditTransferModel.MessageModel)
+		assert.Equal(t, "QA20250310000001", message.MessageId)
+		require.Len(t, message.Transactions, 1)
+		assert.Equal(t, "QA Corporation", message.Transactions[0].DebtorName)
+		assert.Equal(t, "1000.10", message.Transactions[0].InterBankSettAmount.Amount.String())
+		// Fields the input leaves out come from the template
+		assert.Equal(t, "Scenario01EtoEId001", message.Transactions[0].EndToEndId)
+	})
+
+	t.Run("invalid input", func(t *testing.T) {
+		misspelt := filepath.Join(dir, "misspelt.yaml")
+		require.NoError(t, os.WriteFile(misspelt, []byte("transactions:\n  - debtorNme: QA Corporation\n"), 0o644))
+		missing := filepath.Join(dir, "missing.json")
+		require.NoError(t, os.WriteFile(missing, []byte(`{"messageId": "QA20250310000001"}`), 0o644))
+		invalid := filepath.Join(dir, "invalid.yaml")
+		require.NoError(t, os.WriteFile(invalid, []byte("transactions:\n  - instructedAgent:\n      PaymentSysCode: USABA\n      PaymentSysMemberId: \"123456789\"\n"+
+			"    instructedAmount:\n      Currency: XYZ\n      Amount: 10.123\n    debtorIBAN: GB00BAD\n"), 0o644))
+		iban := filepath.Join(dir, "iban.yaml")
+		require.NoError(t, os.WriteFile(iban, []byte("transactions:\n  - debtorIBAN: GB00BAD\n"), 0o644))
+
+		tests := []struct {
+			name string
+			args []string
+			code int
+		}{
+			{"unknown field", []string{"-type", "CustomerCreditTransfer", "-version", "pacs.008.001.08", "-template", misspelt}, 1},
+			{"required field", []string{"-type", "CustomerCreditTransfer", "-version", "pacs.008.001.08", missing}, 1},
+			{"invalid values", []string{"-type", "CustomerCreditTransfer", "-version", "pacs.008.001.08", "-template", invalid}, 1},
+			{"invalid IBAN", []string{"-type", "CustomerCreditTransfer", "-version", "pacs.008.001.08", "-template", iban}, 1},
+			{"unsupported version", []string{"-type", "CustomerCreditTransfer", "-version", "pacs.008.001.99", input}, 1},
+			{"unknown type", []string{"-type", "Payment", "-version", "pacs.008.001.08", input}, 2},
+			{"no template", []string{"-type", "PaymentReturn", "-version", "pacs.004.001.10", "-template"}, 2},
+			{"no input", []string{"-type", "CustomerCreditTransfer", "-version", "pacs.008.001.08"}, 2},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				var stdout, stderr bytes.Buffer
+				assert.Equal(t, tt.code, runGenerate(tt.args, nil, &stdout, &stderr))
+				assert.Empty(t, stdout.String())
+			})
+		}
+	})
+}
+
+func TestMergeJSON(t *testing.T) {
+	base := map[string]any{
+		"messageId":    "A",
+		"transactions": []any{map[string]any{"debtorName": "X", "endToEndId": "E1"}},
+	}
+	overlay := map[string]any{
+		"messageId":    "B",
+		"transactions": []any{map[string]any{"debtorName": "Y"}, map[string]any{"debtorName": "Z"}},
+	}
+
+	assert.Equal(t, map[string]any{
+		"messageId": "B",
+		"transactions": []any{
+			map[string]any{"debtorName": "Y", "endToEndId": "E1"},
+			map[string]any{"debtorName": "Z"},
+		},
+	}, mergeJSON(base, overlay))
+	assert.Equal(t, "X", base["transactions"].([]any)[0].(map[string]any)["debtorName"])
+}
+
+func TestUnknownFields(t *testing.T) {
+	model := map[string]any{"messageId": "A", "transactions": []any{map[string]any{"debtorName": "X"}}}
+	input := map[string]any{
+		"MessageID":    "A",
+		"appHdr":       nil,
+		"note":         "B",
+		"transactions": []any{map[string]any{"debtorName": "X", "debtorNme": "X"}},
+	}
+	assert.Equal(t, []string{"note", "transactions[0].debtorNme"}, unknownFields(input, model, ""))
+}
+
+func TestModelJSON(t *testing.T) {
+	data, err := modelJSON("payment.yaml", []byte("messageId: \"0042\"\ncreatedDateTime: 2025-03-10T09:00:00Z\nnumberOfTransactions: 1\nrate: 1.10\nfinal: true\nnote: ~\n"))
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"messageId": "0042", "createdDateTime": "2025-03-10T09:00:00Z", "numberOfTransactions": 1, "rate": 1.10, "final": true, "note": null}`, string(data))
+
+	// JSON is passed through, whatever the file is named
+	data, err = modelJSON("-", []byte(` {"messageId": "0042"}`))
+	require.NoError(t, err)
+	assert.Equal(t, ` {"messageId": "0042"}`, string(data))
+}
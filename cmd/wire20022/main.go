@@ -13,7 +13,9 @@ import (
 	"strings"
 	"time"
 
+	wirerrors "github.com/moov-io/wire20022/pkg/errors"
 	"github.com/moov-io/wire20022/pkg/messages"
+	"github.com/moov-io/wire20022/pkg/rules"
 )
 
 // ValidationResult represents the result of validating a single file
@@ -25,7 +27,9 @@ type ValidationResult struct {
 	DetectionInfo  messages.DetectionInfo `json:"detectionInfo"`
 	ValidationTime time.Duration          `json:"validationTime"`
 	Error          string                 `json:"error,omitempty"`
+	Location       string                 `json:"location,omitempty"` // file:line:column of the failing element, if known
 	ErrorDetails   map[string]string      `json:"errorDetails,omitempty"`
+	Violations     []rules.Violation      `json:"violations,omitempty"` // Fedwire Funds business rules broken, with -rules
 }
 
 // BatchResult represents the results of validating multiple files
@@ -46,6 +50,12 @@ var (
 	maxErrors  int
 	showHelp   bool
 	version    bool
+
+	checkRules   bool
+	businessDate string
+	ruleOptions  rules.Options
+
+	structureAddresses bool
 )
 
 func init() {
@@ -56,12 +66,28 @@ func init() {
 	flag.BoolVar(&recursive, "r", false, "Recursively process directories (shorthand)")
 	flag.StringVar(&pattern, "pattern", "*.xml", "File pattern to match (e.g., '*.xml', 'pacs.008*')")
 	flag.IntVar(&maxErrors, "max-errors", 0, "Maximum number of errors to display (0 = all)")
+	flag.BoolVar(&checkRules, "rules", false, "Also check payment messages against the Fedwire Funds business rules")
+	flag.StringVar(&businessDate, "business-date", "", "Business date for -rules, YYYY-MM-DD (default: the current business day)")
+	flag.BoolVar(&ruleOptions.AddressCompliance, "addresses", false, "Also check postal addresses are structured or hybrid, rule FW014 (implies -rules)")
 	flag.BoolVar(&showHelp, "help", false, "Show help information")
 	flag.BoolVar(&showHelp, "h", false, "Show help information (shorthand)")
 	flag.BoolVar(&version, "version", false, "Show version information")
 }
 
 func main() {
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "convert":
+			os.Exit(runConvert(os.Args[2:], os.Stdout, os.Stderr))
+		case "generate":
+			os.Exit(runGenerate(os.Args[2:], os.Stdin, os.Stdout, os.Stderr))
+		case "diff":
+			os.Exit(runDiff(os.Args[2:], os.Stdout, os.Stderr))
+		case "serve":
+			os.Exit(runServe(os.Args[2:], os.Stderr))
+		}
+	}
+
 	flag.Parse()
 
 	if version {
@@ -77,6 +103,16 @@ func main() {
 		os.Exit(1)
 	}
 
+	if ruleOptions.AddressCompliance {
+		checkRules = true
+	}
+	if businessDate != "" {
+		if err := ruleOptions.BusinessDate.UnmarshalText([]byte(businessDate)); err != nil {
+			printError(fmt.Sprintf("invalid -business-date %q, expected YYYY-MM-DD", businessDate))
+			os.Exit(1)
+		}
+	}
+
 	reader := messages.NewUniversalReader()
 
 	// Process all arguments
@@ -141,6 +177,10 @@ func printVersion() {
 func printHelp() {
 	fmt.Println("wire20022 - Fedwire ISO20022 Message Processing Tool")
 	fmt.Println("\nUsage: wire20022 [options] <file|directory> [<file|directory>...]")
+	fmt.Println("       wire20022 convert [options] <file|directory> [<file|directory>...]")
+	fmt.Println("       wire20022 generate -type <type> -version <version> [options] [<input.json|input.yaml>]")
+	fmt.Println("       wire20022 diff [options] <a.xml> <b.xml>")
+	fmt.Println("       wire20022 serve")
 	fmt.Println("\nThis tool automatically detects and validates Fedwire ISO20022 message files.")
 	fmt.Println("It provides detailed error reporting to help debug parsing and validation issues.")
 	fmt.Println("\nOptions:")
@@ -151,8 +191,16 @@ func printHelp() {
 	fmt.Println("  wire20022 -json *.xml                    # Validate multiple files, output JSON")
 	fmt.Println("  wire20022 -r messages/                   # Recursively validate directory")
 	fmt.Println("  wire20022 -pattern 'pacs.008*' samples/  # Validate only pacs.008 files")
+	fmt.Println("  wire20022 -rules payment.xml             # Also check the Fedwire Funds business rules")
+	fmt.Println("  wire20022 -addresses payment.xml         # Also flag unstructured or incomplete addresses")
+	fmt.Println("  wire20022 convert -to pacs.008.001.05 payment.xml  # Convert to another version")
+	fmt.Println("  wire20022 convert -help                  # Show conversion options")
+	fmt.Println("  wire20022 generate -type CustomerCreditTransfer -version pacs.008.001.08 payment.json")
+	fmt.Println("  wire20022 diff sent.xml resent.xml       # Compare two messages field by field")
+	fmt.Println("  wire20022 serve                          # Serve parse, validate, create and convert over HTTP")
 	fmt.Println("\nSupported Message Types:")
 	fmt.Println("  - CustomerCreditTransfer (pacs.008)")
+	fmt.Println("  - FICreditTransfer (pacs.009)")
 	fmt.Println("  - PaymentReturn (pacs.004)")
 	fmt.Println("  - PaymentStatusRequest (pacs.028)")
 	fmt.Println("  - FedwireFundsPaymentStatus (pacs.002)")
@@ -163,15 +211,17 @@ func printHelp() {
 	fmt.Println("  - EndpointDetailsReport (camt.052/090)")
 	fmt.Println("  - EndpointGapReport (camt.052/087)")
 	fmt.Println("  - EndpointTotalsReport (camt.052/089)")
+	fmt.Println("  - ReturnRequest (camt.056)")
 	fmt.Println("  - ReturnRequestResponse (camt.029)")
+	fmt.Println("  - InvestigationRequest (camt.110)")
+	fmt.Println("  - InvestigationResponse (camt.111)")
 	fmt.Println("  - ConnectionCheck (admi.001/004)")
 	fmt.Println("  - FedwireFundsAcknowledgement (admi.004/007)")
 	fmt.Println("  - FedwireFundsSystemResponse (admi.011)")
+	fmt.Println("  - MessageReject (admi.002)")
+	fmt.Println("  - RetrievalRequest (admi.006)")
 	fmt.Println("  - Master (camt.052)")
-	fmt.Println("\nFuture Features:")
-	fmt.Println("  - Message format conversion between versions")
-	fmt.Println("  - HTTP server mode for message processing")
-	fmt.Println("  - Message generation from templates")
+	fmt.Println("  Each message may be preceded by a head.001 business application header (AppHdr)")
 }
 
 func processFile(reader *messages.UniversalReader, filepath string) ValidationResult {
@@ -194,6 +244,9 @@ func processFile(reader *messages.UniversalReader, filepath string) ValidationRe
 	if err != nil {
 		result.Error = fmt.Sprintf("Failed to parse: %v", err)
 		result.ValidationTime = time.Since(startTime)
+		if location, ok := wirerrors.LocationOf(err); ok {
+			result.Location = fmt.Sprintf("%s:%s", filepath, location)
+		}
 
 		// Extract detailed error information if available
 		if verbose {
@@ -221,6 +274,18 @@ func processFile(reader *messages.UniversalReader, filepath string) ValidationRe
 		result.Success = true
 	}
 
+	// Check the business rules of message types that have them
+	if checkRules && result.Success {
+		if violations, err := rules.Check(parsed.Message, ruleOptions); err == nil && len(violations) > 0 {
+			result.Success = false
+			result.Violations = violations
+			result.Error = "Business rules failed: 1 violation"
+			if len(violations) > 1 {
+				result.Error = fmt.Sprintf("Business rules failed: %d violations", len(violations))
+			}
+		}
+	}
+
 	result.ValidationTime = time.Since(startTime)
 	return result
 }
@@ -230,6 +295,28 @@ func processDirectory(reader *messages.UniversalReader, dirPath string) BatchRes
 		MessageTypeCounts: make(map[string]int),
 	}
 
+	walkFiles(dirPath, func(path string) bool {
+		fileResult := processFile(reader, path)
+		result.Results = append(result.Results, fileResult)
+		result.TotalFiles++
+
+		if fileResult.Success {
+			result.SuccessCount++
+			result.MessageTypeCounts[string(fileResult.MessageType)]++
+		} else {
+			result.FailureCount++
+		}
+
+		// Stop if we've hit max errors
+		return maxErrors == 0 || result.FailureCount < maxErrors
+	})
+
+	return result
+}
+
+// walkFiles calls visit for each file in dirPath matching pattern, descending into
+// subdirectories with -recursive, until visit returns false
+func walkFiles(dirPath string, visit func(path string) bool) {
 	walkFn := func(path string, info os.FileInfo, err error) error {
 		if err != nil {
 			printError(fmt.Sprintf("Error accessing %s: %v", path, err))
@@ -247,23 +334,9 @@ func processDirectory(reader *messages.UniversalReader, dirPath string) BatchRes
 			return nil
 		}
 
-		// Process file
-		fileResult := processFile(reader, path)
-		result.Results = append(result.Results, fileResult)
-		result.TotalFiles++
-
-		if fileResult.Success {
-			result.SuccessCount++
-			result.MessageTypeCounts[string(fileResult.MessageType)]++
-		} else {
-			result.FailureCount++
-		}
-
-		// Stop if we've hit max errors
-		if maxErrors > 0 && result.FailureCount >= maxErrors {
-			return filepath.SkipDir
+		if !visit(path) {
+			return filepath.SkipAll
 		}
-
 		return nil
 	}
 
@@ -274,17 +347,19 @@ func processDirectory(reader *messages.UniversalReader, dirPath string) BatchRes
 		files, err := os.ReadDir(dirPath)
 		if err != nil {
 			printError(fmt.Sprintf("Error reading directory %s: %v", dirPath, err))
-			return result
+			return
 		}
 
 		for _, file := range files {
-			if !file.IsDir() {
-				walkFn(filepath.Join(dirPath, file.Name()), nil, nil)
+			if file.IsDir() {
+				continue
+			}
+			info, err := file.Info()
+			if walkFn(filepath.Join(dirPath, file.Name()), info, err) == filepath.SkipAll {
+				return
 			}
 		}
 	}
-
-	return result
 }
 
 func extractErrorDetails(err error) map[string]string {
@@ -315,6 +390,8 @@ func extractErrorDetails(err error) map[string]string {
 			details["validation_reason"] = strings.TrimPrefix(line, "Validation reason:")
 		} else if strings.Contains(line, "Detection method:") {
 			details["detection_method"] = strings.TrimPrefix(line, "Detection method:")
+		} else if strings.HasPrefix(line, "Location:") {
+			details["location"] = strings.TrimPrefix(line, "Location:")
 		}
 	}
 
@@ -354,7 +431,13 @@ func outputHuman(result BatchResult) {
 			if !r.Success {
 				failedCount++
 				fmt.Printf("\n[%d] File: %s\n", failedCount, r.File)
+				if r.Location != "" {
+					fmt.Printf("    Location: %s\n", r.Location)
+				}
 				fmt.Printf("    Error: %s\n", r.Error)
+				for _, v := range r.Violations {
+					fmt.Printf("      %s %s: %s\n", v.Rule, v.Field, v.Reason)
+				}
 
 				if verbose && len(r.ErrorDetails) > 0 {
 					fmt.Printf("    Details:\n")
@@ -0,0 +1,406 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"sync/atomic"
+	"syscall"
+	"time"
+
+	"github.com/moov-io/base/config"
+	"github.com/moov-io/base/log"
+
+	"github.com/moov-io/wire20022"
+	wirerrors "github.com/moov-io/wire20022/pkg/errors"
+	"github.com/moov-io/wire20022/pkg/messages"
+)
+
+// GlobalConfig is the configuration file of the serve command
+type GlobalConfig struct {
+	Wire20022 ServeConfig
+}
+
+// ServeConfig configures the HTTP server of the serve command
+type ServeConfig struct {
+	Servers ServersConfig
+
+	// MaxRequestBytes is the largest request body accepted
+	MaxRequestBytes int64
+
+	Reader ReaderConfig
+}
+
+// ServersConfig holds the HTTP servers of the serve command
+type ServersConfig struct {
+	Public HTTPConfig
+}
+
+// HTTPConfig configures an HTTP server
+type HTTPConfig struct {
+	BindAddress     string
+	ReadTimeout     time.Duration
+	WriteTimeout    time.Duration
+	ShutdownTimeout time.Duration
+}
+
+// ReaderConfig sets the options of the UniversalReader requests are read with
+type ReaderConfig struct {
+	PreserveUnmapped bool
+	Strict           bool
+}
+
+// loadConfig reads configs/config.default.yml and the files named by APP_CONFIG and
+// APP_CONFIG_SECRETS over it
+func loadConfig(logger log.Logger) (*ServeConfig, error) {
+	var global GlobalConfig
+	service := config.NewService(logger)
+	if err := service.LoadFromFS(&global, wire20022.ConfigDefaults); err != nil {
+		return nil, err
+	}
+	return &global.Wire20022, nil
+}
+
+// ErrorDetail describes one error of a request
+type ErrorDetail struct {
+	Message  string              `json:"message"`
+	Field    string              `json:"field,omitempty"`
+	Reason   string              `json:"reason,omitempty"`
+	Location *wirerrors.Location `json:"location,omitempty"` // position in the posted XML, if known
+}
+
+// ErrorResponse is the body of every failed request
+type ErrorResponse struct {
+	Errors []ErrorDetail `json:"errors"`
+}
+
+// ParseResponse is the body of a successful POST /parse
+type ParseResponse struct {
+	Type      messages.MessageType   `json:"type"`
+	Version   string                 `json:"version"`
+	Detection messages.DetectionInfo `json:"detection"`
+	Message   messages.Message       `json:"message"`
+}
+
+// ValidateResponse is the body of POST /validate
+type ValidateResponse struct {
+	Valid   bool                 `json:"valid"`
+	Type    messages.MessageType `json:"type,omitempty"`
+	Version string               `json:"version,omitempty"`
+	Errors  []ErrorDetail        `json:"errors,omitempty"`
+}
+
+// ConvertResponse is the body of a successful POST /convert
+type ConvertResponse struct {
+	ConversionResult
+	Document string `json:"document"`
+}
+
+// server handles the requests of the serve command
+type server struct {
+	reader          *messages.UniversalReader
+	maxRequestBytes int64
+	ready           atomic.Bool
+}
+
+func newServer(cfg *ServeConfig) *server {
+	reader := messages.NewUniversalReader()
+	reader.PreserveUnmapped = cfg.Reader.PreserveUnmapped
+	reader.Strict = cfg.Reader.Strict
+	return &server{
+		reader:          reader,
+		maxRequestBytes: cfg.MaxRequestBytes,
+	}
+}
+
+// handler routes the endpoints of the server
+func (s *server) handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /health", s.health)
+	mux.HandleFunc("GET /ready", s.readiness)
+	mux.HandleFunc("POST /parse", s.parse)
+	mux.HandleFunc("POST /validate", s.validate)
+	mux.HandleFunc("POST /create", s.create)
+	mux.HandleFunc("POST /convert", s.convert)
+	return mux
+}
+
+// health reports that the process is up
+func (s *server) health(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]string{"status": "ok"})
+}
+
+// readiness reports whether the server accepts requests; it stops once shutdown begins
+func (s *server) readiness(w http.ResponseWriter, _ *http.Request) {
+	if !s.ready.Load() {
+		writeJSON(w, http.StatusServiceUnavailable, map[string]string{"status": "unavailable"})
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]string{"status": "ready"})
+}
+
+// parse detects the type of the posted XML and returns its model as JSON
+func (s *server) parse(w http.ResponseWriter, r *http.Request) {
+	data, ok := s.readBody(w, r)
+	if !ok {
+		return
+	}
+	parsed, err := s.reader.ReadBytes(data)
+	if err != nil {
+		writeError(w, http.StatusUnprocessableEntity, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, ParseResponse{
+		Type:      parsed.Type,
+		Version:   parsed.Version,
+		Detection: parsed.Detection,
+		Message:   parsed.Message,
+	})
+}
+
+// validate parses the posted XML and checks it against the version it was detected as
+func (s *server) validate(w http.ResponseWriter, r *http.Request) {
+	data, ok := s.readBody(w, r)
+	if !ok {
+		return
+	}
+	parsed, err := s.reader.ReadBytes(data)
+	if err != nil {
+		writeJSON(w, http.StatusOK, ValidateResponse{Errors: errorDetails(err)})
+		return
+	}
+
+	response := ValidateResponse{Type: parsed.Type, Version: parsed.Version}
+	if err := s.reader.ValidateMessage(parsed); err != nil {
+		response.Errors = errorDetails(err)
+	} else {
+		response.Valid = true
+	}
+	writeJSON(w, http.StatusOK, response)
+}
+
+// create builds the XML document of the posted model JSON or YAML. The query names the
+// message type and version, e.g. ?type=CustomerCreditTransfer&version=pacs.008.001.08, and
+// template=true starts from the type's built-in sample model.
+func (s *server) create(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	msgType, version := query.Get("type"), query.Get("version")
+	if msgType == "" || version == "" {
+		writeError(w, http.StatusBadRequest, errors.New("type and version query parameters are required"))
+		return
+	}
+	gen, ok := generators[messages.MessageType(msgType)]
+	if !ok {
+		writeError(w, http.StatusBadRequest, fmt.Errorf("unknown message type %q", msgType))
+		return
+	}
+	template := query.Get("template") == "true"
+	if template && !gen.hasTemplate {
+		writeError(w, http.StatusBadRequest, fmt.Errorf("no built-in template for %s", msgType))
+		return
+	}
+
+	data, ok := s.readBody(w, r)
+	if !ok {
+		return
+	}
+	var input []byte
+	if len(strings.TrimSpace(string(data))) > 0 {
+		name := "request.json"
+		if strings.Contains(r.Header.Get("Content-Type"), "yaml") {
+			name = "request.yaml"
+		}
+		var err error
+		if input, err = modelJSON(name, data); err != nil {
+			writeError(w, http.StatusBadRequest, err)
+			return
+		}
+	} else if !template {
+		writeError(w, http.StatusBadRequest, errors.New("request body is empty"))
+		return
+	}
+
+	document, err := gen.generate(input, template, version)
+	if err != nil {
+		writeError(w, http.StatusUnprocessableEntity, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(http.StatusOK)
+	io.WriteString(w, xml.Header)
+	w.Write(document)
+}
+
+// convert converts the posted XML to the version in the query, e.g. ?version=pacs.008.001.05,
+// and returns the document with the fields the version could not carry
+func (s *server) convert(w http.ResponseWriter, r *http.Request) {
+	version := r.URL.Query().Get("version")
+	if version == "" {
+		writeError(w, http.StatusBadRequest, errors.New("version query parameter is required"))
+		return
+	}
+	targets, err := parseTargets(version)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	data, ok := s.readBody(w, r)
+	if !ok {
+		return
+	}
+	result, document, err := convertData(s.reader, data, targets, "xml")
+	if err != nil {
+		writeError(w, http.StatusUnprocessableEntity, err)
+		return
+	}
+	result.Success = true
+	writeJSON(w, http.StatusOK, ConvertResponse{ConversionResult: result, Document: string(document)})
+}
+
+// readBody reads a request body of at most MaxRequestBytes, answering the request if it cannot
+func (s *server) readBody(w http.ResponseWriter, r *http.Request) ([]byte, bool) {
+	body := r.Body
+	if s.maxRequestBytes > 0 {
+		body = http.MaxBytesReader(w, r.Body, s.maxRequestBytes)
+	}
+	data, err := io.ReadAll(body)
+	if err != nil {
+		status := http.StatusBadRequest
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			status = http.StatusRequestEntityTooLarge
+		}
+		writeError(w, status, err)
+		return nil, false
+	}
+	return data, true
+}
+
+// errorDetails lists the errors in err, one for each error combined with errors.Join
+func errorDetails(err error) []ErrorDetail {
+	var joined interface{ Unwrap() []error }
+	if errors.As(err, &joined) {
+		var details []ErrorDetail
+		for _, e := range joined.Unwrap() {
+			details = append(details, errorDetails(e)...)
+		}
+		return details
+	}
+
+	detail := ErrorDetail{Message: err.Error()}
+	var validationErr *wirerrors.ValidationError
+	var fieldErr *wirerrors.FieldError
+	if errors.As(err, &validationErr) {
+		detail.Field = validationErr.Field
+		detail.Reason = validationErr.Reason
+	} else if errors.As(err, &fieldErr) {
+		detail.Field = fieldErr.Path
+	}
+	if location, ok := wirerrors.LocationOf(err); ok {
+		detail.Location = &location
+	}
+	return []ErrorDetail{detail}
+}
+
+func writeError(w http.ResponseWriter, status int, err error) {
+	writeJSON(w, status, ErrorResponse{Errors: errorDetails(err)})
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	encoder.Encode(body)
+}
+
+func runServe(args []string, stderr io.Writer) int {
+	fs := flag.NewFlagSet("serve", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.Usage = func() {
+		fmt.Fprintln(stderr, "Usage: wire20022 serve")
+		fmt.Fprintln(stderr, "\nServes the message processing of wire20022 over HTTP. Settings are read from")
+		fmt.Fprintln(stderr, "configs/config.default.yml, overridden by the files named by APP_CONFIG and")
+		fmt.Fprintln(stderr, "APP_CONFIG_SECRETS.")
+		fmt.Fprintln(stderr, "\nEndpoints:")
+		fmt.Fprintln(stderr, "  GET  /health                              Liveness")
+		fmt.Fprintln(stderr, "  GET  /ready                               Readiness; unavailable once shutdown begins")
+		fmt.Fprintln(stderr, "  POST /parse                               XML in, detected type and message model JSON out")
+		fmt.Fprintln(stderr, "  POST /validate                            XML in, validation result with structured errors out")
+		fmt.Fprintln(stderr, "  POST /create?type=<type>&version=<version>  Model JSON or YAML in, XML out")
+		fmt.Fprintln(stderr, "  POST /convert?version=<version>           XML in, converted XML and dropped fields out")
+	}
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return 0
+		}
+		return 2
+	}
+	if fs.NArg() > 0 {
+		fs.Usage()
+		return 2
+	}
+
+	logger := log.NewDefaultLogger()
+	cfg, err := loadConfig(logger)
+	if err != nil {
+		logger.LogErrorf("loading config: %v", err)
+		return 1
+	}
+
+	s := newServer(cfg)
+	public := cfg.Servers.Public
+	httpServer := &http.Server{
+		Addr:         public.BindAddress,
+		Handler:      s.handler(),
+		ReadTimeout:  public.ReadTimeout,
+		WriteTimeout: public.WriteTimeout,
+	}
+
+	// Bind before reporting ready, so /ready never answers for a port that is not accepting
+	listener, err := net.Listen("tcp", public.BindAddress)
+	if err != nil {
+		logger.LogErrorf("listening: %v", err)
+		return 1
+	}
+	logger.Info().Logf("listening on %s", listener.Addr())
+	s.ready.Store(true)
+
+	errs := make(chan error, 1)
+	go func() {
+		errs <- httpServer.Serve(listener)
+	}()
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM)
+	select {
+	case err := <-errs:
+		logger.LogErrorf("serving: %v", err)
+		return 1
+	case sig := <-signals:
+		logger.Info().Logf("received %v, shutting down", sig)
+	}
+
+	s.ready.Store(false)
+	ctx, cancel := context.WithTimeout(context.Background(), public.ShutdownTimeout)
+	defer cancel()
+	if err := httpServer.Shutdown(ctx); err != nil {
+		logger.LogErrorf("shutting down: %v", err)
+		return 1
+	}
+	return 0
+}
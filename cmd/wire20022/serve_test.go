@@ -0,0 +1,160 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/moov-io/base/log"
+
+	"github.com/moov-io/wire20022/pkg/messages"
+)
+
+func TestLoadConfig(t *testing.T) {
+	cfg, err := loadConfig(log.NewTestLogger())
+	require.NoError(t, err)
+	assert.Equal(t, ":8088", cfg.Servers.Public.BindAddress)
+	assert.Equal(t, 30*time.Second, cfg.Servers.Public.ReadTimeout)
+	assert.Equal(t, int64(10<<20), cfg.MaxRequestBytes)
+	assert.True(t, cfg.Reader.PreserveUnmapped)
+
+	t.Run("APP_CONFIG", func(t *testing.T) {
+		file := filepath.Join(t.TempDir(), "config.yml")
+		require.NoError(t, os.WriteFile(file, []byte("Wire20022:\n  Servers:\n    Public:\n      BindAddress: \":9000\"\n"), 0o644))
+		t.Setenv("APP_CONFIG", file)
+
+		cfg, err := loadConfig(log.NewTestLogger())
+		require.NoError(t, err)
+		assert.Equal(t, ":9000", cfg.Servers.Public.BindAddress)
+		assert.Equal(t, 30*time.Second, cfg.Servers.Public.ReadTimeout)
+	})
+}
+
+func TestRunServe(t *testing.T) {
+	// An address already in use fails before the server reports ready
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+
+	file := filepath.Join(t.TempDir(), "config.yml")
+	require.NoError(t, os.WriteFile(file, []byte("Wire20022:\n  Servers:\n    Public:\n      BindAddress: \""+listener.Addr().String()+"\"\n"), 0o644))
+	t.Setenv("APP_CONFIG", file)
+
+	var stderr bytes.Buffer
+	assert.Equal(t, 1, runServe(nil, &stderr))
+}
+
+func TestServer(t *testing.T) {
+	s := newServer(&ServeConfig{MaxRequestBytes: 1 << 20})
+	handler := s.handler()
+
+	sample, err := os.ReadFile(pacs008Sample)
+	require.NoError(t, err)
+
+	do := func(method, target, contentType string, body []byte) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, target, bytes.NewReader(body))
+		if contentType != "" {
+			req.Header.Set("Content-Type", contentType)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	t.Run("health and readiness", func(t *testing.T) {
+		assert.Equal(t, http.StatusOK, do("GET", "/health", "", nil).Code)
+		assert.Equal(t, http.StatusServiceUnavailable, do("GET", "/ready", "", nil).Code)
+		s.ready.Store(true)
+		defer s.ready.Store(false)
+		assert.Equal(t, http.StatusOK, do("GET", "/ready", "", nil).Code)
+	})
+
+	t.Run("parse", func(t *testing.T) {
+		rec := do("POST", "/parse", "application/xml", sample)
+		require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+		var response struct {
+			Type    messages.MessageType
+			Version string
+			Message map[string]any
+		}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+		assert.Equal(t, messages.TypeCustomerCreditTransfer, response.Type)
+		assert.Equal(t, "001.08", response.Version)
+		assert.Equal(t, "20250310B1QDRCQR000001", response.Message["messageId"])
+
+		rec = do("POST", "/parse", "application/xml", []byte("<Document"))
+		assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+		var errResponse ErrorResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &errResponse))
+		require.NotEmpty(t, errResponse.Errors)
+	})
+
+	t.Run("validate", func(t *testing.T) {
+		var response ValidateResponse
+		rec := do("POST", "/validate", "application/xml", sample)
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+		assert.True(t, response.Valid)
+		assert.Empty(t, response.Errors)
+
+		invalid := bytes.Replace(sample, []byte("<MsgId>20250310B1QDRCQR000001</MsgId>"), nil, 1)
+		require.NotEqual(t, sample, invalid)
+		rec = do("POST", "/validate", "application/xml", invalid)
+		require.Equal(t, http.StatusOK, rec.Code)
+		response = ValidateResponse{}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+		assert.False(t, response.Valid, rec.Body.String())
+		require.Len(t, response.Errors, 1)
+		assert.Equal(t, "MessageId", response.Errors[0].Field)
+	})
+
+	t.Run("create", func(t *testing.T) {
+		rec := do("POST", "/create?type=CustomerCreditTransfer&version=pacs.008.001.08&template=true", "application/yaml", []byte("messageId: QA20250310000001\n"))
+		require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+		assert.Equal(t, "application/xml", rec.Header().Get("Content-Type"))
+
+		parsed, err := messages.NewUniversalReader().ReadBytes(rec.Body.Bytes())
+		require.NoError(t, err)
+		assert.Equal(t, "QA20250310000001", parsed.Message.GetMessageId())
+
+		rec = do("POST", "/create?type=CustomerCreditTransfer&version=pacs.008.001.08", "application/json", []byte(`{"messageId": "QA20250310000001"}`))
+		assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+		rec = do("POST", "/create?type=Payment&version=pacs.008.001.08", "application/json", []byte(`{}`))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		rec = do("POST", "/create", "application/json", []byte(`{}`))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("convert", func(t *testing.T) {
+		rec := do("POST", "/convert?version=pacs.008.001.05", "application/xml", sample)
+		require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+		var response ConvertResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+		assert.Equal(t, "pacs.008.001.08", response.From)
+		assert.Equal(t, "pacs.008.001.05", response.To)
+		assert.NotEmpty(t, response.Dropped)
+		assert.Contains(t, response.Document, "urn:iso:std:iso:20022:tech:xsd:pacs.008.001.05")
+
+		rec = do("POST", "/convert?version=pacs.004.001.10", "application/xml", sample)
+		assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+		rec = do("POST", "/convert", "application/xml", sample)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("request too large", func(t *testing.T) {
+		rec := do("POST", "/parse", "application/xml", []byte(strings.Repeat(" ", 2<<20)))
+		assert.Equal(t, http.StatusRequestEntityTooLarge, rec.Code)
+	})
+}
@@ -0,0 +1,9 @@
+package wire20022
+
+import "embed"
+
+// ConfigDefaults holds configs/config.default.yml, the default configuration of the
+// wire20022 serve command
+//
+//go:embed configs/config.default.yml
+var ConfigDefaults embed.FS
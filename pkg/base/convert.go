@@ -0,0 +1,307 @@
+package base
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/moov-io/wire20022/pkg/models"
+)
+
+// ConversionReport lists what converting a message to another version changed
+type ConversionReport struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+
+	// Dropped lists the values the target version has no element for
+	Dropped []FieldChange `json:"dropped,omitempty"`
+
+	// Defaulted lists the version-specific field groups and values added for the target version
+	Defaulted []FieldChange `json:"defaulted,omitempty"`
+}
+
+// FieldChange is a model field a conversion dropped or filled in
+type FieldChange struct {
+	// Field is the model path, e.g. "Transactions[0].TaxId", or for preserved content the XPath
+	// of the element, e.g. "/Document[1]/FIToFICstmrCdtTrf[1]/CdtTrfTxInf[1]/RgltryRptg[1]"
+	Field string `json:"field"`
+	// Value is the value dropped or filled in, empty for a field group
+	Value string `json:"value,omitempty"`
+}
+
+// Lossless reports whether the conversion kept every value of the message
+func (r *ConversionReport) Lossless() bool {
+	return len(r.Dropped) == 0
+}
+
+// CarryUnmapped returns the content a message preserved from its document, for a document of
+// version to. Elements the target version has are moved to its namespace; each one it has not is
+// dropped and added to the report with its XPath as the field and its XML as the value.
+func (p *MessageProcessor[M, V]) CarryUnmapped(report *ConversionReport, unmapped *models.Unmapped, to V) (*models.Unmapped, error) {
+	doc, namespace, err := p.newDocument(to)
+	if err != nil {
+		return nil, err
+	}
+	carried, dropped, err := unmapped.Carry(doc, namespace)
+	if err != nil {
+		return nil, err
+	}
+	for _, element := range dropped {
+		report.Dropped = append(report.Dropped, FieldChange{Field: element.XPath, Value: element.XML})
+	}
+	return carried, nil
+}
+
+// ConvertMessage returns a copy of message, a message of version from, for version to. Values
+// that no element of the target version holds are cleared, and with a message factory set (see
+// SetMessageFactory) the version-specific field groups are added or removed to match the
+// target version. The message itself is left unchanged.
+func (p *MessageProcessor[M, V]) ConvertMessage(message M, from, to V) (M, *ConversionReport, error) {
+	var result M
+	if _, exists := p.pathMaps[from]; !exists {
+		return result, nil, fmt.Errorf("unsupported version %v", from)
+	}
+	targetMap, exists := p.pathMaps[to]
+	if !exists {
+		return result, nil, fmt.Errorf("unsupported version %v", to)
+	}
+
+	report := &ConversionReport{From: fmt.Sprint(from), To: fmt.Sprint(to)}
+	result = deepCopy(reflect.ValueOf(message)).Interface().(M)
+	root := reflect.ValueOf(&result).Elem()
+
+	// Every field some version maps, less those the target version maps
+	target := models.RemakeMapping(&result, targetMap, false)
+	var dropped []string
+	seen := make(map[string]bool)
+	for _, pathMap := range p.pathMaps {
+		for modelPath := range models.RemakeMapping(&result, pathMap, false) {
+			if _, kept := target[modelPath]; !kept && !seen[modelPath] {
+				seen[modelPath] = true
+				dropped = append(dropped, modelPath)
+			}
+		}
+	}
+	sort.Strings(dropped)
+
+	emptied := make(map[string]bool)
+	for _, modelPath := range dropped {
+		field, ok := lookupField(root, modelPath)
+		if !ok || field.IsZero() {
+			continue
+		}
+		report.Dropped = append(report.Dropped, FieldChange{Field: modelPath, Value: fmt.Sprint(field.Interface())})
+		field.Set(reflect.Zero(field.Type()))
+		if i := strings.LastIndex(modelPath, "["); i > 0 {
+			emptied[modelPath[:i]] = true
+		}
+	}
+
+	// Lists none of whose values the target version holds are removed
+	for slicePath := range emptied {
+		if field, ok := lookupField(root, slicePath); ok && allZero(field) {
+			field.Set(reflect.Zero(field.Type()))
+		}
+	}
+
+	if p.newMessage != nil {
+		groups := make(map[reflect.Type]bool)
+		for version := range p.pathMaps {
+			collectGroups(reflect.ValueOf(p.newMessage(version)), groups)
+		}
+		syncGroups(root, reflect.ValueOf(p.newMessage(to)), groups, target, "", report)
+	}
+
+	// Values the target version holds in an element that does not accept them, such as a
+	// code added in a later version, are dropped as well
+	doc, _, err := p.newDocument(to)
+	if err != nil {
+		return result, nil, err
+	}
+	target = models.RemakeMapping(&result, targetMap, false)
+	kept := make([]string, 0, len(target))
+	for modelPath := range target {
+		kept = append(kept, modelPath)
+	}
+	sort.Strings(kept)
+	for _, modelPath := range kept {
+		if models.CopyMessageValueToDocument(&result, modelPath, doc, target[modelPath]) == nil {
+			continue
+		}
+		if field, ok := lookupField(root, modelPath); ok && !field.IsZero() {
+			report.Dropped = append(report.Dropped, FieldChange{Field: modelPath, Value: fmt.Sprint(field.Interface())})
+			field.Set(reflect.Zero(field.Type()))
+		}
+	}
+
+	return result, report, nil
+}
+
+// deepCopy copies v along with everything its pointers, slices and maps refer to
+func deepCopy(v reflect.Value) reflect.Value {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.New(v.Type().Elem())
+		c.Elem().Set(deepCopy(v.Elem()))
+		return c
+	case reflect.Slice:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+		for i := 0; i < v.Len(); i++ {
+			c.Index(i).Set(deepCopy(v.Index(i)))
+		}
+		return c
+	case reflect.Map:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.MakeMapWithSize(v.Type(), v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			c.SetMapIndex(iter.Key(), deepCopy(iter.Value()))
+		}
+		return c
+	case reflect.Struct:
+		c := reflect.New(v.Type()).Elem()
+		c.Set(v)
+		for i := 0; i < v.NumField(); i++ {
+			if c.Field(i).CanSet() {
+				c.Field(i).Set(deepCopy(v.Field(i)))
+			}
+		}
+		return c
+	default:
+		return v
+	}
+}
+
+var fieldSegment = regexp.MustCompile(`^(\w+)\[(\d+)\]$`)
+
+// lookupField returns the settable field at a model path such as "Transactions[0].TaxId"
+func lookupField(v reflect.Value, path string) (reflect.Value, bool) {
+	for _, segment := range strings.Split(path, ".") {
+		index := -1
+		if matches := fieldSegment.FindStringSubmatch(segment); matches != nil {
+			segment = matches[1]
+			index, _ = strconv.Atoi(matches[2])
+		}
+		if v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				return reflect.Value{}, false
+			}
+			v = v.Elem()
+		}
+		if v.Kind() != reflect.Struct {
+			return reflect.Value{}, false
+		}
+		v = v.FieldByName(segment)
+		if !v.IsValid() {
+			return reflect.Value{}, false
+		}
+		if index >= 0 {
+			if v.Kind() != reflect.Slice || index >= v.Len() {
+				return reflect.Value{}, false
+			}
+			v = v.Index(index)
+		}
+	}
+	return v, v.CanSet()
+}
+
+// allZero reports whether every element of a list is empty
+func allZero(v reflect.Value) bool {
+	if v.Kind() != reflect.Slice {
+		return false
+	}
+	for i := 0; i < v.Len(); i++ {
+		if !v.Index(i).IsZero() {
+			return false
+		}
+	}
+	return true
+}
+
+// collectGroups records the types of the field groups a version's new message starts with
+func collectGroups(v reflect.Value, groups map[reflect.Type]bool) {
+	switch v.Kind() {
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if !v.Type().Field(i).IsExported() {
+				continue
+			}
+			field := v.Field(i)
+			if field.Kind() == reflect.Ptr && !field.IsNil() && field.Elem().Kind() == reflect.Struct {
+				groups[field.Type()] = true
+			}
+			collectGroups(field, groups)
+		}
+	case reflect.Slice:
+		for i := 0; i < v.Len(); i++ {
+			collectGroups(v.Index(i), groups)
+		}
+	}
+}
+
+// syncGroups adds the field groups template has or the target mapping writes from and v lacks,
+// reporting them, and removes the other groups. The groups of list elements follow the
+// template's first element.
+func syncGroups(v, template reflect.Value, groups map[reflect.Type]bool, target map[string]string, path string, report *ConversionReport) {
+	if v.Kind() != reflect.Struct {
+		return
+	}
+	for i := 0; i < v.NumField(); i++ {
+		field, fieldType := v.Field(i), v.Type().Field(i)
+		if !field.CanSet() {
+			continue
+		}
+		want := template.Field(i)
+		fieldPath := fieldType.Name
+		if path != "" {
+			fieldPath = path + "." + fieldType.Name
+		}
+
+		switch {
+		case groups[field.Type()]:
+			wanted := !want.IsNil() || mapsInto(target, fieldPath)
+			if wanted && field.IsNil() {
+				field.Set(reflect.New(field.Type().Elem()))
+				if !want.IsNil() {
+					field.Set(deepCopy(want))
+				}
+				report.Defaulted = append(report.Defaulted, FieldChange{Field: fieldPath})
+			} else if !wanted && !field.IsNil() {
+				field.Set(reflect.Zero(field.Type()))
+			}
+		case field.Kind() == reflect.Struct:
+			if fieldType.Anonymous {
+				fieldPath = path
+			}
+			syncGroups(field, want, groups, target, fieldPath, report)
+		case field.Kind() == reflect.Slice && field.Type().Elem().Kind() == reflect.Struct:
+			if want.Len() == 0 {
+				continue
+			}
+			for j := 0; j < field.Len(); j++ {
+				syncGroups(field.Index(j), want.Index(0), groups, target, fmt.Sprintf("%s[%d]", fieldPath, j), report)
+			}
+		}
+	}
+}
+
+// mapsInto reports whether the mapping writes from a field of the group at path
+func mapsInto(target map[string]string, path string) bool {
+	for modelPath := range target {
+		if strings.HasPrefix(modelPath, path+".") {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,159 @@
+package base
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/moov-io/wire20022/pkg/models"
+)
+
+// convertDocumentV1 only accepts the settlement methods strictCode knows
+type convertDocumentV1 struct {
+	XMLName   xml.Name   `xml:"test:v1 Document"`
+	MessageId string     `xml:"MsgId"`
+	Note      string     `xml:"Note"`
+	Method    strictCode `xml:"SttlmMtd"`
+}
+
+func (d *convertDocumentV1) Validate() error {
+	return nil
+}
+
+type convertDocumentV2 struct {
+	XMLName   xml.Name `xml:"test:v2 Document"`
+	MessageId string   `xml:"MsgId"`
+	Reference string   `xml:"Ref"`
+	Method    string   `xml:"SttlmMtd"`
+}
+
+func (d *convertDocumentV2) Validate() error {
+	return nil
+}
+
+// referenceFields are available in TestV2 only
+type referenceFields struct {
+	Reference string
+}
+
+type convertMessage struct {
+	MessageId string
+	Note      string
+	Method    string
+	Lines     []string
+	Reference *referenceFields
+}
+
+func newConvertProcessor() *MessageProcessor[convertMessage, TestVersion] {
+	processor := NewMessageProcessor[convertMessage, TestVersion](
+		map[string]models.DocumentFactory{
+			"test:v1": func() models.ISODocument { return &convertDocumentV1{} },
+			"test:v2": func() models.ISODocument { return &convertDocumentV2{} },
+		},
+		map[string]TestVersion{"test:v1": TestV1, "test:v2": TestV2},
+		map[TestVersion]map[string]any{
+			TestV1: {"MessageId": "MessageId", "Note": "Note", "Method": "Method"},
+			TestV2: {"MessageId": "MessageId", "Reference": "Reference.Reference", "Method": "Method"},
+		},
+		[]string{"MessageId"},
+	)
+	processor.SetMessageFactory(func(version TestVersion) convertMessage {
+		if version == TestV2 {
+			return convertMessage{Reference: &referenceFields{}}
+		}
+		return convertMessage{}
+	})
+	return processor
+}
+
+func TestConvertMessage(t *testing.T) {
+	processor := newConvertProcessor()
+
+	t.Run("to a newer version", func(t *testing.T) {
+		message := convertMessage{MessageId: "MSG001", Note: "urgent", Method: "CLRG", Lines: []string{"a"}}
+
+		converted, report, err := processor.ConvertMessage(message, TestV1, TestV2)
+		require.NoError(t, err)
+		assert.Equal(t, "001.01", report.From)
+		assert.Equal(t, "001.02", report.To)
+		assert.Equal(t, []FieldChange{{Field: "Note", Value: "urgent"}}, report.Dropped)
+		assert.Equal(t, []FieldChange{{Field: "Reference"}}, report.Defaulted)
+		assert.False(t, report.Lossless())
+
+		assert.Equal(t, "MSG001", converted.MessageId)
+		assert.Empty(t, converted.Note)
+		assert.Equal(t, "CLRG", converted.Method)
+		require.NotNil(t, converted.Reference)
+
+		// Fields no version maps are kept, and the message is copied rather than changed
+		assert.Equal(t, []string{"a"}, converted.Lines)
+		converted.Lines[0] = "b"
+		assert.Equal(t, "a", message.Lines[0])
+		assert.Equal(t, "urgent", message.Note)
+	})
+
+	t.Run("to an older version", func(t *testing.T) {
+		message := convertMessage{MessageId: "MSG001", Method: "ZZZZ", Reference: &referenceFields{Reference: "REF1"}}
+
+		converted, report, err := processor.ConvertMessage(message, TestV2, TestV1)
+		require.NoError(t, err)
+		// ZZZZ fits the element of TestV2 but not the one of TestV1
+		assert.Equal(t, []FieldChange{
+			{Field: "Reference.Reference", Value: "REF1"},
+			{Field: "Method", Value: "ZZZZ"},
+		}, report.Dropped)
+		assert.Empty(t, report.Defaulted)
+		assert.Nil(t, converted.Reference)
+		assert.Empty(t, converted.Method)
+		assert.Equal(t, "REF1", message.Reference.Reference)
+
+		_, err = processor.CreateDocument(converted, TestV1)
+		require.NoError(t, err)
+	})
+
+	t.Run("same version", func(t *testing.T) {
+		message := convertMessage{MessageId: "MSG001", Note: "urgent"}
+		converted, report, err := processor.ConvertMessage(message, TestV1, TestV1)
+		require.NoError(t, err)
+		assert.True(t, report.Lossless())
+		assert.Empty(t, report.Defaulted)
+		assert.Equal(t, message, converted)
+	})
+
+	t.Run("unsupported version", func(t *testing.T) {
+		_, _, err := processor.ConvertMessage(convertMessage{}, TestV1, "001.03")
+		assert.EqualError(t, err, "unsupported version 001.03")
+	})
+}
+
+func TestMessageProcessor_CarryUnmapped(t *testing.T) {
+	processor := newConvertProcessor()
+	report := &ConversionReport{}
+	unmapped := &models.Unmapped{
+		Namespace: "test:v1",
+		XML:       `<Document xmlns="test:v1"><Ref>R1</Ref><Note>urgent</Note><Extra><Line>1</Line></Extra><Note>later</Note></Document>`,
+	}
+
+	carried, err := processor.CarryUnmapped(report, nil, TestV2)
+	require.NoError(t, err)
+	assert.Nil(t, carried)
+	carried, err = processor.CarryUnmapped(report, unmapped, TestV1)
+	require.NoError(t, err)
+	assert.Same(t, unmapped, carried)
+	assert.True(t, report.Lossless())
+
+	// Elements the target has move to its namespace; each of the others is reported on its own
+	carried, err = processor.CarryUnmapped(report, unmapped, TestV2)
+	require.NoError(t, err)
+	assert.Equal(t, &models.Unmapped{Namespace: "test:v2", XML: `<Document xmlns="test:v2"><Ref>R1</Ref></Document>`}, carried)
+	assert.Equal(t, []FieldChange{
+		{Field: "/Document[1]/Note[1]", Value: `<Note xmlns="test:v1">urgent</Note>`},
+		{Field: "/Document[1]/Extra[1]", Value: `<Extra xmlns="test:v1"><Line xmlns="test:v1">1</Line></Extra>`},
+		{Field: "/Document[1]/Note[2]", Value: `<Note xmlns="test:v1">later</Note>`},
+	}, report.Dropped)
+
+	_, err = processor.CarryUnmapped(report, unmapped, "001.03")
+	assert.Error(t, err)
+}
@@ -0,0 +1,202 @@
+package base
+
+import (
+	"encoding"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/moov-io/wire20022/pkg/models"
+)
+
+// FieldDiff is a model field whose value differs between two messages
+type FieldDiff struct {
+	// Field is the model path, e.g. "Transactions[0].DebtorName"
+	Field string `json:"field"`
+	// A and B are the values of the field in each message, empty when absent
+	A string `json:"a,omitempty"`
+	B string `json:"b,omitempty"`
+}
+
+// Kind describes the change from A to B: "added", "removed" or "changed"
+func (d FieldDiff) Kind() string {
+	switch {
+	case d.A == "":
+		return "added"
+	case d.B == "":
+		return "removed"
+	default:
+		return "changed"
+	}
+}
+
+// DiffModels compares two message models of the same type field by field and returns the
+// fields whose values differ, in model order. Empty and absent values are equal, decimals
+// are compared by value and times by instant, so "1000" and "1000.00" match. Lists are
+// compared regardless of the order of their entries: entries equal to one in the other list
+// are matched first and the rest are compared in order, under the index they have in a, or in
+// b for entries only b has. Fields named in ignore, by their model path, are skipped.
+func DiffModels(a, b any, ignore ...string) ([]FieldDiff, error) {
+	va, vb := indirect(reflect.ValueOf(a)), indirect(reflect.ValueOf(b))
+	if !va.IsValid() || !vb.IsValid() || va.Type() != vb.Type() {
+		return nil, fmt.Errorf("cannot compare %T with %T", a, b)
+	}
+	d := differ{ignore: make(map[string]bool, len(ignore))}
+	for _, field := range ignore {
+		d.ignore[field] = true
+	}
+	d.compare(va, vb, "")
+	return d.diffs, nil
+}
+
+type differ struct {
+	ignore map[string]bool
+	diffs  []FieldDiff
+}
+
+var (
+	timeType      = reflect.TypeOf(time.Time{})
+	decimalType   = reflect.TypeOf(models.Decimal(""))
+	stringer      = reflect.TypeOf((*fmt.Stringer)(nil)).Elem()
+	textMarshaler = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+)
+
+func (d *differ) compare(a, b reflect.Value, path string) {
+	a, b = indirect(a), indirect(b)
+	if !a.IsValid() && !b.IsValid() {
+		return
+	}
+	if !a.IsValid() {
+		a = reflect.Zero(b.Type())
+	} else if !b.IsValid() {
+		b = reflect.Zero(a.Type())
+	}
+	t := a.Type()
+
+	switch {
+	case isLeaf(t):
+		if !leafEqual(a, b) {
+			d.diffs = append(d.diffs, FieldDiff{Field: path, A: leafString(a), B: leafString(b)})
+		}
+	case t.Kind() == reflect.Struct:
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			if !field.IsExported() && !field.Anonymous {
+				continue
+			}
+			fieldPath := joinPath(path, field.Name)
+			if field.Anonymous {
+				fieldPath = path
+			} else if d.ignore[fieldPath] {
+				continue
+			}
+			d.compare(a.Field(i), b.Field(i), fieldPath)
+		}
+	case t.Kind() == reflect.Slice || t.Kind() == reflect.Array:
+		d.compareLists(a, b, path)
+	}
+}
+
+// compareLists matches the equal entries of two lists and compares the others in order
+func (d *differ) compareLists(a, b reflect.Value, path string) {
+	matchedA := make([]bool, a.Len())
+	matchedB := make([]bool, b.Len())
+	for i := 0; i < a.Len(); i++ {
+		for j := 0; j < b.Len(); j++ {
+			if !matchedB[j] && d.equal(a.Index(i), b.Index(j)) {
+				matchedA[i], matchedB[j] = true, true
+				break
+			}
+		}
+	}
+
+	var restA, restB []int
+	for i, matched := range matchedA {
+		if !matched {
+			restA = append(restA, i)
+		}
+	}
+	for j, matched := range matchedB {
+		if !matched {
+			restB = append(restB, j)
+		}
+	}
+	for k := 0; k < len(restA) || k < len(restB); k++ {
+		switch {
+		case k >= len(restB):
+			d.compare(a.Index(restA[k]), reflect.Value{}, fmt.Sprintf("%s[%d]", path, restA[k]))
+		case k >= len(restA):
+			d.compare(reflect.Value{}, b.Index(restB[k]), fmt.Sprintf("%s[%d]", path, restB[k]))
+		default:
+			d.compare(a.Index(restA[k]), b.Index(restB[k]), fmt.Sprintf("%s[%d]", path, restA[k]))
+		}
+	}
+}
+
+// equal reports whether two values have no differences
+func (d *differ) equal(a, b reflect.Value) bool {
+	other := differ{ignore: d.ignore}
+	other.compare(a, b, "")
+	return len(other.diffs) == 0
+}
+
+// indirect follows pointers and interfaces, returning the invalid Value for nil
+func indirect(v reflect.Value) reflect.Value {
+	for v.IsValid() && (v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface) {
+		if v.IsNil() {
+			return reflect.Value{}
+		}
+		v = v.Elem()
+	}
+	return v
+}
+
+// isLeaf reports whether values of type t are compared as a whole, which includes dates and
+// times such as fedwire.ISODate and fedwire.ISODateTime
+func isLeaf(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Struct:
+		return t.ConvertibleTo(timeType) || t.Implements(textMarshaler) || t.Implements(stringer)
+	case reflect.Slice, reflect.Array:
+		return t.Elem().Kind() == reflect.Uint8
+	}
+	return true
+}
+
+func leafEqual(a, b reflect.Value) bool {
+	switch {
+	case a.Kind() == reflect.Struct && a.Type().ConvertibleTo(timeType):
+		return a.Convert(timeType).Interface().(time.Time).Equal(b.Convert(timeType).Interface().(time.Time))
+	case a.Type() == decimalType:
+		da, db := a.Interface().(models.Decimal), b.Interface().(models.Decimal)
+		if da != "" && db != "" {
+			if cmp, err := da.Cmp(db); err == nil {
+				return cmp == 0
+			}
+		}
+	}
+	return leafString(a) == leafString(b)
+}
+
+// leafString formats a value, empty for the zero value
+func leafString(v reflect.Value) string {
+	if v.IsZero() {
+		return ""
+	}
+	if v.Kind() == reflect.Struct && v.Type().ConvertibleTo(timeType) {
+		return v.Convert(timeType).Interface().(time.Time).Format(time.RFC3339Nano)
+	}
+	if marshaler, ok := v.Interface().(encoding.TextMarshaler); ok {
+		if text, err := marshaler.MarshalText(); err == nil {
+			return string(text)
+		}
+	}
+	return fmt.Sprint(v.Interface())
+}
+
+func joinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
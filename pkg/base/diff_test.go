@@ -0,0 +1,94 @@
+package base
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/moov-io/fedwire20022/pkg/fedwire"
+
+	"github.com/moov-io/wire20022/pkg/models"
+)
+
+type diffParty struct {
+	Name    string
+	Address *models.PostalAddress
+}
+
+type diffFields struct {
+	Reference string
+}
+
+type diffMessage struct {
+	diffFields
+	MessageId string
+	CreatedAt time.Time
+	SentAt    fedwire.ISODateTime
+	Date      fedwire.ISODate
+	Amount    models.CurrencyAndAmount
+	Debtor    *diffParty
+	Parties   []diffParty
+	Version   string
+}
+
+func TestDiffModels(t *testing.T) {
+	created := time.Date(2025, 3, 10, 9, 0, 0, 0, time.UTC)
+	a := diffMessage{
+		diffFields: diffFields{Reference: "REF1"},
+		MessageId:  "MSG1",
+		CreatedAt:  created,
+		SentAt:     fedwire.ISODateTime(created),
+		Date:       fedwire.UnmarshalISODate("2025-03-10"),
+		Amount:     models.CurrencyAndAmount{Currency: "USD", Amount: "1000"},
+		Parties:    []diffParty{{Name: "A"}, {Name: "B"}, {Name: "C"}},
+		Version:    "v1",
+	}
+
+	t.Run("equal", func(t *testing.T) {
+		b := a
+		b.CreatedAt = created.In(time.FixedZone("EST", -5*60*60))
+		b.SentAt = fedwire.ISODateTime(created.In(time.FixedZone("EST", -5*60*60)))
+		b.Amount.Amount = "1000.00"
+		b.Debtor = &diffParty{}
+		b.Parties = []diffParty{{Name: "C"}, {Name: "A"}, {Name: "B"}}
+
+		diffs, err := DiffModels(a, &b)
+		require.NoError(t, err)
+		assert.Empty(t, diffs)
+	})
+
+	t.Run("differences", func(t *testing.T) {
+		b := a
+		b.Reference = "REF2"
+		b.SentAt = fedwire.ISODateTime(created.Add(time.Minute))
+		b.Date = fedwire.UnmarshalISODate("2025-03-11")
+		b.Amount.Amount = "1000.01"
+		b.Debtor = &diffParty{Name: "D", Address: &models.PostalAddress{TownName: "Boston"}}
+		b.Parties = []diffParty{{Name: "B"}, {Name: "E"}, {Name: "F"}, {Name: "G"}}
+		b.Version = "v2"
+
+		diffs, err := DiffModels(a, b, "Version")
+		require.NoError(t, err)
+		assert.Equal(t, []FieldDiff{
+			{Field: "Reference", A: "REF1", B: "REF2"},
+			{Field: "SentAt", A: "2025-03-10T09:00:00Z", B: "2025-03-10T09:01:00Z"},
+			{Field: "Date", A: "2025-03-10", B: "2025-03-11"},
+			{Field: "Amount.Amount", A: "1000", B: "1000.01"},
+			{Field: "Debtor.Name", B: "D"},
+			{Field: "Debtor.Address.TownName", B: "Boston"},
+			{Field: "Parties[0].Name", A: "A", B: "E"},
+			{Field: "Parties[2].Name", A: "C", B: "F"},
+			{Field: "Parties[3].Name", B: "G"},
+		}, diffs)
+		assert.Equal(t, "changed", diffs[0].Kind())
+		assert.Equal(t, "added", diffs[4].Kind())
+		assert.Equal(t, "removed", FieldDiff{Field: "Note", A: "x"}.Kind())
+	})
+
+	t.Run("different types", func(t *testing.T) {
+		_, err := DiffModels(a, diffParty{})
+		assert.EqualError(t, err, "cannot compare base.diffMessage with base.diffParty")
+	})
+}
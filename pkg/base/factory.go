@@ -97,6 +97,10 @@ func setXMLNameByReflection(doc any, namespace string) {
 	xmlNameField := docValue.FieldByName("XMLName")
 	if xmlNameField.IsValid() && xmlNameField.CanSet() {
 		xmlName := xml.Name{Space: namespace, Local: "Document"}
+		// Keep a root element chosen by the factory, such as AppHdr
+		if current, ok := xmlNameField.Interface().(xml.Name); ok && current.Local != "" {
+			xmlName.Local = current.Local
+		}
 		xmlNameField.Set(reflect.ValueOf(xmlName))
 	}
 }
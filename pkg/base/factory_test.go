@@ -158,6 +158,16 @@ func TestSetXMLNameByReflection(t *testing.T) {
 		assert.Equal(t, "Document", doc.XMLName.Local)
 	})
 
+	t.Run("setXMLNameByReflection keeps root element set by factory", func(t *testing.T) {
+		doc := &TestDoc{XMLName: xml.Name{Local: "AppHdr"}, Content: "test"}
+		namespace := "reflection:namespace"
+
+		setXMLNameByReflection(doc, namespace)
+
+		assert.Equal(t, namespace, doc.XMLName.Space)
+		assert.Equal(t, "AppHdr", doc.XMLName.Local)
+	})
+
 	t.Run("setXMLNameByReflection with non-pointer", func(t *testing.T) {
 		doc := TestDoc{Content: "test"}
 		namespace := "reflection:namespace"
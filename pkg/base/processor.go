@@ -1,9 +1,12 @@
 package base
 
 import (
+	"encoding/xml"
 	"errors"
 	"fmt"
 	"reflect"
+	"sort"
+	"strings"
 
 	wirerrors "github.com/moov-io/wire20022/pkg/errors"
 	"github.com/moov-io/wire20022/pkg/models"
@@ -16,6 +19,7 @@ type MessageProcessor[M any, V comparable] struct {
 	versionMap     map[string]V
 	pathMaps       map[V]map[string]any
 	requiredFields []string
+	newMessage     func(V) M
 }
 
 // NewMessageProcessor creates a new generic message processor
@@ -33,49 +37,221 @@ func NewMessageProcessor[M any, V comparable](
 	}
 }
 
+// SetMessageFactory makes ParseMessage copy a document into the model newMessage returns for its
+// version rather than into an empty one, so that the version-specific field groups the version
+// defines are present even when the document has no content for them
+func (p *MessageProcessor[M, V]) SetMessageFactory(newMessage func(V) M) {
+	p.newMessage = newMessage
+}
+
+// ParseOptions controls how a document is copied into a message model
+type ParseOptions struct {
+	// PreserveUnmapped keeps the document content that the path map does not cover
+	PreserveUnmapped bool
+	// Strict reports every document value that could not be copied to the model
+	Strict bool
+}
+
+// ParseOption configures ParseOptions
+type ParseOption func(*ParseOptions)
+
+// PreserveUnmapped captures the elements of a document that no model field covers, such as
+// RgltryRptg, so that writing the model back out restores them instead of dropping them
+func PreserveUnmapped() ParseOption {
+	return func(o *ParseOptions) {
+		o.PreserveUnmapped = true
+	}
+}
+
+// Strict makes parsing fail with a FieldError for each document value that could not be copied
+// to the model, such as an unknown code or a value of the wrong type, instead of leaving the
+// model field empty. The errors are joined, so errors.As finds the first one.
+func Strict() ParseOption {
+	return func(o *ParseOptions) {
+		o.Strict = true
+	}
+}
+
 // ProcessMessage handles the common pattern of converting XML to message model
 func (p *MessageProcessor[M, V]) ProcessMessage(data []byte) (M, error) {
+	result, _, _, err := p.ParseMessage(data)
+	return result, err
+}
+
+// ParseMessage converts XML to a message model like ProcessMessage, also returning the version of
+// the document. With PreserveUnmapped it returns the document content that the path map does not
+// cover as well, for use with CreateDocumentWith.
+// Errors carry the position of the element they concern where it can be found; see
+// errors.LocationOf.
+func (p *MessageProcessor[M, V]) ParseMessage(data []byte, opts ...ParseOption) (M, V, *models.Unmapped, error) {
 	var result M
+	var version V
+	var options ParseOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
 
 	doc, xmlns, err := models.DocumentFrom(data, p.namespaceMap)
 	if err != nil {
-		return result, HandleDocumentCreationError(err)
+		return result, version, nil, HandleDocumentCreationError(err)
 	}
 
 	version, exists := p.versionMap[xmlns]
 	if !exists {
-		return result, wirerrors.NewParseError("version lookup", xmlns,
+		return result, version, nil, wirerrors.NewParseError("version lookup", xmlns,
 			errors.New("unsupported namespace"))
 	}
 
 	pathMap, exists := p.pathMaps[version]
 	if !exists {
-		return result, wirerrors.NewParseError("path map lookup", fmt.Sprintf("%v", version),
+		return result, version, nil, wirerrors.NewParseError("path map lookup", fmt.Sprintf("%v", version),
 			errors.New("missing path map for version"))
 	}
 
+	if p.newMessage != nil {
+		result = p.newMessage(version)
+	}
+
 	rePathMap := models.RemakeMapping(doc, pathMap, true)
 
+	var copyErrors []error
 	for sourcePath, targetPath := range rePathMap {
-		models.CopyDocumentValueToMessage(doc, sourcePath, &result, targetPath)
+		if err := models.CopyDocumentValue(doc, sourcePath, &result, targetPath); err != nil {
+			copyErrors = append(copyErrors, err)
+		}
+	}
+
+	var unmapped *models.Unmapped
+	if options.PreserveUnmapped {
+		if unmapped, err = models.CaptureUnmapped(doc, xmlns, rePathMap, &result); err != nil {
+			return result, version, nil, err
+		}
+	}
+	models.ReadDecimals(data, doc, rePathMap, &result)
+
+	if options.Strict && len(copyErrors) > 0 {
+		// Map iteration order is random; report the failures in a stable order
+		sort.Slice(copyErrors, func(i, j int) bool { return copyErrors[i].Error() < copyErrors[j].Error() })
+		if err := p.ValidateRequiredFields(result); err != nil {
+			copyErrors = append(copyErrors, err)
+		}
+		err := wirerrors.JoinValidationErrors(copyErrors...)
+		locateErrors(data, doc, rePathMap, err)
+		return result, version, unmapped, err
 	}
 
 	// Validate required fields
 	if err := p.ValidateRequiredFields(result); err != nil {
-		return result, err
+		locateErrors(data, doc, rePathMap, err)
+		return result, version, unmapped, err
+	}
+
+	return result, version, unmapped, nil
+}
+
+// Locate attaches document positions to the errors in err that have none, for checks a message
+// type makes after ParseMessage. data is the document the model was parsed from. See ParseMessage
+// for how errors are placed. It returns err.
+func (p *MessageProcessor[M, V]) Locate(data []byte, err error) error {
+	if err == nil {
+		return nil
+	}
+	doc, xmlns, docErr := models.DocumentFrom(data, p.namespaceMap)
+	if docErr != nil {
+		return err
 	}
+	if pathMap, exists := p.pathMaps[p.versionMap[xmlns]]; exists {
+		locateErrors(data, doc, models.RemakeMapping(doc, pathMap, true), err)
+	}
+	return err
+}
+
+// locateErrors places a FieldError at its source element and a ValidationError at the element its
+// model field is copied from, or at the nearest enclosing element when that one is missing
+func locateErrors(data []byte, doc models.ISODocument, rePathMap map[string]string, err error) {
+	// A partial scan still places the errors before the point the document stops being valid XML
+	positions, _ := models.ScanPositions(data)
 
-	return result, nil
+	docPaths := make(map[string]string, len(rePathMap))
+	for docPath, modelPath := range rePathMap {
+		// Prefer the element that is present when several feed the same field
+		if _, exists := docPaths[modelPath]; exists {
+			if _, _, getErr := models.GetElement(doc, docPath); getErr != nil {
+				continue
+			}
+		}
+		docPaths[modelPath] = docPath
+	}
+
+	wirerrors.SetLocations(err, func(e error) (wirerrors.Location, bool) {
+		var xpath string
+		switch e := e.(type) {
+		case *wirerrors.FieldError:
+			xpath = e.Source
+		case *wirerrors.ValidationError:
+			if docPath, ok := docPaths[e.Field]; ok {
+				xpath, _ = models.DocumentXPath(doc, docPath)
+			}
+		}
+		if !strings.HasPrefix(xpath, "/") {
+			return wirerrors.Location{}, false
+		}
+		return positions.Lookup(xpath)
+	})
 }
 
 // CreateDocument handles the common pattern of converting message model to XML document
 func (p *MessageProcessor[M, V]) CreateDocument(message M, version V) (models.ISODocument, error) {
+	return p.CreateDocumentWith(message, version, nil, nil)
+}
+
+// CreateDocumentWith converts a message model to an XML document, starting from content that
+// ParseMessage preserved. The preserved content must come from a document of the same version.
+// Values the message was parsed with are written as read even where the checks refuse them.
+func (p *MessageProcessor[M, V]) CreateDocumentWith(message M, version V, unmapped *models.Unmapped, read models.ReadValues) (models.ISODocument, error) {
 	pathMap, exists := p.pathMaps[version]
 	if !exists {
 		return nil, wirerrors.NewValidationError("version", "unsupported version")
 	}
 
-	// Get document factory for this version
+	doc, targetNamespace, err := p.newDocument(version)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := read.Except(&message, models.ValidateAmounts(&message)); err != nil {
+		return nil, err
+	}
+	if err := read.Except(&message, models.ValidateAgents(&message)); err != nil {
+		return nil, err
+	}
+	if err := read.Except(&message, models.ValidateAddresses(&message)); err != nil {
+		return nil, err
+	}
+
+	if err := unmapped.Restore(doc, targetNamespace); err != nil {
+		return nil, err
+	}
+	rePathMap := models.RemakeMapping(message, pathMap, false)
+
+	for sourcePath, targetPath := range rePathMap {
+		if err := models.CopyMessageValueToDocument(&message, sourcePath, doc, targetPath); err != nil {
+			return nil, HandleFieldCopyError(targetPath, err)
+		}
+	}
+
+	return doc, nil
+}
+
+// EncodeDocument encodes a document CreateDocumentWith made from message in version, writing each
+// amount with the digits of its Decimal rather than those of the document's binary number
+func (p *MessageProcessor[M, V]) EncodeDocument(encoder *xml.Encoder, doc models.ISODocument, message M, version V) error {
+	rePathMap := models.RemakeMapping(message, p.pathMaps[version], false)
+	return models.EncodeDocument(encoder, doc, models.DecimalText(&message, doc, rePathMap))
+}
+
+// newDocument returns an empty document of a version along with its namespace
+func (p *MessageProcessor[M, V]) newDocument(version V) (models.ISODocument, string, error) {
 	var targetNamespace string
 	for namespace, ver := range p.versionMap {
 		if ver == version {
@@ -86,19 +262,27 @@ func (p *MessageProcessor[M, V]) CreateDocument(message M, version V) (models.IS
 
 	factory, exists := p.namespaceMap[targetNamespace]
 	if !exists {
-		return nil, wirerrors.NewValidationError("namespace", "missing factory for namespace")
+		return nil, "", wirerrors.NewValidationError("namespace", "missing factory for namespace")
 	}
+	return factory(), targetNamespace, nil
+}
 
-	doc := factory()
-	rePathMap := models.RemakeMapping(message, pathMap, false)
-
-	for sourcePath, targetPath := range rePathMap {
-		if err := models.CopyMessageValueToDocument(&message, sourcePath, doc, targetPath); err != nil {
-			return nil, HandleFieldCopyError(targetPath, err)
+// DocumentVersion returns the version to write a message in when none is asked for: that of the
+// content ParseMessage preserved, else the one an AppHdr names as its MsgDefIdr, else latest
+func (p *MessageProcessor[M, V]) DocumentVersion(unmapped *models.Unmapped, msgDefIdr string, latest V) V {
+	if unmapped != nil {
+		if version, ok := p.versionMap[unmapped.Namespace]; ok {
+			return version
 		}
 	}
-
-	return doc, nil
+	if msgDefIdr != "" {
+		for namespace, version := range p.versionMap {
+			if strings.HasSuffix(namespace, ":"+msgDefIdr) {
+				return version
+			}
+		}
+	}
+	return latest
 }
 
 // ValidateRequiredFields performs generic required field validation
@@ -112,8 +296,19 @@ type FieldValidator struct {
 	requiredFields []string
 }
 
+// NewFieldValidator creates a FieldValidator for the given required field names
+func NewFieldValidator(requiredFields []string) *FieldValidator {
+	return &FieldValidator{requiredFields: requiredFields}
+}
+
 // ValidateRequired checks that all required fields are present and non-empty
 func (v *FieldValidator) ValidateRequired(model any) error {
+	return v.ValidateRequiredAt(model, "")
+}
+
+// ValidateRequiredAt checks required fields on a nested model, such as one element of a
+// repeated block, reporting missing fields relative to the given path (e.g. "Transactions[1]")
+func (v *FieldValidator) ValidateRequiredAt(model any, path string) error {
 	modelValue := reflect.ValueOf(model)
 	if modelValue.Kind() == reflect.Ptr {
 		modelValue = modelValue.Elem()
@@ -122,6 +317,9 @@ func (v *FieldValidator) ValidateRequired(model any) error {
 	for _, fieldName := range v.requiredFields {
 		field := modelValue.FieldByName(fieldName)
 		if !field.IsValid() || models.IsEmpty(field.Interface()) {
+			if path != "" {
+				fieldName = path + "." + fieldName
+			}
 			return wirerrors.NewRequiredFieldError(fieldName)
 		}
 	}
@@ -2,10 +2,14 @@ package base
 
 import (
 	"encoding/xml"
+	"fmt"
+	"strings"
 	"testing"
 
+	wirerrors "github.com/moov-io/wire20022/pkg/errors"
 	"github.com/moov-io/wire20022/pkg/models"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 // Mock types for testing
@@ -166,6 +170,16 @@ func TestMessageProcessor(t *testing.T) {
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "MessageId")
 	})
+
+	t.Run("DocumentVersion", func(t *testing.T) {
+		processor := createTestProcessor()
+		preserved := &models.Unmapped{Namespace: "test:namespace:v1"}
+
+		assert.Equal(t, TestV1, processor.DocumentVersion(preserved, "", TestV2))
+		assert.Equal(t, TestV1, processor.DocumentVersion(nil, "v1", TestV2))
+		assert.Equal(t, TestV2, processor.DocumentVersion(nil, "v3", TestV2))
+		assert.Equal(t, TestV2, processor.DocumentVersion(&models.Unmapped{Namespace: "other"}, "", TestV2))
+	})
 }
 
 func TestFieldValidator(t *testing.T) {
@@ -226,6 +240,21 @@ func TestFieldValidator(t *testing.T) {
 		err := validator.ValidateRequired(message)
 		assert.NoError(t, err)
 	})
+
+	t.Run("ValidateRequiredAt reports nested path", func(t *testing.T) {
+		validator := NewFieldValidator([]string{"MessageId", "TestField"})
+
+		message := TestMessage{
+			MessageHeader: MessageHeader{MessageId: "TEST001"},
+		}
+
+		err := validator.ValidateRequiredAt(message, "Transactions[1]")
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "Transactions[1].TestField")
+
+		message.TestField = "test"
+		assert.NoError(t, validator.ValidateRequiredAt(message, "Transactions[1]"))
+	})
 }
 
 func TestErrorHandlingFunctions(t *testing.T) {
@@ -255,6 +284,139 @@ func TestErrorHandlingFunctions(t *testing.T) {
 	})
 }
 
+type strictCode string
+
+func (c strictCode) Validate() error {
+	if c != "CLRG" && c != "INDA" {
+		return fmt.Errorf("unknown settlement method %q", string(c))
+	}
+	return nil
+}
+
+type strictDocument struct {
+	XMLName   xml.Name `xml:"test:strict Document"`
+	MessageId string   `xml:"MsgId"`
+	Count     string   `xml:"NbOfTxs"`
+	Method    *string  `xml:"SttlmMtd,omitempty"`
+}
+
+func (d *strictDocument) Validate() error {
+	return nil
+}
+
+type strictMessage struct {
+	MessageId string
+	Count     int
+	Method    strictCode
+}
+
+func TestParseMessageStrict(t *testing.T) {
+	processor := NewMessageProcessor[strictMessage, TestVersion](
+		map[string]models.DocumentFactory{
+			"test:strict": func() models.ISODocument { return &strictDocument{} },
+		},
+		map[string]TestVersion{"test:strict": TestV1},
+		map[TestVersion]map[string]any{
+			TestV1: {
+				"MessageId": "MessageId",
+				"Count":     "Count",
+				"Method":    "Method",
+				"Priority":  "MessageId",
+			},
+		},
+		[]string{"MessageId"},
+	)
+	xmlData := []byte(`<Document xmlns="test:strict"><MsgId>MSG001</MsgId><NbOfTxs>two</NbOfTxs><SttlmMtd>ZZZZ</SttlmMtd></Document>`)
+
+	// Without Strict the failures are silently skipped
+	message, err := processor.ProcessMessage(xmlData)
+	require.NoError(t, err)
+	assert.Equal(t, "MSG001", message.MessageId)
+	assert.Zero(t, message.Count)
+
+	_, _, _, err = processor.ParseMessage(xmlData, Strict())
+	require.Error(t, err)
+	var joined interface{ Unwrap() []error }
+	require.ErrorAs(t, err, &joined)
+
+	sources := map[string]string{}
+	for _, copyErr := range joined.Unwrap() {
+		var fieldErr *wirerrors.FieldError
+		require.ErrorAs(t, copyErr, &fieldErr)
+		assert.Equal(t, "copy", fieldErr.Operation)
+		sources[fieldErr.Source] = fieldErr.Path
+		if strings.HasPrefix(fieldErr.Source, "/") {
+			// Each failure points at its element
+			require.NotNil(t, fieldErr.Location)
+			element := "<" + fieldErr.Source[strings.LastIndex(fieldErr.Source, "/")+1:] + ">"
+			assert.True(t, strings.HasPrefix(string(xmlData[fieldErr.Location.Offset:]), element), element)
+		}
+	}
+	assert.Equal(t, map[string]string{
+		"/Document/NbOfTxs":  "Count",
+		"/Document/SttlmMtd": "Method",
+		"Priority":           "MessageId",
+	}, sources)
+	assert.ErrorIs(t, err, wirerrors.ErrFieldNotFound)
+	assert.Contains(t, err.Error(), "unknown settlement method")
+
+	// Absent elements are not failures
+	xmlData = []byte(`<Document xmlns="test:strict"><MsgId>MSG001</MsgId><NbOfTxs>2</NbOfTxs></Document>`)
+	processor.pathMaps[TestV1] = map[string]any{"MessageId": "MessageId", "Count": "Count", "Method": "Method"}
+	message, version, _, err := processor.ParseMessage(xmlData, Strict())
+	require.NoError(t, err)
+	assert.Equal(t, 2, message.Count)
+	assert.Equal(t, TestV1, version)
+
+	// A missing required field is placed at the nearest element that is present
+	xmlData = []byte("<Document xmlns=\"test:strict\">\n  <NbOfTxs>2</NbOfTxs>\n</Document>")
+	_, _, _, err = processor.ParseMessage(xmlData)
+	require.ErrorIs(t, err, wirerrors.ErrRequiredField)
+	location, ok := wirerrors.LocationOf(err)
+	require.True(t, ok)
+	assert.Equal(t, "1:1", location.String())
+
+	err = processor.Locate([]byte("<Document xmlns=\"test:strict\">\n  <NbOfTxs>2</NbOfTxs>\n</Document>"),
+		wirerrors.NewInvalidFieldError("Count", "does not match"))
+	location, ok = wirerrors.LocationOf(err)
+	require.True(t, ok)
+	assert.Equal(t, "2:3", location.String())
+}
+
+// extensionFields marks a capability of TestV1 documents without carrying any content
+type extensionFields struct{}
+
+type versionedMessage struct {
+	MessageId string
+	Extension *extensionFields
+}
+
+func TestParseMessageFactory(t *testing.T) {
+	processor := NewMessageProcessor[versionedMessage, TestVersion](
+		map[string]models.DocumentFactory{
+			"test:strict": func() models.ISODocument { return &strictDocument{} },
+		},
+		map[string]TestVersion{"test:strict": TestV1},
+		map[TestVersion]map[string]any{TestV1: {"MessageId": "MessageId"}},
+		[]string{"MessageId"},
+	)
+	xmlData := []byte(`<Document xmlns="test:strict"><MsgId>MSG001</MsgId></Document>`)
+
+	message, err := processor.ProcessMessage(xmlData)
+	require.NoError(t, err)
+	assert.Nil(t, message.Extension)
+
+	// The version's groups are present even though nothing is copied into them
+	processor.SetMessageFactory(func(version TestVersion) versionedMessage {
+		require.Equal(t, TestV1, version)
+		return versionedMessage{Extension: &extensionFields{}}
+	})
+	message, err = processor.ProcessMessage(xmlData)
+	require.NoError(t, err)
+	assert.Equal(t, "MSG001", message.MessageId)
+	assert.NotNil(t, message.Extension)
+}
+
 // Helper functions for tests
 func createTestProcessor() *MessageProcessor[TestMessage, TestVersion] {
 	return createTestProcessorWithRequiredFields()
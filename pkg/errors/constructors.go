@@ -74,6 +74,22 @@ func NewFieldError(path, operation string, cause error) *FieldError {
 	}
 }
 
+// NewCopyError creates a FieldError for a document value that could not be copied to a
+// message model, naming both the source element and the target model field.
+//
+// Example:
+//
+//	err := NewCopyError("/Document/FIToFICstmrCdtTrf/GrpHdr/NbOfTxs", "NumberOfTransactions", cause)
+//	// err.Error() returns: field copy /Document/FIToFICstmrCdtTrf/GrpHdr/NbOfTxs to NumberOfTransactions failed: <cause>
+func NewCopyError(source, path string, cause error) *FieldError {
+	return &FieldError{
+		Path:      path,
+		Operation: "copy",
+		Source:    source,
+		Err:       cause,
+	}
+}
+
 // NewRequiredFieldError creates a ValidationError for missing required fields.
 // This is a convenience constructor that uses the ErrRequiredField sentinel.
 //
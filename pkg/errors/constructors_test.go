@@ -65,6 +65,18 @@ func TestNewFieldError(t *testing.T) {
 	assert.Equal(t, expected, err.Error())
 }
 
+func TestNewCopyError(t *testing.T) {
+	err := NewCopyError("/Document/GrpHdr/NbOfTxs", "NumberOfTransactions", ErrInvalidField)
+
+	assert.Equal(t, "NumberOfTransactions", err.Path)
+	assert.Equal(t, "copy", err.Operation)
+	assert.Equal(t, "/Document/GrpHdr/NbOfTxs", err.Source)
+	assert.ErrorIs(t, err, ErrInvalidField)
+
+	expected := "field copy /Document/GrpHdr/NbOfTxs to NumberOfTransactions failed: invalid field"
+	assert.Equal(t, expected, err.Error())
+}
+
 func TestNewRequiredFieldError(t *testing.T) {
 	err := NewRequiredFieldError("MessageId")
 
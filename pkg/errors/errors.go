@@ -28,6 +28,8 @@ type ValidationError struct {
 	Field  string // The field that failed validation
 	Reason string // Human-readable reason for the failure
 	Err    error  // Underlying error, if any
+
+	Location *Location // Position of the element in the parsed document, if known
 }
 
 // Error implements the error interface.
@@ -57,6 +59,8 @@ type ParseError struct {
 	Operation string // The parsing operation that failed (e.g., "XML unmarshal", "JSON marshal")
 	Content   string // Brief description of content being parsed
 	Err       error  // Underlying parsing error
+
+	Location *Location // Position in the input where parsing stopped, if known
 }
 
 // Error implements the error interface.
@@ -85,11 +89,17 @@ func (e *ParseError) Is(target error) bool {
 type FieldError struct {
 	Path      string // The field path that caused the error
 	Operation string // The operation being performed (e.g., "get", "set")
+	Source    string // XPath of the document element the value was copied from, if any
 	Err       error  // Underlying error
+
+	Location *Location // Position of the source element in the parsed document, if known
 }
 
 // Error implements the error interface.
 func (e *FieldError) Error() string {
+	if e.Source != "" {
+		return fmt.Sprintf("field %s %s to %s failed: %v", e.Operation, e.Source, e.Path, e.Err)
+	}
 	return fmt.Sprintf("field %s %s failed: %v", e.Operation, e.Path, e.Err)
 }
 
@@ -0,0 +1,108 @@
+package errors
+
+import (
+	"bytes"
+	"fmt"
+)
+
+// Location is the position of an element in an XML document.
+// Line and Column are 1-based; Column counts bytes, as encoding/xml does.
+type Location struct {
+	Line   int   `json:"line"`
+	Column int   `json:"column"`
+	Offset int64 `json:"offset"` // Byte offset from the start of the document
+}
+
+// String returns the location as line:column, e.g. "42:7"
+func (l Location) String() string {
+	return fmt.Sprintf("%d:%d", l.Line, l.Column)
+}
+
+// LocationOf returns the first location attached to a ParseError, FieldError or ValidationError
+// in err's tree, including errors combined with errors.Join.
+//
+// Example:
+//
+//	if loc, ok := LocationOf(err); ok {
+//	    fmt.Printf("%s:%s: %v\n", filename, loc, err) // payment.xml:42:7: ...
+//	}
+func LocationOf(err error) (Location, bool) {
+	var found *Location
+	walkLocations(err, func(_ error, loc **Location) bool {
+		found = *loc
+		return found == nil
+	})
+	if found == nil {
+		return Location{}, false
+	}
+	return *found, true
+}
+
+// SetLocations attaches a location to each ParseError, FieldError and ValidationError in err's
+// tree that does not have one yet. locate reports false when it cannot place an error.
+func SetLocations(err error, locate func(err error) (Location, bool)) {
+	walkLocations(err, func(e error, loc **Location) bool {
+		if *loc == nil {
+			if found, ok := locate(e); ok {
+				*loc = &found
+			}
+		}
+		return true
+	})
+}
+
+// ShiftLocations moves every location in err's tree for a document that was preceded by prefix
+// in the original input, such as the Fedwire envelope around a message
+func ShiftLocations(err error, prefix []byte) {
+	if len(prefix) == 0 {
+		return
+	}
+	lines := bytes.Count(prefix, []byte("\n"))
+	lastLine := len(prefix) - bytes.LastIndexByte(prefix, '\n') - 1
+	walkLocations(err, func(_ error, loc **Location) bool {
+		if *loc == nil {
+			return true
+		}
+		shifted := **loc
+		if shifted.Line == 1 {
+			shifted.Column += lastLine
+		}
+		shifted.Line += lines
+		shifted.Offset += int64(len(prefix))
+		*loc = &shifted
+		return true
+	})
+}
+
+// walkLocations calls fn with each ParseError, FieldError and ValidationError in err's tree and
+// its Location field, until fn returns false
+func walkLocations(err error, fn func(err error, loc **Location) bool) bool {
+	if err == nil {
+		return true
+	}
+
+	var loc **Location
+	switch e := err.(type) {
+	case *ParseError:
+		loc = &e.Location
+	case *FieldError:
+		loc = &e.Location
+	case *ValidationError:
+		loc = &e.Location
+	}
+	if loc != nil && !fn(err, loc) {
+		return false
+	}
+
+	switch e := err.(type) {
+	case interface{ Unwrap() []error }:
+		for _, inner := range e.Unwrap() {
+			if !walkLocations(inner, fn) {
+				return false
+			}
+		}
+	case interface{ Unwrap() error }:
+		return walkLocations(e.Unwrap(), fn)
+	}
+	return true
+}
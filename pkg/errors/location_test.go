@@ -0,0 +1,46 @@
+package errors
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocationOf(t *testing.T) {
+	_, ok := LocationOf(errors.New("plain"))
+	assert.False(t, ok)
+	_, ok = LocationOf(nil)
+	assert.False(t, ok)
+
+	located := NewValidationError("NumberOfTransactions", "must be numeric")
+	located.Location = &Location{Line: 42, Column: 7, Offset: 1234}
+	err := fmt.Errorf("parse: %w", errors.Join(NewRequiredFieldError("MessageId"), located))
+
+	loc, ok := LocationOf(err)
+	require.True(t, ok)
+	assert.Equal(t, Location{Line: 42, Column: 7, Offset: 1234}, loc)
+	assert.Equal(t, "42:7", loc.String())
+}
+
+func TestSetAndShiftLocations(t *testing.T) {
+	fieldErr := NewCopyError("/Document/GrpHdr/NbOfTxs", "NumberOfTransactions", ErrInvalidField)
+	required := NewRequiredFieldError("MessageId")
+	err := errors.Join(fieldErr, required)
+
+	SetLocations(err, func(e error) (Location, bool) {
+		if e == error(fieldErr) {
+			return Location{Line: 1, Column: 10, Offset: 9}, true
+		}
+		return Location{}, false
+	})
+	require.NotNil(t, fieldErr.Location)
+	assert.Nil(t, required.Location)
+
+	// The document started on the second line of the input, four bytes in
+	ShiftLocations(err, []byte("<Envelope>\n<Fw>"))
+	assert.Equal(t, Location{Line: 2, Column: 14, Offset: 24}, *fieldErr.Location)
+	assert.Nil(t, required.Location)
+}
@@ -16,6 +16,7 @@ func NewAccountReportingRequest() *AccountReportingRequest {
 		MessageWrapper: NewMessageWrapper[AccountReportingRequestModel.MessageModel, AccountReportingRequestModel.CAMT_060_001_VERSION](
 			"AccountReportingRequest",
 			AccountReportingRequestModel.DocumentWith,                               // Type-safe document creator
+			AccountReportingRequestModel.EncodeDocument,                             // Decimal-aware document encoder
 			AccountReportingRequestModel.CheckRequiredFields,                        // Type-safe field validator
 			func() any { return AccountReportingRequestModel.BuildMessageHelper() }, // Adapted helper builder
 			func(data []byte) (AccountReportingRequestModel.MessageModel, error) { // XML converter using new API
@@ -16,6 +16,7 @@ func NewActivityReport() *ActivityReport {
 		MessageWrapper: NewMessageWrapper[ActivityReportModel.MessageModel, ActivityReportModel.CAMT_052_001_VERSION](
 			"ActivityReport",
 			ActivityReportModel.DocumentWith,                               // Type-safe document creator
+			ActivityReportModel.EncodeDocument,                             // Decimal-aware document encoder
 			ActivityReportModel.CheckRequiredFields,                        // Type-safe field validator
 			func() any { return ActivityReportModel.BuildMessageHelper() }, // Adapted helper builder
 			func(data []byte) (ActivityReportModel.MessageModel, error) { // XML converter using new API
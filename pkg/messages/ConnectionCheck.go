@@ -16,6 +16,7 @@ func NewConnectionCheck() *ConnectionCheck {
 		MessageWrapper: NewMessageWrapper[ConnectionCheckModel.MessageModel, ConnectionCheckModel.ADMI_004_001_VERSION](
 			"ConnectionCheck",
 			ConnectionCheckModel.DocumentWith,                               // Type-safe document creator
+			ConnectionCheckModel.EncodeDocument,                             // Decimal-aware document encoder
 			ConnectionCheckModel.CheckRequiredFields,                        // Type-safe field validator
 			func() any { return ConnectionCheckModel.BuildMessageHelper() }, // Adapted helper builder
 			func(data []byte) (ConnectionCheckModel.MessageModel, error) { // XML converter using new API
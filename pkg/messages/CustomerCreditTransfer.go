@@ -16,6 +16,7 @@ func NewCustomerCreditTransfer() *CustomerCreditTransfer {
 		MessageWrapper: NewMessageWrapper[CustomerCreditTransferModel.MessageModel, CustomerCreditTransferModel.PACS_008_001_VERSION](
 			"CustomerCreditTransfer",
 			CustomerCreditTransferModel.DocumentWith,                               // Type-safe document creator
+			CustomerCreditTransferModel.EncodeDocument,                             // Decimal-aware document encoder
 			CustomerCreditTransferModel.CheckRequiredFields,                        // Type-safe field validator
 			func() any { return CustomerCreditTransferModel.BuildMessageHelper() }, // Adapted helper builder
 			func(data []byte) (CustomerCreditTransferModel.MessageModel, error) { // XML converter using new API
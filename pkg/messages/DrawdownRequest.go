@@ -16,6 +16,7 @@ func NewDrawdownRequest() *DrawdownRequest {
 		MessageWrapper: NewMessageWrapper[DrawdownRequestModel.MessageModel, DrawdownRequestModel.PAIN_013_001_VERSION](
 			"DrawdownRequest",
 			DrawdownRequestModel.DocumentWith,                               // Type-safe document creator
+			DrawdownRequestModel.EncodeDocument,                             // Decimal-aware document encoder
 			DrawdownRequestModel.CheckRequiredFields,                        // Type-safe field validator
 			func() any { return DrawdownRequestModel.BuildMessageHelper() }, // Adapted helper builder
 			func(data []byte) (DrawdownRequestModel.MessageModel, error) { // XML converter using new API
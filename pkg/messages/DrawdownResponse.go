@@ -16,6 +16,7 @@ func NewDrawdownResponse() *DrawdownResponse {
 		MessageWrapper: NewMessageWrapper[DrawdownResponseModel.MessageModel, DrawdownResponseModel.PAIN_014_001_VERSION](
 			"DrawdownResponse",
 			DrawdownResponseModel.DocumentWith,                               // Type-safe document creator
+			DrawdownResponseModel.EncodeDocument,                             // Decimal-aware document encoder
 			DrawdownResponseModel.CheckRequiredFields,                        // Type-safe field validator
 			func() any { return DrawdownResponseModel.BuildMessageHelper() }, // Adapted helper builder
 			func(data []byte) (DrawdownResponseModel.MessageModel, error) { // XML converter using new API
@@ -16,6 +16,7 @@ func NewEndpointDetailsReport() *EndpointDetailsReport {
 		MessageWrapper: NewMessageWrapper[EndpointDetailsReportModel.MessageModel, EndpointDetailsReportModel.CAMT_052_001_VERSION](
 			"EndpointDetailsReport",
 			EndpointDetailsReportModel.DocumentWith,                               // Type-safe document creator
+			EndpointDetailsReportModel.EncodeDocument,                             // Decimal-aware document encoder
 			EndpointDetailsReportModel.CheckRequiredFields,                        // Type-safe field validator
 			func() any { return EndpointDetailsReportModel.BuildMessageHelper() }, // Adapted helper builder
 			func(data []byte) (EndpointDetailsReportModel.MessageModel, error) { // XML converter using new API
@@ -16,6 +16,7 @@ func NewEndpointGapReport() *EndpointGapReport {
 		MessageWrapper: NewMessageWrapper[EndpointGapReportModel.MessageModel, EndpointGapReportModel.CAMT_052_001_VERSION](
 			"EndpointGapReport",
 			EndpointGapReportModel.DocumentWith,                               // Type-safe document creator
+			EndpointGapReportModel.EncodeDocument,                             // Decimal-aware document encoder
 			EndpointGapReportModel.CheckRequiredFields,                        // Type-safe field validator
 			func() any { return EndpointGapReportModel.BuildMessageHelper() }, // Adapted helper builder
 			func(data []byte) (EndpointGapReportModel.MessageModel, error) { // XML converter using new API
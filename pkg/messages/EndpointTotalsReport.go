@@ -16,6 +16,7 @@ func NewEndpointTotalsReport() *EndpointTotalsReport {
 		MessageWrapper: NewMessageWrapper[EndpointTotalsReportModel.MessageModel, EndpointTotalsReportModel.CAMT_052_001_VERSION](
 			"EndpointTotalsReport",
 			EndpointTotalsReportModel.DocumentWith,                               // Type-safe document creator
+			EndpointTotalsReportModel.EncodeDocument,                             // Decimal-aware document encoder
 			EndpointTotalsReportModel.CheckRequiredFields,                        // Type-safe field validator
 			func() any { return EndpointTotalsReportModel.BuildMessageHelper() }, // Adapted helper builder
 			func(data []byte) (EndpointTotalsReportModel.MessageModel, error) { // XML converter using new API
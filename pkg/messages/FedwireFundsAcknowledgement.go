@@ -16,6 +16,7 @@ func NewFedwireFundsAcknowledgement() *FedwireFundsAcknowledgement {
 		MessageWrapper: NewMessageWrapper[FedwireFundsAcknowledgementModel.MessageModel, FedwireFundsAcknowledgementModel.ADMI_007_001_VERSION](
 			"FedwireFundsAcknowledgement",
 			FedwireFundsAcknowledgementModel.DocumentWith,                               // Type-safe document creator
+			FedwireFundsAcknowledgementModel.EncodeDocument,                             // Decimal-aware document encoder
 			FedwireFundsAcknowledgementModel.CheckRequiredFields,                        // Type-safe field validator
 			func() any { return FedwireFundsAcknowledgementModel.BuildMessageHelper() }, // Adapted helper builder
 			func(data []byte) (FedwireFundsAcknowledgementModel.MessageModel, error) { // XML converter using new API
@@ -16,6 +16,7 @@ func NewFedwireFundsPaymentStatus() *FedwireFundsPaymentStatus {
 		MessageWrapper: NewMessageWrapper[FedwireFundsPaymentStatusModel.MessageModel, FedwireFundsPaymentStatusModel.PACS_002_001_VERSION](
 			"FedwireFundsPaymentStatus",
 			FedwireFundsPaymentStatusModel.DocumentWith,                               // Type-safe document creator
+			FedwireFundsPaymentStatusModel.EncodeDocument,                             // Decimal-aware document encoder
 			FedwireFundsPaymentStatusModel.CheckRequiredFields,                        // Type-safe field validator
 			func() any { return FedwireFundsPaymentStatusModel.BuildMessageHelper() }, // Adapted helper builder
 			func(data []byte) (FedwireFundsPaymentStatusModel.MessageModel, error) { // XML converter using new API
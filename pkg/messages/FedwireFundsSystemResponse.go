@@ -16,6 +16,7 @@ func NewFedwireFundsSystemResponse() *FedwireFundsSystemResponse {
 		MessageWrapper: NewMessageWrapper[FedwireFundsSystemResponseModel.MessageModel, FedwireFundsSystemResponseModel.ADMI_011_001_VERSION](
 			"FedwireFundsSystemResponse",
 			FedwireFundsSystemResponseModel.DocumentWith,                               // Type-safe document creator
+			FedwireFundsSystemResponseModel.EncodeDocument,                             // Decimal-aware document encoder
 			FedwireFundsSystemResponseModel.CheckRequiredFields,                        // Type-safe field validator
 			func() any { return FedwireFundsSystemResponseModel.BuildMessageHelper() }, // Adapted helper builder
 			func(data []byte) (FedwireFundsSystemResponseModel.MessageModel, error) { // XML converter using new API
@@ -16,6 +16,7 @@ func NewMaster() *Master {
 		MessageWrapper: NewMessageWrapper[MasterModel.MessageModel, MasterModel.CAMT_052_001_VERSION](
 			"Master",
 			MasterModel.DocumentWith,        // Type-safe document creator
+			MasterModel.EncodeDocument,      // Decimal-aware document encoder
 			MasterModel.CheckRequiredFields, // Type-safe field validator
 			func() any { return MasterModel.BuildMessageHelper() }, // Adapted helper builder
 			func(data []byte) (MasterModel.MessageModel, error) { // XML converter using new API
@@ -16,6 +16,7 @@ func NewPaymentReturn() *PaymentReturn {
 		MessageWrapper: NewMessageWrapper[PaymentReturnModel.MessageModel, PaymentReturnModel.PACS_004_001_VERSION](
 			"PaymentReturn",
 			PaymentReturnModel.DocumentWith,                               // Type-safe document creator
+			PaymentReturnModel.EncodeDocument,                             // Decimal-aware document encoder
 			PaymentReturnModel.CheckRequiredFields,                        // Type-safe field validator
 			func() any { return PaymentReturnModel.BuildMessageHelper() }, // Adapted helper builder
 			func(data []byte) (PaymentReturnModel.MessageModel, error) { // XML converter using new API
@@ -16,6 +16,7 @@ func NewPaymentStatusRequest() *PaymentStatusRequest {
 		MessageWrapper: NewMessageWrapper[PaymentStatusRequestModel.MessageModel, PaymentStatusRequestModel.PACS_028_001_VERSION](
 			"PaymentStatusRequest",
 			PaymentStatusRequestModel.DocumentWith,                               // Type-safe document creator
+			PaymentStatusRequestModel.EncodeDocument,                             // Decimal-aware document encoder
 			PaymentStatusRequestModel.CheckRequiredFields,                        // Type-safe field validator
 			func() any { return PaymentStatusRequestModel.BuildMessageHelper() }, // Adapted helper builder
 			func(data []byte) (PaymentStatusRequestModel.MessageModel, error) { // XML converter using new API
@@ -16,6 +16,7 @@ func NewReturnRequestResponse() *ReturnRequestResponse {
 		MessageWrapper: NewMessageWrapper[ReturnRequestResponseModel.MessageModel, ReturnRequestResponseModel.CAMT_029_001_VERSION](
 			"ReturnRequestResponse",
 			ReturnRequestResponseModel.DocumentWith,                               // Type-safe document creator
+			ReturnRequestResponseModel.EncodeDocument,                             // Decimal-aware document encoder
 			ReturnRequestResponseModel.CheckRequiredFields,                        // Type-safe field validator
 			func() any { return ReturnRequestResponseModel.BuildMessageHelper() }, // Adapted helper builder
 			func(data []byte) (ReturnRequestResponseModel.MessageModel, error) { // XML converter using new API
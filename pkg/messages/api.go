@@ -11,6 +11,7 @@ package messages
 
 // Payment messages (pacs)
 // - CustomerCreditTransfer: pacs.008 - Customer credit transfer
+// - FICreditTransfer: pacs.009 - Financial institution credit transfer
 // - PaymentReturn: pacs.004 - Payment return
 // - PaymentStatusRequest: pacs.028 - Payment status request
 // - FedwireFundsPaymentStatus: pacs.002 - Payment status report
@@ -21,7 +22,10 @@ package messages
 // - EndpointDetailsReport: camt.090 - Request for member profile
 // - EndpointGapReport: camt.087 - Request for duplicate
 // - EndpointTotalsReport: camt.089 - Request to cancel payment
+// - ReturnRequest: camt.056 - FI to FI payment cancellation request
 // - ReturnRequestResponse: camt.029 - Resolution of investigation
+// - InvestigationRequest: camt.110 - Investigation request
+// - InvestigationResponse: camt.111 - Investigation response
 // - Master: camt.052 - Bank to customer account report
 
 // Payment initiation messages (pain)
@@ -32,3 +36,8 @@ package messages
 // - ConnectionCheck: admi.001 - Static data request
 // - FedwireFundsAcknowledgement: admi.004 - System event acknowledgement
 // - FedwireFundsSystemResponse: admi.011 - System event notification
+// - MessageReject: admi.002 - Message reject
+// - RetrievalRequest: admi.006 - Retrieval request
+
+// Business application header (head)
+// - BusinessApplicationHeader: head.001 - Business application header (AppHdr), carried next to any message above
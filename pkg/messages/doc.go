@@ -37,12 +37,12 @@
 //		"interBankSettDate": "2024-01-01",
 //		"instructedAmount": {"currency": "USD", "amount": 1000.00},
 //		"chargeBearer": "SLEV",
-//		"instructingAgent": {"paymentSysCode": "USABA", "paymentSysMemberId": "123456789"},
-//		"instructedAgent": {"paymentSysCode": "USABA", "paymentSysMemberId": "987654321"},
+//		"instructingAgent": {"paymentSysCode": "USABA", "paymentSysMemberId": "011104238"},
+//		"instructedAgent": {"paymentSysCode": "USABA", "paymentSysMemberId": "021040078"},
 //		"debtorName": "John Doe",
 //		"debtorAddress": {"streetName": "Main St", "buildingNumber": "123", "postalCode": "12345", "townName": "Anytown", "country": "US"},
-//		"debtorAgent": {"paymentSysCode": "USABA", "paymentSysMemberId": "123456789"},
-//		"creditorAgent": {"paymentSysCode": "USABA", "paymentSysMemberId": "987654321"}
+//		"debtorAgent": {"paymentSysCode": "USABA", "paymentSysMemberId": "011104238"},
+//		"creditorAgent": {"paymentSysCode": "USABA", "paymentSysMemberId": "021040078"}
 //	}`)
 //
 //	// Create ISO 20022 XML document
@@ -88,16 +88,51 @@
 //		// Handle PaymentReturn
 //	}
 //
+//	// Or handle any message through the Message interface
+//	log.Printf("%s %s", parsed.Message.ISOMessageName(), parsed.Message.GetMessageId())
+//
 //	// Validate the parsed message
 //	err = reader.ValidateMessage(parsed)
 //	if err != nil {
 //		log.Printf("Validation failed: %v", err)
 //	}
 //
+// Messages delivered inside a FedwireFundsIncoming or FedwireFundsOutgoing envelope are
+// unwrapped automatically; the envelope is kept in ParsedMessage.Envelope so a reply can be
+// wrapped the same way with WrapEnvelope:
+//
+//	var buf bytes.Buffer
+//	err = reply.WriteXML(&buf)
+//	wrapped, err := messages.WrapEnvelope(parsed.Envelope, buf.Bytes())
+//
+// Set PreserveUnmapped to keep elements the models do not cover, such as RgltryRptg or a
+// second intermediary agent, in each message's Unmapped field. WriteXML puts them back when
+// the message is written with the version it was read as:
+//
+//	reader.PreserveUnmapped = true
+//	parsed, err := reader.ReadBytes(xmlData)
+//
+// Set Strict to fail when a document value cannot be copied to the message model; each one is
+// reported as an errors.FieldError with the element's XPath and the model field.
+//
+// ConvertMessage converts a parsed message to another version of its definition, reporting the
+// values the version cannot hold and the fields added for it:
+//
+//	older, report, err := reader.ConvertMessage(parsed, "pacs.008.001.05")
+//
+// DiffMessages compares two parsed messages of the same type field by field, whatever their
+// formatting or version, and lists the differences by dotted model path:
+//
+//	diff, err := reader.DiffMessages(sent, resent)
+//
+// Other message types, such as in-house admi.998 messages, can be registered with
+// RegisterMessageType for the reader to detect and parse alongside the built-in ones.
+//
 // # Supported Message Types
 //
 // ## Payment Messages (pacs)
 //   - CustomerCreditTransfer: pacs.008 - Customer credit transfer initiation
+//   - FICreditTransfer: pacs.009 - Financial institution credit transfers, including cover payments
 //   - PaymentReturn: pacs.004 - Payment return messages
 //   - PaymentStatusRequest: pacs.028 - Payment status inquiry
 //   - FedwireFundsPaymentStatus: pacs.002 - Payment status reports
@@ -108,7 +143,10 @@
 //   - EndpointDetailsReport: camt.090 - Member profile requests
 //   - EndpointGapReport: camt.087 - Duplicate message requests
 //   - EndpointTotalsReport: camt.089 - Payment cancellation requests
+//   - ReturnRequest: camt.056 - Payment cancellation (return) requests
 //   - ReturnRequestResponse: camt.029 - Investigation resolution
+//   - InvestigationRequest: camt.110 - Payment investigations such as unable to apply
+//   - InvestigationResponse: camt.111 - Outcome of payment investigations
 //   - Master: camt.052 - Bank to customer account reports
 //
 // ## Payment Initiation Messages (pain)
@@ -119,6 +157,12 @@
 //   - ConnectionCheck: admi.001 - System connectivity checks
 //   - FedwireFundsAcknowledgement: admi.004 - System event acknowledgements
 //   - FedwireFundsSystemResponse: admi.011 - System event notifications
+//   - MessageReject: admi.002 - Technical rejections of previously sent messages
+//   - RetrievalRequest: admi.006 - Requests to resend previously exchanged messages
+//
+// ## Business Application Header (head)
+//   - BusinessApplicationHeader: head.001 - AppHdr sent ahead of any of the messages above;
+//     set the AppHdr field of a model to write it, and UniversalReader exposes it as ParsedMessage.AppHdr
 //
 // # Type Safety Benefits
 //
@@ -0,0 +1,207 @@
+package messages
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+
+	"github.com/moov-io/wire20022/pkg/errors"
+	"github.com/moov-io/wire20022/pkg/models"
+)
+
+// EnvelopeDirection is the root element of a Fedwire Funds Service envelope
+type EnvelopeDirection string
+
+const (
+	// EnvelopeIncoming wraps messages a participant sends to the Fedwire Funds Service
+	EnvelopeIncoming EnvelopeDirection = "FedwireFundsIncoming"
+	// EnvelopeOutgoing wraps messages the Fedwire Funds Service sends to a participant
+	EnvelopeOutgoing EnvelopeDirection = "FedwireFundsOutgoing"
+)
+
+// Envelope describes the Fedwire Funds envelope that holds an AppHdr and a Document together.
+// UnwrapEnvelope records the envelope it removes so WrapEnvelope can restore the same structure.
+type Envelope struct {
+	Direction EnvelopeDirection
+	Namespace string            // Default namespace of the root element, if any
+	Prefixes  map[string]string // Namespaces the root element declares with xmlns:prefix, by prefix
+	Wrappers  []string          // Elements between the root and the AppHdr/Document, outermost first
+}
+
+// NewEnvelope creates an envelope for the given direction. Wrapper element names, if any,
+// are written between the root element and the message in the order given.
+func NewEnvelope(direction EnvelopeDirection, namespace string, wrappers ...string) *Envelope {
+	return &Envelope{
+		Direction: direction,
+		Namespace: namespace,
+		Wrappers:  wrappers,
+	}
+}
+
+// isEnvelopeRoot reports whether an element name is the root of a Fedwire Funds envelope
+func isEnvelopeRoot(name string) bool {
+	return name == string(EnvelopeIncoming) || name == string(EnvelopeOutgoing)
+}
+
+// UnwrapEnvelope removes a FedwireFundsIncoming/FedwireFundsOutgoing envelope and returns the
+// AppHdr and Document it contains. Data that is not enveloped is returned unchanged with a nil Envelope.
+func UnwrapEnvelope(data []byte) (*Envelope, []byte, error) {
+	envelope, message, _, err := unwrapEnvelope(data)
+	return envelope, message, err
+}
+
+// unwrapEnvelope is UnwrapEnvelope that also returns the offset of the message in data
+func unwrapEnvelope(data []byte) (*Envelope, []byte, int64, error) {
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	var envelope *Envelope
+	// Elements open below the root; siblings that close before the message are dropped
+	var path []string
+
+	for {
+		offset := decoder.InputOffset()
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, nil, 0, models.DecodeError("XML decode", "envelope", err, decoder)
+		}
+
+		if _, ok := token.(xml.EndElement); ok && len(path) > 0 {
+			path = path[:len(path)-1]
+			continue
+		}
+		start, ok := token.(xml.StartElement)
+		if !ok {
+			continue
+		}
+
+		if envelope == nil {
+			if !isEnvelopeRoot(start.Name.Local) {
+				return nil, data, 0, nil
+			}
+			envelope = &Envelope{
+				Direction: EnvelopeDirection(start.Name.Local),
+				Namespace: start.Name.Space,
+			}
+			for _, attr := range start.Attr {
+				if attr.Name.Space == "xmlns" {
+					if envelope.Prefixes == nil {
+						envelope.Prefixes = make(map[string]string)
+					}
+					envelope.Prefixes[attr.Name.Local] = attr.Value
+				}
+			}
+			continue
+		}
+
+		if start.Name.Local != "AppHdr" && start.Name.Local != "Document" {
+			path = append(path, start.Name.Local)
+			continue
+		}
+		envelope.Wrappers = path
+
+		// The message is the AppHdr and/or Document up to the end of their parent element
+		end, err := skipSiblings(decoder)
+		if err != nil {
+			return nil, nil, 0, models.DecodeError("XML decode", "envelope", err, decoder)
+		}
+		return envelope, data[offset:end], offset, nil
+	}
+
+	if envelope == nil {
+		return nil, data, 0, nil
+	}
+	return nil, nil, 0, fmt.Errorf("%s envelope contains no Document: %w", envelope.Direction, errors.ErrInvalidXML)
+}
+
+// skipSiblings skips the current element and the siblings that follow it, returning the
+// offset just past the last of them
+func skipSiblings(decoder *xml.Decoder) (int64, error) {
+	if err := decoder.Skip(); err != nil {
+		return 0, err
+	}
+	end := decoder.InputOffset()
+
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			return 0, err
+		}
+		switch token.(type) {
+		case xml.StartElement:
+			if err := decoder.Skip(); err != nil {
+				return 0, err
+			}
+			end = decoder.InputOffset()
+		case xml.EndElement:
+			return end, nil
+		}
+	}
+}
+
+// WrapEnvelope places an AppHdr and Document, such as the output of a model's WriteXML, inside
+// the envelope. A nil envelope returns the message unchanged.
+func WrapEnvelope(envelope *Envelope, message []byte) ([]byte, error) {
+	if envelope == nil {
+		return message, nil
+	}
+	if !isEnvelopeRoot(string(envelope.Direction)) {
+		return nil, fmt.Errorf("unknown envelope %q", envelope.Direction)
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(xml.Header)
+
+	encoder := xml.NewEncoder(&buf)
+	root := xml.StartElement{Name: xml.Name{Local: string(envelope.Direction)}}
+	if envelope.Namespace != "" {
+		root.Attr = []xml.Attr{{Name: xml.Name{Local: "xmlns"}, Value: envelope.Namespace}}
+	}
+	prefixes := slices.Sorted(maps.Keys(envelope.Prefixes))
+	for _, prefix := range prefixes {
+		root.Attr = append(root.Attr, xml.Attr{Name: xml.Name{Local: "xmlns:" + prefix}, Value: envelope.Prefixes[prefix]})
+	}
+	elements := []xml.StartElement{root}
+	for _, wrapper := range envelope.Wrappers {
+		elements = append(elements, xml.StartElement{Name: xml.Name{Local: wrapper}})
+	}
+
+	for _, element := range elements {
+		if err := encoder.EncodeToken(element); err != nil {
+			return nil, fmt.Errorf("encoding envelope: %w", err)
+		}
+	}
+	if err := encoder.Flush(); err != nil {
+		return nil, fmt.Errorf("encoding envelope: %w", err)
+	}
+
+	buf.WriteString("\n")
+	buf.Write(bytes.TrimSpace(stripXMLDeclaration(message)))
+	buf.WriteString("\n")
+
+	for i := len(elements) - 1; i >= 0; i-- {
+		if err := encoder.EncodeToken(elements[i].End()); err != nil {
+			return nil, fmt.Errorf("encoding envelope: %w", err)
+		}
+	}
+	if err := encoder.Flush(); err != nil {
+		return nil, fmt.Errorf("encoding envelope: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// stripXMLDeclaration removes a leading <?xml ...?> declaration
+func stripXMLDeclaration(data []byte) []byte {
+	trimmed := bytes.TrimSpace(data)
+	if !bytes.HasPrefix(trimmed, []byte("<?xml")) {
+		return data
+	}
+	if end := bytes.Index(trimmed, []byte("?>")); end >= 0 {
+		return trimmed[end+2:]
+	}
+	return data
+}
@@ -0,0 +1,159 @@
+package messages
+
+import (
+	"bytes"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/moov-io/wire20022/pkg/errors"
+	CustomerCreditTransferModel "github.com/moov-io/wire20022/pkg/models/CustomerCreditTransfer"
+)
+
+const envelopeSample = "../../pkg/models/BusinessApplicationHeader/swiftSample/BusinessApplicationHeader_Scenario1_Step1_pacs.008"
+
+func envelopeTestData(t *testing.T) []byte {
+	message, err := os.ReadFile(envelopeSample)
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	buf.WriteString(`<?xml version="1.0" encoding="UTF-8"?>` + "\n")
+	buf.WriteString(`<FedwireFundsOutgoing xmlns="urn:fedwirefunds:outgoing:test">` + "\n")
+	buf.WriteString("<FedwireFundsOutgoingMessage>\n")
+	buf.Write(message)
+	buf.WriteString("\n</FedwireFundsOutgoingMessage>\n</FedwireFundsOutgoing>")
+	return buf.Bytes()
+}
+
+func TestUnwrapEnvelope(t *testing.T) {
+	t.Run("enveloped message", func(t *testing.T) {
+		envelope, message, err := UnwrapEnvelope(envelopeTestData(t))
+		require.NoError(t, err)
+		require.NotNil(t, envelope)
+		assert.Equal(t, EnvelopeOutgoing, envelope.Direction)
+		assert.Equal(t, "urn:fedwirefunds:outgoing:test", envelope.Namespace)
+		assert.Equal(t, []string{"FedwireFundsOutgoingMessage"}, envelope.Wrappers)
+
+		content := string(message)
+		assert.True(t, strings.HasPrefix(content, "<AppHdr"))
+		assert.True(t, strings.HasSuffix(content, "</Document>"))
+		assert.NotContains(t, content, "FedwireFundsOutgoing")
+	})
+
+	t.Run("siblings and prefixes", func(t *testing.T) {
+		data := []byte(`<FedwireFundsIncoming xmlns="urn:fedwirefunds:incoming:test" xmlns:fw="urn:fedwirefunds:ext"` +
+			` xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance">` +
+			`<fw:Routing><Queue>A</Queue></fw:Routing>` +
+			`<FedwireFundsIncomingMessage><Document xsi:schemaLocation="x"/></FedwireFundsIncomingMessage>` +
+			`</FedwireFundsIncoming>`)
+		envelope, message, err := UnwrapEnvelope(data)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"FedwireFundsIncomingMessage"}, envelope.Wrappers)
+		assert.Equal(t, map[string]string{
+			"fw":  "urn:fedwirefunds:ext",
+			"xsi": "http://www.w3.org/2001/XMLSchema-instance",
+		}, envelope.Prefixes)
+
+		// The declarations are written back so prefixes in the message stay bound
+		wrapped, err := WrapEnvelope(envelope, message)
+		require.NoError(t, err)
+		assert.Contains(t, string(wrapped), `xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance"`)
+		rewrapped, _, err := UnwrapEnvelope(wrapped)
+		require.NoError(t, err)
+		assert.Equal(t, envelope, rewrapped)
+	})
+
+	t.Run("message without envelope", func(t *testing.T) {
+		data, err := os.ReadFile(envelopeSample)
+		require.NoError(t, err)
+
+		envelope, message, err := UnwrapEnvelope(data)
+		require.NoError(t, err)
+		assert.Nil(t, envelope)
+		assert.Equal(t, data, message)
+	})
+
+	t.Run("empty envelope", func(t *testing.T) {
+		_, _, err := UnwrapEnvelope([]byte(`<FedwireFundsIncoming><FedwireFundsIncomingMessage/></FedwireFundsIncoming>`))
+		require.Error(t, err)
+		assert.ErrorIs(t, err, errors.ErrInvalidXML)
+	})
+
+	t.Run("malformed envelope", func(t *testing.T) {
+		_, _, err := UnwrapEnvelope([]byte(`<FedwireFundsIncoming><Document>`))
+		require.Error(t, err)
+	})
+}
+
+func TestWrapEnvelope(t *testing.T) {
+	envelope, message, err := UnwrapEnvelope(envelopeTestData(t))
+	require.NoError(t, err)
+
+	// Re-wrapping restores the envelope structure that was removed
+	wrapped, err := WrapEnvelope(envelope, message)
+	require.NoError(t, err)
+	rewrapped, remessage, err := UnwrapEnvelope(wrapped)
+	require.NoError(t, err)
+	assert.Equal(t, envelope, rewrapped)
+	assert.Equal(t, string(message), string(remessage))
+
+	// The output of WriteXML can be wrapped directly
+	model, err := CustomerCreditTransferModel.ParseXML(message)
+	require.NoError(t, err)
+	var buf bytes.Buffer
+	require.NoError(t, model.WriteXML(&buf, CustomerCreditTransferModel.PACS_008_001_08))
+
+	wrapped, err = WrapEnvelope(NewEnvelope(EnvelopeIncoming, "urn:fedwirefunds:incoming:test"), buf.Bytes())
+	require.NoError(t, err)
+	assert.Equal(t, 1, strings.Count(string(wrapped), "<?xml"))
+	assert.True(t, strings.HasSuffix(string(wrapped), "</FedwireFundsIncoming>"))
+
+	envelope, _, err = UnwrapEnvelope(wrapped)
+	require.NoError(t, err)
+	assert.Equal(t, EnvelopeIncoming, envelope.Direction)
+	assert.Empty(t, envelope.Wrappers)
+
+	// No envelope leaves the message untouched
+	unwrapped, err := WrapEnvelope(nil, message)
+	require.NoError(t, err)
+	assert.Equal(t, message, unwrapped)
+
+	_, err = WrapEnvelope(&Envelope{Direction: "Unknown"}, message)
+	assert.Error(t, err)
+}
+
+func TestUniversalReader_Envelope(t *testing.T) {
+	reader := NewUniversalReader()
+
+	parsed, err := reader.ReadBytes(envelopeTestData(t))
+	require.NoError(t, err)
+	assert.Equal(t, TypeCustomerCreditTransfer, parsed.Type)
+	require.NotNil(t, parsed.Envelope)
+	assert.Equal(t, EnvelopeOutgoing, parsed.Envelope.Direction)
+	require.NotNil(t, parsed.AppHdr)
+	assert.Equal(t, "pacs.008.001.08", parsed.AppHdr.MessageDefinitionId)
+
+	msg, ok := parsed.Message.(*CustomerCreditTransferModel.MessageModel)
+	require.True(t, ok)
+	assert.Equal(t, "20250310B1QDRCQR000001", msg.MessageId)
+}
+
+func TestUniversalReader_EnvelopeErrorLocation(t *testing.T) {
+	data := envelopeTestData(t)
+	data = bytes.Replace(data, []byte("<NbOfTxs>1</NbOfTxs>"), []byte("<NbOfTxs>2</NbOfTxs>"), 1)
+
+	reader := NewUniversalReader()
+	_, err := reader.ReadBytes(data)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Location: line")
+
+	// The position is in the enveloped input, past both the envelope and the AppHdr
+	location, ok := errors.LocationOf(err)
+	require.True(t, ok)
+	assert.True(t, bytes.HasPrefix(data[location.Offset:], []byte("<NbOfTxs>2")))
+	line := strings.Split(string(data), "\n")[location.Line-1]
+	assert.True(t, strings.HasPrefix(line[location.Column-1:], "<NbOfTxs>2"))
+}
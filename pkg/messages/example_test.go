@@ -28,12 +28,12 @@ func ExampleNewCustomerCreditTransfer() {
 		"interBankSettDate": "2024-01-01",
 		"instructedAmount": {"currency": "USD", "amount": 1000.00},
 		"chargeBearer": "SLEV",
-		"instructingAgent": {"paymentSysCode": "USABA", "paymentSysMemberId": "123456789"},
-		"instructedAgent": {"paymentSysCode": "USABA", "paymentSysMemberId": "987654321"},
+		"instructingAgent": {"paymentSysCode": "USABA", "paymentSysMemberId": "011104238"},
+		"instructedAgent": {"paymentSysCode": "USABA", "paymentSysMemberId": "021040078"},
 		"debtorName": "John Doe",
 		"debtorAddress": {"streetName": "Main St", "buildingNumber": "123", "postalCode": "12345", "townName": "Anytown", "country": "US"},
-		"debtorAgent": {"paymentSysCode": "USABA", "paymentSysMemberId": "123456789"},
-		"creditorAgent": {"paymentSysCode": "USABA", "paymentSysMemberId": "987654321"}
+		"debtorAgent": {"paymentSysCode": "USABA", "paymentSysMemberId": "011104238"},
+		"creditorAgent": {"paymentSysCode": "USABA", "paymentSysMemberId": "021040078"}
 	}`)
 
 	// Create ISO 20022 XML document
@@ -0,0 +1,45 @@
+package messages
+
+import (
+	"io"
+	"time"
+)
+
+// Message is implemented by the MessageModel of every message package, so code that routes,
+// logs or archives messages can handle them without knowing their type.
+//
+// The models cannot offer MessageId() and WriteXML(io.Writer): MessageId is already a field,
+// and WriteXML takes an optional version, so its signature differs. The interface uses
+// GetMessageId and WriteDocument instead.
+//
+// Example:
+//
+//	parsed, err := reader.ReadBytes(xmlData)
+//	if err != nil {
+//		return err
+//	}
+//	log.Printf("%s %s created %s", parsed.Message.ISOMessageName(), parsed.Message.GetMessageId(), parsed.Message.CreatedAt())
+//	err = parsed.Message.WriteDocument(archive)
+type Message interface {
+	// GetMessageId returns the message identification, usually the IMAD in MsgId.
+	// Messages without one return the BizMsgIdr of their AppHdr, if any.
+	GetMessageId() string
+
+	// CreatedAt returns when the message was created
+	CreatedAt() time.Time
+
+	// ISOMessageName returns the ISO 20022 message, such as "pacs.008"
+	ISOMessageName() string
+
+	// Version returns the message definition WriteDocument writes, such as "pacs.008.001.08".
+	// It is the one the message was read as when its AppHdr or preserved content tells,
+	// otherwise the latest supported.
+	Version() string
+
+	// Validate checks the required fields and the rules of Version
+	Validate() error
+
+	// WriteDocument writes the message as XML in Version, with its AppHdr if set.
+	// Use the model's WriteXML to choose the version.
+	WriteDocument(w io.Writer) error
+}
@@ -0,0 +1,85 @@
+package messages
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	BusinessApplicationHeaderModel "github.com/moov-io/wire20022/pkg/models/BusinessApplicationHeader"
+	ConnectionCheckModel "github.com/moov-io/wire20022/pkg/models/ConnectionCheck"
+	CustomerCreditTransferModel "github.com/moov-io/wire20022/pkg/models/CustomerCreditTransfer"
+)
+
+func TestMessage(t *testing.T) {
+	reader := NewUniversalReader()
+
+	data, err := os.ReadFile(envelopeSample)
+	require.NoError(t, err)
+	parsed, err := reader.ReadBytes(data)
+	require.NoError(t, err)
+
+	msg := parsed.Message
+	assert.Equal(t, "20250310B1QDRCQR000001", msg.GetMessageId())
+	assert.Equal(t, time.Date(2025, 3, 10, 13, 0, 0, 0, time.UTC), msg.CreatedAt().UTC())
+	assert.Equal(t, "pacs.008", msg.ISOMessageName())
+	assert.Equal(t, "pacs.008.001.08", msg.Version())
+	assert.NoError(t, msg.Validate())
+
+	// WriteDocument writes the version the message was read as
+	var written, expected bytes.Buffer
+	require.NoError(t, msg.WriteDocument(&written))
+	require.NoError(t, msg.(*CustomerCreditTransferModel.MessageModel).WriteXML(&expected, CustomerCreditTransferModel.PACS_008_001_08))
+	assert.Equal(t, expected.String(), written.String())
+
+	// Validate reports missing required fields
+	msg.(*CustomerCreditTransferModel.MessageModel).MessageId = ""
+	assert.Error(t, msg.Validate())
+
+	t.Run("built in code", func(t *testing.T) {
+		var msg Message = &ConnectionCheckModel.MessageModel{
+			EventType: "PING",
+			EventTime: time.Date(2025, 3, 10, 9, 0, 0, 0, time.UTC),
+		}
+		assert.Empty(t, msg.GetMessageId())
+		assert.Equal(t, "admi.004", msg.ISOMessageName())
+		assert.Equal(t, string(ConnectionCheckModel.ADMI_004_001_02), msg.Version())
+
+		// Messages without a MsgId of their own are identified by their AppHdr
+		msg.(*ConnectionCheckModel.MessageModel).AppHdr = &BusinessApplicationHeaderModel.MessageModel{
+			BusinessMessageId:   "20250310QMGFT015000001",
+			MessageDefinitionId: "admi.004.001.01",
+		}
+		assert.Equal(t, "20250310QMGFT015000001", msg.GetMessageId())
+		assert.Equal(t, "admi.004.001.01", msg.Version())
+	})
+}
+
+func TestMessage_SampleFiles(t *testing.T) {
+	reader := NewUniversalReader()
+
+	for _, msgType := range RegisteredMessageTypes() {
+		t.Run(string(msgType), func(t *testing.T) {
+			files, err := filepath.Glob(filepath.Join("../../pkg/models", string(msgType), "swiftSample", "*"))
+			require.NoError(t, err)
+
+			for _, file := range files {
+				data, err := os.ReadFile(file)
+				require.NoError(t, err)
+
+				parsed, err := reader.ReadBytes(data)
+				if err != nil || parsed.Type != msgType {
+					continue // covered by TestUniversalReader_WithSampleFiles
+				}
+
+				namespaceType := parsed.Detection.AdditionalInfo["namespace_type"]
+				assert.Equal(t, namespaceType, parsed.Message.ISOMessageName(), file)
+				assert.Equal(t, namespaceType+"."+parsed.Version, parsed.Message.Version(), file)
+			}
+		})
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/moov-io/wire20022/pkg/messages"
 	AccountReportingRequestModel "github.com/moov-io/wire20022/pkg/models/AccountReportingRequest"
@@ -105,12 +106,12 @@ func TestCustomerCreditTransfer(t *testing.T) {
 			"interBankSettDate": "2024-01-01",
 			"instructedAmount": {"currency": "USD", "amount": 1000.00},
 			"chargeBearer": "SLEV",
-			"instructingAgent": {"paymentSysCode": "USABA", "paymentSysMemberId": "123456789"},
-			"instructedAgent": {"paymentSysCode": "USABA", "paymentSysMemberId": "987654321"},
+			"instructingAgent": {"paymentSysCode": "USABA", "paymentSysMemberId": "011104238"},
+			"instructedAgent": {"paymentSysCode": "USABA", "paymentSysMemberId": "021040078"},
 			"debtorName": "John Doe",
 			"debtorAddress": {"streetName": "Main St", "buildingNumber": "123", "postalCode": "12345", "townName": "Anytown", "country": "US"},
-			"debtorAgent": {"paymentSysCode": "USABA", "paymentSysMemberId": "123456789"},
-			"creditorAgent": {"paymentSysCode": "USABA", "paymentSysMemberId": "987654321"}
+			"debtorAgent": {"paymentSysCode": "USABA", "paymentSysMemberId": "011104238"},
+			"creditorAgent": {"paymentSysCode": "USABA", "paymentSysMemberId": "021040078"}
 		}`)
 
 		xmlData, err := processor.CreateDocument(validJSON, CustomerCreditTransferModel.PACS_008_001_08)
@@ -122,6 +123,43 @@ func TestCustomerCreditTransfer(t *testing.T) {
 		err = xml.Unmarshal(xmlData, &doc)
 		assert.NoError(t, err)
 	})
+
+	t.Run("CreateDocument writes amounts exactly", func(t *testing.T) {
+		modelJSON := func(amount string) []byte {
+			return []byte(`{
+				"messageId": "20240101B1QDRCQR000001",
+				"createdDateTime": "2024-01-01T10:00:00Z",
+				"numberOfTransactions": "1",
+				"settlementMethod": "CLRG",
+				"commonClearingSysCode": "FDW",
+				"transactions": [{
+					"instructionId": "INSTR001",
+					"endToEndId": "E2E001",
+					"instrumentPropCode": "CTRC",
+					"interBankSettAmount": {"Currency": "USD", "Amount": ` + amount + `},
+					"interBankSettDate": "2024-01-01",
+					"instructedAmount": {"Currency": "USD", "Amount": ` + amount + `},
+					"chargeBearer": "SLEV",
+					"Transaction": {"uniqueEndToEndTransactionRef": "8a562c67-ca16-48ba-b074-65581be6f011"},
+					"instructingAgent": {"PaymentSysCode": "USABA", "PaymentSysMemberId": "011104238"},
+					"instructedAgent": {"PaymentSysCode": "USABA", "PaymentSysMemberId": "021040078"},
+					"debtorName": "John Doe",
+					"debtorAgent": {"PaymentSysCode": "USABA", "PaymentSysMemberId": "011104238"},
+					"creditorAgent": {"PaymentSysCode": "USABA", "PaymentSysMemberId": "021040078"},
+					"creditorName": "Jane Doe"
+				}]
+			}`)
+		}
+
+		xmlData, err := processor.CreateDocument(modelJSON("1500000.10"), CustomerCreditTransferModel.PACS_008_001_08)
+		require.NoError(t, err)
+		assert.Contains(t, string(xmlData), ">1500000.10</InstdAmt>")
+
+		// More decimal places than USD allows are refused rather than rounded
+		_, err = processor.CreateDocument(modelJSON("10.123"), CustomerCreditTransferModel.PACS_008_001_08)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Transactions[0].InstructedAmount")
+	})
 }
 
 // Test that all message processors can be created successfully
@@ -136,12 +174,18 @@ func TestAllMessageProcessors(t *testing.T) {
 		"EndpointDetailsReport":       messages.NewEndpointDetailsReport(),
 		"EndpointGapReport":           messages.NewEndpointGapReport(),
 		"EndpointTotalsReport":        messages.NewEndpointTotalsReport(),
+		"FICreditTransfer":            messages.NewFICreditTransfer(),
 		"FedwireFundsAcknowledgement": messages.NewFedwireFundsAcknowledgement(),
 		"FedwireFundsPaymentStatus":   messages.NewFedwireFundsPaymentStatus(),
 		"FedwireFundsSystemResponse":  messages.NewFedwireFundsSystemResponse(),
+		"InvestigationRequest":        messages.NewInvestigationRequest(),
+		"InvestigationResponse":       messages.NewInvestigationResponse(),
 		"Master":                      messages.NewMaster(),
+		"MessageReject":               messages.NewMessageReject(),
 		"PaymentReturn":               messages.NewPaymentReturn(),
 		"PaymentStatusRequest":        messages.NewPaymentStatusRequest(),
+		"RetrievalRequest":            messages.NewRetrievalRequest(),
+		"ReturnRequest":               messages.NewReturnRequest(),
 		"ReturnRequestResponse":       messages.NewReturnRequestResponse(),
 	}
 
@@ -266,12 +310,12 @@ func BenchmarkCustomerCreditTransfer(b *testing.B) {
 		"interBankSettDate": "2024-01-01",
 		"instructedAmount": {"currency": "USD", "amount": 1000.00},
 		"chargeBearer": "SLEV",
-		"instructingAgent": {"paymentSysCode": "USABA", "paymentSysMemberId": "123456789"},
-		"instructedAgent": {"paymentSysCode": "USABA", "paymentSysMemberId": "987654321"},
+		"instructingAgent": {"paymentSysCode": "USABA", "paymentSysMemberId": "011104238"},
+		"instructedAgent": {"paymentSysCode": "USABA", "paymentSysMemberId": "021040078"},
 		"debtorName": "John Doe",
 		"debtorAddress": {"streetName": "Main St", "buildingNumber": "123", "postalCode": "12345", "townName": "Anytown", "country": "US"},
-		"debtorAgent": {"paymentSysCode": "USABA", "paymentSysMemberId": "123456789"},
-		"creditorAgent": {"paymentSysCode": "USABA", "paymentSysMemberId": "987654321"}
+		"debtorAgent": {"paymentSysCode": "USABA", "paymentSysMemberId": "011104238"},
+		"creditorAgent": {"paymentSysCode": "USABA", "paymentSysMemberId": "021040078"}
 	}`)
 
 	b.ResetTimer()
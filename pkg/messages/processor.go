@@ -1,6 +1,7 @@
 package messages
 
 import (
+	"bytes"
 	"encoding/json"
 	"encoding/xml"
 	"fmt"
@@ -14,6 +15,7 @@ import (
 type MessageWrapper[M any, V comparable] struct {
 	name            string
 	documentCreator func(M, V) (models.ISODocument, error)
+	documentEncoder func(*xml.Encoder, models.ISODocument, M, V) error
 	fieldValidator  func(M) error
 	helpBuilder     func() any
 	xmlConverter    func([]byte) (M, error)
@@ -23,6 +25,7 @@ type MessageWrapper[M any, V comparable] struct {
 func NewMessageWrapper[M any, V comparable](
 	name string,
 	documentCreator func(M, V) (models.ISODocument, error),
+	documentEncoder func(*xml.Encoder, models.ISODocument, M, V) error,
 	fieldValidator func(M) error,
 	helpBuilder func() any,
 	xmlConverter func([]byte) (M, error),
@@ -30,6 +33,7 @@ func NewMessageWrapper[M any, V comparable](
 	return &MessageWrapper[M, V]{
 		name:            name,
 		documentCreator: documentCreator,
+		documentEncoder: documentEncoder,
 		fieldValidator:  fieldValidator,
 		helpBuilder:     helpBuilder,
 		xmlConverter:    xmlConverter,
@@ -50,13 +54,18 @@ func (w *MessageWrapper[M, V]) CreateDocument(modelJson []byte, version V) ([]by
 		return nil, fmt.Errorf("failed to create %s document: %w", w.name, err)
 	}
 
-	// Marshal to XML
-	xmlData, err := xml.MarshalIndent(doc, "", "  ")
-	if err != nil {
+	// Marshal to XML, writing each amount with the digits of the model
+	var xmlData bytes.Buffer
+	encoder := xml.NewEncoder(&xmlData)
+	encoder.Indent("", "  ")
+	if err := w.documentEncoder(encoder, doc, model, version); err != nil {
+		return nil, fmt.Errorf("failed to marshal %s document to XML: %w", w.name, err)
+	}
+	if err := encoder.Flush(); err != nil {
 		return nil, fmt.Errorf("failed to marshal %s document to XML: %w", w.name, err)
 	}
 
-	return xmlData, nil
+	return xmlData.Bytes(), nil
 }
 
 // ValidateDocument validates JSON model without creating XML document
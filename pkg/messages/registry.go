@@ -0,0 +1,340 @@
+package messages
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/moov-io/wire20022/pkg/base"
+	"github.com/moov-io/wire20022/pkg/models"
+	AccountReportingRequestModel "github.com/moov-io/wire20022/pkg/models/AccountReportingRequest"
+	ActivityReportModel "github.com/moov-io/wire20022/pkg/models/ActivityReport"
+	ConnectionCheckModel "github.com/moov-io/wire20022/pkg/models/ConnectionCheck"
+	CustomerCreditTransferModel "github.com/moov-io/wire20022/pkg/models/CustomerCreditTransfer"
+	DrawdownRequestModel "github.com/moov-io/wire20022/pkg/models/DrawdownRequest"
+	DrawdownResponseModel "github.com/moov-io/wire20022/pkg/models/DrawdownResponse"
+	EndpointDetailsReportModel "github.com/moov-io/wire20022/pkg/models/EndpointDetailsReport"
+	EndpointGapReportModel "github.com/moov-io/wire20022/pkg/models/EndpointGapReport"
+	EndpointTotalsReportModel "github.com/moov-io/wire20022/pkg/models/EndpointTotalsReport"
+	FICreditTransferModel "github.com/moov-io/wire20022/pkg/models/FICreditTransfer"
+	FedwireFundsAcknowledgementModel "github.com/moov-io/wire20022/pkg/models/FedwireFundsAcknowledgement"
+	FedwireFundsPaymentStatusModel "github.com/moov-io/wire20022/pkg/models/FedwireFundsPaymentStatus"
+	FedwireFundsSystemResponseModel "github.com/moov-io/wire20022/pkg/models/FedwireFundsSystemResponse"
+	InvestigationRequestModel "github.com/moov-io/wire20022/pkg/models/InvestigationRequest"
+	InvestigationResponseModel "github.com/moov-io/wire20022/pkg/models/InvestigationResponse"
+	MasterModel "github.com/moov-io/wire20022/pkg/models/Master"
+	MessageRejectModel "github.com/moov-io/wire20022/pkg/models/MessageReject"
+	PaymentReturnModel "github.com/moov-io/wire20022/pkg/models/PaymentReturn"
+	PaymentStatusRequestModel "github.com/moov-io/wire20022/pkg/models/PaymentStatusRequest"
+	RetrievalRequestModel "github.com/moov-io/wire20022/pkg/models/RetrievalRequest"
+	ReturnRequestModel "github.com/moov-io/wire20022/pkg/models/ReturnRequest"
+	ReturnRequestResponseModel "github.com/moov-io/wire20022/pkg/models/ReturnRequestResponse"
+)
+
+// MessageTypeRegistration tells the UniversalReader how to recognize and parse one message type
+type MessageTypeRegistration struct {
+	// Type is the name the message is reported as in ParsedMessage.Type
+	Type MessageType
+
+	// RootElement is the local name of the message element, either the document root or
+	// the first child of a Document wrapper, e.g. "FIToFICstmrCdtTrf"
+	RootElement string
+
+	// NamespacePrefix limits the registration to documents whose ISO 20022 namespace names
+	// a matching message, e.g. "admi.998" or "pacs.008.001.08". Documents without an ISO 20022
+	// namespace still match. A registration with a prefix is tried before one without, so it
+	// can take over a root element for a single message definition.
+	NamespacePrefix string
+
+	// Match optionally tells apart message types sharing a root element by their content.
+	// It is given the whole document and may record what it found in info.AdditionalInfo.
+	Match func(info *DetectionInfo, data []byte) bool
+
+	// Parse parses the message, including any AppHdr ahead of the Document
+	Parse func(data []byte, opts ...base.ParseOption) (Message, error)
+
+	// ValidateVersion optionally validates a parsed message against the message definition
+	// it was detected as, e.g. "pacs.008.001.08". Without it, UniversalReader.ValidateMessage
+	// calls the message's own Validate.
+	ValidateVersion func(msg Message, messageId string) error
+
+	// Convert optionally converts a message to another version of its message definition,
+	// e.g. "pacs.008.001.05", for UniversalReader.ConvertMessage
+	Convert func(msg Message, messageId string) (Message, *base.ConversionReport, error)
+}
+
+var registry = struct {
+	sync.RWMutex
+	registrations []MessageTypeRegistration
+}{}
+
+// RegisterMessageType adds a message type for the UniversalReader to detect and parse.
+// It is safe to call from an init function; a Type can only be registered once.
+//
+// Example:
+//
+//	err := messages.RegisterMessageType(messages.MessageTypeRegistration{
+//		Type:            "ProprietaryMessage",
+//		RootElement:     "PrtryMsg",
+//		NamespacePrefix: "admi.998",
+//		Parse: func(data []byte, opts ...base.ParseOption) (messages.Message, error) {
+//			return proprietary.ParseXML(data)
+//		},
+//	})
+func RegisterMessageType(registration MessageTypeRegistration) error {
+	switch {
+	case registration.Type == "" || registration.Type == TypeUnknown:
+		return fmt.Errorf("message type registration needs a Type")
+	case registration.RootElement == "":
+		return fmt.Errorf("message type %s needs a RootElement", registration.Type)
+	case registration.Parse == nil:
+		return fmt.Errorf("message type %s needs a Parse function", registration.Type)
+	}
+
+	registry.Lock()
+	defer registry.Unlock()
+	for _, existing := range registry.registrations {
+		if existing.Type == registration.Type {
+			return fmt.Errorf("message type %s is already registered", registration.Type)
+		}
+	}
+	registry.registrations = append(registry.registrations, registration)
+	return nil
+}
+
+// RegisteredMessageTypes lists the message types the UniversalReader can parse, in registration order
+func RegisteredMessageTypes() []MessageType {
+	registry.RLock()
+	defer registry.RUnlock()
+	types := make([]MessageType, 0, len(registry.registrations))
+	for _, registration := range registry.registrations {
+		types = append(types, registration.Type)
+	}
+	return types
+}
+
+// lookupType returns the registration of a message type
+func lookupType(msgType MessageType) (MessageTypeRegistration, bool) {
+	registry.RLock()
+	defer registry.RUnlock()
+	for _, registration := range registry.registrations {
+		if registration.Type == msgType {
+			return registration, true
+		}
+	}
+	return MessageTypeRegistration{}, false
+}
+
+// lookupElement returns the registrations for a message element in a namespace of the given
+// message identifier (e.g. "pacs.008.001.08"), those with the longest NamespacePrefix first
+func lookupElement(rootElement, messageId string) []MessageTypeRegistration {
+	registry.RLock()
+	defer registry.RUnlock()
+	var candidates []MessageTypeRegistration
+	for _, registration := range registry.registrations {
+		if registration.RootElement != rootElement {
+			continue
+		}
+		if messageId != "" && !strings.HasPrefix(messageId, registration.NamespacePrefix) {
+			continue
+		}
+		candidates = append(candidates, registration)
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return len(candidates[i].NamespacePrefix) > len(candidates[j].NamespacePrefix)
+	})
+	return candidates
+}
+
+// model is the method set builtin needs of a message model of this module
+type model[T any, V ~string] interface {
+	*T
+	Message
+	ValidateForVersion(V) error
+	ConvertToVersion(V) (*T, *base.ConversionReport, error)
+}
+
+// builtin registers a message model of this module, panicking on a conflict. versions maps the
+// model's version constants to their namespaces.
+func builtin[T any, V ~string, M model[T, V]](msgType MessageType, rootElement string, parse func([]byte, ...base.ParseOption) (M, error), versions map[V]string, checkRequired func(T) error, match func(*DetectionInfo, []byte) bool) {
+	err := RegisterMessageType(MessageTypeRegistration{
+		Type:        msgType,
+		RootElement: rootElement,
+		Match:       match,
+		Parse: func(data []byte, opts ...base.ParseOption) (Message, error) {
+			msg, err := parse(data, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return msg, nil
+		},
+		ValidateVersion: func(msg Message, messageId string) error {
+			m, version, err := versionOf[T, V, M](msg, versions, messageId)
+			if err != nil {
+				return err
+			}
+			if err := checkRequired(*m); err != nil {
+				return err
+			}
+			if err := models.ValidateValues(*m); err != nil {
+				return err
+			}
+			return m.ValidateForVersion(version)
+		},
+		Convert: func(msg Message, messageId string) (Message, *base.ConversionReport, error) {
+			m, version, err := versionOf[T, V, M](msg, versions, messageId)
+			if err != nil {
+				return nil, nil, err
+			}
+			converted, report, err := m.ConvertToVersion(version)
+			if err != nil {
+				return nil, nil, err
+			}
+			return M(converted), report, nil
+		},
+	})
+	if err != nil {
+		panic(err)
+	}
+}
+
+// versionOf returns msg as a model of type M along with its version constant whose namespace
+// names the message identifier
+func versionOf[T any, V ~string, M model[T, V]](msg Message, versions map[V]string, messageId string) (M, V, error) {
+	m, ok := msg.(M)
+	if !ok {
+		return nil, "", fmt.Errorf("%T is not a %s message", msg, messageId)
+	}
+	for version, namespace := range versions {
+		if strings.HasSuffix(namespace, ":"+strings.ToLower(messageId)) {
+			return m, version, nil
+		}
+	}
+	return nil, "", fmt.Errorf("unsupported version %s", messageId)
+}
+
+func init() {
+	builtin(TypeCustomerCreditTransfer, "FIToFICstmrCdtTrf", CustomerCreditTransferModel.ParseXML, CustomerCreditTransferModel.VersionNameSpaceMap, CustomerCreditTransferModel.CheckRequiredFields, nil)
+	builtin(TypeFICreditTransfer, "FICdtTrf", FICreditTransferModel.ParseXML, FICreditTransferModel.VersionNameSpaceMap, FICreditTransferModel.CheckRequiredFields, nil)
+	builtin(TypePaymentReturn, "PmtRtr", PaymentReturnModel.ParseXML, PaymentReturnModel.VersionNameSpaceMap, PaymentReturnModel.CheckRequiredFields, nil)
+	builtin(TypePaymentStatusRequest, "FIToFIPmtStsReq", PaymentStatusRequestModel.ParseXML, PaymentStatusRequestModel.VersionNameSpaceMap, PaymentStatusRequestModel.CheckRequiredFields, nil)
+	builtin(TypeFedwireFundsPaymentStatus, "FIToFIPmtStsRpt", FedwireFundsPaymentStatusModel.ParseXML, FedwireFundsPaymentStatusModel.VersionNameSpaceMap, FedwireFundsPaymentStatusModel.CheckRequiredFields, nil)
+	builtin(TypeDrawdownRequest, "CdtrPmtActvtnReq", DrawdownRequestModel.ParseXML, DrawdownRequestModel.VersionNameSpaceMap, DrawdownRequestModel.CheckRequiredFields, nil)
+	builtin(TypeDrawdownResponse, "CdtrPmtActvtnReqStsRpt", DrawdownResponseModel.ParseXML, DrawdownResponseModel.VersionNameSpaceMap, DrawdownResponseModel.CheckRequiredFields, nil)
+	builtin(TypeAccountReportingRequest, "AcctRptgReq", AccountReportingRequestModel.ParseXML, AccountReportingRequestModel.VersionNameSpaceMap, AccountReportingRequestModel.CheckRequiredFields, nil)
+	builtin(TypeReturnRequestResponse, "RsltnOfInvstgtn", ReturnRequestResponseModel.ParseXML, ReturnRequestResponseModel.VersionNameSpaceMap, ReturnRequestResponseModel.CheckRequiredFields, nil)
+	builtin(TypeReturnRequest, "FIToFIPmtCxlReq", ReturnRequestModel.ParseXML, ReturnRequestModel.VersionNameSpaceMap, ReturnRequestModel.CheckRequiredFields, nil)
+	builtin(TypeConnectionCheck, "SysEvtNtfctn", ConnectionCheckModel.ParseXML, ConnectionCheckModel.VersionNameSpaceMap, ConnectionCheckModel.CheckRequiredFields, nil)
+	builtin(TypeFedwireFundsAcknowledgement, "RctAck", FedwireFundsAcknowledgementModel.ParseXML, FedwireFundsAcknowledgementModel.VersionNameSpaceMap, FedwireFundsAcknowledgementModel.CheckRequiredFields, nil)
+	builtin(TypeFedwireFundsSystemResponse, "SysEvtAck", FedwireFundsSystemResponseModel.ParseXML, FedwireFundsSystemResponseModel.VersionNameSpaceMap, FedwireFundsSystemResponseModel.CheckRequiredFields, nil)
+	builtin(TypeInvestigationRequest, "InvstgtnReq", InvestigationRequestModel.ParseXML, InvestigationRequestModel.VersionNameSpaceMap, InvestigationRequestModel.CheckRequiredFields, nil)
+	builtin(TypeInvestigationResponse, "InvstgtnRspn", InvestigationResponseModel.ParseXML, InvestigationResponseModel.VersionNameSpaceMap, InvestigationResponseModel.CheckRequiredFields, nil)
+	builtin(TypeRetrievalRequest, "RsndReq", RetrievalRequestModel.ParseXML, RetrievalRequestModel.VersionNameSpaceMap, RetrievalRequestModel.CheckRequiredFields, nil)
+	// admi.002 names its message element after the message identifier
+	builtin(TypeMessageReject, "admi.002.001.01", MessageRejectModel.ParseXML, MessageRejectModel.VersionNameSpaceMap, MessageRejectModel.CheckRequiredFields, nil)
+
+	// The camt.052 reports share BkToCstmrAcctRpt and are told apart by their content
+	builtin(TypeActivityReport, "BkToCstmrAcctRpt", ActivityReportModel.ParseXML, ActivityReportModel.VersionNameSpaceMap, ActivityReportModel.CheckRequiredFields, accountReport(TypeActivityReport))
+	builtin(TypeEndpointDetailsReport, "BkToCstmrAcctRpt", EndpointDetailsReportModel.ParseXML, EndpointDetailsReportModel.VersionNameSpaceMap, EndpointDetailsReportModel.CheckRequiredFields, accountReport(TypeEndpointDetailsReport))
+	builtin(TypeEndpointGapReport, "BkToCstmrAcctRpt", EndpointGapReportModel.ParseXML, EndpointGapReportModel.VersionNameSpaceMap, EndpointGapReportModel.CheckRequiredFields, accountReport(TypeEndpointGapReport))
+	builtin(TypeEndpointTotalsReport, "BkToCstmrAcctRpt", EndpointTotalsReportModel.ParseXML, EndpointTotalsReportModel.VersionNameSpaceMap, EndpointTotalsReportModel.CheckRequiredFields, accountReport(TypeEndpointTotalsReport))
+	builtin(TypeMaster, "BkToCstmrAcctRpt", MasterModel.ParseXML, MasterModel.VersionNameSpaceMap, MasterModel.CheckRequiredFields, accountReport(TypeMaster))
+}
+
+// camt052Analyzer helps analyze BkToCstmrAcctRpt messages
+type camt052Analyzer struct {
+	GrpHdr struct {
+		MsgId string `xml:"MsgId"`
+	} `xml:"GrpHdr"`
+	Rpt []struct {
+		Id string `xml:"Id"`
+	} `xml:"Rpt"`
+}
+
+// accountReport matches the camt.052 report of the given type
+func accountReport(msgType MessageType) func(*DetectionInfo, []byte) bool {
+	return func(info *DetectionInfo, data []byte) bool {
+		return accountReportType(info, data) == msgType
+	}
+}
+
+// accountReportType determines which camt.052 report a BkToCstmrAcctRpt is from its group
+// header MsgId, falling back to the Id of its first report. The document is decoded once per
+// detection and shared by the candidates through info.
+func accountReportType(info *DetectionInfo, data []byte) MessageType {
+	// A document that failed to decode is kept as a nil *camt052Analyzer
+	analyzer, ok := info.decoded.(*camt052Analyzer)
+	if !ok {
+		analyzer = new(camt052Analyzer)
+		if err := decodeElement(data, "BkToCstmrAcctRpt", analyzer); err != nil {
+			analyzer = nil
+		}
+		info.decoded = analyzer
+	}
+	if analyzer == nil {
+		return TypeUnknown
+	}
+
+	// Store analysis results
+	msgId := analyzer.GrpHdr.MsgId
+	rptId := ""
+	if len(analyzer.Rpt) > 0 {
+		rptId = analyzer.Rpt[0].Id
+	}
+	if info.AdditionalInfo == nil {
+		info.AdditionalInfo = make(map[string]string)
+	}
+	info.AdditionalInfo["GrpHdr.MsgId"] = msgId
+	if rptId != "" {
+		info.AdditionalInfo["Rpt.Id"] = rptId
+	}
+
+	switch {
+	case strings.HasPrefix(msgId, "ACTR"):
+		return TypeActivityReport
+	case strings.HasPrefix(msgId, "DTLS") || strings.HasPrefix(msgId, "DTLR"):
+		return TypeEndpointDetailsReport
+	case strings.HasPrefix(msgId, "GAPR"):
+		return TypeEndpointGapReport
+	case strings.HasPrefix(msgId, "ETOT"):
+		return TypeEndpointTotalsReport
+	case strings.HasPrefix(msgId, "ABAR"):
+		return TypeMaster
+	}
+
+	switch rptId {
+	case "EDAY":
+		return TypeActivityReport
+	case "IMAD", "OMAD":
+		return TypeEndpointGapReport
+	case "IDAY":
+		// Could be EndpointDetailsReport or EndpointTotalsReport
+		// Need more context, default to EndpointDetailsReport
+		return TypeEndpointDetailsReport
+	case "ABMS":
+		return TypeMaster
+	}
+	return TypeUnknown
+}
+
+// decodeElement decodes the first element named local in data into v, whether it is the
+// document root or wrapped in a Document
+func decodeElement(data []byte, local string, v any) error {
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			return fmt.Errorf("no %s element found", local)
+		}
+		if err != nil {
+			return err
+		}
+		if start, ok := token.(xml.StartElement); ok && start.Name.Local == local {
+			return decoder.DecodeElement(v, &start)
+		}
+	}
+}
@@ -0,0 +1,187 @@
+package messages
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/moov-io/wire20022/pkg/base"
+)
+
+// proprietaryMessage stands in for an in-house message model
+type proprietaryMessage struct {
+	XMLName   xml.Name  `xml:"PrtryMsg"`
+	MessageId string    `xml:"MsgId"`
+	Created   time.Time `xml:"CreDtTm"`
+	Content   string    `xml:"Prtry>Data"`
+}
+
+func (m *proprietaryMessage) GetMessageId() string   { return m.MessageId }
+func (m *proprietaryMessage) CreatedAt() time.Time   { return m.Created }
+func (m *proprietaryMessage) ISOMessageName() string { return "admi.998" }
+func (m *proprietaryMessage) Version() string        { return "admi.998.001.02" }
+
+func (m *proprietaryMessage) Validate() error {
+	if m.Content == "" {
+		return fmt.Errorf("Data is required")
+	}
+	return nil
+}
+
+func (m *proprietaryMessage) WriteDocument(w io.Writer) error {
+	return xml.NewEncoder(w).Encode(m)
+}
+
+const proprietarySample = `<?xml version="1.0" encoding="UTF-8"?>
+<Document xmlns="urn:iso:std:iso:20022:tech:xsd:admi.998.001.02">
+  <PrtryMsg>
+    <MsgId>PRTRY20250301001</MsgId>
+    <CreDtTm>2025-03-01T10:00:00Z</CreDtTm>
+    <Prtry><Data>ping</Data></Prtry>
+  </PrtryMsg>
+</Document>`
+
+// registerForTest registers a message type for the duration of a test
+func registerForTest(t *testing.T, registration MessageTypeRegistration) {
+	t.Helper()
+	require.NoError(t, RegisterMessageType(registration))
+	t.Cleanup(func() {
+		registry.Lock()
+		defer registry.Unlock()
+		for i, existing := range registry.registrations {
+			if existing.Type == registration.Type {
+				registry.registrations = append(registry.registrations[:i], registry.registrations[i+1:]...)
+				return
+			}
+		}
+	})
+}
+
+func parseProprietary(data []byte, _ ...base.ParseOption) (Message, error) {
+	var msg proprietaryMessage
+	if err := decodeElement(data, "PrtryMsg", &msg); err != nil {
+		return nil, err
+	}
+	if msg.MessageId == "" {
+		return nil, fmt.Errorf("MsgId is required")
+	}
+	return &msg, nil
+}
+
+func TestRegisterMessageType(t *testing.T) {
+	registerForTest(t, MessageTypeRegistration{
+		Type:            "ProprietaryMessage",
+		RootElement:     "PrtryMsg",
+		NamespacePrefix: "admi.998",
+		Parse:           parseProprietary,
+	})
+	assert.Contains(t, RegisteredMessageTypes(), MessageType("ProprietaryMessage"))
+
+	reader := NewUniversalReader()
+
+	t.Run("parses registered type", func(t *testing.T) {
+		parsed, err := reader.ReadBytes([]byte(proprietarySample))
+		require.NoError(t, err)
+
+		assert.Equal(t, MessageType("ProprietaryMessage"), parsed.Type)
+		assert.Equal(t, "001.02", parsed.Version)
+		assert.Equal(t, "PrtryMsg", parsed.Detection.RootElement)
+		require.IsType(t, &proprietaryMessage{}, parsed.Message)
+		assert.Equal(t, "PRTRY20250301001", parsed.Message.GetMessageId())
+		assert.Equal(t, "ping", parsed.Message.(*proprietaryMessage).Content)
+		assert.NoError(t, reader.ValidateMessage(parsed))
+
+		_, _, err = reader.ConvertMessage(parsed, "admi.998.001.01")
+		assert.EqualError(t, err, "conversion not supported for message type: ProprietaryMessage")
+	})
+
+	t.Run("other namespace", func(t *testing.T) {
+		data := []byte(`<Document xmlns="urn:iso:std:iso:20022:tech:xsd:admi.999.001.01"><PrtryMsg><MsgId>X</MsgId></PrtryMsg></Document>`)
+		_, err := reader.ReadBytes(data)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "unknown message element inside Document: PrtryMsg")
+	})
+
+	t.Run("parse errors", func(t *testing.T) {
+		data := []byte(`<Document xmlns="urn:iso:std:iso:20022:tech:xsd:admi.998.001.02"><PrtryMsg/></Document>`)
+		_, err := reader.ReadBytes(data)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Failed to parse ProprietaryMessage message")
+		assert.Contains(t, err.Error(), "MsgId is required")
+	})
+}
+
+func TestRegisterMessageType_NamespacePrefix(t *testing.T) {
+	registerForTest(t, MessageTypeRegistration{
+		Type:            "CustomerCreditTransferDraft",
+		RootElement:     "FIToFICstmrCdtTrf",
+		NamespacePrefix: "pacs.008.001.99",
+		Parse:           parseProprietary,
+	})
+
+	reader := NewUniversalReader()
+	for namespace, expected := range map[string]MessageType{
+		"urn:iso:std:iso:20022:tech:xsd:pacs.008.001.99": "CustomerCreditTransferDraft",
+		"urn:iso:std:iso:20022:tech:xsd:pacs.008.001.08": TypeCustomerCreditTransfer,
+	} {
+		info, err := reader.detectMessageType(&xmlPeeker{RootElement: "FIToFICstmrCdtTrf", Namespace: namespace}, nil)
+		require.NoError(t, err)
+		assert.Equal(t, expected, info.MessageType, namespace)
+	}
+}
+
+func TestRegisterMessageType_Invalid(t *testing.T) {
+	tests := []struct {
+		name         string
+		registration MessageTypeRegistration
+		expected     string
+	}{
+		{
+			name:         "missing type",
+			registration: MessageTypeRegistration{RootElement: "PrtryMsg", Parse: parseProprietary},
+			expected:     "message type registration needs a Type",
+		},
+		{
+			name:         "missing root element",
+			registration: MessageTypeRegistration{Type: "ProprietaryMessage", Parse: parseProprietary},
+			expected:     "message type ProprietaryMessage needs a RootElement",
+		},
+		{
+			name:         "missing parser",
+			registration: MessageTypeRegistration{Type: "ProprietaryMessage", RootElement: "PrtryMsg"},
+			expected:     "message type ProprietaryMessage needs a Parse function",
+		},
+		{
+			name:         "already registered",
+			registration: MessageTypeRegistration{Type: TypeCustomerCreditTransfer, RootElement: "PrtryMsg", Parse: parseProprietary},
+			expected:     "message type CustomerCreditTransfer is already registered",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.EqualError(t, RegisterMessageType(tt.registration), tt.expected)
+		})
+	}
+}
+
+// TestAccountReportType tests that the camt.052 candidates share one decoding of the document
+func TestAccountReportType(t *testing.T) {
+	data, err := os.ReadFile("../models/Master/swiftSample/AccountBalanceReport_Scenario1_Step2_camt.052_ABAR_MM")
+	require.NoError(t, err)
+
+	var info DetectionInfo
+	assert.False(t, accountReport(TypeActivityReport)(&info, data))
+	assert.Equal(t, TypeMaster, accountReportType(&info, nil))
+	assert.True(t, accountReport(TypeMaster)(&info, []byte("not XML")))
+
+	info = DetectionInfo{}
+	assert.Equal(t, TypeUnknown, accountReportType(&info, []byte("not XML")))
+	assert.Equal(t, TypeUnknown, accountReportType(&info, data))
+}
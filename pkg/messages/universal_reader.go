@@ -7,23 +7,9 @@ import (
 	"io"
 	"strings"
 
+	"github.com/moov-io/wire20022/pkg/base"
 	"github.com/moov-io/wire20022/pkg/errors"
-	AccountReportingRequestModel "github.com/moov-io/wire20022/pkg/models/AccountReportingRequest"
-	ActivityReportModel "github.com/moov-io/wire20022/pkg/models/ActivityReport"
-	ConnectionCheckModel "github.com/moov-io/wire20022/pkg/models/ConnectionCheck"
-	CustomerCreditTransferModel "github.com/moov-io/wire20022/pkg/models/CustomerCreditTransfer"
-	DrawdownRequestModel "github.com/moov-io/wire20022/pkg/models/DrawdownRequest"
-	DrawdownResponseModel "github.com/moov-io/wire20022/pkg/models/DrawdownResponse"
-	EndpointDetailsReportModel "github.com/moov-io/wire20022/pkg/models/EndpointDetailsReport"
-	EndpointGapReportModel "github.com/moov-io/wire20022/pkg/models/EndpointGapReport"
-	EndpointTotalsReportModel "github.com/moov-io/wire20022/pkg/models/EndpointTotalsReport"
-	FedwireFundsAcknowledgementModel "github.com/moov-io/wire20022/pkg/models/FedwireFundsAcknowledgement"
-	FedwireFundsPaymentStatusModel "github.com/moov-io/wire20022/pkg/models/FedwireFundsPaymentStatus"
-	FedwireFundsSystemResponseModel "github.com/moov-io/wire20022/pkg/models/FedwireFundsSystemResponse"
-	MasterModel "github.com/moov-io/wire20022/pkg/models/Master"
-	PaymentReturnModel "github.com/moov-io/wire20022/pkg/models/PaymentReturn"
-	PaymentStatusRequestModel "github.com/moov-io/wire20022/pkg/models/PaymentStatusRequest"
-	ReturnRequestResponseModel "github.com/moov-io/wire20022/pkg/models/ReturnRequestResponse"
+	BusinessApplicationHeaderModel "github.com/moov-io/wire20022/pkg/models/BusinessApplicationHeader"
 )
 
 // MessageType represents the detected ISO 20022 message type
@@ -31,6 +17,7 @@ type MessageType string
 
 const (
 	TypeCustomerCreditTransfer      MessageType = "CustomerCreditTransfer"
+	TypeFICreditTransfer            MessageType = "FICreditTransfer"
 	TypePaymentReturn               MessageType = "PaymentReturn"
 	TypePaymentStatusRequest        MessageType = "PaymentStatusRequest"
 	TypeFedwireFundsPaymentStatus   MessageType = "FedwireFundsPaymentStatus"
@@ -42,9 +29,14 @@ const (
 	TypeEndpointGapReport           MessageType = "EndpointGapReport"
 	TypeEndpointTotalsReport        MessageType = "EndpointTotalsReport"
 	TypeReturnRequestResponse       MessageType = "ReturnRequestResponse"
+	TypeReturnRequest               MessageType = "ReturnRequest"
 	TypeConnectionCheck             MessageType = "ConnectionCheck"
 	TypeFedwireFundsAcknowledgement MessageType = "FedwireFundsAcknowledgement"
 	TypeFedwireFundsSystemResponse  MessageType = "FedwireFundsSystemResponse"
+	TypeMessageReject               MessageType = "MessageReject"
+	TypeRetrievalRequest            MessageType = "RetrievalRequest"
+	TypeInvestigationRequest        MessageType = "InvestigationRequest"
+	TypeInvestigationResponse       MessageType = "InvestigationResponse"
 	TypeMaster                      MessageType = "Master"
 	TypeUnknown                     MessageType = "Unknown"
 )
@@ -58,14 +50,20 @@ type DetectionInfo struct {
 	Version         string
 	DetectedBy      string // "namespace", "root_element", or "content_analysis"
 	AdditionalInfo  map[string]string
+
+	// decoded is what a Match decoded from the document, kept so that the other candidates
+	// for the same root element need not decode it again
+	decoded any
 }
 
 // ParsedMessage contains the detected message type and parsed content
 type ParsedMessage struct {
 	Type      MessageType
-	Message   interface{} // The actual message struct (e.g., *CustomerCreditTransfer.MessageModel)
+	Message   Message // The actual message struct (e.g., *CustomerCreditTransfer.MessageModel)
 	Version   string
 	Detection DetectionInfo
+	AppHdr    *BusinessApplicationHeaderModel.MessageModel // head.001 header sent ahead of the Document, if any
+	Envelope  *Envelope                                    // Fedwire Funds envelope the message arrived in, if any
 }
 
 // UniversalReader reads and automatically detects Fedwire ISO 20022 message types
@@ -73,6 +71,14 @@ type UniversalReader struct {
 	// Configuration for enhanced error reporting
 	VerboseErrors    bool
 	TrackLineNumbers bool
+
+	// PreserveUnmapped keeps document content the models do not cover in each message's
+	// Unmapped field, so that writing the message back out does not drop it
+	PreserveUnmapped bool
+
+	// Strict fails parsing when a document value cannot be copied to the message model,
+	// reporting each one as an errors.FieldError instead of leaving the field empty
+	Strict bool
 }
 
 // NewUniversalReader creates a new universal reader instance
@@ -135,6 +141,9 @@ func (r *UniversalReader) peekXML(data []byte) (*xmlPeeker, error) {
 	return peek, fmt.Errorf("no root element found in XML")
 }
 
+// isoNamespacePrefix precedes the message identifier in ISO 20022 document namespaces
+const isoNamespacePrefix = "urn:iso:std:iso:20022:tech:xsd:"
+
 // extractMessageTypeFromNamespace extracts the message type and version from namespace
 func (r *UniversalReader) extractMessageTypeFromNamespace(namespace string) (string, string) {
 	// Pattern: urn:iso:std:iso:20022:tech:xsd:pacs.008.001.12
@@ -174,49 +183,13 @@ func (r *UniversalReader) detectMessageType(peek *xmlPeeker, data []byte) (*Dete
 		info.AdditionalInfo["namespace_type"] = msgType
 	}
 
-	// Map based on root element and namespace type
-	switch peek.RootElement {
-	case "FIToFICstmrCdtTrf":
-		info.MessageType = TypeCustomerCreditTransfer
-	case "PmtRtr":
-		info.MessageType = TypePaymentReturn
-	case "FIToFIPmtStsReq":
-		info.MessageType = TypePaymentStatusRequest
-	case "FIToFIPmtStsRpt":
-		info.MessageType = TypeFedwireFundsPaymentStatus
-	case "CdtrPmtActvtnReq":
-		info.MessageType = TypeDrawdownRequest
-	case "CdtrPmtActvtnReqStsRpt":
-		info.MessageType = TypeDrawdownResponse
-	case "AcctRptgReq":
-		info.MessageType = TypeAccountReportingRequest
-	case "RsltnOfInvstgtn":
-		info.MessageType = TypeReturnRequestResponse
-	case "SysEvtNtfctn":
-		info.MessageType = TypeConnectionCheck
-	case "RctAck":
-		info.MessageType = TypeFedwireFundsAcknowledgement
-	case "SysEvtAck":
-		info.MessageType = TypeFedwireFundsSystemResponse
-	case "BkToCstmrAcctRpt":
-		// This requires content analysis
-		info.DetectedBy = "content_analysis"
-		return r.analyzeBkToCstmrAcctRpt(info, data)
-	case "Document":
-		// ISO 20022 messages are often wrapped in a Document element
-		// We need to peek deeper to find the actual message element
+	// ISO 20022 messages are often wrapped in a Document element
+	// We need to peek deeper to find the actual message element
+	if peek.RootElement == "Document" {
 		return r.analyzeDocumentWrapper(info, data)
-	default:
-		info.MessageType = TypeUnknown
-		info.DetectedBy = "failed"
-		return info, fmt.Errorf("unknown root element: %s", peek.RootElement)
-	}
-
-	if info.DetectedBy == "" {
-		info.DetectedBy = "root_element"
 	}
 
-	return info, nil
+	return r.resolveMessageType(info, data, "unknown root element")
 }
 
 // analyzeDocumentWrapper analyzes ISO 20022 messages wrapped in Document element
@@ -259,45 +232,7 @@ func (r *UniversalReader) analyzeDocumentWrapper(info *DetectionInfo, data []byt
 					childInfo.AdditionalInfo["namespace_type"] = msgType
 				}
 
-				// Map the child element to message type
-				switch t.Name.Local {
-				case "FIToFICstmrCdtTrf":
-					childInfo.MessageType = TypeCustomerCreditTransfer
-				case "PmtRtr":
-					childInfo.MessageType = TypePaymentReturn
-				case "FIToFIPmtStsReq":
-					childInfo.MessageType = TypePaymentStatusRequest
-				case "FIToFIPmtStsRpt":
-					childInfo.MessageType = TypeFedwireFundsPaymentStatus
-				case "CdtrPmtActvtnReq":
-					childInfo.MessageType = TypeDrawdownRequest
-				case "CdtrPmtActvtnReqStsRpt":
-					childInfo.MessageType = TypeDrawdownResponse
-				case "AcctRptgReq":
-					childInfo.MessageType = TypeAccountReportingRequest
-				case "RsltnOfInvstgtn":
-					childInfo.MessageType = TypeReturnRequestResponse
-				case "SysEvtNtfctn":
-					childInfo.MessageType = TypeConnectionCheck
-				case "RctAck":
-					childInfo.MessageType = TypeFedwireFundsAcknowledgement
-				case "SysEvtAck":
-					childInfo.MessageType = TypeFedwireFundsSystemResponse
-				case "BkToCstmrAcctRpt":
-					// This requires content analysis
-					childInfo.DetectedBy = "content_analysis"
-					return r.analyzeBkToCstmrAcctRpt(childInfo, data)
-				default:
-					childInfo.MessageType = TypeUnknown
-					childInfo.DetectedBy = "failed"
-					return childInfo, fmt.Errorf("unknown message element inside Document: %s", t.Name.Local)
-				}
-
-				if childInfo.DetectedBy == "" {
-					childInfo.DetectedBy = "root_element"
-				}
-
-				return childInfo, nil
+				return r.resolveMessageType(childInfo, data, "unknown message element inside Document")
 			}
 		}
 	}
@@ -305,68 +240,37 @@ func (r *UniversalReader) analyzeDocumentWrapper(info *DetectionInfo, data []byt
 	return info, fmt.Errorf("no message element found inside Document wrapper")
 }
 
-// camt052Analyzer helps analyze BkToCstmrAcctRpt messages
-type camt052Analyzer struct {
-	XMLName xml.Name `xml:"BkToCstmrAcctRpt"`
-	GrpHdr  struct {
-		MsgId string `xml:"MsgId"`
-	} `xml:"GrpHdr"`
-	Rpt []struct {
-		Id string `xml:"Id"`
-	} `xml:"Rpt"`
-}
+// resolveMessageType finds the registered message type for info.RootElement in its namespace.
+// When several share the element, their content discriminators are tried in turn.
+func (r *UniversalReader) resolveMessageType(info *DetectionInfo, data []byte, unknown string) (*DetectionInfo, error) {
+	messageId := ""
+	if msgType := info.AdditionalInfo["namespace_type"]; msgType != "" {
+		messageId = msgType + "." + info.Version
+	}
+
+	candidates := lookupElement(info.RootElement, messageId)
+	if len(candidates) == 0 {
+		info.MessageType = TypeUnknown
+		info.DetectedBy = "failed"
+		return info, fmt.Errorf("%s: %s", unknown, info.RootElement)
+	}
 
-// analyzeBkToCstmrAcctRpt performs content analysis for camt.052 messages
-func (r *UniversalReader) analyzeBkToCstmrAcctRpt(info *DetectionInfo, data []byte) (*DetectionInfo, error) {
-	var analyzer camt052Analyzer
-	if err := xml.Unmarshal(data, &analyzer); err != nil {
-		return info, fmt.Errorf("failed to analyze BkToCstmrAcctRpt content: %w", err)
-	}
-
-	// Store analysis results
-	info.AdditionalInfo["GrpHdr.MsgId"] = analyzer.GrpHdr.MsgId
-	if len(analyzer.Rpt) > 0 {
-		info.AdditionalInfo["Rpt.Id"] = analyzer.Rpt[0].Id
-	}
-
-	// Determine specific type based on MsgId and Rpt.Id
-	msgId := analyzer.GrpHdr.MsgId
-	rptId := ""
-	if len(analyzer.Rpt) > 0 {
-		rptId = analyzer.Rpt[0].Id
-	}
-
-	switch {
-	case strings.HasPrefix(msgId, "ACTR"):
-		info.MessageType = TypeActivityReport
-	case strings.HasPrefix(msgId, "DTLS") || strings.HasPrefix(msgId, "DTLR"):
-		info.MessageType = TypeEndpointDetailsReport
-	case strings.HasPrefix(msgId, "GAPR"):
-		info.MessageType = TypeEndpointGapReport
-	case strings.HasPrefix(msgId, "ETOT"):
-		info.MessageType = TypeEndpointTotalsReport
-	case strings.HasPrefix(msgId, "ABAR"):
-		info.MessageType = TypeMaster
-	default:
-		// Fallback to Rpt.Id analysis
-		switch rptId {
-		case "EDAY":
-			info.MessageType = TypeActivityReport
-		case "IMAD", "OMAD":
-			info.MessageType = TypeEndpointGapReport
-		case "IDAY":
-			// Could be EndpointDetailsReport or EndpointTotalsReport
-			// Need more context, default to EndpointDetailsReport
-			info.MessageType = TypeEndpointDetailsReport
-		case "ABMS":
-			info.MessageType = TypeMaster
-		default:
-			info.MessageType = TypeUnknown
-			return info, fmt.Errorf("unable to determine BkToCstmrAcctRpt subtype: MsgId=%s, Rpt.Id=%s", msgId, rptId)
+	for _, candidate := range candidates {
+		if candidate.Match != nil {
+			info.DetectedBy = "content_analysis"
+			if !candidate.Match(info, data) {
+				continue
+			}
+		}
+		info.MessageType = candidate.Type
+		if info.DetectedBy == "" {
+			info.DetectedBy = "root_element"
 		}
+		return info, nil
 	}
 
-	return info, nil
+	info.MessageType = TypeUnknown
+	return info, fmt.Errorf("unable to determine %s subtype from its content", info.RootElement)
 }
 
 // Read reads XML from an io.Reader and returns the parsed message
@@ -382,14 +286,29 @@ func (r *UniversalReader) Read(reader io.Reader) (*ParsedMessage, error) {
 
 // ReadBytes reads XML from byte slice and returns the parsed message
 func (r *UniversalReader) ReadBytes(data []byte) (*ParsedMessage, error) {
+	// Remove the FedwireFundsIncoming/FedwireFundsOutgoing envelope, if any
+	envelope, message, offset, err := unwrapEnvelope(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unwrap envelope: %w", err)
+	}
+	// Positions in parse errors are relative to the message; make them relative to the input
+	prefix := data[:offset]
+	data = message
+
+	// Set aside the business application header so detection sees the Document
+	appHdr, document, err := BusinessApplicationHeaderModel.Split(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read application header: %w", err)
+	}
+
 	// Peek at XML structure
-	peek, err := r.peekXML(data)
+	peek, err := r.peekXML(document)
 	if err != nil {
 		return nil, fmt.Errorf("failed to peek XML structure: %w", err)
 	}
 
 	// Detect message type
-	detection, err := r.detectMessageType(peek, data)
+	detection, err := r.detectMessageType(peek, document)
 	if err != nil {
 		return nil, fmt.Errorf("failed to detect message type: %w", err)
 	}
@@ -399,133 +318,36 @@ func (r *UniversalReader) ReadBytes(data []byte) (*ParsedMessage, error) {
 		Type:      detection.MessageType,
 		Version:   detection.Version,
 		Detection: *detection,
+		AppHdr:    appHdr,
+		Envelope:  envelope,
 	}
 
-	// Parse the actual message
-	switch detection.MessageType {
-	case TypeUnknown:
-		return nil, fmt.Errorf("unsupported message type: %s", detection.MessageType)
-	case TypeCustomerCreditTransfer:
-		msg, err := CustomerCreditTransferModel.ParseXML(data)
-		if err != nil {
-			return nil, r.enhanceError(err, detection, data)
-		}
-		parsed.Message = msg
-
-	case TypePaymentReturn:
-		msg, err := PaymentReturnModel.ParseXML(data)
-		if err != nil {
-			return nil, r.enhanceError(err, detection, data)
-		}
-		parsed.Message = msg
-
-	case TypePaymentStatusRequest:
-		msg, err := PaymentStatusRequestModel.ParseXML(data)
-		if err != nil {
-			return nil, r.enhanceError(err, detection, data)
-		}
-		parsed.Message = msg
-
-	case TypeFedwireFundsPaymentStatus:
-		msg, err := FedwireFundsPaymentStatusModel.ParseXML(data)
-		if err != nil {
-			return nil, r.enhanceError(err, detection, data)
-		}
-		parsed.Message = msg
-
-	case TypeDrawdownRequest:
-		msg, err := DrawdownRequestModel.ParseXML(data)
-		if err != nil {
-			return nil, r.enhanceError(err, detection, data)
-		}
-		parsed.Message = msg
-
-	case TypeDrawdownResponse:
-		msg, err := DrawdownResponseModel.ParseXML(data)
-		if err != nil {
-			return nil, r.enhanceError(err, detection, data)
-		}
-		parsed.Message = msg
-
-	case TypeAccountReportingRequest:
-		msg, err := AccountReportingRequestModel.ParseXML(data)
-		if err != nil {
-			return nil, r.enhanceError(err, detection, data)
-		}
-		parsed.Message = msg
-
-	case TypeActivityReport:
-		msg, err := ActivityReportModel.ParseXML(data)
-		if err != nil {
-			return nil, r.enhanceError(err, detection, data)
-		}
-		parsed.Message = msg
-
-	case TypeEndpointDetailsReport:
-		msg, err := EndpointDetailsReportModel.ParseXML(data)
-		if err != nil {
-			return nil, r.enhanceError(err, detection, data)
-		}
-		parsed.Message = msg
-
-	case TypeEndpointGapReport:
-		msg, err := EndpointGapReportModel.ParseXML(data)
-		if err != nil {
-			return nil, r.enhanceError(err, detection, data)
-		}
-		parsed.Message = msg
-
-	case TypeEndpointTotalsReport:
-		msg, err := EndpointTotalsReportModel.ParseXML(data)
-		if err != nil {
-			return nil, r.enhanceError(err, detection, data)
-		}
-		parsed.Message = msg
-
-	case TypeReturnRequestResponse:
-		msg, err := ReturnRequestResponseModel.ParseXML(data)
-		if err != nil {
-			return nil, r.enhanceError(err, detection, data)
-		}
-		parsed.Message = msg
-
-	case TypeConnectionCheck:
-		msg, err := ConnectionCheckModel.ParseXML(data)
-		if err != nil {
-			return nil, r.enhanceError(err, detection, data)
-		}
-		parsed.Message = msg
-
-	case TypeFedwireFundsAcknowledgement:
-		msg, err := FedwireFundsAcknowledgementModel.ParseXML(data)
-		if err != nil {
-			return nil, r.enhanceError(err, detection, data)
-		}
-		parsed.Message = msg
-
-	case TypeFedwireFundsSystemResponse:
-		msg, err := FedwireFundsSystemResponseModel.ParseXML(data)
-		if err != nil {
-			return nil, r.enhanceError(err, detection, data)
-		}
-		parsed.Message = msg
-
-	case TypeMaster:
-		msg, err := MasterModel.ParseXML(data)
-		if err != nil {
-			return nil, r.enhanceError(err, detection, data)
-		}
-		parsed.Message = msg
+	var opts []base.ParseOption
+	if r.PreserveUnmapped {
+		opts = append(opts, base.PreserveUnmapped())
+	}
+	if r.Strict {
+		opts = append(opts, base.Strict())
+	}
 
-	default:
+	// Parse the actual message
+	registration, ok := lookupType(detection.MessageType)
+	if !ok {
 		return nil, fmt.Errorf("unsupported message type: %s", detection.MessageType)
 	}
+	msg, err := registration.Parse(data, opts...)
+	if err != nil {
+		return nil, r.enhanceError(err, detection, prefix)
+	}
+	parsed.Message = msg
 
 	return parsed, nil
 }
 
-// enhanceError adds context to parsing/validation errors for debugging
-func (r *UniversalReader) enhanceError(err error, detection *DetectionInfo, data []byte) error {
+// enhanceError adds context to parsing/validation errors for debugging. prefix is the input
+// ahead of the message, such as a Fedwire envelope, which error positions are moved past.
+func (r *UniversalReader) enhanceError(err error, detection *DetectionInfo, prefix []byte) error {
+	errors.ShiftLocations(err, prefix)
 	if !r.VerboseErrors {
 		return err
 	}
@@ -553,50 +375,139 @@ func (r *UniversalReader) enhanceError(err error, detection *DetectionInfo, data
 		enhanced.WriteString(fmt.Sprintf("  Validation reason: %s\n", validationErr.Reason))
 	}
 
+	// Strict parsing reports every field that could not be copied
+	if joined, ok := err.(interface{ Unwrap() []error }); ok {
+		enhanced.WriteString("  Field errors:\n")
+		for _, fieldErr := range joined.Unwrap() {
+			enhanced.WriteString(fmt.Sprintf("    %v\n", fieldErr))
+		}
+	}
+
 	// Add line number context if available and tracking is enabled
-	if r.TrackLineNumbers {
-		// This would require more sophisticated XML parsing with line tracking
-		// For now, just note that it's available in verbose mode
-		enhanced.WriteString("  Enable XML line tracking for detailed position info\n")
+	if location, ok := errors.LocationOf(err); ok && r.TrackLineNumbers {
+		enhanced.WriteString(fmt.Sprintf("  Location: line %d, column %d\n", location.Line, location.Column))
 	}
 
-	return fmt.Errorf("%s", enhanced.String())
+	return &enhancedError{message: enhanced.String(), err: err}
+}
+
+// enhancedError keeps the original parse error reachable with errors.As and errors.Is
+type enhancedError struct {
+	message string
+	err     error
+}
+
+func (e *enhancedError) Error() string {
+	return e.message
 }
 
-// ValidateMessage validates a parsed message (optional since parsing already validates)
-// This method is provided for cases where you want to re-validate after modifying a message.
-// Note: The ParseXML methods already perform validation during parsing.
+func (e *enhancedError) Unwrap() error {
+	return e.err
+}
+
+// ValidateMessage validates a parsed message against the version it was detected as: its
+// required fields and the rules of that version, such as the TransactionFields pacs.008.001.08
+// and later require. Use it to re-validate a message after modifying it.
 func (r *UniversalReader) ValidateMessage(parsed *ParsedMessage) error {
 	if parsed == nil || parsed.Message == nil {
 		return fmt.Errorf("no message to validate")
 	}
 
-	// Messages are already validated during parsing by the ProcessMessage method
-	// in the base processor, so this is mainly for re-validation after modifications.
-	// Since the models don't expose a simple Validate() method (they use ValidateForVersion),
-	// and we don't know the specific version requirements here, we'll just verify
-	// the message structure is intact.
-
-	switch parsed.Message.(type) {
-	case *CustomerCreditTransferModel.MessageModel,
-		*PaymentReturnModel.MessageModel,
-		*PaymentStatusRequestModel.MessageModel,
-		*FedwireFundsPaymentStatusModel.MessageModel,
-		*DrawdownRequestModel.MessageModel,
-		*DrawdownResponseModel.MessageModel,
-		*AccountReportingRequestModel.MessageModel,
-		*ActivityReportModel.MessageModel,
-		*EndpointDetailsReportModel.MessageModel,
-		*EndpointGapReportModel.MessageModel,
-		*EndpointTotalsReportModel.MessageModel,
-		*ReturnRequestResponseModel.MessageModel,
-		*ConnectionCheckModel.MessageModel,
-		*FedwireFundsAcknowledgementModel.MessageModel,
-		*FedwireFundsSystemResponseModel.MessageModel,
-		*MasterModel.MessageModel:
-		// Message is of a known type and was already validated during parsing
-		return nil
-	default:
-		return fmt.Errorf("unknown message type for validation: %T", parsed.Message)
+	// A business application header must name the Document it travels with
+	if parsed.AppHdr != nil && parsed.Detection.Namespace != "" {
+		expected := BusinessApplicationHeaderModel.MessageDefinitionIdFor(parsed.Detection.Namespace)
+		if parsed.AppHdr.MessageDefinitionId != expected {
+			return fmt.Errorf("AppHdr MsgDefIdr %q does not match document %q", parsed.AppHdr.MessageDefinitionId, expected)
+		}
+	}
+
+	registration, ok := lookupType(parsed.Type)
+	if !ok {
+		return fmt.Errorf("unknown message type for validation: %s", parsed.Type)
+	}
+
+	// Check the message against the version it was detected as. Documents without an
+	// ISO 20022 namespace are checked against the version the message itself reports.
+	msgType := parsed.Detection.AdditionalInfo["namespace_type"]
+	if registration.ValidateVersion == nil || msgType == "" || parsed.Version == "" {
+		return parsed.Message.Validate()
+	}
+	return registration.ValidateVersion(parsed.Message, msgType+"."+parsed.Version)
+}
+
+// ConvertMessage converts a parsed message to another version of its message definition, such
+// as "pacs.008.001.05" for a counterparty on an older release. Along with the converted message
+// it returns a report of the values the version has no element for, which are dropped, and of
+// the fields added for it. The parsed message is left unchanged.
+func (r *UniversalReader) ConvertMessage(parsed *ParsedMessage, version string) (*ParsedMessage, *base.ConversionReport, error) {
+	if parsed == nil || parsed.Message == nil {
+		return nil, nil, fmt.Errorf("no message to convert")
+	}
+
+	registration, ok := lookupType(parsed.Type)
+	if !ok || registration.Convert == nil {
+		return nil, nil, fmt.Errorf("conversion not supported for message type: %s", parsed.Type)
+	}
+	msg, report, err := registration.Convert(parsed.Message, version)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	converted := *parsed
+	converted.Message = msg
+	converted.Detection.Namespace = isoNamespacePrefix + msg.Version()
+	converted.Detection.AdditionalInfo = make(map[string]string, len(parsed.Detection.AdditionalInfo))
+	for key, value := range parsed.Detection.AdditionalInfo {
+		converted.Detection.AdditionalInfo[key] = value
+	}
+	msgType, msgVersion := r.extractMessageTypeFromNamespace(converted.Detection.Namespace)
+	converted.Version = msgVersion
+	converted.Detection.Version = msgVersion
+	converted.Detection.AdditionalInfo["namespace_type"] = msgType
+	if parsed.AppHdr != nil {
+		appHdr := *parsed.AppHdr
+		appHdr.MessageDefinitionId = msg.Version()
+		converted.AppHdr = &appHdr
+	}
+	return &converted, report, nil
+}
+
+// MessageDiff lists the model fields two messages of the same type differ in
+type MessageDiff struct {
+	Type        MessageType      `json:"type"`
+	VersionA    string           `json:"versionA"`
+	VersionB    string           `json:"versionB"`
+	Differences []base.FieldDiff `json:"differences"`
+}
+
+// Equal reports whether the two messages have the same content
+func (d *MessageDiff) Equal() bool {
+	return len(d.Differences) == 0
+}
+
+// DiffMessages compares two parsed messages of the same type field by field at the model level,
+// so the formatting and element order of the documents they were read from make no difference.
+// The messages may be different versions of the message definition; fields one version has no
+// element for show as added or removed. Fields are named by their dotted model path, such as
+// "Transactions[0].InterBankSettAmount.Amount".
+func (r *UniversalReader) DiffMessages(a, b *ParsedMessage) (*MessageDiff, error) {
+	if a == nil || a.Message == nil || b == nil || b.Message == nil {
+		return nil, fmt.Errorf("no message to compare")
+	}
+	if a.Type != b.Type {
+		return nil, fmt.Errorf("cannot compare %s with %s", a.Type, b.Type)
+	}
+
+	// The version a message was read as, which its header names too, and the document
+	// content outside its model are not part of the message itself
+	differences, err := base.DiffModels(a.Message, b.Message, "ParsedVersion", "Unmapped", "AppHdr.MessageDefinitionId")
+	if err != nil {
+		return nil, err
 	}
+	return &MessageDiff{
+		Type:        a.Type,
+		VersionA:    a.Message.Version(),
+		VersionB:    b.Message.Version(),
+		Differences: differences,
+	}, nil
 }
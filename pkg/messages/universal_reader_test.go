@@ -9,6 +9,10 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/moov-io/wire20022/pkg/base"
+	"github.com/moov-io/wire20022/pkg/errors"
+	CustomerCreditTransferModel "github.com/moov-io/wire20022/pkg/models/CustomerCreditTransfer"
 )
 
 func TestUniversalReader_PeekXML(t *testing.T) {
@@ -127,6 +131,13 @@ func TestUniversalReader_DetectMessageType(t *testing.T) {
 			expectedType: TypeCustomerCreditTransfer,
 			expectedBy:   "namespace",
 		},
+		{
+			name:         "FICreditTransfer",
+			rootElement:  "FICdtTrf",
+			namespace:    "urn:iso:std:iso:20022:tech:xsd:pacs.009.001.08",
+			expectedType: TypeFICreditTransfer,
+			expectedBy:   "namespace",
+		},
 		{
 			name:         "PaymentReturn",
 			rootElement:  "PmtRtr",
@@ -141,6 +152,41 @@ func TestUniversalReader_DetectMessageType(t *testing.T) {
 			expectedType: TypeDrawdownRequest,
 			expectedBy:   "namespace",
 		},
+		{
+			name:         "ReturnRequest",
+			rootElement:  "FIToFIPmtCxlReq",
+			namespace:    "urn:iso:std:iso:20022:tech:xsd:camt.056.001.08",
+			expectedType: TypeReturnRequest,
+			expectedBy:   "namespace",
+		},
+		{
+			name:         "MessageReject",
+			rootElement:  "admi.002.001.01",
+			namespace:    "urn:iso:std:iso:20022:tech:xsd:admi.002.001.01",
+			expectedType: TypeMessageReject,
+			expectedBy:   "namespace",
+		},
+		{
+			name:         "RetrievalRequest",
+			rootElement:  "RsndReq",
+			namespace:    "urn:iso:std:iso:20022:tech:xsd:admi.006.001.01",
+			expectedType: TypeRetrievalRequest,
+			expectedBy:   "namespace",
+		},
+		{
+			name:         "InvestigationRequest",
+			rootElement:  "InvstgtnReq",
+			namespace:    "urn:iso:std:iso:20022:tech:xsd:camt.110.001.01",
+			expectedType: TypeInvestigationRequest,
+			expectedBy:   "namespace",
+		},
+		{
+			name:         "InvestigationResponse",
+			rootElement:  "InvstgtnRspn",
+			namespace:    "urn:iso:std:iso:20022:tech:xsd:camt.111.001.01",
+			expectedType: TypeInvestigationResponse,
+			expectedBy:   "namespace",
+		},
 		{
 			name:         "BkToCstmrAcctRpt requires content analysis",
 			rootElement:  "BkToCstmrAcctRpt",
@@ -251,10 +297,11 @@ func TestUniversalReader_AnalyzeBkToCstmrAcctRpt(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			info := &DetectionInfo{
+				RootElement:    "BkToCstmrAcctRpt",
 				AdditionalInfo: make(map[string]string),
 			}
 
-			resultInfo, err := reader.analyzeBkToCstmrAcctRpt(info, []byte(tt.xml))
+			resultInfo, err := reader.resolveMessageType(info, []byte(tt.xml), "unknown root element")
 
 			if tt.expectError {
 				assert.Error(t, err)
@@ -306,10 +353,24 @@ func TestUniversalReader_WithSampleFiles(t *testing.T) {
 		messageType MessageType
 		samplePath  string
 		skipFiles   []string // Some files might be test variations
+		// Samples whose values Fedwire would refuse, by file name, with the error ValidateMessage reports
+		invalidFiles map[string]string
 	}{
 		{
 			messageType: TypeCustomerCreditTransfer,
 			samplePath:  "../../pkg/models/CustomerCreditTransfer/swiftSample",
+			invalidFiles: map[string]string{
+				"CustomerCreditTransfer_Scenario2_Step1_pacs.008": `routing number "021040079" has check digit 9, expected 8`,
+				"CustomerCreditTransfer_Scenario5_Step1_pacs.008": `IBAN "BE34001216371411" fails the mod-97 check`,
+				"CustomerCreditTransfer_Scenario5_Step2_pacs.008": `IBAN "BE34001216371411" fails the mod-97 check`,
+				"CustomerCreditTransfer_Variation5_pacs.008":      `IBAN "BE34001216371411" fails the mod-97 check`,
+				"CustomerCreditTransfer_Variation6_pacs.008":      `IBAN "BE34001216371411" fails the mod-97 check`,
+				"PaymentReturn_Scenario5_Step1_pacs.008":          `IBAN "BE34001216371411" fails the mod-97 check`,
+			},
+		},
+		{
+			messageType: TypeFICreditTransfer,
+			samplePath:  "../../pkg/models/FICreditTransfer/swiftSample",
 		},
 		{
 			messageType: TypePaymentReturn,
@@ -331,6 +392,46 @@ func TestUniversalReader_WithSampleFiles(t *testing.T) {
 			messageType: TypeFedwireFundsAcknowledgement,
 			samplePath:  "../../pkg/models/FedwireFundsAcknowledgement/swiftSample",
 		},
+		{
+			messageType: TypeInvestigationRequest,
+			samplePath:  "../../pkg/models/InvestigationRequest/swiftSample",
+		},
+		{
+			messageType: TypeInvestigationResponse,
+			samplePath:  "../../pkg/models/InvestigationResponse/swiftSample",
+		},
+		{
+			messageType: TypeRetrievalRequest,
+			samplePath:  "../../pkg/models/RetrievalRequest/swiftSample",
+		},
+		{
+			messageType: TypeMessageReject,
+			samplePath:  "../../pkg/models/MessageReject/swiftSample",
+		},
+		{
+			messageType: TypeReturnRequest,
+			samplePath:  "../../pkg/models/ReturnRequest/swiftSample",
+		},
+		{
+			messageType: TypeActivityReport,
+			samplePath:  "../../pkg/models/ActivityReport/swiftSample",
+		},
+		{
+			messageType: TypeEndpointDetailsReport,
+			samplePath:  "../../pkg/models/EndpointDetailsReport/swiftSample",
+		},
+		{
+			messageType: TypeEndpointGapReport,
+			samplePath:  "../../pkg/models/EndpointGapReport/swiftSample",
+		},
+		{
+			messageType: TypeEndpointTotalsReport,
+			samplePath:  "../../pkg/models/EndpointTotalsReport/swiftSample",
+		},
+		{
+			messageType: TypeMaster,
+			samplePath:  "../../pkg/models/Master/swiftSample",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -409,6 +510,13 @@ func TestUniversalReader_WithSampleFiles(t *testing.T) {
 					assert.NotNil(t, parsed.Message)
 					assert.NotEmpty(t, parsed.Detection.RootElement)
 					assert.NotEmpty(t, parsed.Detection.Namespace)
+					if expected, ok := tc.invalidFiles[file.Name()]; ok {
+						err := reader.ValidateMessage(parsed)
+						require.Error(t, err)
+						assert.Contains(t, err.Error(), expected)
+					} else {
+						assert.NoError(t, reader.ValidateMessage(parsed))
+					}
 
 					tested = true
 				})
@@ -441,6 +549,181 @@ func TestUniversalReader_ErrorEnhancement(t *testing.T) {
 	assert.Contains(t, errStr, "Namespace:")
 }
 
+func TestUniversalReader_AppHdr(t *testing.T) {
+	reader := NewUniversalReader()
+
+	data, err := os.ReadFile("../../pkg/models/BusinessApplicationHeader/swiftSample/BusinessApplicationHeader_Scenario1_Step1_pacs.008")
+	require.NoError(t, err)
+
+	parsed, err := reader.ReadBytes(data)
+	require.NoError(t, err)
+	assert.Equal(t, TypeCustomerCreditTransfer, parsed.Type)
+	assert.Equal(t, "FIToFICstmrCdtTrf", parsed.Detection.RootElement)
+	require.NotNil(t, parsed.AppHdr)
+	assert.Equal(t, "20250310B1QDRCQR000001", parsed.AppHdr.BusinessMessageId)
+	assert.Equal(t, "pacs.008.001.08", parsed.AppHdr.MessageDefinitionId)
+
+	msg, ok := parsed.Message.(*CustomerCreditTransferModel.MessageModel)
+	require.True(t, ok)
+	require.NotNil(t, msg.AppHdr)
+	assert.Equal(t, parsed.AppHdr.From, msg.AppHdr.From)
+	assert.NoError(t, reader.ValidateMessage(parsed))
+
+	// The header has to name the Document it travels with
+	parsed.AppHdr.MessageDefinitionId = "pacs.009.001.08"
+	err = reader.ValidateMessage(parsed)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "MsgDefIdr")
+
+	// A header on its own is not a message
+	data, err = os.ReadFile("../../pkg/models/BusinessApplicationHeader/swiftSample/BusinessApplicationHeader_Scenario1_Step1_head.001")
+	require.NoError(t, err)
+	_, err = reader.ReadBytes(data)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "AppHdr without Document")
+}
+
+func TestUniversalReader_PreserveUnmapped(t *testing.T) {
+	data, err := os.ReadFile("../../pkg/models/CustomerCreditTransfer/swiftSample/CustomerCreditTransfer_Variation6_pacs.008")
+	require.NoError(t, err)
+
+	reader := NewUniversalReader()
+	parsed, err := reader.ReadBytes(data)
+	require.NoError(t, err)
+	msg, ok := parsed.Message.(*CustomerCreditTransferModel.MessageModel)
+	require.True(t, ok)
+	assert.Nil(t, msg.Unmapped)
+
+	// The intermediary agent is not part of the model
+	reader.PreserveUnmapped = true
+	parsed, err = reader.ReadBytes(data)
+	require.NoError(t, err)
+	msg, ok = parsed.Message.(*CustomerCreditTransferModel.MessageModel)
+	require.True(t, ok)
+	require.NotNil(t, msg.Unmapped)
+	assert.Contains(t, msg.Unmapped.XML, "BANYBRRJ")
+
+	var buf bytes.Buffer
+	require.NoError(t, msg.WriteXML(&buf, CustomerCreditTransferModel.PACS_008_001_08))
+	assert.Contains(t, buf.String(), "BANYBRRJ")
+}
+
+func TestUniversalReader_Strict(t *testing.T) {
+	data, err := os.ReadFile("../../pkg/models/CustomerCreditTransfer/swiftSample/CustomerCreditTransfer_Scenario1_Step1_pacs.008")
+	require.NoError(t, err)
+
+	reader := NewUniversalReader()
+	reader.Strict = true
+	parsed, err := reader.ReadBytes(data)
+	require.NoError(t, err)
+	assert.Equal(t, TypeCustomerCreditTransfer, parsed.Type)
+
+	// Verbose errors keep the underlying error reachable
+	data = []byte(strings.Replace(string(data), "<NbOfTxs>1</NbOfTxs>", "<NbOfTxs>one</NbOfTxs>", 1))
+	_, err = reader.ReadBytes(data)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Failed to parse")
+	var validationErr *errors.ValidationError
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, "NumberOfTransactions", validationErr.Field)
+}
+
+func TestUniversalReader_ValidateMessageVersion(t *testing.T) {
+	data, err := os.ReadFile("../../pkg/models/CustomerCreditTransfer/swiftSample/CustomerCreditTransfer_Scenario1_Step1_pacs.008")
+	require.NoError(t, err)
+
+	reader := NewUniversalReader()
+	parsed, err := reader.ReadBytes(data)
+	require.NoError(t, err)
+	require.Equal(t, "001.08", parsed.Version)
+	require.NoError(t, reader.ValidateMessage(parsed))
+
+	msg, ok := parsed.Message.(*CustomerCreditTransferModel.MessageModel)
+	require.True(t, ok)
+	transaction := msg.Transactions[0].Transaction
+	msg.Transactions[0].Transaction = nil
+
+	// pacs.008.001.08 carries the UETR, earlier versions do not
+	err = reader.ValidateMessage(parsed)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "TransactionFields required for version pacs.008.001.08")
+
+	parsed.Version = "001.07"
+	assert.NoError(t, reader.ValidateMessage(parsed))
+
+	parsed.Version = "001.99"
+	assert.EqualError(t, reader.ValidateMessage(parsed), "unsupported version pacs.008.001.99")
+
+	// Required fields are checked as well
+	parsed.Version = "001.08"
+	msg.Transactions[0].Transaction = transaction
+	msg.Transactions[0].DebtorName = ""
+	err = reader.ValidateMessage(parsed)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "DebtorName")
+}
+
+func TestUniversalReader_ConvertMessage(t *testing.T) {
+	data, err := os.ReadFile(envelopeSample)
+	require.NoError(t, err)
+
+	reader := NewUniversalReader()
+	parsed, err := reader.ReadBytes(data)
+	require.NoError(t, err)
+
+	converted, report, err := reader.ConvertMessage(parsed, "pacs.008.001.05")
+	require.NoError(t, err)
+	assert.Equal(t, "pacs.008.001.05", report.To)
+	assert.NotEmpty(t, report.Dropped)
+	assert.Equal(t, TypeCustomerCreditTransfer, converted.Type)
+	assert.Equal(t, "001.05", converted.Version)
+	assert.Equal(t, "001.05", converted.Detection.Version)
+	assert.Equal(t, "urn:iso:std:iso:20022:tech:xsd:pacs.008.001.05", converted.Detection.Namespace)
+	assert.Equal(t, "pacs.008.001.05", converted.AppHdr.MessageDefinitionId)
+	assert.Equal(t, "pacs.008.001.05", converted.Message.Version())
+	require.NoError(t, reader.ValidateMessage(converted))
+
+	// The parsed message is left unchanged
+	assert.Equal(t, "001.08", parsed.Version)
+	assert.Equal(t, "pacs.008.001.08", parsed.AppHdr.MessageDefinitionId)
+	assert.Equal(t, "pacs.008.001.08", parsed.Message.Version())
+	require.NoError(t, reader.ValidateMessage(parsed))
+
+	// The converted message reads back as the version it was converted to
+	var buf bytes.Buffer
+	require.NoError(t, converted.Message.WriteDocument(&buf))
+	reread, err := reader.ReadBytes(buf.Bytes())
+	require.NoError(t, err)
+	assert.Equal(t, "001.05", reread.Version)
+
+	_, _, err = reader.ConvertMessage(parsed, "pacs.009.001.08")
+	assert.EqualError(t, err, "unsupported version pacs.009.001.08")
+	_, _, err = reader.ConvertMessage(nil, "pacs.008.001.05")
+	assert.EqualError(t, err, "no message to convert")
+}
+
+func TestUniversalReader_ConvertMessageSampleFiles(t *testing.T) {
+	reader := NewUniversalReader()
+	files, err := filepath.Glob("../../pkg/models/*/swiftSample/*")
+	require.NoError(t, err)
+
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		require.NoError(t, err)
+		parsed, err := reader.ReadBytes(data)
+		if err != nil {
+			continue // covered by TestUniversalReader_WithSampleFiles
+		}
+
+		// Converting to the version a message is in changes nothing
+		converted, report, err := reader.ConvertMessage(parsed, parsed.Message.Version())
+		require.NoError(t, err, file)
+		assert.True(t, report.Lossless(), "%s: %v", file, report.Dropped)
+		assert.Empty(t, report.Defaulted, file)
+		assert.Equal(t, parsed.Message, converted.Message, file)
+	}
+}
+
 func testUniversalReader_ValidateMessage(t *testing.T) { // disabled due to validation requirements
 	reader := NewUniversalReader()
 
@@ -497,3 +780,52 @@ func testUniversalReader_Read(t *testing.T) { // disabled due to validation requ
 	assert.Equal(t, "001.10", parsed.Version)
 	assert.NotNil(t, parsed.Message)
 }
+
+func TestUniversalReader_DiffMessages(t *testing.T) {
+	data, err := os.ReadFile(envelopeSample)
+	require.NoError(t, err)
+
+	reader := NewUniversalReader()
+	parsed, err := reader.ReadBytes(data)
+	require.NoError(t, err)
+
+	// Formatting and the way values are written make no difference
+	reformatted := bytes.ReplaceAll(data, []byte("\t"), nil)
+	reformatted = bytes.Replace(reformatted, []byte(">510000.74<"), []byte(">510000.740<"), 1)
+	other, err := reader.ReadBytes(reformatted)
+	require.NoError(t, err)
+	diff, err := reader.DiffMessages(parsed, other)
+	require.NoError(t, err)
+	assert.True(t, diff.Equal(), "%v", diff.Differences)
+
+	changed := bytes.Replace(data, []byte(">510000.74<"), []byte(">510000.75<"), 1)
+	changed = bytes.Replace(changed, []byte("<Nm>Corporation B</Nm>"), []byte("<Nm>Corporation C</Nm>"), 1)
+	other, err = reader.ReadBytes(changed)
+	require.NoError(t, err)
+	diff, err = reader.DiffMessages(parsed, other)
+	require.NoError(t, err)
+	assert.Equal(t, TypeCustomerCreditTransfer, diff.Type)
+	assert.Equal(t, []base.FieldDiff{
+		{Field: "Transactions[0].InterBankSettAmount.Amount", A: "510000.74", B: "510000.75"},
+		{Field: "Transactions[0].CreditorName", A: "Corporation B", B: "Corporation C"},
+	}, diff.Differences)
+
+	// Fields a version has no element for show as removed
+	converted, report, err := reader.ConvertMessage(parsed, "pacs.008.001.05")
+	require.NoError(t, err)
+	diff, err = reader.DiffMessages(parsed, converted)
+	require.NoError(t, err)
+	assert.Equal(t, "pacs.008.001.08", diff.VersionA)
+	assert.Equal(t, "pacs.008.001.05", diff.VersionB)
+	require.Len(t, diff.Differences, len(report.Dropped))
+	for _, difference := range diff.Differences {
+		assert.Equal(t, "removed", difference.Kind(), difference.Field)
+	}
+
+	data, err = os.ReadFile("../../pkg/models/PaymentReturn/swiftSample/PaymentReturn_Scenario1_Step4_pacs.004")
+	require.NoError(t, err)
+	payment, err := reader.ReadBytes(data)
+	require.NoError(t, err)
+	_, err = reader.DiffMessages(parsed, payment)
+	assert.EqualError(t, err, "cannot compare CustomerCreditTransfer with PaymentReturn")
+}
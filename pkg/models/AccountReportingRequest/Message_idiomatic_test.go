@@ -25,7 +25,7 @@ func TestReadWriteXMLIdiomatic(t *testing.T) {
 		AccountProperty:    models.AccountTypeMerchant,
 		AccountOwnerAgent: models.Agent{
 			PaymentSysCode:     models.PaymentSysUSABA,
-			PaymentSysMemberId: "123456789",
+			PaymentSysMemberId: "011104238",
 		},
 		ReportingSequence: &ReportingSequenceFields{
 			FromToSequence: models.SequenceRange{
@@ -78,7 +78,7 @@ func TestParseXMLIdiomatic(t *testing.T) {
               <ClrSysId>
                 <Cd>USABA</Cd>
               </ClrSysId>
-              <MmbId>987654321</MmbId>
+              <MmbId>021040078</MmbId>
             </ClrSysMmbId>
           </FinInstnId>
         </Agt>
@@ -119,7 +119,7 @@ func TestValidateFields(t *testing.T) {
 		RequestedMsgNameId: "camt.052.001.08",
 		AccountOwnerAgent: models.Agent{
 			PaymentSysCode:     models.PaymentSysUSABA,
-			PaymentSysMemberId: "123456789",
+			PaymentSysMemberId: "011104238",
 		},
 	}
 
@@ -145,7 +145,7 @@ func TestDocumentWithFunction(t *testing.T) {
 		RequestedMsgNameId: "camt.052.001.08",
 		AccountOwnerAgent: models.Agent{
 			PaymentSysCode:     models.PaymentSysUSABA,
-			PaymentSysMemberId: "123456789",
+			PaymentSysMemberId: "011104238",
 		},
 	}
 
@@ -28,11 +28,11 @@ func TestReadWriteXMLIdiomatic(t *testing.T) {
 		TotalEntries:         "5",
 		TotalCreditEntries: models.NumberAndSumOfTransactions{
 			NumberOfEntries: "2",
-			Sum:             1000.00,
+			Sum:             "1000.00",
 		},
 		TotalDebitEntries: models.NumberAndSumOfTransactions{
 			NumberOfEntries: "3",
-			Sum:             1500.00,
+			Sum:             "1500.00",
 		},
 		AccountEnhancement: &AccountEnhancementFields{
 			AccountOtherId: "ACC123456789",
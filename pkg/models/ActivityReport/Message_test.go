@@ -28,14 +28,14 @@ func TestDocumentToModel08(t *testing.T) {
 	require.Equal(t, model.AccountEnhancement.AccountOtherId, "011104238", "Failed to get AccountOtherId")
 	require.Equal(t, model.TotalEntries, "61", "Failed to get TotalEntries")
 	require.Equal(t, model.TotalCreditEntries.NumberOfEntries, "29", "Failed to get TotalCreditEntries")
-	require.Equal(t, model.TotalCreditEntries.Sum, 8775299.29, "Failed to get TotalCreditEntries")
+	require.Equal(t, model.TotalCreditEntries.Sum, models.Decimal("8775299.29"), "Failed to get TotalCreditEntries")
 	require.Equal(t, model.TotalDebitEntries.NumberOfEntries, "27", "Failed to get TotalDebitEntries")
-	require.Equal(t, model.TotalDebitEntries.Sum, 9932294.43, "Failed to get TotalDebitEntries")
+	require.Equal(t, model.TotalDebitEntries.Sum, models.Decimal("9932294.43"), "Failed to get TotalDebitEntries")
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[0].NumberOfEntries, "0", "Failed to get TotalEntriesPerBankTransactionCode")
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[0].BankTransactionCode, models.Sent, "Failed to get TotalEntriesPerBankTransactionCode")
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[1].NumberOfEntries, "5", "Failed to get TotalEntriesPerBankTransactionCode")
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[1].BankTransactionCode, models.TransReceived, "Failed to get TotalEntriesPerBankTransactionCode")
-	require.Equal(t, model.EntryDetails[0].Amount.Amount, 240.67, "Failed to get Amount")
+	require.Equal(t, model.EntryDetails[0].Amount.Amount, models.Decimal("240.67"), "Failed to get Amount")
 	require.Equal(t, model.EntryDetails[0].Amount.Currency, "USD", "Failed to get Currency")
 	require.Equal(t, model.EntryDetails[0].CreditDebitIndicator, models.Debit, "Failed to get CreditDebitIndicator")
 	require.Equal(t, model.EntryDetails[0].Status, models.Book, "Failed to get Status")
@@ -50,7 +50,7 @@ func TestDocumentToModel08(t *testing.T) {
 	require.Equal(t, model.EntryDetails[0].EntryDetails.InstructedAgent.PaymentSysMemberId, "011104238", "Failed to get PaymentSysMemberId")
 	require.Equal(t, model.EntryDetails[0].EntryDetails.LocalInstrumentChoice, models.InstrumentCTRC, "Failed to get LocalInstrumentChoice")
 	require.Equal(t, model.EntryDetails[0].EntryDetails.RelatedDatesProprietary, models.BusinessProcessingDate, "Failed to get RelatedDatesProprietary")
-	require.Equal(t, model.EntryDetails[1].Amount.Amount, 1000.00, "Failed to get Amount")
+	require.Equal(t, model.EntryDetails[1].Amount.Amount, models.Decimal("1000.00"), "Failed to get Amount")
 	require.Equal(t, model.EntryDetails[1].Amount.Currency, "USD", "Failed to get Currency")
 	require.Equal(t, model.EntryDetails[1].CreditDebitIndicator, models.Debit, "Failed to get CreditDebitIndicator")
 	require.Equal(t, model.EntryDetails[1].Status, models.Book, "Failed to get Status")
@@ -65,7 +65,7 @@ func TestDocumentToModel08(t *testing.T) {
 	require.Equal(t, model.EntryDetails[1].EntryDetails.InstructedAgent.PaymentSysMemberId, "011104238", "Failed to get PaymentSysMemberId")
 	require.Equal(t, model.EntryDetails[1].EntryDetails.LocalInstrumentChoice, models.InstrumentCTRC, "Failed to get LocalInstrumentChoice")
 	require.Equal(t, model.EntryDetails[1].EntryDetails.RelatedDatesProprietary, models.BusinessProcessingDate, "Failed to get RelatedDatesProprietary")
-	require.Equal(t, model.EntryDetails[2].Amount.Amount, 1197.00, "Failed to get Amount")
+	require.Equal(t, model.EntryDetails[2].Amount.Amount, models.Decimal("1197.00"), "Failed to get Amount")
 	require.Equal(t, model.EntryDetails[2].Amount.Currency, "USD", "Failed to get Currency")
 	require.Equal(t, model.EntryDetails[2].CreditDebitIndicator, models.Debit, "Failed to get CreditDebitIndicator")
 	require.Equal(t, model.EntryDetails[2].Status, models.Book, "Failed to get Status")
@@ -165,11 +165,11 @@ func ActivityReportDataModel() MessageModel {
 	mesage.TotalEntries = "61"
 	mesage.TotalCreditEntries = models.NumberAndSumOfTransactions{
 		NumberOfEntries: "29",
-		Sum:             8775299.29,
+		Sum:             "8775299.29",
 	}
 	mesage.TotalDebitEntries = models.NumberAndSumOfTransactions{
 		NumberOfEntries: "27",
-		Sum:             9932294.43,
+		Sum:             "9932294.43",
 	}
 	mesage.TotalEntriesPerBankTransactionCode = []models.TotalsPerBankTransactionCode{
 		{
@@ -184,7 +184,7 @@ func ActivityReportDataModel() MessageModel {
 	mesage.EntryDetails = []models.Entry{
 		{
 			Amount: models.CurrencyAndAmount{
-				Amount:   240.67,
+				Amount:   "240.67",
 				Currency: "USD",
 			},
 			CreditDebitIndicator: models.Debit,
@@ -212,7 +212,7 @@ func ActivityReportDataModel() MessageModel {
 
 		{
 			Amount: models.CurrencyAndAmount{
-				Amount:   1000.00,
+				Amount:   "1000.00",
 				Currency: "USD",
 			},
 			CreditDebitIndicator: models.Debit,
@@ -240,7 +240,7 @@ func ActivityReportDataModel() MessageModel {
 
 		{
 			Amount: models.CurrencyAndAmount{
-				Amount:   1197.00,
+				Amount:   "1197.00",
 				Currency: "USD",
 			},
 			CreditDebitIndicator: models.Debit,
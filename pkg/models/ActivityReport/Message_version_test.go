@@ -40,14 +40,14 @@ func TestVersion01(t *testing.T) {
 	require.Equal(t, model.ReportId, models.EveryDay, "Failed to get ReportId")
 	require.Equal(t, model.TotalEntries, "61", "Failed to get TotalEntries")
 	require.Equal(t, model.TotalCreditEntries.NumberOfEntries, "29", "Failed to get TotalCreditEntries")
-	require.Equal(t, model.TotalCreditEntries.Sum, 8775299.29, "Failed to get TotalCreditEntries")
+	require.Equal(t, model.TotalCreditEntries.Sum, models.Decimal("8775299.29"), "Failed to get TotalCreditEntries")
 	require.Equal(t, model.TotalDebitEntries.NumberOfEntries, "27", "Failed to get TotalDebitEntries")
-	require.Equal(t, model.TotalDebitEntries.Sum, 9932294.43, "Failed to get TotalDebitEntries")
+	require.Equal(t, model.TotalDebitEntries.Sum, models.Decimal("9932294.43"), "Failed to get TotalDebitEntries")
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[0].NumberOfEntries, "0", "Failed to get TotalEntriesPerBankTransactionCode")
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[0].BankTransactionCode, models.Sent, "Failed to get TotalEntriesPerBankTransactionCode")
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[1].NumberOfEntries, "5", "Failed to get TotalEntriesPerBankTransactionCode")
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[1].BankTransactionCode, models.TransReceived, "Failed to get TotalEntriesPerBankTransactionCode")
-	require.Equal(t, model.EntryDetails[0].Amount.Amount, 240.67, "Failed to get Amount")
+	require.Equal(t, model.EntryDetails[0].Amount.Amount, models.Decimal("240.67"), "Failed to get Amount")
 	require.Equal(t, model.EntryDetails[0].Amount.Currency, "USD", "Failed to get Currency")
 	require.Equal(t, model.EntryDetails[0].CreditDebitIndicator, models.Debit, "Failed to get CreditDebitIndicator")
 	require.Equal(t, model.EntryDetails[0].Status, models.Book, "Failed to get Status")
@@ -60,7 +60,7 @@ func TestVersion01(t *testing.T) {
 	require.Equal(t, model.EntryDetails[0].EntryDetails.InstructedAgent.PaymentSysCode, models.PaymentSysUSABA, "Failed to get PaymentSysCode")
 	require.Equal(t, model.EntryDetails[0].EntryDetails.InstructedAgent.PaymentSysMemberId, "011104238", "Failed to get PaymentSysMemberId")
 	require.Equal(t, model.EntryDetails[0].EntryDetails.RelatedDatesProprietary, models.BusinessProcessingDate, "Failed to get RelatedDatesProprietary")
-	require.Equal(t, model.EntryDetails[1].Amount.Amount, 1000.00, "Failed to get Amount")
+	require.Equal(t, model.EntryDetails[1].Amount.Amount, models.Decimal("1000"), "Failed to get Amount")
 	require.Equal(t, model.EntryDetails[1].Amount.Currency, "USD", "Failed to get Currency")
 	require.Equal(t, model.EntryDetails[1].CreditDebitIndicator, models.Debit, "Failed to get CreditDebitIndicator")
 	require.Equal(t, model.EntryDetails[1].Status, models.Book, "Failed to get Status")
@@ -73,7 +73,7 @@ func TestVersion01(t *testing.T) {
 	require.Equal(t, model.EntryDetails[1].EntryDetails.InstructedAgent.PaymentSysCode, models.PaymentSysUSABA, "Failed to get PaymentSysCode")
 	require.Equal(t, model.EntryDetails[1].EntryDetails.InstructedAgent.PaymentSysMemberId, "011104238", "Failed to get PaymentSysMemberId")
 	require.Equal(t, model.EntryDetails[1].EntryDetails.RelatedDatesProprietary, models.BusinessProcessingDate, "Failed to get RelatedDatesProprietary")
-	require.Equal(t, model.EntryDetails[2].Amount.Amount, 1197.00, "Failed to get Amount")
+	require.Equal(t, model.EntryDetails[2].Amount.Amount, models.Decimal("1197"), "Failed to get Amount")
 	require.Equal(t, model.EntryDetails[2].Amount.Currency, "USD", "Failed to get Currency")
 	require.Equal(t, model.EntryDetails[2].CreditDebitIndicator, models.Debit, "Failed to get CreditDebitIndicator")
 	require.Equal(t, model.EntryDetails[2].Status, models.Book, "Failed to get Status")
@@ -140,14 +140,14 @@ func TestVersion02(t *testing.T) {
 	require.Equal(t, model.AccountEnhancement.AccountOtherId, "011104238", "Failed to get AccountOtherId")
 	require.Equal(t, model.TotalEntries, "61", "Failed to get TotalEntries")
 	require.Equal(t, model.TotalCreditEntries.NumberOfEntries, "29", "Failed to get TotalCreditEntries")
-	require.Equal(t, model.TotalCreditEntries.Sum, 8775299.29, "Failed to get TotalCreditEntries")
+	require.Equal(t, model.TotalCreditEntries.Sum, models.Decimal("8775299.29"), "Failed to get TotalCreditEntries")
 	require.Equal(t, model.TotalDebitEntries.NumberOfEntries, "27", "Failed to get TotalDebitEntries")
-	require.Equal(t, model.TotalDebitEntries.Sum, 9932294.43, "Failed to get TotalDebitEntries")
+	require.Equal(t, model.TotalDebitEntries.Sum, models.Decimal("9932294.43"), "Failed to get TotalDebitEntries")
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[0].NumberOfEntries, "0", "Failed to get TotalEntriesPerBankTransactionCode")
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[0].BankTransactionCode, models.Sent, "Failed to get TotalEntriesPerBankTransactionCode")
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[1].NumberOfEntries, "5", "Failed to get TotalEntriesPerBankTransactionCode")
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[1].BankTransactionCode, models.TransReceived, "Failed to get TotalEntriesPerBankTransactionCode")
-	require.Equal(t, model.EntryDetails[0].Amount.Amount, 240.67, "Failed to get Amount")
+	require.Equal(t, model.EntryDetails[0].Amount.Amount, models.Decimal("240.67"), "Failed to get Amount")
 	require.Equal(t, model.EntryDetails[0].Amount.Currency, "USD", "Failed to get Currency")
 	require.Equal(t, model.EntryDetails[0].CreditDebitIndicator, models.Debit, "Failed to get CreditDebitIndicator")
 	require.Equal(t, model.EntryDetails[0].Status, models.Book, "Failed to get Status")
@@ -160,7 +160,7 @@ func TestVersion02(t *testing.T) {
 	require.Equal(t, model.EntryDetails[0].EntryDetails.InstructedAgent.PaymentSysCode, models.PaymentSysUSABA, "Failed to get PaymentSysCode")
 	require.Equal(t, model.EntryDetails[0].EntryDetails.InstructedAgent.PaymentSysMemberId, "011104238", "Failed to get PaymentSysMemberId")
 	require.Equal(t, model.EntryDetails[0].EntryDetails.RelatedDatesProprietary, models.BusinessProcessingDate, "Failed to get RelatedDatesProprietary")
-	require.Equal(t, model.EntryDetails[1].Amount.Amount, 1000.00, "Failed to get Amount")
+	require.Equal(t, model.EntryDetails[1].Amount.Amount, models.Decimal("1000.00"), "Failed to get Amount")
 	require.Equal(t, model.EntryDetails[1].Amount.Currency, "USD", "Failed to get Currency")
 	require.Equal(t, model.EntryDetails[1].CreditDebitIndicator, models.Debit, "Failed to get CreditDebitIndicator")
 	require.Equal(t, model.EntryDetails[1].Status, models.Book, "Failed to get Status")
@@ -173,7 +173,7 @@ func TestVersion02(t *testing.T) {
 	require.Equal(t, model.EntryDetails[1].EntryDetails.InstructedAgent.PaymentSysCode, models.PaymentSysUSABA, "Failed to get PaymentSysCode")
 	require.Equal(t, model.EntryDetails[1].EntryDetails.InstructedAgent.PaymentSysMemberId, "011104238", "Failed to get PaymentSysMemberId")
 	require.Equal(t, model.EntryDetails[1].EntryDetails.RelatedDatesProprietary, models.BusinessProcessingDate, "Failed to get RelatedDatesProprietary")
-	require.Equal(t, model.EntryDetails[2].Amount.Amount, 1197.00, "Failed to get Amount")
+	require.Equal(t, model.EntryDetails[2].Amount.Amount, models.Decimal("1197.00"), "Failed to get Amount")
 	require.Equal(t, model.EntryDetails[2].Amount.Currency, "USD", "Failed to get Currency")
 	require.Equal(t, model.EntryDetails[2].CreditDebitIndicator, models.Debit, "Failed to get CreditDebitIndicator")
 	require.Equal(t, model.EntryDetails[2].Status, models.Book, "Failed to get Status")
@@ -241,14 +241,14 @@ func TestVersion03(t *testing.T) {
 	require.Equal(t, model.AccountEnhancement.AccountOtherId, "011104238", "Failed to get AccountOtherId")
 	require.Equal(t, model.TotalEntries, "61", "Failed to get TotalEntries")
 	require.Equal(t, model.TotalCreditEntries.NumberOfEntries, "29", "Failed to get TotalCreditEntries")
-	require.Equal(t, model.TotalCreditEntries.Sum, 8775299.29, "Failed to get TotalCreditEntries")
+	require.Equal(t, model.TotalCreditEntries.Sum, models.Decimal("8775299.29"), "Failed to get TotalCreditEntries")
 	require.Equal(t, model.TotalDebitEntries.NumberOfEntries, "27", "Failed to get TotalDebitEntries")
-	require.Equal(t, model.TotalDebitEntries.Sum, 9932294.43, "Failed to get TotalDebitEntries")
+	require.Equal(t, model.TotalDebitEntries.Sum, models.Decimal("9932294.43"), "Failed to get TotalDebitEntries")
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[0].NumberOfEntries, "0", "Failed to get TotalEntriesPerBankTransactionCode")
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[0].BankTransactionCode, models.Sent, "Failed to get TotalEntriesPerBankTransactionCode")
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[1].NumberOfEntries, "5", "Failed to get TotalEntriesPerBankTransactionCode")
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[1].BankTransactionCode, models.TransReceived, "Failed to get TotalEntriesPerBankTransactionCode")
-	require.Equal(t, model.EntryDetails[0].Amount.Amount, 240.67, "Failed to get Amount")
+	require.Equal(t, model.EntryDetails[0].Amount.Amount, models.Decimal("240.67"), "Failed to get Amount")
 	require.Equal(t, model.EntryDetails[0].Amount.Currency, "USD", "Failed to get Currency")
 	require.Equal(t, model.EntryDetails[0].CreditDebitIndicator, models.Debit, "Failed to get CreditDebitIndicator")
 	require.Equal(t, model.EntryDetails[0].Status, models.Book, "Failed to get Status")
@@ -261,7 +261,7 @@ func TestVersion03(t *testing.T) {
 	require.Equal(t, model.EntryDetails[0].EntryDetails.InstructedAgent.PaymentSysCode, models.PaymentSysUSABA, "Failed to get PaymentSysCode")
 	require.Equal(t, model.EntryDetails[0].EntryDetails.InstructedAgent.PaymentSysMemberId, "011104238", "Failed to get PaymentSysMemberId")
 	require.Equal(t, model.EntryDetails[0].EntryDetails.RelatedDatesProprietary, models.BusinessProcessingDate, "Failed to get RelatedDatesProprietary")
-	require.Equal(t, model.EntryDetails[1].Amount.Amount, 1000.00, "Failed to get Amount")
+	require.Equal(t, model.EntryDetails[1].Amount.Amount, models.Decimal("1000.00"), "Failed to get Amount")
 	require.Equal(t, model.EntryDetails[1].Amount.Currency, "USD", "Failed to get Currency")
 	require.Equal(t, model.EntryDetails[1].CreditDebitIndicator, models.Debit, "Failed to get CreditDebitIndicator")
 	require.Equal(t, model.EntryDetails[1].Status, models.Book, "Failed to get Status")
@@ -274,7 +274,7 @@ func TestVersion03(t *testing.T) {
 	require.Equal(t, model.EntryDetails[1].EntryDetails.InstructedAgent.PaymentSysCode, models.PaymentSysUSABA, "Failed to get PaymentSysCode")
 	require.Equal(t, model.EntryDetails[1].EntryDetails.InstructedAgent.PaymentSysMemberId, "011104238", "Failed to get PaymentSysMemberId")
 	require.Equal(t, model.EntryDetails[1].EntryDetails.RelatedDatesProprietary, models.BusinessProcessingDate, "Failed to get RelatedDatesProprietary")
-	require.Equal(t, model.EntryDetails[2].Amount.Amount, 1197.00, "Failed to get Amount")
+	require.Equal(t, model.EntryDetails[2].Amount.Amount, models.Decimal("1197.00"), "Failed to get Amount")
 	require.Equal(t, model.EntryDetails[2].Amount.Currency, "USD", "Failed to get Currency")
 	require.Equal(t, model.EntryDetails[2].CreditDebitIndicator, models.Debit, "Failed to get CreditDebitIndicator")
 	require.Equal(t, model.EntryDetails[2].Status, models.Book, "Failed to get Status")
@@ -342,14 +342,14 @@ func TestVersion04(t *testing.T) {
 	require.Equal(t, model.AccountEnhancement.AccountOtherId, "011104238", "Failed to get AccountOtherId")
 	require.Equal(t, model.TotalEntries, "61", "Failed to get TotalEntries")
 	require.Equal(t, model.TotalCreditEntries.NumberOfEntries, "29", "Failed to get TotalCreditEntries")
-	require.Equal(t, model.TotalCreditEntries.Sum, 8775299.29, "Failed to get TotalCreditEntries")
+	require.Equal(t, model.TotalCreditEntries.Sum, models.Decimal("8775299.29"), "Failed to get TotalCreditEntries")
 	require.Equal(t, model.TotalDebitEntries.NumberOfEntries, "27", "Failed to get TotalDebitEntries")
-	require.Equal(t, model.TotalDebitEntries.Sum, 9932294.43, "Failed to get TotalDebitEntries")
+	require.Equal(t, model.TotalDebitEntries.Sum, models.Decimal("9932294.43"), "Failed to get TotalDebitEntries")
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[0].NumberOfEntries, "0", "Failed to get TotalEntriesPerBankTransactionCode")
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[0].BankTransactionCode, models.Sent, "Failed to get TotalEntriesPerBankTransactionCode")
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[1].NumberOfEntries, "5", "Failed to get TotalEntriesPerBankTransactionCode")
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[1].BankTransactionCode, models.TransReceived, "Failed to get TotalEntriesPerBankTransactionCode")
-	require.Equal(t, model.EntryDetails[0].Amount.Amount, 240.67, "Failed to get Amount")
+	require.Equal(t, model.EntryDetails[0].Amount.Amount, models.Decimal("240.67"), "Failed to get Amount")
 	require.Equal(t, model.EntryDetails[0].Amount.Currency, "USD", "Failed to get Currency")
 	require.Equal(t, model.EntryDetails[0].CreditDebitIndicator, models.Debit, "Failed to get CreditDebitIndicator")
 	require.Equal(t, model.EntryDetails[0].Status, models.Book, "Failed to get Status")
@@ -362,7 +362,7 @@ func TestVersion04(t *testing.T) {
 	require.Equal(t, model.EntryDetails[0].EntryDetails.InstructedAgent.PaymentSysCode, models.PaymentSysUSABA, "Failed to get PaymentSysCode")
 	require.Equal(t, model.EntryDetails[0].EntryDetails.InstructedAgent.PaymentSysMemberId, "011104238", "Failed to get PaymentSysMemberId")
 	require.Equal(t, model.EntryDetails[0].EntryDetails.RelatedDatesProprietary, models.BusinessProcessingDate, "Failed to get RelatedDatesProprietary")
-	require.Equal(t, model.EntryDetails[1].Amount.Amount, 1000.00, "Failed to get Amount")
+	require.Equal(t, model.EntryDetails[1].Amount.Amount, models.Decimal("1000.00"), "Failed to get Amount")
 	require.Equal(t, model.EntryDetails[1].Amount.Currency, "USD", "Failed to get Currency")
 	require.Equal(t, model.EntryDetails[1].CreditDebitIndicator, models.Debit, "Failed to get CreditDebitIndicator")
 	require.Equal(t, model.EntryDetails[1].Status, models.Book, "Failed to get Status")
@@ -375,7 +375,7 @@ func TestVersion04(t *testing.T) {
 	require.Equal(t, model.EntryDetails[1].EntryDetails.InstructedAgent.PaymentSysCode, models.PaymentSysUSABA, "Failed to get PaymentSysCode")
 	require.Equal(t, model.EntryDetails[1].EntryDetails.InstructedAgent.PaymentSysMemberId, "011104238", "Failed to get PaymentSysMemberId")
 	require.Equal(t, model.EntryDetails[1].EntryDetails.RelatedDatesProprietary, models.BusinessProcessingDate, "Failed to get RelatedDatesProprietary")
-	require.Equal(t, model.EntryDetails[2].Amount.Amount, 1197.00, "Failed to get Amount")
+	require.Equal(t, model.EntryDetails[2].Amount.Amount, models.Decimal("1197.00"), "Failed to get Amount")
 	require.Equal(t, model.EntryDetails[2].Amount.Currency, "USD", "Failed to get Currency")
 	require.Equal(t, model.EntryDetails[2].CreditDebitIndicator, models.Debit, "Failed to get CreditDebitIndicator")
 	require.Equal(t, model.EntryDetails[2].Status, models.Book, "Failed to get Status")
@@ -443,14 +443,14 @@ func TestVersion05(t *testing.T) {
 	require.Equal(t, model.AccountEnhancement.AccountOtherId, "011104238", "Failed to get AccountOtherId")
 	require.Equal(t, model.TotalEntries, "61", "Failed to get TotalEntries")
 	require.Equal(t, model.TotalCreditEntries.NumberOfEntries, "29", "Failed to get TotalCreditEntries")
-	require.Equal(t, model.TotalCreditEntries.Sum, 8775299.29, "Failed to get TotalCreditEntries")
+	require.Equal(t, model.TotalCreditEntries.Sum, models.Decimal("8775299.29"), "Failed to get TotalCreditEntries")
 	require.Equal(t, model.TotalDebitEntries.NumberOfEntries, "27", "Failed to get TotalDebitEntries")
-	require.Equal(t, model.TotalDebitEntries.Sum, 9932294.43, "Failed to get TotalDebitEntries")
+	require.Equal(t, model.TotalDebitEntries.Sum, models.Decimal("9932294.43"), "Failed to get TotalDebitEntries")
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[0].NumberOfEntries, "0", "Failed to get TotalEntriesPerBankTransactionCode")
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[0].BankTransactionCode, models.Sent, "Failed to get TotalEntriesPerBankTransactionCode")
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[1].NumberOfEntries, "5", "Failed to get TotalEntriesPerBankTransactionCode")
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[1].BankTransactionCode, models.TransReceived, "Failed to get TotalEntriesPerBankTransactionCode")
-	require.Equal(t, model.EntryDetails[0].Amount.Amount, 240.67, "Failed to get Amount")
+	require.Equal(t, model.EntryDetails[0].Amount.Amount, models.Decimal("240.67"), "Failed to get Amount")
 	require.Equal(t, model.EntryDetails[0].Amount.Currency, "USD", "Failed to get Currency")
 	require.Equal(t, model.EntryDetails[0].CreditDebitIndicator, models.Debit, "Failed to get CreditDebitIndicator")
 	require.Equal(t, model.EntryDetails[0].Status, models.Book, "Failed to get Status")
@@ -463,7 +463,7 @@ func TestVersion05(t *testing.T) {
 	require.Equal(t, model.EntryDetails[0].EntryDetails.InstructedAgent.PaymentSysCode, models.PaymentSysUSABA, "Failed to get PaymentSysCode")
 	require.Equal(t, model.EntryDetails[0].EntryDetails.InstructedAgent.PaymentSysMemberId, "011104238", "Failed to get PaymentSysMemberId")
 	require.Equal(t, model.EntryDetails[0].EntryDetails.RelatedDatesProprietary, models.BusinessProcessingDate, "Failed to get RelatedDatesProprietary")
-	require.Equal(t, model.EntryDetails[1].Amount.Amount, 1000.00, "Failed to get Amount")
+	require.Equal(t, model.EntryDetails[1].Amount.Amount, models.Decimal("1000.00"), "Failed to get Amount")
 	require.Equal(t, model.EntryDetails[1].Amount.Currency, "USD", "Failed to get Currency")
 	require.Equal(t, model.EntryDetails[1].CreditDebitIndicator, models.Debit, "Failed to get CreditDebitIndicator")
 	require.Equal(t, model.EntryDetails[1].Status, models.Book, "Failed to get Status")
@@ -476,7 +476,7 @@ func TestVersion05(t *testing.T) {
 	require.Equal(t, model.EntryDetails[1].EntryDetails.InstructedAgent.PaymentSysCode, models.PaymentSysUSABA, "Failed to get PaymentSysCode")
 	require.Equal(t, model.EntryDetails[1].EntryDetails.InstructedAgent.PaymentSysMemberId, "011104238", "Failed to get PaymentSysMemberId")
 	require.Equal(t, model.EntryDetails[1].EntryDetails.RelatedDatesProprietary, models.BusinessProcessingDate, "Failed to get RelatedDatesProprietary")
-	require.Equal(t, model.EntryDetails[2].Amount.Amount, 1197.00, "Failed to get Amount")
+	require.Equal(t, model.EntryDetails[2].Amount.Amount, models.Decimal("1197.00"), "Failed to get Amount")
 	require.Equal(t, model.EntryDetails[2].Amount.Currency, "USD", "Failed to get Currency")
 	require.Equal(t, model.EntryDetails[2].CreditDebitIndicator, models.Debit, "Failed to get CreditDebitIndicator")
 	require.Equal(t, model.EntryDetails[2].Status, models.Book, "Failed to get Status")
@@ -544,14 +544,14 @@ func TestVersion06(t *testing.T) {
 	require.Equal(t, model.AccountEnhancement.AccountOtherId, "011104238", "Failed to get AccountOtherId")
 	require.Equal(t, model.TotalEntries, "61", "Failed to get TotalEntries")
 	require.Equal(t, model.TotalCreditEntries.NumberOfEntries, "29", "Failed to get TotalCreditEntries")
-	require.Equal(t, model.TotalCreditEntries.Sum, 8775299.29, "Failed to get TotalCreditEntries")
+	require.Equal(t, model.TotalCreditEntries.Sum, models.Decimal("8775299.29"), "Failed to get TotalCreditEntries")
 	require.Equal(t, model.TotalDebitEntries.NumberOfEntries, "27", "Failed to get TotalDebitEntries")
-	require.Equal(t, model.TotalDebitEntries.Sum, 9932294.43, "Failed to get TotalDebitEntries")
+	require.Equal(t, model.TotalDebitEntries.Sum, models.Decimal("9932294.43"), "Failed to get TotalDebitEntries")
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[0].NumberOfEntries, "0", "Failed to get TotalEntriesPerBankTransactionCode")
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[0].BankTransactionCode, models.Sent, "Failed to get TotalEntriesPerBankTransactionCode")
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[1].NumberOfEntries, "5", "Failed to get TotalEntriesPerBankTransactionCode")
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[1].BankTransactionCode, models.TransReceived, "Failed to get TotalEntriesPerBankTransactionCode")
-	require.Equal(t, model.EntryDetails[0].Amount.Amount, 240.67, "Failed to get Amount")
+	require.Equal(t, model.EntryDetails[0].Amount.Amount, models.Decimal("240.67"), "Failed to get Amount")
 	require.Equal(t, model.EntryDetails[0].Amount.Currency, "USD", "Failed to get Currency")
 	require.Equal(t, model.EntryDetails[0].CreditDebitIndicator, models.Debit, "Failed to get CreditDebitIndicator")
 	require.Equal(t, model.EntryDetails[0].Status, models.Book, "Failed to get Status")
@@ -564,7 +564,7 @@ func TestVersion06(t *testing.T) {
 	require.Equal(t, model.EntryDetails[0].EntryDetails.InstructedAgent.PaymentSysCode, models.PaymentSysUSABA, "Failed to get PaymentSysCode")
 	require.Equal(t, model.EntryDetails[0].EntryDetails.InstructedAgent.PaymentSysMemberId, "011104238", "Failed to get PaymentSysMemberId")
 	require.Equal(t, model.EntryDetails[0].EntryDetails.RelatedDatesProprietary, models.BusinessProcessingDate, "Failed to get RelatedDatesProprietary")
-	require.Equal(t, model.EntryDetails[1].Amount.Amount, 1000.00, "Failed to get Amount")
+	require.Equal(t, model.EntryDetails[1].Amount.Amount, models.Decimal("1000.00"), "Failed to get Amount")
 	require.Equal(t, model.EntryDetails[1].Amount.Currency, "USD", "Failed to get Currency")
 	require.Equal(t, model.EntryDetails[1].CreditDebitIndicator, models.Debit, "Failed to get CreditDebitIndicator")
 	require.Equal(t, model.EntryDetails[1].Status, models.Book, "Failed to get Status")
@@ -577,7 +577,7 @@ func TestVersion06(t *testing.T) {
 	require.Equal(t, model.EntryDetails[1].EntryDetails.InstructedAgent.PaymentSysCode, models.PaymentSysUSABA, "Failed to get PaymentSysCode")
 	require.Equal(t, model.EntryDetails[1].EntryDetails.InstructedAgent.PaymentSysMemberId, "011104238", "Failed to get PaymentSysMemberId")
 	require.Equal(t, model.EntryDetails[1].EntryDetails.RelatedDatesProprietary, models.BusinessProcessingDate, "Failed to get RelatedDatesProprietary")
-	require.Equal(t, model.EntryDetails[2].Amount.Amount, 1197.00, "Failed to get Amount")
+	require.Equal(t, model.EntryDetails[2].Amount.Amount, models.Decimal("1197.00"), "Failed to get Amount")
 	require.Equal(t, model.EntryDetails[2].Amount.Currency, "USD", "Failed to get Currency")
 	require.Equal(t, model.EntryDetails[2].CreditDebitIndicator, models.Debit, "Failed to get CreditDebitIndicator")
 	require.Equal(t, model.EntryDetails[2].Status, models.Book, "Failed to get Status")
@@ -645,14 +645,14 @@ func TestVersion07(t *testing.T) {
 	require.Equal(t, model.AccountEnhancement.AccountOtherId, "011104238", "Failed to get AccountOtherId")
 	require.Equal(t, model.TotalEntries, "61", "Failed to get TotalEntries")
 	require.Equal(t, model.TotalCreditEntries.NumberOfEntries, "29", "Failed to get TotalCreditEntries")
-	require.Equal(t, model.TotalCreditEntries.Sum, 8775299.29, "Failed to get TotalCreditEntries")
+	require.Equal(t, model.TotalCreditEntries.Sum, models.Decimal("8775299.29"), "Failed to get TotalCreditEntries")
 	require.Equal(t, model.TotalDebitEntries.NumberOfEntries, "27", "Failed to get TotalDebitEntries")
-	require.Equal(t, model.TotalDebitEntries.Sum, 9932294.43, "Failed to get TotalDebitEntries")
+	require.Equal(t, model.TotalDebitEntries.Sum, models.Decimal("9932294.43"), "Failed to get TotalDebitEntries")
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[0].NumberOfEntries, "0", "Failed to get TotalEntriesPerBankTransactionCode")
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[0].BankTransactionCode, models.Sent, "Failed to get TotalEntriesPerBankTransactionCode")
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[1].NumberOfEntries, "5", "Failed to get TotalEntriesPerBankTransactionCode")
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[1].BankTransactionCode, models.TransReceived, "Failed to get TotalEntriesPerBankTransactionCode")
-	require.Equal(t, model.EntryDetails[0].Amount.Amount, 240.67, "Failed to get Amount")
+	require.Equal(t, model.EntryDetails[0].Amount.Amount, models.Decimal("240.67"), "Failed to get Amount")
 	require.Equal(t, model.EntryDetails[0].Amount.Currency, "USD", "Failed to get Currency")
 	require.Equal(t, model.EntryDetails[0].CreditDebitIndicator, models.Debit, "Failed to get CreditDebitIndicator")
 	require.Equal(t, model.EntryDetails[0].Status, models.Book, "Failed to get Status")
@@ -665,7 +665,7 @@ func TestVersion07(t *testing.T) {
 	require.Equal(t, model.EntryDetails[0].EntryDetails.InstructedAgent.PaymentSysCode, models.PaymentSysUSABA, "Failed to get PaymentSysCode")
 	require.Equal(t, model.EntryDetails[0].EntryDetails.InstructedAgent.PaymentSysMemberId, "011104238", "Failed to get PaymentSysMemberId")
 	require.Equal(t, model.EntryDetails[0].EntryDetails.RelatedDatesProprietary, models.BusinessProcessingDate, "Failed to get RelatedDatesProprietary")
-	require.Equal(t, model.EntryDetails[1].Amount.Amount, 1000.00, "Failed to get Amount")
+	require.Equal(t, model.EntryDetails[1].Amount.Amount, models.Decimal("1000.00"), "Failed to get Amount")
 	require.Equal(t, model.EntryDetails[1].Amount.Currency, "USD", "Failed to get Currency")
 	require.Equal(t, model.EntryDetails[1].CreditDebitIndicator, models.Debit, "Failed to get CreditDebitIndicator")
 	require.Equal(t, model.EntryDetails[1].Status, models.Book, "Failed to get Status")
@@ -678,7 +678,7 @@ func TestVersion07(t *testing.T) {
 	require.Equal(t, model.EntryDetails[1].EntryDetails.InstructedAgent.PaymentSysCode, models.PaymentSysUSABA, "Failed to get PaymentSysCode")
 	require.Equal(t, model.EntryDetails[1].EntryDetails.InstructedAgent.PaymentSysMemberId, "011104238", "Failed to get PaymentSysMemberId")
 	require.Equal(t, model.EntryDetails[1].EntryDetails.RelatedDatesProprietary, models.BusinessProcessingDate, "Failed to get RelatedDatesProprietary")
-	require.Equal(t, model.EntryDetails[2].Amount.Amount, 1197.00, "Failed to get Amount")
+	require.Equal(t, model.EntryDetails[2].Amount.Amount, models.Decimal("1197.00"), "Failed to get Amount")
 	require.Equal(t, model.EntryDetails[2].Amount.Currency, "USD", "Failed to get Currency")
 	require.Equal(t, model.EntryDetails[2].CreditDebitIndicator, models.Debit, "Failed to get CreditDebitIndicator")
 	require.Equal(t, model.EntryDetails[2].Status, models.Book, "Failed to get Status")
@@ -746,14 +746,14 @@ func TestVersion08(t *testing.T) {
 	require.Equal(t, model.AccountEnhancement.AccountOtherId, "011104238", "Failed to get AccountOtherId")
 	require.Equal(t, model.TotalEntries, "61", "Failed to get TotalEntries")
 	require.Equal(t, model.TotalCreditEntries.NumberOfEntries, "29", "Failed to get TotalCreditEntries")
-	require.Equal(t, model.TotalCreditEntries.Sum, 8775299.29, "Failed to get TotalCreditEntries")
+	require.Equal(t, model.TotalCreditEntries.Sum, models.Decimal("8775299.29"), "Failed to get TotalCreditEntries")
 	require.Equal(t, model.TotalDebitEntries.NumberOfEntries, "27", "Failed to get TotalDebitEntries")
-	require.Equal(t, model.TotalDebitEntries.Sum, 9932294.43, "Failed to get TotalDebitEntries")
+	require.Equal(t, model.TotalDebitEntries.Sum, models.Decimal("9932294.43"), "Failed to get TotalDebitEntries")
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[0].NumberOfEntries, "0", "Failed to get TotalEntriesPerBankTransactionCode")
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[0].BankTransactionCode, models.Sent, "Failed to get TotalEntriesPerBankTransactionCode")
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[1].NumberOfEntries, "5", "Failed to get TotalEntriesPerBankTransactionCode")
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[1].BankTransactionCode, models.TransReceived, "Failed to get TotalEntriesPerBankTransactionCode")
-	require.Equal(t, model.EntryDetails[0].Amount.Amount, 240.67, "Failed to get Amount")
+	require.Equal(t, model.EntryDetails[0].Amount.Amount, models.Decimal("240.67"), "Failed to get Amount")
 	require.Equal(t, model.EntryDetails[0].Amount.Currency, "USD", "Failed to get Currency")
 	require.Equal(t, model.EntryDetails[0].CreditDebitIndicator, models.Debit, "Failed to get CreditDebitIndicator")
 	require.Equal(t, model.EntryDetails[0].Status, models.Book, "Failed to get Status")
@@ -766,7 +766,7 @@ func TestVersion08(t *testing.T) {
 	require.Equal(t, model.EntryDetails[0].EntryDetails.InstructedAgent.PaymentSysCode, models.PaymentSysUSABA, "Failed to get PaymentSysCode")
 	require.Equal(t, model.EntryDetails[0].EntryDetails.InstructedAgent.PaymentSysMemberId, "011104238", "Failed to get PaymentSysMemberId")
 	require.Equal(t, model.EntryDetails[0].EntryDetails.RelatedDatesProprietary, models.BusinessProcessingDate, "Failed to get RelatedDatesProprietary")
-	require.Equal(t, model.EntryDetails[1].Amount.Amount, 1000.00, "Failed to get Amount")
+	require.Equal(t, model.EntryDetails[1].Amount.Amount, models.Decimal("1000.00"), "Failed to get Amount")
 	require.Equal(t, model.EntryDetails[1].Amount.Currency, "USD", "Failed to get Currency")
 	require.Equal(t, model.EntryDetails[1].CreditDebitIndicator, models.Debit, "Failed to get CreditDebitIndicator")
 	require.Equal(t, model.EntryDetails[1].Status, models.Book, "Failed to get Status")
@@ -779,7 +779,7 @@ func TestVersion08(t *testing.T) {
 	require.Equal(t, model.EntryDetails[1].EntryDetails.InstructedAgent.PaymentSysCode, models.PaymentSysUSABA, "Failed to get PaymentSysCode")
 	require.Equal(t, model.EntryDetails[1].EntryDetails.InstructedAgent.PaymentSysMemberId, "011104238", "Failed to get PaymentSysMemberId")
 	require.Equal(t, model.EntryDetails[1].EntryDetails.RelatedDatesProprietary, models.BusinessProcessingDate, "Failed to get RelatedDatesProprietary")
-	require.Equal(t, model.EntryDetails[2].Amount.Amount, 1197.00, "Failed to get Amount")
+	require.Equal(t, model.EntryDetails[2].Amount.Amount, models.Decimal("1197.00"), "Failed to get Amount")
 	require.Equal(t, model.EntryDetails[2].Amount.Currency, "USD", "Failed to get Currency")
 	require.Equal(t, model.EntryDetails[2].CreditDebitIndicator, models.Debit, "Failed to get CreditDebitIndicator")
 	require.Equal(t, model.EntryDetails[2].Status, models.Book, "Failed to get Status")
@@ -847,14 +847,14 @@ func TestVersion09(t *testing.T) {
 	require.Equal(t, model.AccountEnhancement.AccountOtherId, "011104238", "Failed to get AccountOtherId")
 	require.Equal(t, model.TotalEntries, "61", "Failed to get TotalEntries")
 	require.Equal(t, model.TotalCreditEntries.NumberOfEntries, "29", "Failed to get TotalCreditEntries")
-	require.Equal(t, model.TotalCreditEntries.Sum, 8775299.29, "Failed to get TotalCreditEntries")
+	require.Equal(t, model.TotalCreditEntries.Sum, models.Decimal("8775299.29"), "Failed to get TotalCreditEntries")
 	require.Equal(t, model.TotalDebitEntries.NumberOfEntries, "27", "Failed to get TotalDebitEntries")
-	require.Equal(t, model.TotalDebitEntries.Sum, 9932294.43, "Failed to get TotalDebitEntries")
+	require.Equal(t, model.TotalDebitEntries.Sum, models.Decimal("9932294.43"), "Failed to get TotalDebitEntries")
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[0].NumberOfEntries, "0", "Failed to get TotalEntriesPerBankTransactionCode")
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[0].BankTransactionCode, models.Sent, "Failed to get TotalEntriesPerBankTransactionCode")
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[1].NumberOfEntries, "5", "Failed to get TotalEntriesPerBankTransactionCode")
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[1].BankTransactionCode, models.TransReceived, "Failed to get TotalEntriesPerBankTransactionCode")
-	require.Equal(t, model.EntryDetails[0].Amount.Amount, 240.67, "Failed to get Amount")
+	require.Equal(t, model.EntryDetails[0].Amount.Amount, models.Decimal("240.67"), "Failed to get Amount")
 	require.Equal(t, model.EntryDetails[0].Amount.Currency, "USD", "Failed to get Currency")
 	require.Equal(t, model.EntryDetails[0].CreditDebitIndicator, models.Debit, "Failed to get CreditDebitIndicator")
 	require.Equal(t, model.EntryDetails[0].Status, models.Book, "Failed to get Status")
@@ -867,7 +867,7 @@ func TestVersion09(t *testing.T) {
 	require.Equal(t, model.EntryDetails[0].EntryDetails.InstructedAgent.PaymentSysCode, models.PaymentSysUSABA, "Failed to get PaymentSysCode")
 	require.Equal(t, model.EntryDetails[0].EntryDetails.InstructedAgent.PaymentSysMemberId, "011104238", "Failed to get PaymentSysMemberId")
 	require.Equal(t, model.EntryDetails[0].EntryDetails.RelatedDatesProprietary, models.BusinessProcessingDate, "Failed to get RelatedDatesProprietary")
-	require.Equal(t, model.EntryDetails[1].Amount.Amount, 1000.00, "Failed to get Amount")
+	require.Equal(t, model.EntryDetails[1].Amount.Amount, models.Decimal("1000.00"), "Failed to get Amount")
 	require.Equal(t, model.EntryDetails[1].Amount.Currency, "USD", "Failed to get Currency")
 	require.Equal(t, model.EntryDetails[1].CreditDebitIndicator, models.Debit, "Failed to get CreditDebitIndicator")
 	require.Equal(t, model.EntryDetails[1].Status, models.Book, "Failed to get Status")
@@ -880,7 +880,7 @@ func TestVersion09(t *testing.T) {
 	require.Equal(t, model.EntryDetails[1].EntryDetails.InstructedAgent.PaymentSysCode, models.PaymentSysUSABA, "Failed to get PaymentSysCode")
 	require.Equal(t, model.EntryDetails[1].EntryDetails.InstructedAgent.PaymentSysMemberId, "011104238", "Failed to get PaymentSysMemberId")
 	require.Equal(t, model.EntryDetails[1].EntryDetails.RelatedDatesProprietary, models.BusinessProcessingDate, "Failed to get RelatedDatesProprietary")
-	require.Equal(t, model.EntryDetails[2].Amount.Amount, 1197.00, "Failed to get Amount")
+	require.Equal(t, model.EntryDetails[2].Amount.Amount, models.Decimal("1197.00"), "Failed to get Amount")
 	require.Equal(t, model.EntryDetails[2].Amount.Currency, "USD", "Failed to get Currency")
 	require.Equal(t, model.EntryDetails[2].CreditDebitIndicator, models.Debit, "Failed to get CreditDebitIndicator")
 	require.Equal(t, model.EntryDetails[2].Status, models.Book, "Failed to get Status")
@@ -948,14 +948,14 @@ func TestVersion10(t *testing.T) {
 	require.Equal(t, model.AccountEnhancement.AccountOtherId, "011104238", "Failed to get AccountOtherId")
 	require.Equal(t, model.TotalEntries, "61", "Failed to get TotalEntries")
 	require.Equal(t, model.TotalCreditEntries.NumberOfEntries, "29", "Failed to get TotalCreditEntries")
-	require.Equal(t, model.TotalCreditEntries.Sum, 8775299.29, "Failed to get TotalCreditEntries")
+	require.Equal(t, model.TotalCreditEntries.Sum, models.Decimal("8775299.29"), "Failed to get TotalCreditEntries")
 	require.Equal(t, model.TotalDebitEntries.NumberOfEntries, "27", "Failed to get TotalDebitEntries")
-	require.Equal(t, model.TotalDebitEntries.Sum, 9932294.43, "Failed to get TotalDebitEntries")
+	require.Equal(t, model.TotalDebitEntries.Sum, models.Decimal("9932294.43"), "Failed to get TotalDebitEntries")
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[0].NumberOfEntries, "0", "Failed to get TotalEntriesPerBankTransactionCode")
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[0].BankTransactionCode, models.Sent, "Failed to get TotalEntriesPerBankTransactionCode")
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[1].NumberOfEntries, "5", "Failed to get TotalEntriesPerBankTransactionCode")
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[1].BankTransactionCode, models.TransReceived, "Failed to get TotalEntriesPerBankTransactionCode")
-	require.Equal(t, model.EntryDetails[0].Amount.Amount, 240.67, "Failed to get Amount")
+	require.Equal(t, model.EntryDetails[0].Amount.Amount, models.Decimal("240.67"), "Failed to get Amount")
 	require.Equal(t, model.EntryDetails[0].Amount.Currency, "USD", "Failed to get Currency")
 	require.Equal(t, model.EntryDetails[0].CreditDebitIndicator, models.Debit, "Failed to get CreditDebitIndicator")
 	require.Equal(t, model.EntryDetails[0].Status, models.Book, "Failed to get Status")
@@ -968,7 +968,7 @@ func TestVersion10(t *testing.T) {
 	require.Equal(t, model.EntryDetails[0].EntryDetails.InstructedAgent.PaymentSysCode, models.PaymentSysUSABA, "Failed to get PaymentSysCode")
 	require.Equal(t, model.EntryDetails[0].EntryDetails.InstructedAgent.PaymentSysMemberId, "011104238", "Failed to get PaymentSysMemberId")
 	require.Equal(t, model.EntryDetails[0].EntryDetails.RelatedDatesProprietary, models.BusinessProcessingDate, "Failed to get RelatedDatesProprietary")
-	require.Equal(t, model.EntryDetails[1].Amount.Amount, 1000.00, "Failed to get Amount")
+	require.Equal(t, model.EntryDetails[1].Amount.Amount, models.Decimal("1000.00"), "Failed to get Amount")
 	require.Equal(t, model.EntryDetails[1].Amount.Currency, "USD", "Failed to get Currency")
 	require.Equal(t, model.EntryDetails[1].CreditDebitIndicator, models.Debit, "Failed to get CreditDebitIndicator")
 	require.Equal(t, model.EntryDetails[1].Status, models.Book, "Failed to get Status")
@@ -981,7 +981,7 @@ func TestVersion10(t *testing.T) {
 	require.Equal(t, model.EntryDetails[1].EntryDetails.InstructedAgent.PaymentSysCode, models.PaymentSysUSABA, "Failed to get PaymentSysCode")
 	require.Equal(t, model.EntryDetails[1].EntryDetails.InstructedAgent.PaymentSysMemberId, "011104238", "Failed to get PaymentSysMemberId")
 	require.Equal(t, model.EntryDetails[1].EntryDetails.RelatedDatesProprietary, models.BusinessProcessingDate, "Failed to get RelatedDatesProprietary")
-	require.Equal(t, model.EntryDetails[2].Amount.Amount, 1197.00, "Failed to get Amount")
+	require.Equal(t, model.EntryDetails[2].Amount.Amount, models.Decimal("1197.00"), "Failed to get Amount")
 	require.Equal(t, model.EntryDetails[2].Amount.Currency, "USD", "Failed to get Currency")
 	require.Equal(t, model.EntryDetails[2].CreditDebitIndicator, models.Debit, "Failed to get CreditDebitIndicator")
 	require.Equal(t, model.EntryDetails[2].Status, models.Book, "Failed to get Status")
@@ -1049,14 +1049,14 @@ func TestVersion11(t *testing.T) {
 	require.Equal(t, model.AccountEnhancement.AccountOtherId, "011104238", "Failed to get AccountOtherId")
 	require.Equal(t, model.TotalEntries, "61", "Failed to get TotalEntries")
 	require.Equal(t, model.TotalCreditEntries.NumberOfEntries, "29", "Failed to get TotalCreditEntries")
-	require.Equal(t, model.TotalCreditEntries.Sum, 8775299.29, "Failed to get TotalCreditEntries")
+	require.Equal(t, model.TotalCreditEntries.Sum, models.Decimal("8775299.29"), "Failed to get TotalCreditEntries")
 	require.Equal(t, model.TotalDebitEntries.NumberOfEntries, "27", "Failed to get TotalDebitEntries")
-	require.Equal(t, model.TotalDebitEntries.Sum, 9932294.43, "Failed to get TotalDebitEntries")
+	require.Equal(t, model.TotalDebitEntries.Sum, models.Decimal("9932294.43"), "Failed to get TotalDebitEntries")
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[0].NumberOfEntries, "0", "Failed to get TotalEntriesPerBankTransactionCode")
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[0].BankTransactionCode, models.Sent, "Failed to get TotalEntriesPerBankTransactionCode")
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[1].NumberOfEntries, "5", "Failed to get TotalEntriesPerBankTransactionCode")
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[1].BankTransactionCode, models.TransReceived, "Failed to get TotalEntriesPerBankTransactionCode")
-	require.Equal(t, model.EntryDetails[0].Amount.Amount, 240.67, "Failed to get Amount")
+	require.Equal(t, model.EntryDetails[0].Amount.Amount, models.Decimal("240.67"), "Failed to get Amount")
 	require.Equal(t, model.EntryDetails[0].Amount.Currency, "USD", "Failed to get Currency")
 	require.Equal(t, model.EntryDetails[0].CreditDebitIndicator, models.Debit, "Failed to get CreditDebitIndicator")
 	require.Equal(t, model.EntryDetails[0].Status, models.Book, "Failed to get Status")
@@ -1069,7 +1069,7 @@ func TestVersion11(t *testing.T) {
 	require.Equal(t, model.EntryDetails[0].EntryDetails.InstructedAgent.PaymentSysCode, models.PaymentSysUSABA, "Failed to get PaymentSysCode")
 	require.Equal(t, model.EntryDetails[0].EntryDetails.InstructedAgent.PaymentSysMemberId, "011104238", "Failed to get PaymentSysMemberId")
 	require.Equal(t, model.EntryDetails[0].EntryDetails.RelatedDatesProprietary, models.BusinessProcessingDate, "Failed to get RelatedDatesProprietary")
-	require.Equal(t, model.EntryDetails[1].Amount.Amount, 1000.00, "Failed to get Amount")
+	require.Equal(t, model.EntryDetails[1].Amount.Amount, models.Decimal("1000.00"), "Failed to get Amount")
 	require.Equal(t, model.EntryDetails[1].Amount.Currency, "USD", "Failed to get Currency")
 	require.Equal(t, model.EntryDetails[1].CreditDebitIndicator, models.Debit, "Failed to get CreditDebitIndicator")
 	require.Equal(t, model.EntryDetails[1].Status, models.Book, "Failed to get Status")
@@ -1082,7 +1082,7 @@ func TestVersion11(t *testing.T) {
 	require.Equal(t, model.EntryDetails[1].EntryDetails.InstructedAgent.PaymentSysCode, models.PaymentSysUSABA, "Failed to get PaymentSysCode")
 	require.Equal(t, model.EntryDetails[1].EntryDetails.InstructedAgent.PaymentSysMemberId, "011104238", "Failed to get PaymentSysMemberId")
 	require.Equal(t, model.EntryDetails[1].EntryDetails.RelatedDatesProprietary, models.BusinessProcessingDate, "Failed to get RelatedDatesProprietary")
-	require.Equal(t, model.EntryDetails[2].Amount.Amount, 1197.00, "Failed to get Amount")
+	require.Equal(t, model.EntryDetails[2].Amount.Amount, models.Decimal("1197.00"), "Failed to get Amount")
 	require.Equal(t, model.EntryDetails[2].Amount.Currency, "USD", "Failed to get Currency")
 	require.Equal(t, model.EntryDetails[2].CreditDebitIndicator, models.Debit, "Failed to get CreditDebitIndicator")
 	require.Equal(t, model.EntryDetails[2].Status, models.Book, "Failed to get Status")
@@ -1150,14 +1150,14 @@ func TestVersion12(t *testing.T) {
 	require.Equal(t, model.AccountEnhancement.AccountOtherId, "011104238", "Failed to get AccountOtherId")
 	require.Equal(t, model.TotalEntries, "61", "Failed to get TotalEntries")
 	require.Equal(t, model.TotalCreditEntries.NumberOfEntries, "29", "Failed to get TotalCreditEntries")
-	require.Equal(t, model.TotalCreditEntries.Sum, 8775299.29, "Failed to get TotalCreditEntries")
+	require.Equal(t, model.TotalCreditEntries.Sum, models.Decimal("8775299.29"), "Failed to get TotalCreditEntries")
 	require.Equal(t, model.TotalDebitEntries.NumberOfEntries, "27", "Failed to get TotalDebitEntries")
-	require.Equal(t, model.TotalDebitEntries.Sum, 9932294.43, "Failed to get TotalDebitEntries")
+	require.Equal(t, model.TotalDebitEntries.Sum, models.Decimal("9932294.43"), "Failed to get TotalDebitEntries")
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[0].NumberOfEntries, "0", "Failed to get TotalEntriesPerBankTransactionCode")
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[0].BankTransactionCode, models.Sent, "Failed to get TotalEntriesPerBankTransactionCode")
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[1].NumberOfEntries, "5", "Failed to get TotalEntriesPerBankTransactionCode")
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[1].BankTransactionCode, models.TransReceived, "Failed to get TotalEntriesPerBankTransactionCode")
-	require.Equal(t, model.EntryDetails[0].Amount.Amount, 240.67, "Failed to get Amount")
+	require.Equal(t, model.EntryDetails[0].Amount.Amount, models.Decimal("240.67"), "Failed to get Amount")
 	require.Equal(t, model.EntryDetails[0].Amount.Currency, "USD", "Failed to get Currency")
 	require.Equal(t, model.EntryDetails[0].CreditDebitIndicator, models.Debit, "Failed to get CreditDebitIndicator")
 	require.Equal(t, model.EntryDetails[0].Status, models.Book, "Failed to get Status")
@@ -1170,7 +1170,7 @@ func TestVersion12(t *testing.T) {
 	require.Equal(t, model.EntryDetails[0].EntryDetails.InstructedAgent.PaymentSysCode, models.PaymentSysUSABA, "Failed to get PaymentSysCode")
 	require.Equal(t, model.EntryDetails[0].EntryDetails.InstructedAgent.PaymentSysMemberId, "011104238", "Failed to get PaymentSysMemberId")
 	require.Equal(t, model.EntryDetails[0].EntryDetails.RelatedDatesProprietary, models.BusinessProcessingDate, "Failed to get RelatedDatesProprietary")
-	require.Equal(t, model.EntryDetails[1].Amount.Amount, 1000.00, "Failed to get Amount")
+	require.Equal(t, model.EntryDetails[1].Amount.Amount, models.Decimal("1000.00"), "Failed to get Amount")
 	require.Equal(t, model.EntryDetails[1].Amount.Currency, "USD", "Failed to get Currency")
 	require.Equal(t, model.EntryDetails[1].CreditDebitIndicator, models.Debit, "Failed to get CreditDebitIndicator")
 	require.Equal(t, model.EntryDetails[1].Status, models.Book, "Failed to get Status")
@@ -1183,7 +1183,7 @@ func TestVersion12(t *testing.T) {
 	require.Equal(t, model.EntryDetails[1].EntryDetails.InstructedAgent.PaymentSysCode, models.PaymentSysUSABA, "Failed to get PaymentSysCode")
 	require.Equal(t, model.EntryDetails[1].EntryDetails.InstructedAgent.PaymentSysMemberId, "011104238", "Failed to get PaymentSysMemberId")
 	require.Equal(t, model.EntryDetails[1].EntryDetails.RelatedDatesProprietary, models.BusinessProcessingDate, "Failed to get RelatedDatesProprietary")
-	require.Equal(t, model.EntryDetails[2].Amount.Amount, 1197.00, "Failed to get Amount")
+	require.Equal(t, model.EntryDetails[2].Amount.Amount, models.Decimal("1197.00"), "Failed to get Amount")
 	require.Equal(t, model.EntryDetails[2].Amount.Currency, "USD", "Failed to get Currency")
 	require.Equal(t, model.EntryDetails[2].CreditDebitIndicator, models.Debit, "Failed to get CreditDebitIndicator")
 	require.Equal(t, model.EntryDetails[2].Status, models.Book, "Failed to get Status")
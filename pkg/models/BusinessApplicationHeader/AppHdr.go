@@ -0,0 +1,106 @@
+package BusinessApplicationHeader
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/moov-io/wire20022/pkg/errors"
+	"github.com/moov-io/wire20022/pkg/models"
+)
+
+// MessageDefinitionIdFor returns the MsgDefIdr value (e.g. "pacs.008.001.08") for a
+// Document namespace such as "urn:iso:std:iso:20022:tech:xsd:pacs.008.001.08"
+func MessageDefinitionIdFor(namespace string) string {
+	return namespace[strings.LastIndex(namespace, ":")+1:]
+}
+
+// Split separates an AppHdr element sent ahead of the Document from the rest of the data.
+// When the first element is not an AppHdr, it returns a nil header and the data unchanged.
+// Otherwise everything up to the end of the header is blanked out rather than removed, so that
+// lines, columns and offsets in the rest of the data match the original. A header with no
+// element after it is an error, as it does not say anything on its own.
+func Split(data []byte) (*MessageModel, []byte, error) {
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+
+	for {
+		offset := decoder.InputOffset()
+		token, err := decoder.Token()
+		if err == io.EOF {
+			return nil, data, nil
+		}
+		if err != nil {
+			return nil, nil, models.DecodeError("XML decode", "document", err, decoder)
+		}
+
+		start, ok := token.(xml.StartElement)
+		if !ok {
+			continue
+		}
+		if start.Name.Local != "AppHdr" {
+			return nil, data, nil
+		}
+
+		if err := decoder.Skip(); err != nil {
+			return nil, nil, models.DecodeError("XML decode", "AppHdr", err, decoder)
+		}
+		end := decoder.InputOffset()
+
+		header, err := ParseXML(data[offset:end])
+		if err != nil {
+			errors.ShiftLocations(err, data[:offset])
+			return nil, nil, fmt.Errorf("application header: %w", err)
+		}
+		if !hasElement(decoder) {
+			return nil, nil, fmt.Errorf("AppHdr without Document: header %s is not followed by a message", header.BusinessMessageId)
+		}
+		return header, blank(data, end), nil
+	}
+}
+
+// hasElement reports whether another element follows in the decoder's input
+func hasElement(decoder *xml.Decoder) bool {
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			return false
+		}
+		if _, ok := token.(xml.StartElement); ok {
+			return true
+		}
+	}
+}
+
+// blank returns a copy of data with the bytes before end replaced by spaces, keeping line breaks
+func blank(data []byte, end int64) []byte {
+	blanked := bytes.Clone(data)
+	for i := int64(0); i < end; i++ {
+		if blanked[i] != '\n' && blanked[i] != '\r' {
+			blanked[i] = ' '
+		}
+	}
+	return blanked
+}
+
+// Encode writes the header ahead of a Document with the given namespace. MsgDefIdr is
+// always taken from the namespace so the header stays in sync with the chosen version.
+// A nil header writes nothing.
+func Encode(encoder *xml.Encoder, header *MessageModel, namespace string) error {
+	if header == nil {
+		return nil
+	}
+
+	model := *header
+	model.MessageDefinitionId = MessageDefinitionIdFor(namespace)
+
+	doc, err := DocumentWith(model, HEAD_001_001_03)
+	if err != nil {
+		return fmt.Errorf("creating application header: %w", err)
+	}
+	if err := encoder.Encode(doc); err != nil {
+		return fmt.Errorf("encoding application header: %w", err)
+	}
+	return nil
+}
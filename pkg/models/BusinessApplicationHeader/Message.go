@@ -0,0 +1,233 @@
+package BusinessApplicationHeader
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"time"
+
+	head_001_001_03 "github.com/moov-io/fedwire20022/gen/BusinessApplicationHeader_head_001_001_03"
+	"github.com/moov-io/wire20022/pkg/base"
+	"github.com/moov-io/wire20022/pkg/models"
+)
+
+// BusinessService tells the Fedwire Funds Service which environment a message is meant for
+type BusinessService string
+
+const (
+	BusinessServiceTest       BusinessService = "TEST"
+	BusinessServiceProduction BusinessService = "PROD"
+)
+
+// Market practice values defined by the Fedwire Funds Service usage guidelines
+const (
+	MarketPracticeRegistry = "www2.swift.com/mystandards/#/group/Federal_Reserve_Financial_Services/Fedwire_Funds_Service"
+	MarketPracticeFedwire  = "frb.fedwire.01"
+	CopyDuplicateIndicator = "DUPL"
+)
+
+// MarketPractice identifies the usage guideline the message conforms to
+type MarketPractice struct {
+	Registry string `json:"registry"`
+	Id       string `json:"id"`
+}
+
+// NewMessageForVersion creates a MessageModel with appropriate version-specific fields initialized
+func NewMessageForVersion(version HEAD_001_001_VERSION) MessageModel {
+	model := MessageModel{
+		// Core fields initialized to zero values
+	}
+
+	// No version-specific fields for BusinessApplicationHeader - single version message
+
+	return model
+}
+
+// ValidateForVersion performs type-safe validation for a specific version
+func (m MessageModel) ValidateForVersion(version HEAD_001_001_VERSION) error {
+	// Base field validation (always required)
+	if err := m.validateCoreFields(); err != nil {
+		return fmt.Errorf("core field validation failed: %w", err)
+	}
+
+	// No version-specific validation needed - single version message
+
+	return nil
+}
+
+// validateCoreFields checks required core fields present in all versions
+func (m MessageModel) validateCoreFields() error {
+	// Direct field access - compile-time verified, no reflection
+	if m.From == "" {
+		return fmt.Errorf("From is required")
+	}
+	if m.To == "" {
+		return fmt.Errorf("To is required")
+	}
+	if m.BusinessMessageId == "" {
+		return fmt.Errorf("BusinessMessageId is required")
+	}
+	if m.MessageDefinitionId == "" {
+		return fmt.Errorf("MessageDefinitionId is required")
+	}
+	if m.BusinessService == "" {
+		return fmt.Errorf("BusinessService is required")
+	}
+	if m.MarketPractice.Registry == "" || m.MarketPractice.Id == "" {
+		return fmt.Errorf("MarketPractice is required")
+	}
+	if m.CreatedDateTime.IsZero() {
+		return fmt.Errorf("CreatedDateTime is required")
+	}
+	return nil
+}
+
+// GetVersionCapabilities returns which version-specific features are available
+func (m MessageModel) GetVersionCapabilities() map[string]bool {
+	// BusinessApplicationHeader has no version-specific features
+	return map[string]bool{}
+}
+
+// MessageModel represents the head.001 business application header (AppHdr) that travels
+// next to the Document of every Fedwire Funds Service message
+type MessageModel struct {
+	// Connection party identifiers of the sender and the receiver
+	From string `json:"from"`
+	To   string `json:"to"`
+
+	// Business message identification; MessageDefinitionId names the Document it accompanies
+	BusinessMessageId   string          `json:"businessMessageId"`
+	MessageDefinitionId string          `json:"messageDefinitionId"`
+	BusinessService     BusinessService `json:"businessService"`
+	MarketPractice      MarketPractice  `json:"marketPractice"`
+
+	CreatedDateTime        time.Time `json:"createdDateTime"`
+	BusinessProcessingDate time.Time `json:"businessProcessingDate"`
+
+	// Duplicate indicators
+	CopyDuplicate     string `json:"copyDuplicate"`
+	PossibleDuplicate bool   `json:"possibleDuplicate"`
+}
+
+var RequiredFields = []string{
+	"From", "To", "BusinessMessageId", "MessageDefinitionId",
+	"BusinessService", "MarketPractice", "CreatedDateTime",
+}
+
+// Global processor instance using the base abstraction
+var processor *base.MessageProcessor[MessageModel, HEAD_001_001_VERSION]
+
+// init sets up the processor using base abstractions
+func init() {
+	// Register version using factory registration pattern
+	registrations := []base.FactoryRegistration[models.ISODocument, HEAD_001_001_VERSION]{
+		{
+			Namespace: "urn:iso:std:iso:20022:tech:xsd:head.001.001.03",
+			Version:   HEAD_001_001_03,
+			Factory: func() models.ISODocument {
+				return &head_001_001_03.AppHdr{XMLName: xml.Name{Space: VersionNameSpaceMap[HEAD_001_001_03], Local: "AppHdr"}}
+			},
+		},
+	}
+
+	versionedFactory := base.BuildFactoryFromRegistrations(registrations)
+
+	// Create the processor using base abstractions
+	processor = base.NewMessageProcessor[MessageModel, HEAD_001_001_VERSION](
+		versionedFactory.BuildNameSpaceModelMap(),
+		versionedFactory.GetVersionMap(),
+		VersionPathMap,
+		RequiredFields,
+	)
+	processor.SetMessageFactory(NewMessageForVersion)
+}
+
+// ParseXML reads XML data into the MessageModel
+// This is the primary function for parsing XML from byte data
+func ParseXML(data []byte) (*MessageModel, error) {
+	model, err := processor.ProcessMessage(data)
+	if err != nil {
+		return nil, err
+	}
+	return &model, nil
+}
+
+// DocumentWith creates a versioned AppHdr document from the MessageModel.
+// This is a lower-level API that returns the raw document structure for advanced use cases.
+//
+// Example:
+//
+//	doc, err := BusinessApplicationHeader.DocumentWith(model, BusinessApplicationHeader.HEAD_001_001_03)
+//	if err != nil {
+//	    return err
+//	}
+//	xmlBytes, err := xml.Marshal(doc)
+func DocumentWith(model MessageModel, version HEAD_001_001_VERSION) (models.ISODocument, error) {
+	// Validate required fields before creating document
+	if err := processor.ValidateRequiredFields(model); err != nil {
+		return nil, err
+	}
+
+	// Use processor to create document
+	return processor.CreateDocument(model, version)
+}
+
+// ReadXML reads XML data from an io.Reader into the MessageModel
+func (m *MessageModel) ReadXML(r io.Reader) error {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return fmt.Errorf("reading XML: %w", err)
+	}
+
+	model, err := processor.ProcessMessage(data)
+	if err != nil {
+		return err
+	}
+
+	*m = model
+	return nil
+}
+
+// WriteXML writes the MessageModel as a standalone AppHdr to an io.Writer.
+// To send the header together with a message, set the AppHdr field of that
+// message's MessageModel and use its WriteXML instead.
+//
+// Example:
+//
+//	var buf bytes.Buffer
+//	err := header.WriteXML(&buf, BusinessApplicationHeader.HEAD_001_001_03)
+func (m *MessageModel) WriteXML(w io.Writer, version ...HEAD_001_001_VERSION) error {
+	// Default to latest version
+	ver := HEAD_001_001_03
+	if len(version) > 0 {
+		ver = version[0]
+	}
+
+	// Create versioned document
+	doc, err := DocumentWith(*m, ver)
+	if err != nil {
+		return fmt.Errorf("creating document: %w", err)
+	}
+
+	// Write XML with proper formatting
+	encoder := xml.NewEncoder(w)
+	defer encoder.Close()
+	encoder.Indent("", "  ")
+
+	// Write XML declaration
+	if _, err := w.Write([]byte(xml.Header)); err != nil {
+		return fmt.Errorf("writing XML header: %w", err)
+	}
+
+	// Encode document
+	if err := encoder.Encode(doc); err != nil {
+		return fmt.Errorf("encoding XML: %w", err)
+	}
+
+	return encoder.Flush()
+}
+
+// CheckRequiredFields uses base abstractions to replace 20+ lines with a single call
+func CheckRequiredFields(model MessageModel) error {
+	return processor.ValidateRequiredFields(model)
+}
@@ -0,0 +1,94 @@
+package BusinessApplicationHeader
+
+import "github.com/moov-io/wire20022/pkg/models"
+
+type MarketPracticeHelper struct {
+	Registry models.ElementHelper
+	Id       models.ElementHelper
+}
+
+type MessageHelper struct {
+	From                   models.ElementHelper
+	To                     models.ElementHelper
+	BusinessMessageId      models.ElementHelper
+	MessageDefinitionId    models.ElementHelper
+	BusinessService        models.ElementHelper
+	MarketPractice         MarketPracticeHelper
+	CreatedDateTime        models.ElementHelper
+	BusinessProcessingDate models.ElementHelper
+	CopyDuplicate          models.ElementHelper
+	PossibleDuplicate      models.ElementHelper
+}
+
+func BuildMessageHelper() MessageHelper {
+	return MessageHelper{
+		From: models.ElementHelper{
+			Title:         "From",
+			Rules:         "",
+			Type:          `ConnectionPartyIdentifierFedwireFunds1 (based on string) pattern: [A-Z0-9]{9,9}`,
+			Documentation: `The sender that has created this message for the receiver that processes this message.`,
+		},
+		To: models.ElementHelper{
+			Title:         "To",
+			Rules:         "",
+			Type:          `ConnectionPartyIdentifierFedwireFunds1 (based on string) pattern: [A-Z0-9]{9,9}`,
+			Documentation: `The receiver designated by the sender who ultimately processes this message.`,
+		},
+		BusinessMessageId: models.ElementHelper{
+			Title:         "Business Message Identifier",
+			Rules:         "",
+			Type:          `Max35Text (based on string) minLength: 1 maxLength: 35`,
+			Documentation: `Unambiguously identifies the business message to the messaging endpoint that has created the business message.`,
+		},
+		MessageDefinitionId: models.ElementHelper{
+			Title:         "Message Definition Identifier",
+			Rules:         "Kept in sync with the version of the Document the header is written with.",
+			Type:          `MessageNameIdentificationFRS1 (based on string) exactLength: 15`,
+			Documentation: `Contains the MessageIdentifier that defines the business message, for example pacs.008.001.08.`,
+		},
+		BusinessService: models.ElementHelper{
+			Title:         "Business Service",
+			Rules:         "",
+			Type:          `BusinessServiceFedwireFunds1 (TEST, PROD)`,
+			Documentation: `Specifies the business service agreed between the two parties under which rules this business message is exchanged.`,
+		},
+		MarketPractice: MarketPracticeHelper{
+			Registry: models.ElementHelper{
+				Title:         "Registry",
+				Rules:         "",
+				Type:          `Max350TextFixed (based on string)`,
+				Documentation: `Name of the implementation specification registry in which the implementation specification of the ISO 20022 message is maintained.`,
+			},
+			Id: models.ElementHelper{
+				Title:         "Identification",
+				Rules:         "",
+				Type:          `MarketPracticeIdentificationFedwireFunds1 (based on string) minLength: 14 maxLength: 18`,
+				Documentation: `Identifier which unambiguously identifies, within the implementation specification registry, the implementation specification to which the ISO 20022 message is compliant.`,
+			},
+		},
+		CreatedDateTime: models.ElementHelper{
+			Title:         "Creation Date",
+			Rules:         "",
+			Type:          `ISODateTime (based on dateTime)`,
+			Documentation: `Date and time when this business message (header) was created.`,
+		},
+		BusinessProcessingDate: models.ElementHelper{
+			Title:         "Business Processing Date",
+			Rules:         "",
+			Type:          `ISODateTime (based on dateTime)`,
+			Documentation: `Processing date and time indicated by the sender for the receiver of the business message.`,
+		},
+		CopyDuplicate: models.ElementHelper{
+			Title:         "Copy Duplicate",
+			Rules:         "",
+			Type:          `CopyDuplicate1Code (DUPL)`,
+			Documentation: `Indicates whether the message is a copy, a duplicate or a copy of a duplicate of a previously sent ISO 20022 message.`,
+		},
+		PossibleDuplicate: models.ElementHelper{
+			Title:         "Possible Duplicate",
+			Rules:         "",
+			Type:          `YesNoIndicator (based on boolean)`,
+			Documentation: `Flag indicating if the business message exchanged between the business application is possibly a duplicate.`,
+		},
+	}
+}
@@ -0,0 +1,165 @@
+package BusinessApplicationHeader
+
+import (
+	"bytes"
+	"path/filepath"
+	"testing"
+
+	"github.com/moov-io/wire20022/pkg/models"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDocumentToModel03(t *testing.T) {
+	var sampleXML = filepath.Join("swiftSample", "BusinessApplicationHeader_Scenario1_Step1_head.001")
+	var xmlData, err = models.ReadXMLFile(sampleXML)
+	require.NoError(t, err, "Failed to read XML file")
+
+	model, err := ParseXML(xmlData)
+	if err != nil {
+		t.Fatal(err)
+	}
+	require.NoError(t, err, "Failed to make XML structure")
+	require.Equal(t, model.From, "011104238")
+	require.Equal(t, model.To, "021151080")
+	require.Equal(t, model.BusinessMessageId, "20250310B1QDRCQR000001")
+	require.Equal(t, model.MessageDefinitionId, "pacs.008.001.08")
+	require.Equal(t, model.BusinessService, BusinessServiceTest)
+	require.Equal(t, model.MarketPractice.Registry, MarketPracticeRegistry)
+	require.Equal(t, model.MarketPractice.Id, MarketPracticeFedwire)
+	require.False(t, model.CreatedDateTime.IsZero())
+	require.True(t, model.BusinessProcessingDate.IsZero())
+	require.False(t, model.PossibleDuplicate)
+}
+
+func TestSplit(t *testing.T) {
+	t.Run("header ahead of document", func(t *testing.T) {
+		xmlData, err := models.ReadXMLFile(filepath.Join("swiftSample", "BusinessApplicationHeader_Scenario1_Step1_pacs.008"))
+		require.NoError(t, err)
+
+		header, document, err := Split(xmlData)
+		require.NoError(t, err)
+		require.NotNil(t, header)
+		require.Equal(t, header.BusinessMessageId, "20250310B1QDRCQR000001")
+		require.Equal(t, header.MessageDefinitionId, "pacs.008.001.08")
+		require.True(t, bytes.HasPrefix(bytes.TrimSpace(document), []byte("<Document")))
+		require.NotContains(t, string(document), "AppHdr")
+	})
+
+	t.Run("document keeps its position", func(t *testing.T) {
+		xmlData, err := models.ReadXMLFile(filepath.Join("swiftSample", "BusinessApplicationHeader_Scenario1_Step1_pacs.008"))
+		require.NoError(t, err)
+
+		_, document, err := Split(xmlData)
+		require.NoError(t, err)
+		require.Len(t, document, len(xmlData))
+		start := bytes.Index(xmlData, []byte("<Document"))
+		require.Equal(t, xmlData[start:], document[start:])
+		require.Equal(t, bytes.Count(xmlData, []byte("\n")), bytes.Count(document, []byte("\n")))
+	})
+
+	t.Run("document without header", func(t *testing.T) {
+		data := []byte(`<?xml version="1.0" encoding="UTF-8"?><Document xmlns="urn:iso:std:iso:20022:tech:xsd:pacs.008.001.08"></Document>`)
+		header, document, err := Split(data)
+		require.NoError(t, err)
+		require.Nil(t, header)
+		require.Equal(t, data, document)
+	})
+
+	t.Run("header without document", func(t *testing.T) {
+		xmlData, err := models.ReadXMLFile(filepath.Join("swiftSample", "BusinessApplicationHeader_Scenario1_Step1_head.001"))
+		require.NoError(t, err)
+
+		_, _, err = Split(xmlData)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "AppHdr without Document")
+	})
+
+	t.Run("invalid header", func(t *testing.T) {
+		data := []byte(`<AppHdr xmlns="urn:iso:std:iso:20022:tech:xsd:head.001.001.03"><BizMsgIdr>1</BizMsgIdr></AppHdr><Document/>`)
+		_, _, err := Split(data)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "application header")
+	})
+
+	t.Run("malformed XML", func(t *testing.T) {
+		_, _, err := Split([]byte(`<AppHdr><Fr>`))
+		require.Error(t, err)
+	})
+}
+
+func TestEncode(t *testing.T) {
+	require.Equal(t, "pacs.008.001.08", MessageDefinitionIdFor("urn:iso:std:iso:20022:tech:xsd:pacs.008.001.08"))
+	require.Equal(t, "admi.006.001.01", MessageDefinitionIdFor("urn:iso:std:iso:20022:tech:xsd:admi.006.001.01"))
+
+	t.Run("nil header writes nothing", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, Encode(xmlEncoder(&buf), nil, "urn:iso:std:iso:20022:tech:xsd:pacs.008.001.08"))
+		require.Empty(t, buf.String())
+	})
+
+	t.Run("MsgDefIdr follows the document namespace", func(t *testing.T) {
+		header := BusinessApplicationHeaderDataModel()
+		header.MessageDefinitionId = "pacs.008.001.12"
+
+		var buf bytes.Buffer
+		encoder := xmlEncoder(&buf)
+		require.NoError(t, Encode(encoder, &header, "urn:iso:std:iso:20022:tech:xsd:pacs.008.001.08"))
+		require.NoError(t, encoder.Flush())
+		require.Contains(t, buf.String(), "pacs.008.001.08</MsgDefIdr>")
+		// The caller's model is left untouched
+		require.Equal(t, "pacs.008.001.12", header.MessageDefinitionId)
+	})
+
+	t.Run("incomplete header", func(t *testing.T) {
+		header := BusinessApplicationHeaderDataModel()
+		header.From = ""
+
+		var buf bytes.Buffer
+		err := Encode(xmlEncoder(&buf), &header, "urn:iso:std:iso:20022:tech:xsd:pacs.008.001.08")
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "From")
+	})
+}
+
+// Test helper functions for better coverage
+func TestHelperFunctions(t *testing.T) {
+	t.Run("NewMessageForVersion", func(t *testing.T) {
+		model := NewMessageForVersion(HEAD_001_001_03)
+		require.NotNil(t, model)
+		// Basic model should have zero values
+		require.Empty(t, model.BusinessMessageId)
+	})
+
+	t.Run("ValidateForVersion", func(t *testing.T) {
+		model := MessageModel{}
+		err := model.ValidateForVersion(HEAD_001_001_03)
+		require.Error(t, err) // Should fail validation with empty model
+		require.Contains(t, err.Error(), "From")
+
+		model = BusinessApplicationHeaderDataModel()
+		require.NoError(t, model.ValidateForVersion(HEAD_001_001_03))
+
+		model.MarketPractice.Id = ""
+		err = model.ValidateForVersion(HEAD_001_001_03)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "MarketPractice")
+	})
+
+	t.Run("GetVersionCapabilities", func(t *testing.T) {
+		model := MessageModel{}
+		capabilities := model.GetVersionCapabilities()
+		require.Empty(t, capabilities) // BusinessApplicationHeader has no version-specific features
+	})
+
+	t.Run("CheckRequiredFields", func(t *testing.T) {
+		model := MessageModel{}
+		err := CheckRequiredFields(model)
+		require.Error(t, err) // Should fail with empty model
+	})
+
+	t.Run("BuildMessageHelper", func(t *testing.T) {
+		helper := BuildMessageHelper()
+		require.Equal(t, "Message Definition Identifier", helper.MessageDefinitionId.Title)
+		require.Equal(t, "Registry", helper.MarketPractice.Registry.Title)
+	})
+}
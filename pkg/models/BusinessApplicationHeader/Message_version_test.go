@@ -0,0 +1,96 @@
+package BusinessApplicationHeader
+
+import (
+	"bytes"
+	"encoding/xml"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/moov-io/wire20022/pkg/models"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVersion03(t *testing.T) {
+	modelName := HEAD_001_001_03
+	xmlName := "BusinessApplicationHeader_03.xml"
+
+	dataModel := BusinessApplicationHeaderDataModel()
+	/*Create Document from Model*/
+	var doc03, err = DocumentWith(dataModel, modelName)
+	require.NoError(t, err, "Failed to create document")
+	/*Validate Check for created Document*/
+	vErr := doc03.Validate()
+	require.NoError(t, vErr, "Failed to validate document")
+	/*Create XML file from Document*/
+	xmlData, err := xml.MarshalIndent(doc03, "", "  ")
+	require.NoError(t, err)
+	err = models.WriteXMLToGenerate(xmlName, xmlData)
+	require.NoError(t, err)
+
+	/*Create Date Model from XML (Read XML)*/
+	var xmlDoc, xmlErr = models.ReadXMLFile("./generated/" + xmlName)
+	require.NoError(t, xmlErr, "Failed to read XML file")
+
+	/*Compare*/
+	model, err := ParseXML(xmlDoc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	require.NoError(t, err, "Failed to make XML structure")
+	require.Equal(t, model.From, "011104238")
+	require.Equal(t, model.To, "021151080")
+	require.Equal(t, model.BusinessMessageId, "20250310B1QDRCQR000001")
+	require.Equal(t, model.MessageDefinitionId, "pacs.008.001.08")
+	require.Equal(t, model.BusinessService, BusinessServiceTest)
+	require.Equal(t, model.MarketPractice.Registry, MarketPracticeRegistry)
+	require.Equal(t, model.MarketPractice.Id, MarketPracticeFedwire)
+	require.False(t, model.CreatedDateTime.IsZero())
+	require.Equal(t, model.CopyDuplicate, CopyDuplicateIndicator)
+	require.True(t, model.PossibleDuplicate)
+
+	/*Validation check*/
+	model.BusinessMessageId = "InvalideMessageIdLength5012345678901234567890"
+	_, err = DocumentWith(*model, modelName)
+	require.NotNil(t, err, "Expected error but got nil")
+	require.Equal(t, err.Error(), "field copy BizMsgIdr failed: failed to set BusinessMessageId: InvalideMessageIdLength5012345678901234567890 fails validation with length 45 <= required maxLength 35")
+	model.BusinessMessageId = "20250310B1QDRCQR000001"
+
+	/*Require field check*/
+	model.To = ""
+	_, err = DocumentWith(*model, modelName)
+	require.NotNil(t, err, "Expected error but got nil")
+	require.Equal(t, err.Error(), "validation failed for field \"To\": is required: required field missing")
+	model.To = "021151080"
+
+	/*Read and write through io*/
+	var buf bytes.Buffer
+	require.NoError(t, model.WriteXML(&buf))
+	require.Contains(t, buf.String(), "<AppHdr xmlns=\"urn:iso:std:iso:20022:tech:xsd:head.001.001.03\">")
+	var read MessageModel
+	require.NoError(t, read.ReadXML(&buf))
+	require.Equal(t, model.BusinessMessageId, read.BusinessMessageId)
+}
+
+func BusinessApplicationHeaderDataModel() MessageModel {
+	message := MessageModel{}
+	message.From = "011104238"
+	message.To = "021151080"
+	message.BusinessMessageId = "20250310B1QDRCQR000001"
+	message.MessageDefinitionId = "pacs.008.001.08"
+	message.BusinessService = BusinessServiceTest
+	message.MarketPractice = MarketPractice{
+		Registry: MarketPracticeRegistry,
+		Id:       MarketPracticeFedwire,
+	}
+	message.CreatedDateTime = time.Now()
+	message.CopyDuplicate = CopyDuplicateIndicator
+	message.PossibleDuplicate = true
+	return message
+}
+
+func xmlEncoder(w io.Writer) *xml.Encoder {
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	return encoder
+}
@@ -0,0 +1,17 @@
+package BusinessApplicationHeader
+
+func pathMapV3() map[string]any {
+	return map[string]any{
+		"Fr.FIId.FinInstnId.ClrSysMmbId.MmbId": "From",
+		"To.FIId.FinInstnId.ClrSysMmbId.MmbId": "To",
+		"BizMsgIdr":                            "BusinessMessageId",
+		"MsgDefIdr":                            "MessageDefinitionId",
+		"BizSvc":                               "BusinessService",
+		"MktPrctc.Regy":                        "MarketPractice.Registry",
+		"MktPrctc.Id":                          "MarketPractice.Id",
+		"CreDt":                                "CreatedDateTime",
+		"BizPrcgDt":                            "BusinessProcessingDate",
+		"CpyDplct":                             "CopyDuplicate",
+		"PssblDplct":                           "PossibleDuplicate",
+	}
+}
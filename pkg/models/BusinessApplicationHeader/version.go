@@ -0,0 +1,19 @@
+package BusinessApplicationHeader
+
+type HEAD_001_001_VERSION string
+
+const (
+	HEAD_001_001_03 HEAD_001_001_VERSION = "head.001.001.03"
+)
+
+var VersionNameSpaceMap = map[HEAD_001_001_VERSION]string{
+	HEAD_001_001_03: "urn:iso:std:iso:20022:tech:xsd:head.001.001.03",
+}
+
+var NameSpaceVersionMap = map[string]HEAD_001_001_VERSION{
+	"urn:iso:std:iso:20022:tech:xsd:head.001.001.03": HEAD_001_001_03,
+}
+
+var VersionPathMap = map[HEAD_001_001_VERSION]map[string]any{
+	HEAD_001_001_03: pathMapV3(),
+}
@@ -1,10 +1,12 @@
 package CustomerCreditTransfer
 
 import (
+	"crypto/rand"
 	"encoding/json"
 	"encoding/xml"
 	"fmt"
 	"io"
+	"strconv"
 	"time"
 
 	"cloud.google.com/go/civil"
@@ -21,12 +23,17 @@ import (
 	"github.com/moov-io/fedwire20022/gen/CustomerCreditTransfer/pacs_008_001_12"
 	"github.com/moov-io/fedwire20022/pkg/fedwire"
 	"github.com/moov-io/wire20022/pkg/base"
+	wirerrors "github.com/moov-io/wire20022/pkg/errors"
 	"github.com/moov-io/wire20022/pkg/models"
+	"github.com/moov-io/wire20022/pkg/models/BusinessApplicationHeader"
 )
 
 // TransactionFields available in V8+ versions
 type TransactionFields struct {
 	UniqueEndToEndTransactionRef string `json:"uniqueEndToEndTransactionRef"`
+	// Legal entity identifiers of debtor and creditor organisations (Id/OrgId/LEI)
+	DebtorLEI   string `json:"debtorLEI"`
+	CreditorLEI string `json:"creditorLEI"`
 }
 
 // Validate checks if transaction fields meet requirements
@@ -40,17 +47,23 @@ func (t *TransactionFields) Validate() error {
 // NewMessageForVersion creates a MessageModel with appropriate version-specific fields initialized
 func NewMessageForVersion(version PACS_008_001_VERSION) MessageModel {
 	model := MessageModel{
-		PaymentCore: base.PaymentCore{},
-		// Core fields initialized to zero values
+		PaymentCore:  base.PaymentCore{},
+		Transactions: []CreditTransferTransaction{NewTransactionForVersion(version)},
 	}
+	return model
+}
+
+// NewTransactionForVersion creates a CreditTransferTransaction with appropriate version-specific fields initialized
+func NewTransactionForVersion(version PACS_008_001_VERSION) CreditTransferTransaction {
+	tx := CreditTransferTransaction{}
 
 	// Type-safe version-specific field initialization
 	switch {
 	case version >= PACS_008_001_08:
-		model.Transaction = &TransactionFields{}
+		tx.Transaction = &TransactionFields{}
 	}
 
-	return model
+	return tx
 }
 
 // ValidateForVersion performs type-safe validation for a specific version
@@ -60,13 +73,31 @@ func (m MessageModel) ValidateForVersion(version PACS_008_001_VERSION) error {
 		return fmt.Errorf("core field validation failed: %w", err)
 	}
 
+	for i, tx := range m.Transactions {
+		if err := tx.ValidateForVersion(version); err != nil {
+			return fmt.Errorf("Transactions[%d]: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+// ValidateForVersion performs type-safe validation of a single transaction for a specific version
+func (t CreditTransferTransaction) ValidateForVersion(version PACS_008_001_VERSION) error {
+	if t.InstructionId == "" {
+		return fmt.Errorf("InstructionId is required")
+	}
+	if t.EndToEndId == "" {
+		return fmt.Errorf("EndToEndId is required")
+	}
+
 	// Type-safe version-specific validation
 	switch {
 	case version >= PACS_008_001_08:
-		if m.Transaction == nil {
+		if t.Transaction == nil {
 			return fmt.Errorf("TransactionFields required for version %v but not present", version)
 		}
-		if err := m.Transaction.Validate(); err != nil {
+		if err := t.Transaction.Validate(); err != nil {
 			return fmt.Errorf("TransactionFields validation failed: %w", err)
 		}
 	}
@@ -83,19 +114,36 @@ func (m MessageModel) validateCoreFields() error {
 	if m.CreatedDateTime.IsZero() {
 		return fmt.Errorf("CreatedDateTime is required")
 	}
-	if m.InstructionId == "" {
-		return fmt.Errorf("InstructionId is required")
+	return m.validateTransactionCount()
+}
+
+// validateTransactionCount checks that NumberOfTransactions matches the number of transactions carried
+func (m MessageModel) validateTransactionCount() error {
+	if len(m.Transactions) == 0 {
+		return wirerrors.NewRequiredFieldError("Transactions")
 	}
-	if m.EndToEndId == "" {
-		return fmt.Errorf("EndToEndId is required")
+	count, err := strconv.Atoi(m.NumberOfTransactions)
+	if err != nil {
+		return wirerrors.NewValidationErrorWithCause("NumberOfTransactions", "must be numeric", err)
+	}
+	if count != len(m.Transactions) {
+		return wirerrors.NewInvalidFieldError("NumberOfTransactions",
+			fmt.Sprintf("declares %d transactions but message contains %d", count, len(m.Transactions)))
 	}
 	return nil
 }
 
 // GetVersionCapabilities returns which version-specific features are available
 func (m MessageModel) GetVersionCapabilities() map[string]bool {
+	hasTransaction := len(m.Transactions) > 0
+	for _, tx := range m.Transactions {
+		if tx.Transaction == nil {
+			hasTransaction = false
+			break
+		}
+	}
 	return map[string]bool{
-		"Transaction": m.Transaction != nil,
+		"Transaction": hasTransaction,
 	}
 }
 
@@ -104,6 +152,24 @@ type MessageModel struct {
 	// Embed common payment fields instead of duplicating them
 	base.PaymentCore `json:",inline"`
 
+	// One entry per CdtTrfTxInf block; NumberOfTransactions must match its length
+	Transactions []CreditTransferTransaction `json:"transactions"`
+
+	// Optional business application header, written and read next to the Document
+	AppHdr *BusinessApplicationHeader.MessageModel `json:"appHdr,omitempty"`
+
+	// Document content not covered by the path map, kept when parsed with base.PreserveUnmapped
+	Unmapped *models.Unmapped `json:"unmapped,omitempty"`
+
+	// Version of the document the message was parsed from; empty for messages built in code
+	ParsedVersion PACS_008_001_VERSION `json:"parsedVersion,omitempty"`
+
+	// Values Fedwire would refuse that the document held, which WriteXML writes back as read
+	ReadValues models.ReadValues `json:"-"`
+}
+
+// CreditTransferTransaction holds the fields of a single CdtTrfTxInf block
+type CreditTransferTransaction struct {
 	// Core fields present in all versions (V2+)
 	InstructionId       string                        `json:"instructionId"`
 	EndToEndId          string                        `json:"endToEndId"`
@@ -113,7 +179,7 @@ type MessageModel struct {
 	InterBankSettAmount models.CurrencyAndAmount      `json:"interBankSettAmount"`
 	InterBankSettDate   fedwire.ISODate               `json:"interBankSettDate"`
 	InstructedAmount    models.CurrencyAndAmount      `json:"instructedAmount"`
-	ExchangeRate        float64                       `json:"exchangeRate"`
+	ExchangeRate        models.Decimal                `json:"exchangeRate"`
 	ChargeBearer        models.ChargeBearerType       `json:"chargeBearer"`
 	ChargesInfo         []ChargeInfo                  `json:"chargesInfo"`
 
@@ -143,10 +209,11 @@ type MessageModel struct {
 	RemittanceInfor         RemittanceDocument          `json:"remittanceInfor"`
 }
 
-// UnmarshalJSON implements custom JSON unmarshaling to properly handle grouped fields
+// UnmarshalJSON implements custom JSON unmarshaling to support both the transactions array
+// and the earlier single-transaction layout where transaction fields sat next to the group header
 func (m *MessageModel) UnmarshalJSON(data []byte) error {
-	// Parse into a generic map first to check for inline fields
-	var rawMap map[string]interface{}
+	// Parse into a generic map first to check which layout is used
+	var rawMap map[string]json.RawMessage
 	if err := json.Unmarshal(data, &rawMap); err != nil {
 		return err
 	}
@@ -163,10 +230,52 @@ func (m *MessageModel) UnmarshalJSON(data []byte) error {
 	// Copy all fields
 	*m = MessageModel(temp)
 
+	// Single-transaction layout: decode the top-level object as the only transaction
+	if _, hasTransactions := rawMap["transactions"]; !hasTransactions && hasTransactionKeys(rawMap) {
+		var tx CreditTransferTransaction
+		if err := json.Unmarshal(data, &tx); err != nil {
+			return err
+		}
+		m.Transactions = []CreditTransferTransaction{tx}
+	}
+
+	return nil
+}
+
+// hasTransactionKeys reports whether a JSON object carries per-transaction fields at the top level
+func hasTransactionKeys(rawMap map[string]json.RawMessage) bool {
+	for _, key := range []string{"instructionId", "endToEndId", "interBankSettAmount", "uniqueEndToEndTransactionRef", "debtorName"} {
+		if _, ok := rawMap[key]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// UnmarshalJSON implements custom JSON unmarshaling to properly handle grouped fields
+func (t *CreditTransferTransaction) UnmarshalJSON(data []byte) error {
+	// Parse into a generic map first to check for inline fields
+	var rawMap map[string]interface{}
+	if err := json.Unmarshal(data, &rawMap); err != nil {
+		return err
+	}
+
+	// Create an alias to avoid recursion
+	type Alias CreditTransferTransaction
+
+	// Unmarshal into the aliased structure normally
+	var temp Alias
+	if err := json.Unmarshal(data, &temp); err != nil {
+		return err
+	}
+
+	// Copy all fields
+	*t = CreditTransferTransaction(temp)
+
 	// Post-process: Initialize grouped fields based on presence of inline fields
 	if _, hasUETR := rawMap["uniqueEndToEndTransactionRef"]; hasUETR {
-		if m.Transaction == nil {
-			m.Transaction = &TransactionFields{}
+		if t.Transaction == nil {
+			t.Transaction = &TransactionFields{}
 		}
 		// The inline JSON should have already populated the field due to the inline tag
 	}
@@ -175,18 +284,18 @@ func (m *MessageModel) UnmarshalJSON(data []byte) error {
 }
 
 // ReadXML reads XML data from an io.Reader into the MessageModel
-func (m *MessageModel) ReadXML(r io.Reader) error {
+func (m *MessageModel) ReadXML(r io.Reader, opts ...base.ParseOption) error {
 	data, err := io.ReadAll(r)
 	if err != nil {
 		return fmt.Errorf("reading XML: %w", err)
 	}
 
-	model, err := processor.ProcessMessage(data)
+	model, err := ParseXML(data, opts...)
 	if err != nil {
 		return err
 	}
 
-	*m = model
+	*m = *model
 	return nil
 }
 
@@ -198,7 +307,8 @@ func (m *MessageModel) ReadXML(r io.Reader) error {
 //   - Properly formatted with indentation
 //   - Automatic namespace handling
 //   - Validates required fields before writing
-//   - Defaults to latest version if not specified
+//   - Defaults to the version the message was parsed from, else the latest, if not specified
+//   - Writes the AppHdr ahead of the Document when set, with MsgDefIdr matching the version
 //
 // Example:
 //
@@ -213,8 +323,8 @@ func (m *MessageModel) ReadXML(r io.Reader) error {
 //
 // For advanced use cases requiring document inspection before serialization, see DocumentWith.
 func (m *MessageModel) WriteXML(w io.Writer, version ...PACS_008_001_VERSION) error {
-	// Default to latest version
-	ver := PACS_008_001_12
+	// Default to the version the message was read in, else latest
+	ver := m.documentVersion()
 	if len(version) > 0 {
 		ver = version[0]
 	}
@@ -235,18 +345,116 @@ func (m *MessageModel) WriteXML(w io.Writer, version ...PACS_008_001_VERSION) er
 		return fmt.Errorf("writing XML header: %w", err)
 	}
 
+	// Write the business application header, if any, ahead of the document
+	if err := BusinessApplicationHeader.Encode(encoder, m.AppHdr, VersionNameSpaceMap[ver]); err != nil {
+		return err
+	}
+
 	// Encode document
-	if err := encoder.Encode(doc); err != nil {
+	if err := processor.EncodeDocument(encoder, doc, *m, ver); err != nil {
 		return fmt.Errorf("encoding XML: %w", err)
 	}
 
 	return encoder.Flush()
 }
 
+// GetMessageId returns the message identification (MsgId)
+func (m *MessageModel) GetMessageId() string {
+	return m.MessageId
+}
+
+// CreatedAt returns when the message was created (CreDtTm)
+func (m *MessageModel) CreatedAt() time.Time {
+	return m.CreatedDateTime
+}
+
+// ISOMessageName returns the ISO 20022 message the model represents, "pacs.008"
+func (m *MessageModel) ISOMessageName() string {
+	return "pacs.008"
+}
+
+// Version returns the version WriteDocument writes: the one the message was parsed from or its
+// AppHdr names, otherwise PACS_008_001_12
+func (m *MessageModel) Version() string {
+	return string(m.documentVersion())
+}
+
+// Validate checks the required fields, the values models.ValidateValues covers and the rules of
+// the message's Version
+func (m *MessageModel) Validate() error {
+	if err := CheckRequiredFields(*m); err != nil {
+		return err
+	}
+	if err := models.ValidateValues(*m); err != nil {
+		return err
+	}
+	return m.ValidateForVersion(m.documentVersion())
+}
+
+// WriteDocument writes the message like WriteXML, in its Version
+func (m *MessageModel) WriteDocument(w io.Writer) error {
+	return m.WriteXML(w, m.documentVersion())
+}
+
+// documentVersion returns the version WriteXML writes when none is given
+func (m *MessageModel) documentVersion() PACS_008_001_VERSION {
+	if m.ParsedVersion != "" {
+		return m.ParsedVersion
+	}
+	var msgDefIdr string
+	if m.AppHdr != nil {
+		msgDefIdr = m.AppHdr.MessageDefinitionId
+	}
+	return processor.DocumentVersion(m.Unmapped, msgDefIdr, PACS_008_001_12)
+}
+
+// ConvertToVersion returns a copy of the message for another version of pacs.008, e.g. for a
+// counterparty on an older release. Values the version has no element for are dropped and the
+// field groups it needs are added; the report lists both. The message is left unchanged.
+func (m *MessageModel) ConvertToVersion(version PACS_008_001_VERSION) (*MessageModel, *base.ConversionReport, error) {
+	converted, report, err := processor.ConvertMessage(*m, m.documentVersion(), version)
+	if err != nil {
+		return nil, nil, err
+	}
+	namespace := VersionNameSpaceMap[version]
+	if converted.Unmapped, err = processor.CarryUnmapped(report, converted.Unmapped, version); err != nil {
+		return nil, nil, err
+	}
+	if converted.AppHdr != nil {
+		converted.AppHdr.MessageDefinitionId = BusinessApplicationHeader.MessageDefinitionIdFor(namespace)
+	}
+	converted.ParsedVersion = version
+
+	// Versions with TransactionFields require a UETR; payments from older versions get a new one
+	for i := range converted.Transactions {
+		if tx := converted.Transactions[i].Transaction; tx != nil && tx.UniqueEndToEndTransactionRef == "" {
+			tx.UniqueEndToEndTransactionRef = newUETR()
+			report.Defaulted = append(report.Defaulted, base.FieldChange{
+				Field: fmt.Sprintf("Transactions[%d].Transaction.UniqueEndToEndTransactionRef", i),
+				Value: tx.UniqueEndToEndTransactionRef,
+			})
+		}
+	}
+	return &converted, report, nil
+}
+
+// newUETR returns a random RFC 4122 version 4 UUID for use as a UETR
+func newUETR() string {
+	var b [16]byte
+	_, _ = rand.Read(b[:])
+	b[6] = b[6]&0x0f | 0x40
+	b[8] = b[8]&0x3f | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
+
 var RequiredFields = []string{
 	"MessageId", "CreatedDateTime", "NumberOfTransactions",
-	"SettlementMethod", "CommonClearingSysCode", "InstructionId",
-	"EndToEndId", "InstrumentPropCode",
+	"SettlementMethod", "CommonClearingSysCode", "Transactions",
+}
+
+// TransactionRequiredFields lists the fields every CreditTransferTransaction must carry
+var TransactionRequiredFields = []string{
+	"InstructionId", "EndToEndId", "InstrumentPropCode",
 	"InterBankSettAmount", "InterBankSettDate", "InstructedAmount",
 	"ChargeBearer", "InstructingAgent", "InstructedAgent",
 	"DebtorName", "DebtorAddress", "DebtorAgent",
@@ -256,6 +464,9 @@ var RequiredFields = []string{
 // Global processor instance using the base abstraction
 var processor *base.MessageProcessor[MessageModel, PACS_008_001_VERSION]
 
+// transactionValidator checks TransactionRequiredFields on each transaction
+var transactionValidator = base.NewFieldValidator(TransactionRequiredFields)
+
 // init sets up the processor using base abstractions
 func init() {
 	// Register all versions using cleaner factory registration pattern
@@ -348,15 +559,30 @@ func init() {
 		VersionPathMap,
 		RequiredFields,
 	)
+	processor.SetMessageFactory(NewMessageForVersion)
 }
 
 // ParseXML reads XML data into the MessageModel
 // This is the primary function for parsing XML from byte data
-func ParseXML(data []byte) (*MessageModel, error) {
-	model, err := processor.ProcessMessage(data)
+// An AppHdr sent ahead of the Document is read into the AppHdr field
+// Pass base.PreserveUnmapped() to keep elements the model does not cover for WriteXML
+func ParseXML(data []byte, opts ...base.ParseOption) (*MessageModel, error) {
+	appHdr, data, err := BusinessApplicationHeader.Split(data)
 	if err != nil {
 		return nil, err
 	}
+
+	model, version, unmapped, err := processor.ParseMessage(data, opts...)
+	if err != nil {
+		return nil, err
+	}
+	model.AppHdr = appHdr
+	model.Unmapped = unmapped
+	model.ParsedVersion = version
+	model.ReadValues = models.NewReadValues(&model)
+	if err := checkTransactions(model); err != nil {
+		return nil, processor.Locate(data, err)
+	}
 	return &model, nil
 }
 
@@ -385,15 +611,38 @@ func ParseXML(data []byte) (*MessageModel, error) {
 // if validation fails or if the specified version is not supported.
 func DocumentWith(model MessageModel, version PACS_008_001_VERSION) (models.ISODocument, error) {
 	// Validate required fields before creating document
-	if err := processor.ValidateRequiredFields(model); err != nil {
+	if err := CheckRequiredFields(model); err != nil {
 		return nil, err
 	}
-	return processor.CreateDocument(model, version)
+	return processor.CreateDocumentWith(model, version, model.Unmapped, model.ReadValues)
 }
 
-// CheckRequiredFields uses base abstractions to replace 35+ lines with a single call
+// EncodeDocument writes a document DocumentWith created from the MessageModel, with each amount
+// in the digits of its Decimal rather than those of the document's binary number
+func EncodeDocument(encoder *xml.Encoder, doc models.ISODocument, model MessageModel, version PACS_008_001_VERSION) error {
+	return processor.EncodeDocument(encoder, doc, model, version)
+}
+
+// CheckRequiredFields validates the group header fields, every transaction's required fields
+// and that NumberOfTransactions matches the number of transactions
 func CheckRequiredFields(model MessageModel) error {
-	return processor.ValidateRequiredFields(model)
+	if err := processor.ValidateRequiredFields(model); err != nil {
+		return err
+	}
+	return checkTransactions(model)
+}
+
+// checkTransactions validates the transaction count and each transaction's required fields
+func checkTransactions(model MessageModel) error {
+	if err := model.validateTransactionCount(); err != nil {
+		return err
+	}
+	for i, tx := range model.Transactions {
+		if err := transactionValidator.ValidateRequiredAt(tx, fmt.Sprintf("Transactions[%d]", i)); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 // CustomerCreditTransferDataModel creates a new message model with sample data for testing
@@ -408,106 +657,110 @@ func CustomerCreditTransferDataModel() MessageModel {
 			SettlementMethod:      "CLRG",
 			CommonClearingSysCode: "FDW",
 		},
-		InstructionId:      "Scenario01InstrId001",
-		EndToEndId:         "Scenario01EtoEId001",
-		TaxId:              "123456789",
-		InstrumentPropCode: "CTRC",
-		Transaction: &TransactionFields{
-			UniqueEndToEndTransactionRef: "8a562c67-ca16-48ba-b074-65581be6f011",
-		},
-		InterBankSettAmount: models.CurrencyAndAmount{
-			Currency: "USD", Amount: 510000.74,
-		},
-		InterBankSettDate: fedwire.ISODate(civil.DateOf(time.Now())),
-		InstructedAmount: models.CurrencyAndAmount{
-			Currency: "USD", Amount: 510000.74,
-		},
-		ChargeBearer: "SLEV",
-		ChargesInfo: []ChargeInfo{
-			{
-				Amount:         models.CurrencyAndAmount{Currency: "USD", Amount: 90.00},
-				BusinessIdCode: "BANZBEBB",
-			},
+		Transactions: []CreditTransferTransaction{
 			{
-				Amount:         models.CurrencyAndAmount{Currency: "USD", Amount: 40.00},
-				BusinessIdCode: "BANCUS33",
-			},
-		},
-		AgentPair: base.AgentPair{
-			InstructingAgent: models.Agent{
-				PaymentSysCode:     "USABA",
-				PaymentSysMemberId: "011104238",
-			},
-			InstructedAgent: models.Agent{
-				PaymentSysCode:     "USABA",
-				PaymentSysMemberId: "021040078",
-			},
-		},
-		DebtorCreditorPair: base.DebtorCreditorPair{
-			DebtorAgent: models.Agent{
-				PaymentSysCode:     "USABA",
-				PaymentSysMemberId: "011104238",
-				BankName:           "Bank A",
-				PostalAddress: models.PostalAddress{
-					StreetName:     "Avenue A",
-					BuildingNumber: "66",
-					PostalCode:     "60532",
-					TownName:       "Lisle",
-					Subdivision:    "IL",
+				InstructionId:      "Scenario01InstrId001",
+				EndToEndId:         "Scenario01EtoEId001",
+				TaxId:              "123456789",
+				InstrumentPropCode: "CTRC",
+				Transaction: &TransactionFields{
+					UniqueEndToEndTransactionRef: "8a562c67-ca16-48ba-b074-65581be6f011",
+				},
+				InterBankSettAmount: models.CurrencyAndAmount{
+					Currency: "USD", Amount: "510000.74",
+				},
+				InterBankSettDate: fedwire.ISODate(civil.DateOf(time.Now())),
+				InstructedAmount: models.CurrencyAndAmount{
+					Currency: "USD", Amount: "510000.74",
+				},
+				ChargeBearer: "SLEV",
+				ChargesInfo: []ChargeInfo{
+					{
+						Amount:         models.CurrencyAndAmount{Currency: "USD", Amount: "90.00"},
+						BusinessIdCode: "BANZBEBB",
+					},
+					{
+						Amount:         models.CurrencyAndAmount{Currency: "USD", Amount: "40.00"},
+						BusinessIdCode: "BANCUS33",
+					},
+				},
+				AgentPair: base.AgentPair{
+					InstructingAgent: models.Agent{
+						PaymentSysCode:     "USABA",
+						PaymentSysMemberId: "011104238",
+					},
+					InstructedAgent: models.Agent{
+						PaymentSysCode:     "USABA",
+						PaymentSysMemberId: "021040078",
+					},
+				},
+				DebtorCreditorPair: base.DebtorCreditorPair{
+					DebtorAgent: models.Agent{
+						PaymentSysCode:     "USABA",
+						PaymentSysMemberId: "011104238",
+						BankName:           "Bank A",
+						PostalAddress: models.PostalAddress{
+							StreetName:     "Avenue A",
+							BuildingNumber: "66",
+							PostalCode:     "60532",
+							TownName:       "Lisle",
+							Subdivision:    "IL",
+							Country:        "US",
+						},
+					},
+					CreditorAgent: models.Agent{
+						PaymentSysCode:     "USABA",
+						PaymentSysMemberId: "021040078",
+						BankName:           "Bank B",
+						PostalAddress: models.PostalAddress{
+							StreetName:     "Avenue B",
+							BuildingNumber: "25",
+							PostalCode:     "19067",
+							TownName:       "Yardley",
+							Subdivision:    "PA",
+							Country:        "US",
+						},
+					},
+				},
+				DebtorName: "Corporation A",
+				DebtorAddress: models.PostalAddress{
+					StreetName:     "Avenue of the Fountains",
+					BuildingNumber: "167565",
+					RoomNumber:     "Suite D110",
+					PostalCode:     "85268",
+					TownName:       "Fountain Hills",
+					Subdivision:    "AZ",
 					Country:        "US",
 				},
-			},
-			CreditorAgent: models.Agent{
-				PaymentSysCode:     "USABA",
-				PaymentSysMemberId: "021040078",
-				BankName:           "Bank B",
-				PostalAddress: models.PostalAddress{
-					StreetName:     "Avenue B",
-					BuildingNumber: "25",
+				DebtorOtherTypeId: "5647772655",
+				CreditorName:      "Corporation B",
+				CreditorPostalAddress: models.PostalAddress{
+					StreetName:     "Desert View Street",
+					BuildingNumber: "1",
+					Floor:          "33",
 					PostalCode:     "19067",
-					TownName:       "Yardley",
-					Subdivision:    "PA",
+					TownName:       "Palm Springs",
+					Subdivision:    "CA",
 					Country:        "US",
 				},
+				CreditorOtherTypeId: "567876543",
+				RemittanceInfor: RemittanceDocument{
+					CodeOrProprietary: models.CodeCINV,
+					Number:            "INV34563",
+					RelatedDate:       fedwire.ISODate(civil.DateOf(time.Now())),
+					TaxDetail: TaxRecord{
+						TaxId:              "123456789",
+						TaxTypeCode:        "09455",
+						TaxPeriodYear:      fedwire.ISODate(civil.DateOf(time.Now())),
+						TaxperiodTimeFrame: "MM04",
+					},
+				},
+				RelatedRemittanceInfo: RemittanceDetail{
+					RemittanceId:      "Scenario01Var2RemittanceId001",
+					Method:            models.Email,
+					ElectronicAddress: "CustomerService@CorporationB.com",
+				},
 			},
 		},
-		DebtorName: "Corporation A",
-		DebtorAddress: models.PostalAddress{
-			StreetName:     "Avenue of the Fountains",
-			BuildingNumber: "167565",
-			RoomNumber:     "Suite D110",
-			PostalCode:     "85268",
-			TownName:       "Fountain Hills",
-			Subdivision:    "AZ",
-			Country:        "US",
-		},
-		DebtorOtherTypeId: "5647772655",
-		CreditorName:      "Corporation B",
-		CreditorPostalAddress: models.PostalAddress{
-			StreetName:     "Desert View Street",
-			BuildingNumber: "1",
-			Floor:          "33",
-			PostalCode:     "19067",
-			TownName:       "Palm Springs",
-			Subdivision:    "CA",
-			Country:        "US",
-		},
-		CreditorOtherTypeId: "567876543",
-		RemittanceInfor: RemittanceDocument{
-			CodeOrProprietary: models.CodeCINV,
-			Number:            "INV34563",
-			RelatedDate:       fedwire.ISODate(civil.DateOf(time.Now())),
-			TaxDetail: TaxRecord{
-				TaxId:              "123456789",
-				TaxTypeCode:        "09455",
-				TaxPeriodYear:      fedwire.ISODate(civil.DateOf(time.Now())),
-				TaxperiodTimeFrame: "MM04",
-			},
-		},
-		RelatedRemittanceInfo: RemittanceDetail{
-			RemittanceId:      "Scenario01Var2RemittanceId001",
-			Method:            models.Email,
-			ElectronicAddress: "CustomerService@CorporationB.com",
-		},
 	}
 }
@@ -135,11 +135,15 @@ func BuildRemittanceDocumentHelper() RemittanceDocumentHelper {
 }
 
 type MessageHelper struct {
-	MessageId                    models.ElementHelper
-	CreatedDateTime              models.ElementHelper
-	NumberOfTransactions         models.ElementHelper
-	SettlementMethod             models.ElementHelper
-	CommonClearingSysCode        models.ElementHelper
+	MessageId             models.ElementHelper
+	CreatedDateTime       models.ElementHelper
+	NumberOfTransactions  models.ElementHelper
+	SettlementMethod      models.ElementHelper
+	CommonClearingSysCode models.ElementHelper
+	Transactions          CreditTransferTransactionHelper
+}
+
+type CreditTransferTransactionHelper struct {
 	InstructionId                models.ElementHelper
 	EndToEndId                   models.ElementHelper
 	UniqueEndToEndTransactionRef models.ElementHelper
@@ -157,12 +161,14 @@ type MessageHelper struct {
 	UltimateDebtorName           models.ElementHelper
 	UltimateDebtorAddress        models.PostalAddressHelper
 	DebtorName                   models.ElementHelper
+	DebtorLEI                    models.ElementHelper
 	DebtorAddress                models.PostalAddressHelper
 	DebtorIBAN                   models.ElementHelper
 	DebtorOtherTypeId            models.ElementHelper
 	DebtorAgent                  models.AgentHelper
 	CreditorAgent                models.AgentHelper
 	CreditorName                 models.ElementHelper
+	CreditorLEI                  models.ElementHelper
 	CreditorPostalAddress        models.PostalAddressHelper
 	UltimateCreditorName         models.ElementHelper
 	UltimateCreditorAddress      models.PostalAddressHelper
@@ -208,6 +214,14 @@ func BuildMessageHelper() MessageHelper {
 			Type:          `CommonClearingSysCodeType(ClearingSysFDW, ClearingSysCHIPS, ClearingSysSEPA ...)`,
 			Documentation: `Infrastructure through which the payment instruction is processed, as published in an external clearing system identification code list.`,
 		},
+		Transactions: BuildCreditTransferTransactionHelper(),
+	}
+}
+
+// BuildCreditTransferTransactionHelper creates a helper structure for the fields repeated in each
+// credit transfer transaction (CdtTrfTxInf) of a pacs.008 message.
+func BuildCreditTransferTransactionHelper() CreditTransferTransactionHelper {
+	return CreditTransferTransactionHelper{
 		InstructionId: models.ElementHelper{
 			Title:         "Instruction Identification",
 			Rules:         "Fedwire Funds Tag {3320} Sender Reference",
@@ -280,6 +294,12 @@ func BuildMessageHelper() MessageHelper {
 			Type:          `Max140Text (based on string) minLength: 1 maxLength: 140`,
 			Documentation: `Name by which a party is known and which is usually used to identify that party.`,
 		},
+		DebtorLEI: models.ElementHelper{
+			Title:         "Debtor LEI",
+			Rules:         "",
+			Type:          `LEIIdentifier (based on string) pattern: [A-Z0-9]{18,18}[0-9]{2,2}`,
+			Documentation: `Legal entity identifier of the debtor organisation.`,
+		},
 		DebtorAddress: models.BuildPostalAddressHelper(),
 		DebtorIBAN: models.ElementHelper{
 			Title:         "Debtor IBAN",
@@ -301,6 +321,12 @@ func BuildMessageHelper() MessageHelper {
 			Type:          `Max70Text (based on string) minLength: 1 maxLength: 70`,
 			Documentation: `Name of the account, as assigned by the account servicing institution, in agreement with the account owner in order to provide an additional means of identification of the account. Usage: The account name is different from the account owner name. The account name is used in certain user communities to provide a means of identifying the account, in addition to the account owner's identity and the account number.`,
 		},
+		CreditorLEI: models.ElementHelper{
+			Title:         "Creditor LEI",
+			Rules:         "",
+			Type:          `LEIIdentifier (based on string) pattern: [A-Z0-9]{18,18}[0-9]{2,2}`,
+			Documentation: `Legal entity identifier of the creditor organisation.`,
+		},
 		CreditorPostalAddress: models.BuildPostalAddressHelper(),
 		UltimateCreditorName: models.ElementHelper{
 			Title:         "Ultimate Creditor Name",
@@ -2,6 +2,7 @@ package CustomerCreditTransfer_test
 
 import (
 	"bytes"
+	"encoding/json"
 	"os"
 	"strings"
 	"testing"
@@ -9,6 +10,10 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/moov-io/wire20022/pkg/base"
+	"github.com/moov-io/wire20022/pkg/errors"
+	"github.com/moov-io/wire20022/pkg/models"
+	"github.com/moov-io/wire20022/pkg/models/BusinessApplicationHeader"
 	"github.com/moov-io/wire20022/pkg/models/CustomerCreditTransfer"
 )
 
@@ -67,6 +72,44 @@ func TestWriteXMLWithVersion(t *testing.T) {
 	assert.Contains(t, xmlOutput, "pacs.008.001.08")
 }
 
+// TestWriteXMLWithAppHdr tests that the business application header is written and read next to the Document
+func TestWriteXMLWithAppHdr(t *testing.T) {
+	model := CustomerCreditTransfer.CustomerCreditTransferDataModel()
+	model.AppHdr = &BusinessApplicationHeader.MessageModel{
+		From:                "011104238",
+		To:                  "021151080",
+		BusinessMessageId:   model.MessageId,
+		MessageDefinitionId: "pacs.008.001.12",
+		BusinessService:     BusinessApplicationHeader.BusinessServiceTest,
+		MarketPractice: BusinessApplicationHeader.MarketPractice{
+			Registry: BusinessApplicationHeader.MarketPracticeRegistry,
+			Id:       BusinessApplicationHeader.MarketPracticeFedwire,
+		},
+		CreatedDateTime: model.CreatedDateTime,
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, model.WriteXML(&buf, CustomerCreditTransfer.PACS_008_001_08))
+
+	// MsgDefIdr follows the version the Document is written with
+	xmlOutput := buf.String()
+	assert.Less(t, strings.Index(xmlOutput, "<AppHdr"), strings.Index(xmlOutput, "<Document"))
+	assert.Contains(t, xmlOutput, "pacs.008.001.08</MsgDefIdr>")
+
+	var parsed CustomerCreditTransfer.MessageModel
+	require.NoError(t, parsed.ReadXML(strings.NewReader(xmlOutput)))
+	require.NotNil(t, parsed.AppHdr)
+	assert.Equal(t, "pacs.008.001.08", parsed.AppHdr.MessageDefinitionId)
+	assert.Equal(t, model.MessageId, parsed.AppHdr.BusinessMessageId)
+	assert.Equal(t, model.MessageId, parsed.MessageId)
+
+	// Messages without a header are written as a bare Document
+	parsed.AppHdr = nil
+	buf.Reset()
+	require.NoError(t, parsed.WriteXML(&buf))
+	assert.NotContains(t, buf.String(), "AppHdr")
+}
+
 // TestParseXML tests the new ParseXML function
 func TestParseXML(t *testing.T) {
 	// Load sample XML
@@ -92,8 +135,10 @@ func TestIdiomaticWorkflow(t *testing.T) {
 	require.NoError(t, err)
 
 	// 2. Modify the payment
-	originalAmount := payment.InterBankSettAmount.Amount
-	payment.InterBankSettAmount.Amount = originalAmount + 100
+	originalAmount := payment.Transactions[0].InterBankSettAmount.Amount
+	newAmount, err := originalAmount.Add("100")
+	require.NoError(t, err)
+	payment.Transactions[0].InterBankSettAmount.Amount = newAmount
 
 	// 3. Write to new file
 	var output bytes.Buffer
@@ -104,5 +149,359 @@ func TestIdiomaticWorkflow(t *testing.T) {
 	var verifyPayment CustomerCreditTransfer.MessageModel
 	err = verifyPayment.ReadXML(bytes.NewReader(output.Bytes()))
 	require.NoError(t, err)
-	assert.Equal(t, originalAmount+100, verifyPayment.InterBankSettAmount.Amount)
+	assert.Equal(t, models.Decimal("510100.74"), verifyPayment.Transactions[0].InterBankSettAmount.Amount)
+}
+
+// TestAmountMinorUnits tests that amounts keep their lexical value, are padded only when the caller
+// scales them and respect the currency's minor units
+func TestAmountMinorUnits(t *testing.T) {
+	data, err := os.ReadFile("./swiftSample/CustomerCreditTransfer_Scenario1_Step1_pacs.008")
+	require.NoError(t, err)
+	payment, err := CustomerCreditTransfer.ParseXML(data)
+	require.NoError(t, err)
+	assert.Equal(t, models.Decimal("510000.74"), payment.Transactions[0].InstructedAmount.Amount)
+
+	payment.Transactions[0].InstructedAmount.Amount = "50000"
+	var output bytes.Buffer
+	require.NoError(t, payment.WriteXML(&output))
+	assert.Contains(t, output.String(), ">50000</InstdAmt>")
+
+	reread, err := CustomerCreditTransfer.ParseXML(output.Bytes())
+	require.NoError(t, err)
+	assert.Equal(t, models.Decimal("50000"), reread.Transactions[0].InstructedAmount.Amount)
+
+	models.ScaleAmounts(payment)
+	assert.Equal(t, models.Decimal("50000.00"), payment.Transactions[0].InstructedAmount.Amount)
+	output.Reset()
+	require.NoError(t, payment.WriteXML(&output))
+	assert.Contains(t, output.String(), ">50000.00</InstdAmt>")
+
+	payment.Transactions[0].InstructedAmount.Amount = "50000.001"
+	err = payment.Validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Transactions[0].InstructedAmount")
+	output.Reset()
+	err = payment.WriteXML(&output)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Transactions[0].InstructedAmount")
+
+	// A document holding such an amount is written back as read
+	received, err := CustomerCreditTransfer.ParseXML([]byte(strings.ReplaceAll(string(data), ">510000.74<", ">510000.741<")))
+	require.NoError(t, err)
+	output.Reset()
+	require.NoError(t, received.WriteXML(&output))
+	assert.Contains(t, output.String(), ">510000.741</InstdAmt>")
+}
+
+// TestExactAmounts tests that amounts are written with their own digits and that one a document
+// cannot hold exactly is refused rather than rounded
+func TestExactAmounts(t *testing.T) {
+	data, err := os.ReadFile("./swiftSample/CustomerCreditTransfer_Scenario1_Step1_pacs.008")
+	require.NoError(t, err)
+	payment, err := CustomerCreditTransfer.ParseXML(data)
+	require.NoError(t, err)
+
+	payment.Transactions[0].InterBankSettAmount.Amount = "1234567.89"
+	payment.Transactions[0].InstructedAmount.Amount = "1234567.89"
+	var output bytes.Buffer
+	require.NoError(t, payment.WriteXML(&output))
+	assert.Contains(t, output.String(), ">1234567.89</IntrBkSttlmAmt>")
+	assert.NotContains(t, output.String(), "e+06")
+
+	reread, err := CustomerCreditTransfer.ParseXML(output.Bytes())
+	require.NoError(t, err)
+	assert.Equal(t, models.Decimal("1234567.89"), reread.Transactions[0].InterBankSettAmount.Amount)
+
+	payment.Transactions[0].InstructedAmount.Amount = "12345678901234567.89"
+	output.Reset()
+	err = payment.WriteXML(&output)
+	require.Error(t, err)
+	assert.ErrorIs(t, err, errors.ErrInvalidField)
+	assert.Contains(t, err.Error(), "12345678901234567.89")
+}
+
+// TestPreserveUnmapped tests that elements without a model field survive a parse/write round trip
+func TestPreserveUnmapped(t *testing.T) {
+	data, err := os.ReadFile("./swiftSample/CustomerCreditTransfer_Scenario1_Step1_pacs.008")
+	require.NoError(t, err)
+	data = bytes.Replace(data, []byte("<RmtInf>"), []byte(
+		"<InstrForNxtAgt><InstrInf>Call before crediting</InstrInf></InstrForNxtAgt>"+
+			"<RgltryRptg><DbtCdtRptgInd>CRED</DbtCdtRptgInd><Dtls><Cd>ABC</Cd><Inf>Export of goods</Inf></Dtls></RgltryRptg>"+
+			"<RmtInf>"), 1)
+
+	// Without the option the regulatory reporting is dropped
+	payment, err := CustomerCreditTransfer.ParseXML(data)
+	require.NoError(t, err)
+	assert.Nil(t, payment.Unmapped)
+	var output bytes.Buffer
+	require.NoError(t, payment.WriteXML(&output, CustomerCreditTransfer.PACS_008_001_08))
+	assert.NotContains(t, output.String(), "RgltryRptg")
+
+	payment, err = CustomerCreditTransfer.ParseXML(data, base.PreserveUnmapped())
+	require.NoError(t, err)
+	require.NotNil(t, payment.Unmapped)
+	assert.NotContains(t, payment.Unmapped.XML, "Corporation A")
+
+	// Edits to mapped fields are written next to the preserved content
+	payment.Transactions[0].CreditorName = "Corporation C"
+	output.Reset()
+	require.NoError(t, payment.WriteXML(&output, CustomerCreditTransfer.PACS_008_001_08))
+	xmlOut := output.String()
+	assert.Contains(t, xmlOut, "Call before crediting</InstrInf>")
+	assert.Contains(t, xmlOut, "CRED</DbtCdtRptgInd>")
+	assert.Contains(t, xmlOut, "Export of goods</Inf>")
+	assert.Contains(t, xmlOut, "Corporation C</Nm>")
+	assert.NotContains(t, xmlOut, "Corporation B")
+
+	reread, err := CustomerCreditTransfer.ParseXML(output.Bytes(), base.PreserveUnmapped())
+	require.NoError(t, err)
+	assert.Equal(t, payment.Unmapped, reread.Unmapped)
+
+	// The preserved content can be stored as JSON alongside the model
+	jsonData, err := json.Marshal(payment.Unmapped)
+	require.NoError(t, err)
+	var fromJSON models.Unmapped
+	require.NoError(t, json.Unmarshal(jsonData, &fromJSON))
+	assert.Equal(t, *payment.Unmapped, fromJSON)
+
+	// Content captured from one version is not silently dropped when writing another
+	err = payment.WriteXML(&output, CustomerCreditTransfer.PACS_008_001_12)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "pacs.008.001.08")
+
+	// Without a version the message is written in the one it was parsed from
+	output.Reset()
+	require.NoError(t, payment.WriteXML(&output))
+	assert.Contains(t, output.String(), `xmlns="urn:iso:std:iso:20022:tech:xsd:pacs.008.001.08"`)
+	assert.Contains(t, output.String(), "CRED</DbtCdtRptgInd>")
+}
+
+// TestMultipleTransactions tests that every CdtTrfTxInf block survives a write/read round trip
+func TestMultipleTransactions(t *testing.T) {
+	model := CustomerCreditTransfer.CustomerCreditTransferDataModel()
+	second := model.Transactions[0]
+	second.InstructionId = "Scenario01InstrId002"
+	second.EndToEndId = "Scenario01EtoEId002"
+	second.InterBankSettAmount.Amount = "1250.50"
+	second.DebtorName = "Corporation C"
+	model.Transactions = append(model.Transactions, second)
+	model.NumberOfTransactions = "2"
+
+	var buf bytes.Buffer
+	require.NoError(t, model.WriteXML(&buf))
+	assert.Equal(t, 2, strings.Count(buf.String(), "<CdtTrfTxInf "))
+
+	var parsed CustomerCreditTransfer.MessageModel
+	require.NoError(t, parsed.ReadXML(strings.NewReader(buf.String())))
+	require.Len(t, parsed.Transactions, 2)
+	assert.Equal(t, "2", parsed.NumberOfTransactions)
+	assert.Equal(t, "Scenario01InstrId001", parsed.Transactions[0].InstructionId)
+	assert.Equal(t, "Scenario01InstrId002", parsed.Transactions[1].InstructionId)
+	assert.Equal(t, "Scenario01EtoEId002", parsed.Transactions[1].EndToEndId)
+	assert.Equal(t, models.Decimal("1250.50"), parsed.Transactions[1].InterBankSettAmount.Amount)
+	assert.Equal(t, "Corporation C", parsed.Transactions[1].DebtorName)
+	assert.Equal(t, model.Transactions[0].DebtorName, parsed.Transactions[0].DebtorName)
+}
+
+// TestNumberOfTransactionsMismatch tests that NbOfTxs must match the number of transactions
+func TestNumberOfTransactionsMismatch(t *testing.T) {
+	model := CustomerCreditTransfer.CustomerCreditTransferDataModel()
+	model.NumberOfTransactions = "2"
+
+	_, err := CustomerCreditTransfer.DocumentWith(model, CustomerCreditTransfer.PACS_008_001_08)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "NumberOfTransactions")
+
+	model.NumberOfTransactions = "1"
+	model.Transactions[0].DebtorName = ""
+	_, err = CustomerCreditTransfer.DocumentWith(model, CustomerCreditTransfer.PACS_008_001_08)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Transactions[0].DebtorName")
+
+	model.Transactions = nil
+	assert.Error(t, CustomerCreditTransfer.CheckRequiredFields(model))
+}
+
+// TestIdentifiers tests that Validate checks IBANs, BICs and LEIs
+func TestIdentifiers(t *testing.T) {
+	model := CustomerCreditTransfer.CustomerCreditTransferDataModel()
+	model.Transactions[0].DebtorIBAN = "BE30001216371411"
+	model.Transactions[0].CreditorIBAN = "BR9700360305000010009795493P1"
+	require.NoError(t, models.ValidateIdentifiers(model))
+
+	model.Transactions[0].DebtorIBAN = "BE34001216371411"
+	model.Transactions[0].ChargesInfo[1].BusinessIdCode = "BANCUS3"
+	err := model.Validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `"Transactions[0].DebtorIBAN": IBAN "BE34001216371411" fails the mod-97 check`)
+	assert.Contains(t, err.Error(), `"Transactions[0].ChargesInfo[1].BusinessIdCode": BIC "BANCUS3" must be 8 or 11 characters`)
+
+	model.Transactions[0].DebtorIBAN = ""
+	model.Transactions[0].ChargesInfo[1].BusinessIdCode = "BANCUS33"
+	model.Transactions[0].DebtorAgent.BusinessIdCode = "bancus33"
+	err = model.Validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Transactions[0].DebtorAgent.BusinessIdCode")
+
+	// Organisation LEIs are read from and written to Id/OrgId/LEI
+	model.Transactions[0].DebtorAgent.BusinessIdCode = ""
+	model.Transactions[0].Transaction.DebtorLEI = "5493001KJTIIGC8Y1R12"
+	model.Transactions[0].Transaction.CreditorLEI = "HWUPKR0MPOU8FGXBT394"
+	require.NoError(t, model.Validate())
+	var output bytes.Buffer
+	require.NoError(t, model.WriteXML(&output, CustomerCreditTransfer.PACS_008_001_08))
+	assert.Contains(t, output.String(), ">5493001KJTIIGC8Y1R12</LEI>")
+	reread, err := CustomerCreditTransfer.ParseXML(output.Bytes())
+	require.NoError(t, err)
+	assert.Equal(t, model.Transactions[0].Transaction, reread.Transactions[0].Transaction)
+
+	model.Transactions[0].Transaction.CreditorLEI = "HWUPKR0MPOU8FGXBT349"
+	err = model.Validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `"Transactions[0].Transaction.CreditorLEI": LEI "HWUPKR0MPOU8FGXBT349" fails the mod-97 check`)
+}
+
+// TestInvalidRoutingNumber tests that a sample whose instructed agent has a bad check digit is read
+// and written back unchanged, with Validate reporting the routing number and WriteXML refusing one
+// it was not read with
+func TestInvalidRoutingNumber(t *testing.T) {
+	data, err := os.ReadFile("./swiftSample/CustomerCreditTransfer_Scenario2_Step1_pacs.008")
+	require.NoError(t, err)
+	payment, err := CustomerCreditTransfer.ParseXML(data)
+	require.NoError(t, err)
+	assert.Equal(t, "021040079", payment.Transactions[0].InstructedAgent.PaymentSysMemberId)
+
+	err = payment.Validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `"Transactions[0].InstructedAgent.PaymentSysMemberId": routing number "021040079" has check digit 9, expected 8`)
+
+	var output bytes.Buffer
+	require.NoError(t, payment.WriteXML(&output))
+	reread, err := CustomerCreditTransfer.ParseXML(output.Bytes())
+	require.NoError(t, err)
+	assert.Equal(t, payment.Transactions[0].InstructedAgent, reread.Transactions[0].InstructedAgent)
+
+	converted, _, err := payment.ConvertToVersion(CustomerCreditTransfer.PACS_008_001_12)
+	require.NoError(t, err)
+	assert.Equal(t, "021040079", converted.Transactions[0].InstructedAgent.PaymentSysMemberId)
+	output.Reset()
+	require.NoError(t, converted.WriteXML(&output))
+
+	// A routing number the message was not read with is refused
+	payment.Transactions[0].InstructedAgent.PaymentSysMemberId = "021040077"
+	output.Reset()
+	err = payment.WriteXML(&output)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `"Transactions[0].InstructedAgent.PaymentSysMemberId"`)
+}
+
+// TestCountryCodes tests that an address whose country is not an ISO 3166-1 code is reported by
+// Validate and refused by WriteXML
+func TestCountryCodes(t *testing.T) {
+	data, err := os.ReadFile("./swiftSample/CustomerCreditTransfer_Scenario1_Step1_pacs.008")
+	require.NoError(t, err)
+	payment, err := CustomerCreditTransfer.ParseXML(data)
+	require.NoError(t, err)
+
+	payment.Transactions[0].DebtorAddress.Country = "UK"
+	err = payment.Validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `"Transactions[0].DebtorAddress.Country"`)
+
+	var output bytes.Buffer
+	err = payment.WriteXML(&output)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `"Transactions[0].DebtorAddress.Country"`)
+}
+
+// TestConvertToVersion tests converting a payment for a counterparty on another release
+func TestConvertToVersion(t *testing.T) {
+	data, err := os.ReadFile("./swiftSample/CustomerCreditTransfer_Scenario1_Step1_pacs.008")
+	require.NoError(t, err)
+	payment, err := CustomerCreditTransfer.ParseXML(data)
+	require.NoError(t, err)
+	uetr := payment.Transactions[0].Transaction.UniqueEndToEndTransactionRef
+
+	// pacs.008.001.05 has no UETR and no room or floor in postal addresses
+	older, report, err := payment.ConvertToVersion(CustomerCreditTransfer.PACS_008_001_05)
+	require.NoError(t, err)
+	assert.Equal(t, "pacs.008.001.08", report.From)
+	assert.Equal(t, "pacs.008.001.05", report.To)
+	assert.False(t, report.Lossless())
+	assert.Equal(t, []base.FieldChange{
+		{Field: "Transactions[0].CreditorPostalAddress.Floor", Value: "33"},
+		{Field: "Transactions[0].DebtorAddress.RoomNumber", Value: "Suite D110"},
+		{Field: "Transactions[0].Transaction.UniqueEndToEndTransactionRef", Value: uetr},
+	}, report.Dropped)
+	assert.Empty(t, report.Defaulted)
+	assert.Nil(t, older.Transactions[0].Transaction)
+	assert.Equal(t, "pacs.008.001.05", older.Version())
+	require.NoError(t, older.Validate())
+
+	// The original is left as it was
+	assert.Equal(t, uetr, payment.Transactions[0].Transaction.UniqueEndToEndTransactionRef)
+	assert.Equal(t, "Suite D110", payment.Transactions[0].DebtorAddress.RoomNumber)
+
+	var buf bytes.Buffer
+	require.NoError(t, older.WriteDocument(&buf))
+	assert.Contains(t, buf.String(), "urn:iso:std:iso:20022:tech:xsd:pacs.008.001.05")
+	assert.NotContains(t, buf.String(), "<UETR>")
+
+	// Going back to a version with UETRs assigns a new one
+	newer, report, err := older.ConvertToVersion(CustomerCreditTransfer.PACS_008_001_12)
+	require.NoError(t, err)
+	assert.True(t, report.Lossless())
+	require.Len(t, report.Defaulted, 2)
+	assert.Equal(t, "Transactions[0].Transaction", report.Defaulted[0].Field)
+	assert.Equal(t, "Transactions[0].Transaction.UniqueEndToEndTransactionRef", report.Defaulted[1].Field)
+	require.NotNil(t, newer.Transactions[0].Transaction)
+	assert.Regexp(t, `^[a-f0-9]{8}-[a-f0-9]{4}-4[a-f0-9]{3}-[89ab][a-f0-9]{3}-[a-f0-9]{12}$`,
+		newer.Transactions[0].Transaction.UniqueEndToEndTransactionRef)
+	assert.Equal(t, report.Defaulted[1].Value, newer.Transactions[0].Transaction.UniqueEndToEndTransactionRef)
+	require.NoError(t, newer.Validate())
+
+	// An AppHdr names the converted document
+	payment.AppHdr = &BusinessApplicationHeader.MessageModel{MessageDefinitionId: "pacs.008.001.08"}
+	older, _, err = payment.ConvertToVersion(CustomerCreditTransfer.PACS_008_001_05)
+	require.NoError(t, err)
+	assert.Equal(t, "pacs.008.001.05", older.AppHdr.MessageDefinitionId)
+	assert.Equal(t, "pacs.008.001.08", payment.AppHdr.MessageDefinitionId)
+
+	// Preserved content is moved to the namespace of the target version
+	data = bytes.Replace(data, []byte("<RmtInf>"), []byte(
+		"<InstrForNxtAgt><InstrInf>Call before crediting</InstrInf></InstrForNxtAgt><RmtInf>"), 1)
+	payment, err = CustomerCreditTransfer.ParseXML(data, base.PreserveUnmapped())
+	require.NoError(t, err)
+	newer, report, err = payment.ConvertToVersion(CustomerCreditTransfer.PACS_008_001_12)
+	require.NoError(t, err)
+	assert.True(t, report.Lossless(), "%v", report.Dropped)
+	require.NotNil(t, newer.Unmapped)
+	assert.Equal(t, "urn:iso:std:iso:20022:tech:xsd:pacs.008.001.12", newer.Unmapped.Namespace)
+	buf.Reset()
+	require.NoError(t, newer.WriteXML(&buf))
+	assert.Contains(t, buf.String(), "urn:iso:std:iso:20022:tech:xsd:pacs.008.001.12")
+	assert.Contains(t, buf.String(), "Call before crediting</InstrInf>")
+}
+
+// TestAddressLines tests that the AdrLine lines of parties and agents survive a write/read round trip
+func TestAddressLines(t *testing.T) {
+	data, err := os.ReadFile("./swiftSample/CustomerCreditTransfer_Scenario1_Step1_pacs.008")
+	require.NoError(t, err)
+	payment, err := CustomerCreditTransfer.ParseXML(data)
+	require.NoError(t, err)
+	payment.Transactions[0].DebtorAddress.AddressLine = []string{"Attn Treasury", "Mail Stop 4"}
+	payment.Transactions[0].CreditorAgent.PostalAddress.AddressLine = []string{"Wire Room"}
+	require.NoError(t, models.CheckAddressCompliance(payment))
+
+	for _, version := range []CustomerCreditTransfer.PACS_008_001_VERSION{CustomerCreditTransfer.PACS_008_001_02, CustomerCreditTransfer.PACS_008_001_08, CustomerCreditTransfer.PACS_008_001_12} {
+		var buf bytes.Buffer
+		require.NoError(t, payment.WriteXML(&buf, version), version)
+		assert.Contains(t, buf.String(), "Mail Stop 4</AdrLine>", version)
+
+		reread, err := CustomerCreditTransfer.ParseXML(buf.Bytes())
+		require.NoError(t, err, version)
+		assert.Equal(t, []string{"Attn Treasury", "Mail Stop 4"}, reread.Transactions[0].DebtorAddress.AddressLine, version)
+		assert.Equal(t, "Fountain Hills", reread.Transactions[0].DebtorAddress.TownName, version)
+		assert.Equal(t, []string{"Wire Room"}, reread.Transactions[0].CreditorAgent.PostalAddress.AddressLine, version)
+	}
 }
@@ -39,65 +39,65 @@ func TestVersion02(t *testing.T) {
 	require.Equal(t, model.NumberOfTransactions, "1")
 	require.Equal(t, model.SettlementMethod, models.SettlementMethodType("CLRG"))
 	require.Equal(t, model.CommonClearingSysCode, models.CommonClearingSysCodeType("FDW"))
-	require.Equal(t, model.InstructionId, "Scenario01InstrId001")
-	require.Equal(t, model.EndToEndId, "Scenario01EtoEId001")
-	require.Equal(t, model.TaxId, "123456789")
-	require.Equal(t, model.InstrumentPropCode, models.InstrumentPropCodeType("CTRC"))
-	require.Equal(t, model.InterBankSettAmount.Amount, 510000.74)
-	require.Equal(t, model.InterBankSettAmount.Currency, "USD")
-	require.NotNil(t, model.InterBankSettDate)
-	require.Equal(t, model.InstructedAmount.Amount, 510000.74)
-	require.Equal(t, model.InstructedAmount.Currency, "USD")
-	require.Equal(t, model.ChargeBearer, models.ChargeBearerSLEV)
+	require.Equal(t, model.Transactions[0].InstructionId, "Scenario01InstrId001")
+	require.Equal(t, model.Transactions[0].EndToEndId, "Scenario01EtoEId001")
+	require.Equal(t, model.Transactions[0].TaxId, "123456789")
+	require.Equal(t, model.Transactions[0].InstrumentPropCode, models.InstrumentPropCodeType("CTRC"))
+	require.Equal(t, model.Transactions[0].InterBankSettAmount.Amount, models.Decimal("510000.74"))
+	require.Equal(t, model.Transactions[0].InterBankSettAmount.Currency, "USD")
+	require.NotNil(t, model.Transactions[0].InterBankSettDate)
+	require.Equal(t, model.Transactions[0].InstructedAmount.Amount, models.Decimal("510000.74"))
+	require.Equal(t, model.Transactions[0].InstructedAmount.Currency, "USD")
+	require.Equal(t, model.Transactions[0].ChargeBearer, models.ChargeBearerSLEV)
 	// ChargesInfo is not supported in V02 schema
-	// require.Equal(t, model.ChargesInfo[0].Amount.Amount, 90.00)
-	// require.Equal(t, model.ChargesInfo[0].Amount.Currency, "USD")
-	// require.Equal(t, model.ChargesInfo[1].Amount.Amount, 40.00)
-	// require.Equal(t, model.ChargesInfo[1].Amount.Currency, "USD")
-	require.Equal(t, model.InstructingAgent.PaymentSysCode, models.PaymentSysUSABA)
-	require.Equal(t, model.InstructingAgent.PaymentSysMemberId, "011104238")
-	require.Equal(t, model.InstructedAgent.PaymentSysCode, models.PaymentSysUSABA)
-	require.Equal(t, model.InstructedAgent.PaymentSysMemberId, "021040078")
-	require.Equal(t, model.DebtorName, "Corporation A")
-	require.Equal(t, model.DebtorAddress.StreetName, "Avenue of the Fountains")
-	require.Equal(t, model.DebtorAddress.BuildingNumber, "167565")
-	require.Equal(t, model.DebtorAddress.PostalCode, "85268")
-	require.Equal(t, model.DebtorAddress.TownName, "Fountain Hills")
-	require.Equal(t, model.DebtorAddress.Subdivision, "AZ")
-	require.Equal(t, model.DebtorAddress.Country, "US")
-	require.Equal(t, model.DebtorOtherTypeId, "5647772655")
-	require.Equal(t, model.DebtorAgent.PaymentSysCode, models.PaymentSysUSABA)
-	require.Equal(t, model.DebtorAgent.PaymentSysMemberId, "011104238")
-	require.Equal(t, model.DebtorAgent.BankName, "Bank A")
-	require.Equal(t, model.DebtorAgent.PostalAddress.StreetName, "Avenue A")
-	require.Equal(t, model.DebtorAgent.PostalAddress.BuildingNumber, "66")
-	require.Equal(t, model.DebtorAgent.PostalAddress.PostalCode, "60532")
-	require.Equal(t, model.DebtorAgent.PostalAddress.TownName, "Lisle")
-	require.Equal(t, model.DebtorAgent.PostalAddress.Subdivision, "IL")
-	require.Equal(t, model.DebtorAgent.PostalAddress.Country, "US")
-	require.Equal(t, model.CreditorAgent.PaymentSysCode, models.PaymentSysUSABA)
-	require.Equal(t, model.CreditorAgent.PaymentSysMemberId, "021040078")
-	require.Equal(t, model.CreditorAgent.BankName, "Bank B")
-	require.Equal(t, model.CreditorAgent.PostalAddress.StreetName, "Avenue B")
-	require.Equal(t, model.CreditorAgent.PostalAddress.BuildingNumber, "25")
-	require.Equal(t, model.CreditorAgent.PostalAddress.PostalCode, "19067")
-	require.Equal(t, model.CreditorAgent.PostalAddress.TownName, "Yardley")
-	require.Equal(t, model.CreditorAgent.PostalAddress.Subdivision, "PA")
-	require.Equal(t, model.CreditorAgent.PostalAddress.Country, "US")
-	require.Equal(t, model.CreditorName, "Corporation B")
-	require.Equal(t, model.CreditorPostalAddress.StreetName, "Desert View Street")
-	require.Equal(t, model.CreditorPostalAddress.BuildingNumber, "1")
-	require.Equal(t, model.CreditorPostalAddress.PostalCode, "19067")
-	require.Equal(t, model.CreditorPostalAddress.TownName, "Palm Springs")
-	require.Equal(t, model.CreditorPostalAddress.Subdivision, "CA")
-	require.Equal(t, model.CreditorPostalAddress.Country, "US")
-	require.Equal(t, model.CreditorOtherTypeId, "567876543")
-	require.Equal(t, models.CodeCINV, model.RemittanceInfor.CodeOrProprietary)
-	require.Equal(t, model.RemittanceInfor.Number, "INV34563")
-	require.NotNil(t, model.RemittanceInfor.RelatedDate)
-	require.Equal(t, model.RelatedRemittanceInfo.RemittanceId, "Scenario01Var2RemittanceId001")
-	require.Equal(t, model.RelatedRemittanceInfo.Method, models.Email)
-	require.Equal(t, model.RelatedRemittanceInfo.ElectronicAddress, "CustomerService@CorporationB.com")
+	// require.Equal(t, model.Transactions[0].ChargesInfo[0].Amount.Amount, models.Decimal("90.00"))
+	// require.Equal(t, model.Transactions[0].ChargesInfo[0].Amount.Currency, "USD")
+	// require.Equal(t, model.Transactions[0].ChargesInfo[1].Amount.Amount, models.Decimal("40.00"))
+	// require.Equal(t, model.Transactions[0].ChargesInfo[1].Amount.Currency, "USD")
+	require.Equal(t, model.Transactions[0].InstructingAgent.PaymentSysCode, models.PaymentSysUSABA)
+	require.Equal(t, model.Transactions[0].InstructingAgent.PaymentSysMemberId, "011104238")
+	require.Equal(t, model.Transactions[0].InstructedAgent.PaymentSysCode, models.PaymentSysUSABA)
+	require.Equal(t, model.Transactions[0].InstructedAgent.PaymentSysMemberId, "021040078")
+	require.Equal(t, model.Transactions[0].DebtorName, "Corporation A")
+	require.Equal(t, model.Transactions[0].DebtorAddress.StreetName, "Avenue of the Fountains")
+	require.Equal(t, model.Transactions[0].DebtorAddress.BuildingNumber, "167565")
+	require.Equal(t, model.Transactions[0].DebtorAddress.PostalCode, "85268")
+	require.Equal(t, model.Transactions[0].DebtorAddress.TownName, "Fountain Hills")
+	require.Equal(t, model.Transactions[0].DebtorAddress.Subdivision, "AZ")
+	require.Equal(t, model.Transactions[0].DebtorAddress.Country, "US")
+	require.Equal(t, model.Transactions[0].DebtorOtherTypeId, "5647772655")
+	require.Equal(t, model.Transactions[0].DebtorAgent.PaymentSysCode, models.PaymentSysUSABA)
+	require.Equal(t, model.Transactions[0].DebtorAgent.PaymentSysMemberId, "011104238")
+	require.Equal(t, model.Transactions[0].DebtorAgent.BankName, "Bank A")
+	require.Equal(t, model.Transactions[0].DebtorAgent.PostalAddress.StreetName, "Avenue A")
+	require.Equal(t, model.Transactions[0].DebtorAgent.PostalAddress.BuildingNumber, "66")
+	require.Equal(t, model.Transactions[0].DebtorAgent.PostalAddress.PostalCode, "60532")
+	require.Equal(t, model.Transactions[0].DebtorAgent.PostalAddress.TownName, "Lisle")
+	require.Equal(t, model.Transactions[0].DebtorAgent.PostalAddress.Subdivision, "IL")
+	require.Equal(t, model.Transactions[0].DebtorAgent.PostalAddress.Country, "US")
+	require.Equal(t, model.Transactions[0].CreditorAgent.PaymentSysCode, models.PaymentSysUSABA)
+	require.Equal(t, model.Transactions[0].CreditorAgent.PaymentSysMemberId, "021040078")
+	require.Equal(t, model.Transactions[0].CreditorAgent.BankName, "Bank B")
+	require.Equal(t, model.Transactions[0].CreditorAgent.PostalAddress.StreetName, "Avenue B")
+	require.Equal(t, model.Transactions[0].CreditorAgent.PostalAddress.BuildingNumber, "25")
+	require.Equal(t, model.Transactions[0].CreditorAgent.PostalAddress.PostalCode, "19067")
+	require.Equal(t, model.Transactions[0].CreditorAgent.PostalAddress.TownName, "Yardley")
+	require.Equal(t, model.Transactions[0].CreditorAgent.PostalAddress.Subdivision, "PA")
+	require.Equal(t, model.Transactions[0].CreditorAgent.PostalAddress.Country, "US")
+	require.Equal(t, model.Transactions[0].CreditorName, "Corporation B")
+	require.Equal(t, model.Transactions[0].CreditorPostalAddress.StreetName, "Desert View Street")
+	require.Equal(t, model.Transactions[0].CreditorPostalAddress.BuildingNumber, "1")
+	require.Equal(t, model.Transactions[0].CreditorPostalAddress.PostalCode, "19067")
+	require.Equal(t, model.Transactions[0].CreditorPostalAddress.TownName, "Palm Springs")
+	require.Equal(t, model.Transactions[0].CreditorPostalAddress.Subdivision, "CA")
+	require.Equal(t, model.Transactions[0].CreditorPostalAddress.Country, "US")
+	require.Equal(t, model.Transactions[0].CreditorOtherTypeId, "567876543")
+	require.Equal(t, models.CodeCINV, model.Transactions[0].RemittanceInfor.CodeOrProprietary)
+	require.Equal(t, model.Transactions[0].RemittanceInfor.Number, "INV34563")
+	require.NotNil(t, model.Transactions[0].RemittanceInfor.RelatedDate)
+	require.Equal(t, model.Transactions[0].RelatedRemittanceInfo.RemittanceId, "Scenario01Var2RemittanceId001")
+	require.Equal(t, model.Transactions[0].RelatedRemittanceInfo.Method, models.Email)
+	require.Equal(t, model.Transactions[0].RelatedRemittanceInfo.ElectronicAddress, "CustomerService@CorporationB.com")
 
 	/*Validation check*/
 	model.MessageId = "InvalideMessageIdLength5012345678901234567890"
@@ -144,66 +144,66 @@ func TestVersion03(t *testing.T) {
 	require.Equal(t, model.NumberOfTransactions, "1")
 	require.Equal(t, model.SettlementMethod, models.SettlementMethodType("CLRG"))
 	require.Equal(t, model.CommonClearingSysCode, models.CommonClearingSysCodeType("FDW"))
-	require.Equal(t, model.InstructionId, "Scenario01InstrId001")
-	require.Equal(t, model.EndToEndId, "Scenario01EtoEId001")
-	require.Equal(t, model.TaxId, "123456789")
-	require.Equal(t, model.InstrumentPropCode, models.InstrumentPropCodeType("CTRC"))
-	require.Equal(t, model.InterBankSettAmount.Amount, 510000.74)
-	require.Equal(t, model.InterBankSettAmount.Currency, "USD")
-	require.NotNil(t, model.InterBankSettDate)
-	require.Equal(t, model.InstructedAmount.Amount, 510000.74)
-	require.Equal(t, model.InstructedAmount.Currency, "USD")
-	require.Equal(t, model.ChargeBearer, models.ChargeBearerSLEV)
-	require.Equal(t, model.ChargesInfo[0].Amount.Amount, 90.00)
-	require.Equal(t, model.ChargesInfo[0].Amount.Currency, "USD")
-	require.Equal(t, model.ChargesInfo[0].BusinessIdCode, "BANZBEBB")
-	require.Equal(t, model.ChargesInfo[1].Amount.Amount, 40.00)
-	require.Equal(t, model.ChargesInfo[1].Amount.Currency, "USD")
-	require.Equal(t, model.ChargesInfo[1].BusinessIdCode, "BANCUS33")
-	require.Equal(t, model.InstructingAgent.PaymentSysCode, models.PaymentSysUSABA)
-	require.Equal(t, model.InstructingAgent.PaymentSysMemberId, "011104238")
-	require.Equal(t, model.InstructedAgent.PaymentSysCode, models.PaymentSysUSABA)
-	require.Equal(t, model.InstructedAgent.PaymentSysMemberId, "021040078")
-	require.Equal(t, model.DebtorName, "Corporation A")
-	require.Equal(t, model.DebtorAddress.StreetName, "Avenue of the Fountains")
-	require.Equal(t, model.DebtorAddress.BuildingNumber, "167565")
-	require.Equal(t, model.DebtorAddress.PostalCode, "85268")
-	require.Equal(t, model.DebtorAddress.TownName, "Fountain Hills")
-	require.Equal(t, model.DebtorAddress.Subdivision, "AZ")
-	require.Equal(t, model.DebtorAddress.Country, "US")
-	require.Equal(t, model.DebtorOtherTypeId, "5647772655")
-	require.Equal(t, model.DebtorAgent.PaymentSysCode, models.PaymentSysUSABA)
-	require.Equal(t, model.DebtorAgent.PaymentSysMemberId, "011104238")
-	require.Equal(t, model.DebtorAgent.BankName, "Bank A")
-	require.Equal(t, model.DebtorAgent.PostalAddress.StreetName, "Avenue A")
-	require.Equal(t, model.DebtorAgent.PostalAddress.BuildingNumber, "66")
-	require.Equal(t, model.DebtorAgent.PostalAddress.PostalCode, "60532")
-	require.Equal(t, model.DebtorAgent.PostalAddress.TownName, "Lisle")
-	require.Equal(t, model.DebtorAgent.PostalAddress.Subdivision, "IL")
-	require.Equal(t, model.DebtorAgent.PostalAddress.Country, "US")
-	require.Equal(t, model.CreditorAgent.PaymentSysCode, models.PaymentSysUSABA)
-	require.Equal(t, model.CreditorAgent.PaymentSysMemberId, "021040078")
-	require.Equal(t, model.CreditorAgent.BankName, "Bank B")
-	require.Equal(t, model.CreditorAgent.PostalAddress.StreetName, "Avenue B")
-	require.Equal(t, model.CreditorAgent.PostalAddress.BuildingNumber, "25")
-	require.Equal(t, model.CreditorAgent.PostalAddress.PostalCode, "19067")
-	require.Equal(t, model.CreditorAgent.PostalAddress.TownName, "Yardley")
-	require.Equal(t, model.CreditorAgent.PostalAddress.Subdivision, "PA")
-	require.Equal(t, model.CreditorAgent.PostalAddress.Country, "US")
-	require.Equal(t, model.CreditorName, "Corporation B")
-	require.Equal(t, model.CreditorPostalAddress.StreetName, "Desert View Street")
-	require.Equal(t, model.CreditorPostalAddress.BuildingNumber, "1")
-	require.Equal(t, model.CreditorPostalAddress.PostalCode, "19067")
-	require.Equal(t, model.CreditorPostalAddress.TownName, "Palm Springs")
-	require.Equal(t, model.CreditorPostalAddress.Subdivision, "CA")
-	require.Equal(t, model.CreditorPostalAddress.Country, "US")
-	require.Equal(t, model.CreditorOtherTypeId, "567876543")
-	require.Equal(t, model.RemittanceInfor.CodeOrProprietary, models.CodeCINV)
-	require.Equal(t, model.RemittanceInfor.Number, "INV34563")
-	require.NotNil(t, model.RemittanceInfor.RelatedDate)
-	require.Equal(t, model.RelatedRemittanceInfo.RemittanceId, "Scenario01Var2RemittanceId001")
-	require.Equal(t, model.RelatedRemittanceInfo.Method, models.Email)
-	require.Equal(t, model.RelatedRemittanceInfo.ElectronicAddress, "CustomerService@CorporationB.com")
+	require.Equal(t, model.Transactions[0].InstructionId, "Scenario01InstrId001")
+	require.Equal(t, model.Transactions[0].EndToEndId, "Scenario01EtoEId001")
+	require.Equal(t, model.Transactions[0].TaxId, "123456789")
+	require.Equal(t, model.Transactions[0].InstrumentPropCode, models.InstrumentPropCodeType("CTRC"))
+	require.Equal(t, model.Transactions[0].InterBankSettAmount.Amount, models.Decimal("510000.74"))
+	require.Equal(t, model.Transactions[0].InterBankSettAmount.Currency, "USD")
+	require.NotNil(t, model.Transactions[0].InterBankSettDate)
+	require.Equal(t, model.Transactions[0].InstructedAmount.Amount, models.Decimal("510000.74"))
+	require.Equal(t, model.Transactions[0].InstructedAmount.Currency, "USD")
+	require.Equal(t, model.Transactions[0].ChargeBearer, models.ChargeBearerSLEV)
+	require.Equal(t, model.Transactions[0].ChargesInfo[0].Amount.Amount, models.Decimal("90.00"))
+	require.Equal(t, model.Transactions[0].ChargesInfo[0].Amount.Currency, "USD")
+	require.Equal(t, model.Transactions[0].ChargesInfo[0].BusinessIdCode, "BANZBEBB")
+	require.Equal(t, model.Transactions[0].ChargesInfo[1].Amount.Amount, models.Decimal("40.00"))
+	require.Equal(t, model.Transactions[0].ChargesInfo[1].Amount.Currency, "USD")
+	require.Equal(t, model.Transactions[0].ChargesInfo[1].BusinessIdCode, "BANCUS33")
+	require.Equal(t, model.Transactions[0].InstructingAgent.PaymentSysCode, models.PaymentSysUSABA)
+	require.Equal(t, model.Transactions[0].InstructingAgent.PaymentSysMemberId, "011104238")
+	require.Equal(t, model.Transactions[0].InstructedAgent.PaymentSysCode, models.PaymentSysUSABA)
+	require.Equal(t, model.Transactions[0].InstructedAgent.PaymentSysMemberId, "021040078")
+	require.Equal(t, model.Transactions[0].DebtorName, "Corporation A")
+	require.Equal(t, model.Transactions[0].DebtorAddress.StreetName, "Avenue of the Fountains")
+	require.Equal(t, model.Transactions[0].DebtorAddress.BuildingNumber, "167565")
+	require.Equal(t, model.Transactions[0].DebtorAddress.PostalCode, "85268")
+	require.Equal(t, model.Transactions[0].DebtorAddress.TownName, "Fountain Hills")
+	require.Equal(t, model.Transactions[0].DebtorAddress.Subdivision, "AZ")
+	require.Equal(t, model.Transactions[0].DebtorAddress.Country, "US")
+	require.Equal(t, model.Transactions[0].DebtorOtherTypeId, "5647772655")
+	require.Equal(t, model.Transactions[0].DebtorAgent.PaymentSysCode, models.PaymentSysUSABA)
+	require.Equal(t, model.Transactions[0].DebtorAgent.PaymentSysMemberId, "011104238")
+	require.Equal(t, model.Transactions[0].DebtorAgent.BankName, "Bank A")
+	require.Equal(t, model.Transactions[0].DebtorAgent.PostalAddress.StreetName, "Avenue A")
+	require.Equal(t, model.Transactions[0].DebtorAgent.PostalAddress.BuildingNumber, "66")
+	require.Equal(t, model.Transactions[0].DebtorAgent.PostalAddress.PostalCode, "60532")
+	require.Equal(t, model.Transactions[0].DebtorAgent.PostalAddress.TownName, "Lisle")
+	require.Equal(t, model.Transactions[0].DebtorAgent.PostalAddress.Subdivision, "IL")
+	require.Equal(t, model.Transactions[0].DebtorAgent.PostalAddress.Country, "US")
+	require.Equal(t, model.Transactions[0].CreditorAgent.PaymentSysCode, models.PaymentSysUSABA)
+	require.Equal(t, model.Transactions[0].CreditorAgent.PaymentSysMemberId, "021040078")
+	require.Equal(t, model.Transactions[0].CreditorAgent.BankName, "Bank B")
+	require.Equal(t, model.Transactions[0].CreditorAgent.PostalAddress.StreetName, "Avenue B")
+	require.Equal(t, model.Transactions[0].CreditorAgent.PostalAddress.BuildingNumber, "25")
+	require.Equal(t, model.Transactions[0].CreditorAgent.PostalAddress.PostalCode, "19067")
+	require.Equal(t, model.Transactions[0].CreditorAgent.PostalAddress.TownName, "Yardley")
+	require.Equal(t, model.Transactions[0].CreditorAgent.PostalAddress.Subdivision, "PA")
+	require.Equal(t, model.Transactions[0].CreditorAgent.PostalAddress.Country, "US")
+	require.Equal(t, model.Transactions[0].CreditorName, "Corporation B")
+	require.Equal(t, model.Transactions[0].CreditorPostalAddress.StreetName, "Desert View Street")
+	require.Equal(t, model.Transactions[0].CreditorPostalAddress.BuildingNumber, "1")
+	require.Equal(t, model.Transactions[0].CreditorPostalAddress.PostalCode, "19067")
+	require.Equal(t, model.Transactions[0].CreditorPostalAddress.TownName, "Palm Springs")
+	require.Equal(t, model.Transactions[0].CreditorPostalAddress.Subdivision, "CA")
+	require.Equal(t, model.Transactions[0].CreditorPostalAddress.Country, "US")
+	require.Equal(t, model.Transactions[0].CreditorOtherTypeId, "567876543")
+	require.Equal(t, model.Transactions[0].RemittanceInfor.CodeOrProprietary, models.CodeCINV)
+	require.Equal(t, model.Transactions[0].RemittanceInfor.Number, "INV34563")
+	require.NotNil(t, model.Transactions[0].RemittanceInfor.RelatedDate)
+	require.Equal(t, model.Transactions[0].RelatedRemittanceInfo.RemittanceId, "Scenario01Var2RemittanceId001")
+	require.Equal(t, model.Transactions[0].RelatedRemittanceInfo.Method, models.Email)
+	require.Equal(t, model.Transactions[0].RelatedRemittanceInfo.ElectronicAddress, "CustomerService@CorporationB.com")
 
 	/*Validation check*/
 	model.MessageId = "InvalideMessageIdLength5012345678901234567890"
@@ -250,66 +250,66 @@ func TestVersion04(t *testing.T) {
 	require.Equal(t, model.NumberOfTransactions, "1")
 	require.Equal(t, model.SettlementMethod, models.SettlementMethodType("CLRG"))
 	require.Equal(t, model.CommonClearingSysCode, models.CommonClearingSysCodeType("FDW"))
-	require.Equal(t, model.InstructionId, "Scenario01InstrId001")
-	require.Equal(t, model.EndToEndId, "Scenario01EtoEId001")
-	require.Equal(t, model.TaxId, "123456789")
-	require.Equal(t, model.InstrumentPropCode, models.InstrumentPropCodeType("CTRC"))
-	require.Equal(t, model.InterBankSettAmount.Amount, 510000.74)
-	require.Equal(t, model.InterBankSettAmount.Currency, "USD")
-	require.NotNil(t, model.InterBankSettDate)
-	require.Equal(t, model.InstructedAmount.Amount, 510000.74)
-	require.Equal(t, model.InstructedAmount.Currency, "USD")
-	require.Equal(t, model.ChargeBearer, models.ChargeBearerSLEV)
-	require.Equal(t, model.ChargesInfo[0].Amount.Amount, 90.00)
-	require.Equal(t, model.ChargesInfo[0].Amount.Currency, "USD")
-	require.Equal(t, model.ChargesInfo[0].BusinessIdCode, "BANZBEBB")
-	require.Equal(t, model.ChargesInfo[1].Amount.Amount, 40.00)
-	require.Equal(t, model.ChargesInfo[1].Amount.Currency, "USD")
-	require.Equal(t, model.ChargesInfo[1].BusinessIdCode, "BANCUS33")
-	require.Equal(t, model.InstructingAgent.PaymentSysCode, models.PaymentSysUSABA)
-	require.Equal(t, model.InstructingAgent.PaymentSysMemberId, "011104238")
-	require.Equal(t, model.InstructedAgent.PaymentSysCode, models.PaymentSysUSABA)
-	require.Equal(t, model.InstructedAgent.PaymentSysMemberId, "021040078")
-	require.Equal(t, model.DebtorName, "Corporation A")
-	require.Equal(t, model.DebtorAddress.StreetName, "Avenue of the Fountains")
-	require.Equal(t, model.DebtorAddress.BuildingNumber, "167565")
-	require.Equal(t, model.DebtorAddress.PostalCode, "85268")
-	require.Equal(t, model.DebtorAddress.TownName, "Fountain Hills")
-	require.Equal(t, model.DebtorAddress.Subdivision, "AZ")
-	require.Equal(t, model.DebtorAddress.Country, "US")
-	require.Equal(t, model.DebtorOtherTypeId, "5647772655")
-	require.Equal(t, model.DebtorAgent.PaymentSysCode, models.PaymentSysUSABA)
-	require.Equal(t, model.DebtorAgent.PaymentSysMemberId, "011104238")
-	require.Equal(t, model.DebtorAgent.BankName, "Bank A")
-	require.Equal(t, model.DebtorAgent.PostalAddress.StreetName, "Avenue A")
-	require.Equal(t, model.DebtorAgent.PostalAddress.BuildingNumber, "66")
-	require.Equal(t, model.DebtorAgent.PostalAddress.PostalCode, "60532")
-	require.Equal(t, model.DebtorAgent.PostalAddress.TownName, "Lisle")
-	require.Equal(t, model.DebtorAgent.PostalAddress.Subdivision, "IL")
-	require.Equal(t, model.DebtorAgent.PostalAddress.Country, "US")
-	require.Equal(t, model.CreditorAgent.PaymentSysCode, models.PaymentSysUSABA)
-	require.Equal(t, model.CreditorAgent.PaymentSysMemberId, "021040078")
-	require.Equal(t, model.CreditorAgent.BankName, "Bank B")
-	require.Equal(t, model.CreditorAgent.PostalAddress.StreetName, "Avenue B")
-	require.Equal(t, model.CreditorAgent.PostalAddress.BuildingNumber, "25")
-	require.Equal(t, model.CreditorAgent.PostalAddress.PostalCode, "19067")
-	require.Equal(t, model.CreditorAgent.PostalAddress.TownName, "Yardley")
-	require.Equal(t, model.CreditorAgent.PostalAddress.Subdivision, "PA")
-	require.Equal(t, model.CreditorAgent.PostalAddress.Country, "US")
-	require.Equal(t, model.CreditorName, "Corporation B")
-	require.Equal(t, model.CreditorPostalAddress.StreetName, "Desert View Street")
-	require.Equal(t, model.CreditorPostalAddress.BuildingNumber, "1")
-	require.Equal(t, model.CreditorPostalAddress.PostalCode, "19067")
-	require.Equal(t, model.CreditorPostalAddress.TownName, "Palm Springs")
-	require.Equal(t, model.CreditorPostalAddress.Subdivision, "CA")
-	require.Equal(t, model.CreditorPostalAddress.Country, "US")
-	require.Equal(t, model.CreditorOtherTypeId, "567876543")
-	require.Equal(t, model.RemittanceInfor.CodeOrProprietary, models.CodeCINV)
-	require.Equal(t, model.RemittanceInfor.Number, "INV34563")
-	require.NotNil(t, model.RemittanceInfor.RelatedDate)
-	require.Equal(t, model.RelatedRemittanceInfo.RemittanceId, "Scenario01Var2RemittanceId001")
-	require.Equal(t, model.RelatedRemittanceInfo.Method, models.Email)
-	require.Equal(t, model.RelatedRemittanceInfo.ElectronicAddress, "CustomerService@CorporationB.com")
+	require.Equal(t, model.Transactions[0].InstructionId, "Scenario01InstrId001")
+	require.Equal(t, model.Transactions[0].EndToEndId, "Scenario01EtoEId001")
+	require.Equal(t, model.Transactions[0].TaxId, "123456789")
+	require.Equal(t, model.Transactions[0].InstrumentPropCode, models.InstrumentPropCodeType("CTRC"))
+	require.Equal(t, model.Transactions[0].InterBankSettAmount.Amount, models.Decimal("510000.74"))
+	require.Equal(t, model.Transactions[0].InterBankSettAmount.Currency, "USD")
+	require.NotNil(t, model.Transactions[0].InterBankSettDate)
+	require.Equal(t, model.Transactions[0].InstructedAmount.Amount, models.Decimal("510000.74"))
+	require.Equal(t, model.Transactions[0].InstructedAmount.Currency, "USD")
+	require.Equal(t, model.Transactions[0].ChargeBearer, models.ChargeBearerSLEV)
+	require.Equal(t, model.Transactions[0].ChargesInfo[0].Amount.Amount, models.Decimal("90.00"))
+	require.Equal(t, model.Transactions[0].ChargesInfo[0].Amount.Currency, "USD")
+	require.Equal(t, model.Transactions[0].ChargesInfo[0].BusinessIdCode, "BANZBEBB")
+	require.Equal(t, model.Transactions[0].ChargesInfo[1].Amount.Amount, models.Decimal("40.00"))
+	require.Equal(t, model.Transactions[0].ChargesInfo[1].Amount.Currency, "USD")
+	require.Equal(t, model.Transactions[0].ChargesInfo[1].BusinessIdCode, "BANCUS33")
+	require.Equal(t, model.Transactions[0].InstructingAgent.PaymentSysCode, models.PaymentSysUSABA)
+	require.Equal(t, model.Transactions[0].InstructingAgent.PaymentSysMemberId, "011104238")
+	require.Equal(t, model.Transactions[0].InstructedAgent.PaymentSysCode, models.PaymentSysUSABA)
+	require.Equal(t, model.Transactions[0].InstructedAgent.PaymentSysMemberId, "021040078")
+	require.Equal(t, model.Transactions[0].DebtorName, "Corporation A")
+	require.Equal(t, model.Transactions[0].DebtorAddress.StreetName, "Avenue of the Fountains")
+	require.Equal(t, model.Transactions[0].DebtorAddress.BuildingNumber, "167565")
+	require.Equal(t, model.Transactions[0].DebtorAddress.PostalCode, "85268")
+	require.Equal(t, model.Transactions[0].DebtorAddress.TownName, "Fountain Hills")
+	require.Equal(t, model.Transactions[0].DebtorAddress.Subdivision, "AZ")
+	require.Equal(t, model.Transactions[0].DebtorAddress.Country, "US")
+	require.Equal(t, model.Transactions[0].DebtorOtherTypeId, "5647772655")
+	require.Equal(t, model.Transactions[0].DebtorAgent.PaymentSysCode, models.PaymentSysUSABA)
+	require.Equal(t, model.Transactions[0].DebtorAgent.PaymentSysMemberId, "011104238")
+	require.Equal(t, model.Transactions[0].DebtorAgent.BankName, "Bank A")
+	require.Equal(t, model.Transactions[0].DebtorAgent.PostalAddress.StreetName, "Avenue A")
+	require.Equal(t, model.Transactions[0].DebtorAgent.PostalAddress.BuildingNumber, "66")
+	require.Equal(t, model.Transactions[0].DebtorAgent.PostalAddress.PostalCode, "60532")
+	require.Equal(t, model.Transactions[0].DebtorAgent.PostalAddress.TownName, "Lisle")
+	require.Equal(t, model.Transactions[0].DebtorAgent.PostalAddress.Subdivision, "IL")
+	require.Equal(t, model.Transactions[0].DebtorAgent.PostalAddress.Country, "US")
+	require.Equal(t, model.Transactions[0].CreditorAgent.PaymentSysCode, models.PaymentSysUSABA)
+	require.Equal(t, model.Transactions[0].CreditorAgent.PaymentSysMemberId, "021040078")
+	require.Equal(t, model.Transactions[0].CreditorAgent.BankName, "Bank B")
+	require.Equal(t, model.Transactions[0].CreditorAgent.PostalAddress.StreetName, "Avenue B")
+	require.Equal(t, model.Transactions[0].CreditorAgent.PostalAddress.BuildingNumber, "25")
+	require.Equal(t, model.Transactions[0].CreditorAgent.PostalAddress.PostalCode, "19067")
+	require.Equal(t, model.Transactions[0].CreditorAgent.PostalAddress.TownName, "Yardley")
+	require.Equal(t, model.Transactions[0].CreditorAgent.PostalAddress.Subdivision, "PA")
+	require.Equal(t, model.Transactions[0].CreditorAgent.PostalAddress.Country, "US")
+	require.Equal(t, model.Transactions[0].CreditorName, "Corporation B")
+	require.Equal(t, model.Transactions[0].CreditorPostalAddress.StreetName, "Desert View Street")
+	require.Equal(t, model.Transactions[0].CreditorPostalAddress.BuildingNumber, "1")
+	require.Equal(t, model.Transactions[0].CreditorPostalAddress.PostalCode, "19067")
+	require.Equal(t, model.Transactions[0].CreditorPostalAddress.TownName, "Palm Springs")
+	require.Equal(t, model.Transactions[0].CreditorPostalAddress.Subdivision, "CA")
+	require.Equal(t, model.Transactions[0].CreditorPostalAddress.Country, "US")
+	require.Equal(t, model.Transactions[0].CreditorOtherTypeId, "567876543")
+	require.Equal(t, model.Transactions[0].RemittanceInfor.CodeOrProprietary, models.CodeCINV)
+	require.Equal(t, model.Transactions[0].RemittanceInfor.Number, "INV34563")
+	require.NotNil(t, model.Transactions[0].RemittanceInfor.RelatedDate)
+	require.Equal(t, model.Transactions[0].RelatedRemittanceInfo.RemittanceId, "Scenario01Var2RemittanceId001")
+	require.Equal(t, model.Transactions[0].RelatedRemittanceInfo.Method, models.Email)
+	require.Equal(t, model.Transactions[0].RelatedRemittanceInfo.ElectronicAddress, "CustomerService@CorporationB.com")
 
 	/*Validation check*/
 	model.MessageId = "InvalideMessageIdLength5012345678901234567890"
@@ -356,70 +356,70 @@ func TestVersion05(t *testing.T) {
 	require.Equal(t, model.NumberOfTransactions, "1")
 	require.Equal(t, model.SettlementMethod, models.SettlementMethodType("CLRG"))
 	require.Equal(t, model.CommonClearingSysCode, models.CommonClearingSysCodeType("FDW"))
-	require.Equal(t, model.InstructionId, "Scenario01InstrId001")
-	require.Equal(t, model.EndToEndId, "Scenario01EtoEId001")
-	require.Equal(t, model.TaxId, "123456789")
-	require.Equal(t, model.InstrumentPropCode, models.InstrumentPropCodeType("CTRC"))
-	require.Equal(t, model.InterBankSettAmount.Amount, 510000.74)
-	require.Equal(t, model.InterBankSettAmount.Currency, "USD")
-	require.NotNil(t, model.InterBankSettDate)
-	require.Equal(t, model.InstructedAmount.Amount, 510000.74)
-	require.Equal(t, model.InstructedAmount.Currency, "USD")
-	require.Equal(t, model.ChargeBearer, models.ChargeBearerSLEV)
-	require.Equal(t, model.ChargesInfo[0].Amount.Amount, 90.00)
-	require.Equal(t, model.ChargesInfo[0].Amount.Currency, "USD")
-	require.Equal(t, model.ChargesInfo[0].BusinessIdCode, "BANZBEBB")
-	require.Equal(t, model.ChargesInfo[1].Amount.Amount, 40.00)
-	require.Equal(t, model.ChargesInfo[1].Amount.Currency, "USD")
-	require.Equal(t, model.ChargesInfo[1].BusinessIdCode, "BANCUS33")
-	require.Equal(t, model.InstructingAgent.PaymentSysCode, models.PaymentSysUSABA)
-	require.Equal(t, model.InstructingAgent.PaymentSysMemberId, "011104238")
-	require.Equal(t, model.InstructedAgent.PaymentSysCode, models.PaymentSysUSABA)
-	require.Equal(t, model.InstructedAgent.PaymentSysMemberId, "021040078")
-	require.Equal(t, model.DebtorName, "Corporation A")
-	require.Equal(t, model.DebtorAddress.StreetName, "Avenue of the Fountains")
-	require.Equal(t, model.DebtorAddress.BuildingNumber, "167565")
-	require.Equal(t, model.DebtorAddress.PostalCode, "85268")
-	require.Equal(t, model.DebtorAddress.TownName, "Fountain Hills")
-	require.Equal(t, model.DebtorAddress.Subdivision, "AZ")
-	require.Equal(t, model.DebtorAddress.Country, "US")
-	require.Equal(t, model.DebtorOtherTypeId, "5647772655")
-	require.Equal(t, model.DebtorAgent.PaymentSysCode, models.PaymentSysUSABA)
-	require.Equal(t, model.DebtorAgent.PaymentSysMemberId, "011104238")
-	require.Equal(t, model.DebtorAgent.BankName, "Bank A")
-	require.Equal(t, model.DebtorAgent.PostalAddress.StreetName, "Avenue A")
-	require.Equal(t, model.DebtorAgent.PostalAddress.BuildingNumber, "66")
-	require.Equal(t, model.DebtorAgent.PostalAddress.PostalCode, "60532")
-	require.Equal(t, model.DebtorAgent.PostalAddress.TownName, "Lisle")
-	require.Equal(t, model.DebtorAgent.PostalAddress.Subdivision, "IL")
-	require.Equal(t, model.DebtorAgent.PostalAddress.Country, "US")
-	require.Equal(t, model.CreditorAgent.PaymentSysCode, models.PaymentSysUSABA)
-	require.Equal(t, model.CreditorAgent.PaymentSysMemberId, "021040078")
-	require.Equal(t, model.CreditorAgent.BankName, "Bank B")
-	require.Equal(t, model.CreditorAgent.PostalAddress.StreetName, "Avenue B")
-	require.Equal(t, model.CreditorAgent.PostalAddress.BuildingNumber, "25")
-	require.Equal(t, model.CreditorAgent.PostalAddress.PostalCode, "19067")
-	require.Equal(t, model.CreditorAgent.PostalAddress.TownName, "Yardley")
-	require.Equal(t, model.CreditorAgent.PostalAddress.Subdivision, "PA")
-	require.Equal(t, model.CreditorAgent.PostalAddress.Country, "US")
-	require.Equal(t, model.CreditorName, "Corporation B")
-	require.Equal(t, model.CreditorPostalAddress.StreetName, "Desert View Street")
-	require.Equal(t, model.CreditorPostalAddress.BuildingNumber, "1")
-	require.Equal(t, model.CreditorPostalAddress.PostalCode, "19067")
-	require.Equal(t, model.CreditorPostalAddress.TownName, "Palm Springs")
-	require.Equal(t, model.CreditorPostalAddress.Subdivision, "CA")
-	require.Equal(t, model.CreditorPostalAddress.Country, "US")
-	require.Equal(t, model.CreditorOtherTypeId, "567876543")
-	require.Equal(t, model.RemittanceInfor.CodeOrProprietary, models.CodeCINV)
-	require.Equal(t, model.RemittanceInfor.Number, "INV34563")
-	require.NotNil(t, model.RemittanceInfor.RelatedDate)
-	require.Equal(t, model.RemittanceInfor.TaxDetail.TaxId, "123456789")
-	require.Equal(t, model.RemittanceInfor.TaxDetail.TaxTypeCode, "09455")
-	require.NotNil(t, model.RemittanceInfor.TaxDetail.TaxPeriodYear)
-	require.Equal(t, model.RemittanceInfor.TaxDetail.TaxperiodTimeFrame, "MM04")
-	require.Equal(t, model.RelatedRemittanceInfo.RemittanceId, "Scenario01Var2RemittanceId001")
-	require.Equal(t, model.RelatedRemittanceInfo.Method, models.Email)
-	require.Equal(t, model.RelatedRemittanceInfo.ElectronicAddress, "CustomerService@CorporationB.com")
+	require.Equal(t, model.Transactions[0].InstructionId, "Scenario01InstrId001")
+	require.Equal(t, model.Transactions[0].EndToEndId, "Scenario01EtoEId001")
+	require.Equal(t, model.Transactions[0].TaxId, "123456789")
+	require.Equal(t, model.Transactions[0].InstrumentPropCode, models.InstrumentPropCodeType("CTRC"))
+	require.Equal(t, model.Transactions[0].InterBankSettAmount.Amount, models.Decimal("510000.74"))
+	require.Equal(t, model.Transactions[0].InterBankSettAmount.Currency, "USD")
+	require.NotNil(t, model.Transactions[0].InterBankSettDate)
+	require.Equal(t, model.Transactions[0].InstructedAmount.Amount, models.Decimal("510000.74"))
+	require.Equal(t, model.Transactions[0].InstructedAmount.Currency, "USD")
+	require.Equal(t, model.Transactions[0].ChargeBearer, models.ChargeBearerSLEV)
+	require.Equal(t, model.Transactions[0].ChargesInfo[0].Amount.Amount, models.Decimal("90.00"))
+	require.Equal(t, model.Transactions[0].ChargesInfo[0].Amount.Currency, "USD")
+	require.Equal(t, model.Transactions[0].ChargesInfo[0].BusinessIdCode, "BANZBEBB")
+	require.Equal(t, model.Transactions[0].ChargesInfo[1].Amount.Amount, models.Decimal("40.00"))
+	require.Equal(t, model.Transactions[0].ChargesInfo[1].Amount.Currency, "USD")
+	require.Equal(t, model.Transactions[0].ChargesInfo[1].BusinessIdCode, "BANCUS33")
+	require.Equal(t, model.Transactions[0].InstructingAgent.PaymentSysCode, models.PaymentSysUSABA)
+	require.Equal(t, model.Transactions[0].InstructingAgent.PaymentSysMemberId, "011104238")
+	require.Equal(t, model.Transactions[0].InstructedAgent.PaymentSysCode, models.PaymentSysUSABA)
+	require.Equal(t, model.Transactions[0].InstructedAgent.PaymentSysMemberId, "021040078")
+	require.Equal(t, model.Transactions[0].DebtorName, "Corporation A")
+	require.Equal(t, model.Transactions[0].DebtorAddress.StreetName, "Avenue of the Fountains")
+	require.Equal(t, model.Transactions[0].DebtorAddress.BuildingNumber, "167565")
+	require.Equal(t, model.Transactions[0].DebtorAddress.PostalCode, "85268")
+	require.Equal(t, model.Transactions[0].DebtorAddress.TownName, "Fountain Hills")
+	require.Equal(t, model.Transactions[0].DebtorAddress.Subdivision, "AZ")
+	require.Equal(t, model.Transactions[0].DebtorAddress.Country, "US")
+	require.Equal(t, model.Transactions[0].DebtorOtherTypeId, "5647772655")
+	require.Equal(t, model.Transactions[0].DebtorAgent.PaymentSysCode, models.PaymentSysUSABA)
+	require.Equal(t, model.Transactions[0].DebtorAgent.PaymentSysMemberId, "011104238")
+	require.Equal(t, model.Transactions[0].DebtorAgent.BankName, "Bank A")
+	require.Equal(t, model.Transactions[0].DebtorAgent.PostalAddress.StreetName, "Avenue A")
+	require.Equal(t, model.Transactions[0].DebtorAgent.PostalAddress.BuildingNumber, "66")
+	require.Equal(t, model.Transactions[0].DebtorAgent.PostalAddress.PostalCode, "60532")
+	require.Equal(t, model.Transactions[0].DebtorAgent.PostalAddress.TownName, "Lisle")
+	require.Equal(t, model.Transactions[0].DebtorAgent.PostalAddress.Subdivision, "IL")
+	require.Equal(t, model.Transactions[0].DebtorAgent.PostalAddress.Country, "US")
+	require.Equal(t, model.Transactions[0].CreditorAgent.PaymentSysCode, models.PaymentSysUSABA)
+	require.Equal(t, model.Transactions[0].CreditorAgent.PaymentSysMemberId, "021040078")
+	require.Equal(t, model.Transactions[0].CreditorAgent.BankName, "Bank B")
+	require.Equal(t, model.Transactions[0].CreditorAgent.PostalAddress.StreetName, "Avenue B")
+	require.Equal(t, model.Transactions[0].CreditorAgent.PostalAddress.BuildingNumber, "25")
+	require.Equal(t, model.Transactions[0].CreditorAgent.PostalAddress.PostalCode, "19067")
+	require.Equal(t, model.Transactions[0].CreditorAgent.PostalAddress.TownName, "Yardley")
+	require.Equal(t, model.Transactions[0].CreditorAgent.PostalAddress.Subdivision, "PA")
+	require.Equal(t, model.Transactions[0].CreditorAgent.PostalAddress.Country, "US")
+	require.Equal(t, model.Transactions[0].CreditorName, "Corporation B")
+	require.Equal(t, model.Transactions[0].CreditorPostalAddress.StreetName, "Desert View Street")
+	require.Equal(t, model.Transactions[0].CreditorPostalAddress.BuildingNumber, "1")
+	require.Equal(t, model.Transactions[0].CreditorPostalAddress.PostalCode, "19067")
+	require.Equal(t, model.Transactions[0].CreditorPostalAddress.TownName, "Palm Springs")
+	require.Equal(t, model.Transactions[0].CreditorPostalAddress.Subdivision, "CA")
+	require.Equal(t, model.Transactions[0].CreditorPostalAddress.Country, "US")
+	require.Equal(t, model.Transactions[0].CreditorOtherTypeId, "567876543")
+	require.Equal(t, model.Transactions[0].RemittanceInfor.CodeOrProprietary, models.CodeCINV)
+	require.Equal(t, model.Transactions[0].RemittanceInfor.Number, "INV34563")
+	require.NotNil(t, model.Transactions[0].RemittanceInfor.RelatedDate)
+	require.Equal(t, model.Transactions[0].RemittanceInfor.TaxDetail.TaxId, "123456789")
+	require.Equal(t, model.Transactions[0].RemittanceInfor.TaxDetail.TaxTypeCode, "09455")
+	require.NotNil(t, model.Transactions[0].RemittanceInfor.TaxDetail.TaxPeriodYear)
+	require.Equal(t, model.Transactions[0].RemittanceInfor.TaxDetail.TaxperiodTimeFrame, "MM04")
+	require.Equal(t, model.Transactions[0].RelatedRemittanceInfo.RemittanceId, "Scenario01Var2RemittanceId001")
+	require.Equal(t, model.Transactions[0].RelatedRemittanceInfo.Method, models.Email)
+	require.Equal(t, model.Transactions[0].RelatedRemittanceInfo.ElectronicAddress, "CustomerService@CorporationB.com")
 
 	/*Validation check*/
 	model.MessageId = "InvalideMessageIdLength5012345678901234567890"
@@ -466,70 +466,70 @@ func TestVersion06(t *testing.T) {
 	require.Equal(t, model.NumberOfTransactions, "1")
 	require.Equal(t, model.SettlementMethod, models.SettlementMethodType("CLRG"))
 	require.Equal(t, model.CommonClearingSysCode, models.CommonClearingSysCodeType("FDW"))
-	require.Equal(t, model.InstructionId, "Scenario01InstrId001")
-	require.Equal(t, model.EndToEndId, "Scenario01EtoEId001")
-	require.Equal(t, model.TaxId, "123456789")
-	require.Equal(t, model.InstrumentPropCode, models.InstrumentPropCodeType("CTRC"))
-	require.Equal(t, model.InterBankSettAmount.Amount, 510000.74)
-	require.Equal(t, model.InterBankSettAmount.Currency, "USD")
-	require.NotNil(t, model.InterBankSettDate)
-	require.Equal(t, model.InstructedAmount.Amount, 510000.74)
-	require.Equal(t, model.InstructedAmount.Currency, "USD")
-	require.Equal(t, model.ChargeBearer, models.ChargeBearerSLEV)
-	require.Equal(t, model.ChargesInfo[0].Amount.Amount, 90.00)
-	require.Equal(t, model.ChargesInfo[0].Amount.Currency, "USD")
-	require.Equal(t, model.ChargesInfo[0].BusinessIdCode, "BANZBEBB")
-	require.Equal(t, model.ChargesInfo[1].Amount.Amount, 40.00)
-	require.Equal(t, model.ChargesInfo[1].Amount.Currency, "USD")
-	require.Equal(t, model.ChargesInfo[1].BusinessIdCode, "BANCUS33")
-	require.Equal(t, model.InstructingAgent.PaymentSysCode, models.PaymentSysUSABA)
-	require.Equal(t, model.InstructingAgent.PaymentSysMemberId, "011104238")
-	require.Equal(t, model.InstructedAgent.PaymentSysCode, models.PaymentSysUSABA)
-	require.Equal(t, model.InstructedAgent.PaymentSysMemberId, "021040078")
-	require.Equal(t, model.DebtorName, "Corporation A")
-	require.Equal(t, model.DebtorAddress.StreetName, "Avenue of the Fountains")
-	require.Equal(t, model.DebtorAddress.BuildingNumber, "167565")
-	require.Equal(t, model.DebtorAddress.PostalCode, "85268")
-	require.Equal(t, model.DebtorAddress.TownName, "Fountain Hills")
-	require.Equal(t, model.DebtorAddress.Subdivision, "AZ")
-	require.Equal(t, model.DebtorAddress.Country, "US")
-	require.Equal(t, model.DebtorOtherTypeId, "5647772655")
-	require.Equal(t, model.DebtorAgent.PaymentSysCode, models.PaymentSysUSABA)
-	require.Equal(t, model.DebtorAgent.PaymentSysMemberId, "011104238")
-	require.Equal(t, model.DebtorAgent.BankName, "Bank A")
-	require.Equal(t, model.DebtorAgent.PostalAddress.StreetName, "Avenue A")
-	require.Equal(t, model.DebtorAgent.PostalAddress.BuildingNumber, "66")
-	require.Equal(t, model.DebtorAgent.PostalAddress.PostalCode, "60532")
-	require.Equal(t, model.DebtorAgent.PostalAddress.TownName, "Lisle")
-	require.Equal(t, model.DebtorAgent.PostalAddress.Subdivision, "IL")
-	require.Equal(t, model.DebtorAgent.PostalAddress.Country, "US")
-	require.Equal(t, model.CreditorAgent.PaymentSysCode, models.PaymentSysUSABA)
-	require.Equal(t, model.CreditorAgent.PaymentSysMemberId, "021040078")
-	require.Equal(t, model.CreditorAgent.BankName, "Bank B")
-	require.Equal(t, model.CreditorAgent.PostalAddress.StreetName, "Avenue B")
-	require.Equal(t, model.CreditorAgent.PostalAddress.BuildingNumber, "25")
-	require.Equal(t, model.CreditorAgent.PostalAddress.PostalCode, "19067")
-	require.Equal(t, model.CreditorAgent.PostalAddress.TownName, "Yardley")
-	require.Equal(t, model.CreditorAgent.PostalAddress.Subdivision, "PA")
-	require.Equal(t, model.CreditorAgent.PostalAddress.Country, "US")
-	require.Equal(t, model.CreditorName, "Corporation B")
-	require.Equal(t, model.CreditorPostalAddress.StreetName, "Desert View Street")
-	require.Equal(t, model.CreditorPostalAddress.BuildingNumber, "1")
-	require.Equal(t, model.CreditorPostalAddress.PostalCode, "19067")
-	require.Equal(t, model.CreditorPostalAddress.TownName, "Palm Springs")
-	require.Equal(t, model.CreditorPostalAddress.Subdivision, "CA")
-	require.Equal(t, model.CreditorPostalAddress.Country, "US")
-	require.Equal(t, model.CreditorOtherTypeId, "567876543")
-	require.Equal(t, model.RemittanceInfor.CodeOrProprietary, models.CodeCINV)
-	require.Equal(t, model.RemittanceInfor.Number, "INV34563")
-	require.NotNil(t, model.RemittanceInfor.RelatedDate)
-	require.Equal(t, model.RemittanceInfor.TaxDetail.TaxId, "123456789")
-	require.Equal(t, model.RemittanceInfor.TaxDetail.TaxTypeCode, "09455")
-	require.NotNil(t, model.RemittanceInfor.TaxDetail.TaxPeriodYear)
-	require.Equal(t, model.RemittanceInfor.TaxDetail.TaxperiodTimeFrame, "MM04")
-	require.Equal(t, model.RelatedRemittanceInfo.RemittanceId, "Scenario01Var2RemittanceId001")
-	require.Equal(t, model.RelatedRemittanceInfo.Method, models.Email)
-	require.Equal(t, model.RelatedRemittanceInfo.ElectronicAddress, "CustomerService@CorporationB.com")
+	require.Equal(t, model.Transactions[0].InstructionId, "Scenario01InstrId001")
+	require.Equal(t, model.Transactions[0].EndToEndId, "Scenario01EtoEId001")
+	require.Equal(t, model.Transactions[0].TaxId, "123456789")
+	require.Equal(t, model.Transactions[0].InstrumentPropCode, models.InstrumentPropCodeType("CTRC"))
+	require.Equal(t, model.Transactions[0].InterBankSettAmount.Amount, models.Decimal("510000.74"))
+	require.Equal(t, model.Transactions[0].InterBankSettAmount.Currency, "USD")
+	require.NotNil(t, model.Transactions[0].InterBankSettDate)
+	require.Equal(t, model.Transactions[0].InstructedAmount.Amount, models.Decimal("510000.74"))
+	require.Equal(t, model.Transactions[0].InstructedAmount.Currency, "USD")
+	require.Equal(t, model.Transactions[0].ChargeBearer, models.ChargeBearerSLEV)
+	require.Equal(t, model.Transactions[0].ChargesInfo[0].Amount.Amount, models.Decimal("90.00"))
+	require.Equal(t, model.Transactions[0].ChargesInfo[0].Amount.Currency, "USD")
+	require.Equal(t, model.Transactions[0].ChargesInfo[0].BusinessIdCode, "BANZBEBB")
+	require.Equal(t, model.Transactions[0].ChargesInfo[1].Amount.Amount, models.Decimal("40.00"))
+	require.Equal(t, model.Transactions[0].ChargesInfo[1].Amount.Currency, "USD")
+	require.Equal(t, model.Transactions[0].ChargesInfo[1].BusinessIdCode, "BANCUS33")
+	require.Equal(t, model.Transactions[0].InstructingAgent.PaymentSysCode, models.PaymentSysUSABA)
+	require.Equal(t, model.Transactions[0].InstructingAgent.PaymentSysMemberId, "011104238")
+	require.Equal(t, model.Transactions[0].InstructedAgent.PaymentSysCode, models.PaymentSysUSABA)
+	require.Equal(t, model.Transactions[0].InstructedAgent.PaymentSysMemberId, "021040078")
+	require.Equal(t, model.Transactions[0].DebtorName, "Corporation A")
+	require.Equal(t, model.Transactions[0].DebtorAddress.StreetName, "Avenue of the Fountains")
+	require.Equal(t, model.Transactions[0].DebtorAddress.BuildingNumber, "167565")
+	require.Equal(t, model.Transactions[0].DebtorAddress.PostalCode, "85268")
+	require.Equal(t, model.Transactions[0].DebtorAddress.TownName, "Fountain Hills")
+	require.Equal(t, model.Transactions[0].DebtorAddress.Subdivision, "AZ")
+	require.Equal(t, model.Transactions[0].DebtorAddress.Country, "US")
+	require.Equal(t, model.Transactions[0].DebtorOtherTypeId, "5647772655")
+	require.Equal(t, model.Transactions[0].DebtorAgent.PaymentSysCode, models.PaymentSysUSABA)
+	require.Equal(t, model.Transactions[0].DebtorAgent.PaymentSysMemberId, "011104238")
+	require.Equal(t, model.Transactions[0].DebtorAgent.BankName, "Bank A")
+	require.Equal(t, model.Transactions[0].DebtorAgent.PostalAddress.StreetName, "Avenue A")
+	require.Equal(t, model.Transactions[0].DebtorAgent.PostalAddress.BuildingNumber, "66")
+	require.Equal(t, model.Transactions[0].DebtorAgent.PostalAddress.PostalCode, "60532")
+	require.Equal(t, model.Transactions[0].DebtorAgent.PostalAddress.TownName, "Lisle")
+	require.Equal(t, model.Transactions[0].DebtorAgent.PostalAddress.Subdivision, "IL")
+	require.Equal(t, model.Transactions[0].DebtorAgent.PostalAddress.Country, "US")
+	require.Equal(t, model.Transactions[0].CreditorAgent.PaymentSysCode, models.PaymentSysUSABA)
+	require.Equal(t, model.Transactions[0].CreditorAgent.PaymentSysMemberId, "021040078")
+	require.Equal(t, model.Transactions[0].CreditorAgent.BankName, "Bank B")
+	require.Equal(t, model.Transactions[0].CreditorAgent.PostalAddress.StreetName, "Avenue B")
+	require.Equal(t, model.Transactions[0].CreditorAgent.PostalAddress.BuildingNumber, "25")
+	require.Equal(t, model.Transactions[0].CreditorAgent.PostalAddress.PostalCode, "19067")
+	require.Equal(t, model.Transactions[0].CreditorAgent.PostalAddress.TownName, "Yardley")
+	require.Equal(t, model.Transactions[0].CreditorAgent.PostalAddress.Subdivision, "PA")
+	require.Equal(t, model.Transactions[0].CreditorAgent.PostalAddress.Country, "US")
+	require.Equal(t, model.Transactions[0].CreditorName, "Corporation B")
+	require.Equal(t, model.Transactions[0].CreditorPostalAddress.StreetName, "Desert View Street")
+	require.Equal(t, model.Transactions[0].CreditorPostalAddress.BuildingNumber, "1")
+	require.Equal(t, model.Transactions[0].CreditorPostalAddress.PostalCode, "19067")
+	require.Equal(t, model.Transactions[0].CreditorPostalAddress.TownName, "Palm Springs")
+	require.Equal(t, model.Transactions[0].CreditorPostalAddress.Subdivision, "CA")
+	require.Equal(t, model.Transactions[0].CreditorPostalAddress.Country, "US")
+	require.Equal(t, model.Transactions[0].CreditorOtherTypeId, "567876543")
+	require.Equal(t, model.Transactions[0].RemittanceInfor.CodeOrProprietary, models.CodeCINV)
+	require.Equal(t, model.Transactions[0].RemittanceInfor.Number, "INV34563")
+	require.NotNil(t, model.Transactions[0].RemittanceInfor.RelatedDate)
+	require.Equal(t, model.Transactions[0].RemittanceInfor.TaxDetail.TaxId, "123456789")
+	require.Equal(t, model.Transactions[0].RemittanceInfor.TaxDetail.TaxTypeCode, "09455")
+	require.NotNil(t, model.Transactions[0].RemittanceInfor.TaxDetail.TaxPeriodYear)
+	require.Equal(t, model.Transactions[0].RemittanceInfor.TaxDetail.TaxperiodTimeFrame, "MM04")
+	require.Equal(t, model.Transactions[0].RelatedRemittanceInfo.RemittanceId, "Scenario01Var2RemittanceId001")
+	require.Equal(t, model.Transactions[0].RelatedRemittanceInfo.Method, models.Email)
+	require.Equal(t, model.Transactions[0].RelatedRemittanceInfo.ElectronicAddress, "CustomerService@CorporationB.com")
 
 	/*Validation check*/
 	model.MessageId = "InvalideMessageIdLength5012345678901234567890"
@@ -576,70 +576,70 @@ func TestVersion07(t *testing.T) {
 	require.Equal(t, model.NumberOfTransactions, "1")
 	require.Equal(t, model.SettlementMethod, models.SettlementMethodType("CLRG"))
 	require.Equal(t, model.CommonClearingSysCode, models.CommonClearingSysCodeType("FDW"))
-	require.Equal(t, model.InstructionId, "Scenario01InstrId001")
-	require.Equal(t, model.EndToEndId, "Scenario01EtoEId001")
-	require.Equal(t, model.TaxId, "123456789")
-	require.Equal(t, model.InstrumentPropCode, models.InstrumentPropCodeType("CTRC"))
-	require.Equal(t, model.InterBankSettAmount.Amount, 510000.74)
-	require.Equal(t, model.InterBankSettAmount.Currency, "USD")
-	require.NotNil(t, model.InterBankSettDate)
-	require.Equal(t, model.InstructedAmount.Amount, 510000.74)
-	require.Equal(t, model.InstructedAmount.Currency, "USD")
-	require.Equal(t, model.ChargeBearer, models.ChargeBearerSLEV)
-	require.Equal(t, model.ChargesInfo[0].Amount.Amount, 90.00)
-	require.Equal(t, model.ChargesInfo[0].Amount.Currency, "USD")
-	require.Equal(t, model.ChargesInfo[0].BusinessIdCode, "BANZBEBB")
-	require.Equal(t, model.ChargesInfo[1].Amount.Amount, 40.00)
-	require.Equal(t, model.ChargesInfo[1].Amount.Currency, "USD")
-	require.Equal(t, model.ChargesInfo[1].BusinessIdCode, "BANCUS33")
-	require.Equal(t, model.InstructingAgent.PaymentSysCode, models.PaymentSysUSABA)
-	require.Equal(t, model.InstructingAgent.PaymentSysMemberId, "011104238")
-	require.Equal(t, model.InstructedAgent.PaymentSysCode, models.PaymentSysUSABA)
-	require.Equal(t, model.InstructedAgent.PaymentSysMemberId, "021040078")
-	require.Equal(t, model.DebtorName, "Corporation A")
-	require.Equal(t, model.DebtorAddress.StreetName, "Avenue of the Fountains")
-	require.Equal(t, model.DebtorAddress.BuildingNumber, "167565")
-	require.Equal(t, model.DebtorAddress.PostalCode, "85268")
-	require.Equal(t, model.DebtorAddress.TownName, "Fountain Hills")
-	require.Equal(t, model.DebtorAddress.Subdivision, "AZ")
-	require.Equal(t, model.DebtorAddress.Country, "US")
-	require.Equal(t, model.DebtorOtherTypeId, "5647772655")
-	require.Equal(t, model.DebtorAgent.PaymentSysCode, models.PaymentSysUSABA)
-	require.Equal(t, model.DebtorAgent.PaymentSysMemberId, "011104238")
-	require.Equal(t, model.DebtorAgent.BankName, "Bank A")
-	require.Equal(t, model.DebtorAgent.PostalAddress.StreetName, "Avenue A")
-	require.Equal(t, model.DebtorAgent.PostalAddress.BuildingNumber, "66")
-	require.Equal(t, model.DebtorAgent.PostalAddress.PostalCode, "60532")
-	require.Equal(t, model.DebtorAgent.PostalAddress.TownName, "Lisle")
-	require.Equal(t, model.DebtorAgent.PostalAddress.Subdivision, "IL")
-	require.Equal(t, model.DebtorAgent.PostalAddress.Country, "US")
-	require.Equal(t, model.CreditorAgent.PaymentSysCode, models.PaymentSysUSABA)
-	require.Equal(t, model.CreditorAgent.PaymentSysMemberId, "021040078")
-	require.Equal(t, model.CreditorAgent.BankName, "Bank B")
-	require.Equal(t, model.CreditorAgent.PostalAddress.StreetName, "Avenue B")
-	require.Equal(t, model.CreditorAgent.PostalAddress.BuildingNumber, "25")
-	require.Equal(t, model.CreditorAgent.PostalAddress.PostalCode, "19067")
-	require.Equal(t, model.CreditorAgent.PostalAddress.TownName, "Yardley")
-	require.Equal(t, model.CreditorAgent.PostalAddress.Subdivision, "PA")
-	require.Equal(t, model.CreditorAgent.PostalAddress.Country, "US")
-	require.Equal(t, model.CreditorName, "Corporation B")
-	require.Equal(t, model.CreditorPostalAddress.StreetName, "Desert View Street")
-	require.Equal(t, model.CreditorPostalAddress.BuildingNumber, "1")
-	require.Equal(t, model.CreditorPostalAddress.PostalCode, "19067")
-	require.Equal(t, model.CreditorPostalAddress.TownName, "Palm Springs")
-	require.Equal(t, model.CreditorPostalAddress.Subdivision, "CA")
-	require.Equal(t, model.CreditorPostalAddress.Country, "US")
-	require.Equal(t, model.CreditorOtherTypeId, "567876543")
-	require.Equal(t, model.RemittanceInfor.CodeOrProprietary, models.CodeCINV)
-	require.Equal(t, model.RemittanceInfor.Number, "INV34563")
-	require.NotNil(t, model.RemittanceInfor.RelatedDate)
-	require.Equal(t, model.RemittanceInfor.TaxDetail.TaxId, "123456789")
-	require.Equal(t, model.RemittanceInfor.TaxDetail.TaxTypeCode, "09455")
-	require.NotNil(t, model.RemittanceInfor.TaxDetail.TaxPeriodYear)
-	require.Equal(t, model.RemittanceInfor.TaxDetail.TaxperiodTimeFrame, "MM04")
-	require.Equal(t, model.RelatedRemittanceInfo.RemittanceId, "Scenario01Var2RemittanceId001")
-	require.Equal(t, model.RelatedRemittanceInfo.Method, models.Email)
-	require.Equal(t, model.RelatedRemittanceInfo.ElectronicAddress, "CustomerService@CorporationB.com")
+	require.Equal(t, model.Transactions[0].InstructionId, "Scenario01InstrId001")
+	require.Equal(t, model.Transactions[0].EndToEndId, "Scenario01EtoEId001")
+	require.Equal(t, model.Transactions[0].TaxId, "123456789")
+	require.Equal(t, model.Transactions[0].InstrumentPropCode, models.InstrumentPropCodeType("CTRC"))
+	require.Equal(t, model.Transactions[0].InterBankSettAmount.Amount, models.Decimal("510000.74"))
+	require.Equal(t, model.Transactions[0].InterBankSettAmount.Currency, "USD")
+	require.NotNil(t, model.Transactions[0].InterBankSettDate)
+	require.Equal(t, model.Transactions[0].InstructedAmount.Amount, models.Decimal("510000.74"))
+	require.Equal(t, model.Transactions[0].InstructedAmount.Currency, "USD")
+	require.Equal(t, model.Transactions[0].ChargeBearer, models.ChargeBearerSLEV)
+	require.Equal(t, model.Transactions[0].ChargesInfo[0].Amount.Amount, models.Decimal("90.00"))
+	require.Equal(t, model.Transactions[0].ChargesInfo[0].Amount.Currency, "USD")
+	require.Equal(t, model.Transactions[0].ChargesInfo[0].BusinessIdCode, "BANZBEBB")
+	require.Equal(t, model.Transactions[0].ChargesInfo[1].Amount.Amount, models.Decimal("40.00"))
+	require.Equal(t, model.Transactions[0].ChargesInfo[1].Amount.Currency, "USD")
+	require.Equal(t, model.Transactions[0].ChargesInfo[1].BusinessIdCode, "BANCUS33")
+	require.Equal(t, model.Transactions[0].InstructingAgent.PaymentSysCode, models.PaymentSysUSABA)
+	require.Equal(t, model.Transactions[0].InstructingAgent.PaymentSysMemberId, "011104238")
+	require.Equal(t, model.Transactions[0].InstructedAgent.PaymentSysCode, models.PaymentSysUSABA)
+	require.Equal(t, model.Transactions[0].InstructedAgent.PaymentSysMemberId, "021040078")
+	require.Equal(t, model.Transactions[0].DebtorName, "Corporation A")
+	require.Equal(t, model.Transactions[0].DebtorAddress.StreetName, "Avenue of the Fountains")
+	require.Equal(t, model.Transactions[0].DebtorAddress.BuildingNumber, "167565")
+	require.Equal(t, model.Transactions[0].DebtorAddress.PostalCode, "85268")
+	require.Equal(t, model.Transactions[0].DebtorAddress.TownName, "Fountain Hills")
+	require.Equal(t, model.Transactions[0].DebtorAddress.Subdivision, "AZ")
+	require.Equal(t, model.Transactions[0].DebtorAddress.Country, "US")
+	require.Equal(t, model.Transactions[0].DebtorOtherTypeId, "5647772655")
+	require.Equal(t, model.Transactions[0].DebtorAgent.PaymentSysCode, models.PaymentSysUSABA)
+	require.Equal(t, model.Transactions[0].DebtorAgent.PaymentSysMemberId, "011104238")
+	require.Equal(t, model.Transactions[0].DebtorAgent.BankName, "Bank A")
+	require.Equal(t, model.Transactions[0].DebtorAgent.PostalAddress.StreetName, "Avenue A")
+	require.Equal(t, model.Transactions[0].DebtorAgent.PostalAddress.BuildingNumber, "66")
+	require.Equal(t, model.Transactions[0].DebtorAgent.PostalAddress.PostalCode, "60532")
+	require.Equal(t, model.Transactions[0].DebtorAgent.PostalAddress.TownName, "Lisle")
+	require.Equal(t, model.Transactions[0].DebtorAgent.PostalAddress.Subdivision, "IL")
+	require.Equal(t, model.Transactions[0].DebtorAgent.PostalAddress.Country, "US")
+	require.Equal(t, model.Transactions[0].CreditorAgent.PaymentSysCode, models.PaymentSysUSABA)
+	require.Equal(t, model.Transactions[0].CreditorAgent.PaymentSysMemberId, "021040078")
+	require.Equal(t, model.Transactions[0].CreditorAgent.BankName, "Bank B")
+	require.Equal(t, model.Transactions[0].CreditorAgent.PostalAddress.StreetName, "Avenue B")
+	require.Equal(t, model.Transactions[0].CreditorAgent.PostalAddress.BuildingNumber, "25")
+	require.Equal(t, model.Transactions[0].CreditorAgent.PostalAddress.PostalCode, "19067")
+	require.Equal(t, model.Transactions[0].CreditorAgent.PostalAddress.TownName, "Yardley")
+	require.Equal(t, model.Transactions[0].CreditorAgent.PostalAddress.Subdivision, "PA")
+	require.Equal(t, model.Transactions[0].CreditorAgent.PostalAddress.Country, "US")
+	require.Equal(t, model.Transactions[0].CreditorName, "Corporation B")
+	require.Equal(t, model.Transactions[0].CreditorPostalAddress.StreetName, "Desert View Street")
+	require.Equal(t, model.Transactions[0].CreditorPostalAddress.BuildingNumber, "1")
+	require.Equal(t, model.Transactions[0].CreditorPostalAddress.PostalCode, "19067")
+	require.Equal(t, model.Transactions[0].CreditorPostalAddress.TownName, "Palm Springs")
+	require.Equal(t, model.Transactions[0].CreditorPostalAddress.Subdivision, "CA")
+	require.Equal(t, model.Transactions[0].CreditorPostalAddress.Country, "US")
+	require.Equal(t, model.Transactions[0].CreditorOtherTypeId, "567876543")
+	require.Equal(t, model.Transactions[0].RemittanceInfor.CodeOrProprietary, models.CodeCINV)
+	require.Equal(t, model.Transactions[0].RemittanceInfor.Number, "INV34563")
+	require.NotNil(t, model.Transactions[0].RemittanceInfor.RelatedDate)
+	require.Equal(t, model.Transactions[0].RemittanceInfor.TaxDetail.TaxId, "123456789")
+	require.Equal(t, model.Transactions[0].RemittanceInfor.TaxDetail.TaxTypeCode, "09455")
+	require.NotNil(t, model.Transactions[0].RemittanceInfor.TaxDetail.TaxPeriodYear)
+	require.Equal(t, model.Transactions[0].RemittanceInfor.TaxDetail.TaxperiodTimeFrame, "MM04")
+	require.Equal(t, model.Transactions[0].RelatedRemittanceInfo.RemittanceId, "Scenario01Var2RemittanceId001")
+	require.Equal(t, model.Transactions[0].RelatedRemittanceInfo.Method, models.Email)
+	require.Equal(t, model.Transactions[0].RelatedRemittanceInfo.ElectronicAddress, "CustomerService@CorporationB.com")
 
 	/*Validation check*/
 	model.MessageId = "InvalideMessageIdLength5012345678901234567890"
@@ -686,73 +686,73 @@ func TestVersion08(t *testing.T) {
 	require.Equal(t, model.NumberOfTransactions, "1")
 	require.Equal(t, model.SettlementMethod, models.SettlementMethodType("CLRG"))
 	require.Equal(t, model.CommonClearingSysCode, models.CommonClearingSysCodeType("FDW"))
-	require.Equal(t, model.InstructionId, "Scenario01InstrId001")
-	require.Equal(t, model.EndToEndId, "Scenario01EtoEId001")
-	require.NotNil(t, model.Transaction)
-	require.Equal(t, model.Transaction.UniqueEndToEndTransactionRef, "8a562c67-ca16-48ba-b074-65581be6f011")
-	require.Equal(t, model.InstrumentPropCode, models.InstrumentPropCodeType("CTRC"))
-	require.Equal(t, model.InterBankSettAmount.Amount, 510000.74)
-	require.Equal(t, model.InterBankSettAmount.Currency, "USD")
-	require.NotNil(t, model.InterBankSettDate)
-	require.Equal(t, model.InstructedAmount.Amount, 510000.74)
-	require.Equal(t, model.InstructedAmount.Currency, "USD")
-	require.Equal(t, model.ChargeBearer, models.ChargeBearerSLEV)
-	require.Equal(t, model.ChargesInfo[0].Amount.Amount, 90.00)
-	require.Equal(t, model.ChargesInfo[0].Amount.Currency, "USD")
-	require.Equal(t, model.ChargesInfo[0].BusinessIdCode, "BANZBEBB")
-	require.Equal(t, model.ChargesInfo[1].Amount.Amount, 40.00)
-	require.Equal(t, model.ChargesInfo[1].Amount.Currency, "USD")
-	require.Equal(t, model.ChargesInfo[1].BusinessIdCode, "BANCUS33")
-	require.Equal(t, model.InstructingAgent.PaymentSysCode, models.PaymentSysUSABA)
-	require.Equal(t, model.InstructingAgent.PaymentSysMemberId, "011104238")
-	require.Equal(t, model.InstructedAgent.PaymentSysCode, models.PaymentSysUSABA)
-	require.Equal(t, model.InstructedAgent.PaymentSysMemberId, "021040078")
-	require.Equal(t, model.DebtorName, "Corporation A")
-	require.Equal(t, model.DebtorAddress.StreetName, "Avenue of the Fountains")
-	require.Equal(t, model.DebtorAddress.BuildingNumber, "167565")
-	require.Equal(t, model.DebtorAddress.RoomNumber, "Suite D110")
-	require.Equal(t, model.DebtorAddress.PostalCode, "85268")
-	require.Equal(t, model.DebtorAddress.TownName, "Fountain Hills")
-	require.Equal(t, model.DebtorAddress.Subdivision, "AZ")
-	require.Equal(t, model.DebtorAddress.Country, "US")
-	require.Equal(t, model.DebtorOtherTypeId, "5647772655")
-	require.Equal(t, model.DebtorAgent.PaymentSysCode, models.PaymentSysUSABA)
-	require.Equal(t, model.DebtorAgent.PaymentSysMemberId, "011104238")
-	require.Equal(t, model.DebtorAgent.BankName, "Bank A")
-	require.Equal(t, model.DebtorAgent.PostalAddress.StreetName, "Avenue A")
-	require.Equal(t, model.DebtorAgent.PostalAddress.BuildingNumber, "66")
-	require.Equal(t, model.DebtorAgent.PostalAddress.PostalCode, "60532")
-	require.Equal(t, model.DebtorAgent.PostalAddress.TownName, "Lisle")
-	require.Equal(t, model.DebtorAgent.PostalAddress.Subdivision, "IL")
-	require.Equal(t, model.DebtorAgent.PostalAddress.Country, "US")
-	require.Equal(t, model.CreditorAgent.PaymentSysCode, models.PaymentSysUSABA)
-	require.Equal(t, model.CreditorAgent.PaymentSysMemberId, "021040078")
-	require.Equal(t, model.CreditorAgent.BankName, "Bank B")
-	require.Equal(t, model.CreditorAgent.PostalAddress.StreetName, "Avenue B")
-	require.Equal(t, model.CreditorAgent.PostalAddress.BuildingNumber, "25")
-	require.Equal(t, model.CreditorAgent.PostalAddress.PostalCode, "19067")
-	require.Equal(t, model.CreditorAgent.PostalAddress.TownName, "Yardley")
-	require.Equal(t, model.CreditorAgent.PostalAddress.Subdivision, "PA")
-	require.Equal(t, model.CreditorAgent.PostalAddress.Country, "US")
-	require.Equal(t, model.CreditorName, "Corporation B")
-	require.Equal(t, model.CreditorPostalAddress.StreetName, "Desert View Street")
-	require.Equal(t, model.CreditorPostalAddress.BuildingNumber, "1")
-	require.Equal(t, model.CreditorPostalAddress.Floor, "33")
-	require.Equal(t, model.CreditorPostalAddress.PostalCode, "19067")
-	require.Equal(t, model.CreditorPostalAddress.TownName, "Palm Springs")
-	require.Equal(t, model.CreditorPostalAddress.Subdivision, "CA")
-	require.Equal(t, model.CreditorPostalAddress.Country, "US")
-	require.Equal(t, model.CreditorOtherTypeId, "567876543")
-	require.Equal(t, model.RemittanceInfor.CodeOrProprietary, models.CodeCINV)
-	require.Equal(t, model.RemittanceInfor.Number, "INV34563")
-	require.NotNil(t, model.RemittanceInfor.RelatedDate)
-	require.Equal(t, model.RemittanceInfor.TaxDetail.TaxId, "123456789")
-	require.Equal(t, model.RemittanceInfor.TaxDetail.TaxTypeCode, "09455")
-	require.NotNil(t, model.RemittanceInfor.TaxDetail.TaxPeriodYear)
-	require.Equal(t, model.RemittanceInfor.TaxDetail.TaxperiodTimeFrame, "MM04")
-	require.Equal(t, model.RelatedRemittanceInfo.RemittanceId, "Scenario01Var2RemittanceId001")
-	require.Equal(t, model.RelatedRemittanceInfo.Method, models.Email)
-	require.Equal(t, model.RelatedRemittanceInfo.ElectronicAddress, "CustomerService@CorporationB.com")
+	require.Equal(t, model.Transactions[0].InstructionId, "Scenario01InstrId001")
+	require.Equal(t, model.Transactions[0].EndToEndId, "Scenario01EtoEId001")
+	require.NotNil(t, model.Transactions[0].Transaction)
+	require.Equal(t, model.Transactions[0].Transaction.UniqueEndToEndTransactionRef, "8a562c67-ca16-48ba-b074-65581be6f011")
+	require.Equal(t, model.Transactions[0].InstrumentPropCode, models.InstrumentPropCodeType("CTRC"))
+	require.Equal(t, model.Transactions[0].InterBankSettAmount.Amount, models.Decimal("510000.74"))
+	require.Equal(t, model.Transactions[0].InterBankSettAmount.Currency, "USD")
+	require.NotNil(t, model.Transactions[0].InterBankSettDate)
+	require.Equal(t, model.Transactions[0].InstructedAmount.Amount, models.Decimal("510000.74"))
+	require.Equal(t, model.Transactions[0].InstructedAmount.Currency, "USD")
+	require.Equal(t, model.Transactions[0].ChargeBearer, models.ChargeBearerSLEV)
+	require.Equal(t, model.Transactions[0].ChargesInfo[0].Amount.Amount, models.Decimal("90.00"))
+	require.Equal(t, model.Transactions[0].ChargesInfo[0].Amount.Currency, "USD")
+	require.Equal(t, model.Transactions[0].ChargesInfo[0].BusinessIdCode, "BANZBEBB")
+	require.Equal(t, model.Transactions[0].ChargesInfo[1].Amount.Amount, models.Decimal("40.00"))
+	require.Equal(t, model.Transactions[0].ChargesInfo[1].Amount.Currency, "USD")
+	require.Equal(t, model.Transactions[0].ChargesInfo[1].BusinessIdCode, "BANCUS33")
+	require.Equal(t, model.Transactions[0].InstructingAgent.PaymentSysCode, models.PaymentSysUSABA)
+	require.Equal(t, model.Transactions[0].InstructingAgent.PaymentSysMemberId, "011104238")
+	require.Equal(t, model.Transactions[0].InstructedAgent.PaymentSysCode, models.PaymentSysUSABA)
+	require.Equal(t, model.Transactions[0].InstructedAgent.PaymentSysMemberId, "021040078")
+	require.Equal(t, model.Transactions[0].DebtorName, "Corporation A")
+	require.Equal(t, model.Transactions[0].DebtorAddress.StreetName, "Avenue of the Fountains")
+	require.Equal(t, model.Transactions[0].DebtorAddress.BuildingNumber, "167565")
+	require.Equal(t, model.Transactions[0].DebtorAddress.RoomNumber, "Suite D110")
+	require.Equal(t, model.Transactions[0].DebtorAddress.PostalCode, "85268")
+	require.Equal(t, model.Transactions[0].DebtorAddress.TownName, "Fountain Hills")
+	require.Equal(t, model.Transactions[0].DebtorAddress.Subdivision, "AZ")
+	require.Equal(t, model.Transactions[0].DebtorAddress.Country, "US")
+	require.Equal(t, model.Transactions[0].DebtorOtherTypeId, "5647772655")
+	require.Equal(t, model.Transactions[0].DebtorAgent.PaymentSysCode, models.PaymentSysUSABA)
+	require.Equal(t, model.Transactions[0].DebtorAgent.PaymentSysMemberId, "011104238")
+	require.Equal(t, model.Transactions[0].DebtorAgent.BankName, "Bank A")
+	require.Equal(t, model.Transactions[0].DebtorAgent.PostalAddress.StreetName, "Avenue A")
+	require.Equal(t, model.Transactions[0].DebtorAgent.PostalAddress.BuildingNumber, "66")
+	require.Equal(t, model.Transactions[0].DebtorAgent.PostalAddress.PostalCode, "60532")
+	require.Equal(t, model.Transactions[0].DebtorAgent.PostalAddress.TownName, "Lisle")
+	require.Equal(t, model.Transactions[0].DebtorAgent.PostalAddress.Subdivision, "IL")
+	require.Equal(t, model.Transactions[0].DebtorAgent.PostalAddress.Country, "US")
+	require.Equal(t, model.Transactions[0].CreditorAgent.PaymentSysCode, models.PaymentSysUSABA)
+	require.Equal(t, model.Transactions[0].CreditorAgent.PaymentSysMemberId, "021040078")
+	require.Equal(t, model.Transactions[0].CreditorAgent.BankName, "Bank B")
+	require.Equal(t, model.Transactions[0].CreditorAgent.PostalAddress.StreetName, "Avenue B")
+	require.Equal(t, model.Transactions[0].CreditorAgent.PostalAddress.BuildingNumber, "25")
+	require.Equal(t, model.Transactions[0].CreditorAgent.PostalAddress.PostalCode, "19067")
+	require.Equal(t, model.Transactions[0].CreditorAgent.PostalAddress.TownName, "Yardley")
+	require.Equal(t, model.Transactions[0].CreditorAgent.PostalAddress.Subdivision, "PA")
+	require.Equal(t, model.Transactions[0].CreditorAgent.PostalAddress.Country, "US")
+	require.Equal(t, model.Transactions[0].CreditorName, "Corporation B")
+	require.Equal(t, model.Transactions[0].CreditorPostalAddress.StreetName, "Desert View Street")
+	require.Equal(t, model.Transactions[0].CreditorPostalAddress.BuildingNumber, "1")
+	require.Equal(t, model.Transactions[0].CreditorPostalAddress.Floor, "33")
+	require.Equal(t, model.Transactions[0].CreditorPostalAddress.PostalCode, "19067")
+	require.Equal(t, model.Transactions[0].CreditorPostalAddress.TownName, "Palm Springs")
+	require.Equal(t, model.Transactions[0].CreditorPostalAddress.Subdivision, "CA")
+	require.Equal(t, model.Transactions[0].CreditorPostalAddress.Country, "US")
+	require.Equal(t, model.Transactions[0].CreditorOtherTypeId, "567876543")
+	require.Equal(t, model.Transactions[0].RemittanceInfor.CodeOrProprietary, models.CodeCINV)
+	require.Equal(t, model.Transactions[0].RemittanceInfor.Number, "INV34563")
+	require.NotNil(t, model.Transactions[0].RemittanceInfor.RelatedDate)
+	require.Equal(t, model.Transactions[0].RemittanceInfor.TaxDetail.TaxId, "123456789")
+	require.Equal(t, model.Transactions[0].RemittanceInfor.TaxDetail.TaxTypeCode, "09455")
+	require.NotNil(t, model.Transactions[0].RemittanceInfor.TaxDetail.TaxPeriodYear)
+	require.Equal(t, model.Transactions[0].RemittanceInfor.TaxDetail.TaxperiodTimeFrame, "MM04")
+	require.Equal(t, model.Transactions[0].RelatedRemittanceInfo.RemittanceId, "Scenario01Var2RemittanceId001")
+	require.Equal(t, model.Transactions[0].RelatedRemittanceInfo.Method, models.Email)
+	require.Equal(t, model.Transactions[0].RelatedRemittanceInfo.ElectronicAddress, "CustomerService@CorporationB.com")
 
 	/*Validation check*/
 	model.MessageId = "InvalideMessageIdLength5012345678901234567890"
@@ -799,73 +799,73 @@ func TestVersion09(t *testing.T) {
 	require.Equal(t, model.NumberOfTransactions, "1")
 	require.Equal(t, model.SettlementMethod, models.SettlementMethodType("CLRG"))
 	require.Equal(t, model.CommonClearingSysCode, models.CommonClearingSysCodeType("FDW"))
-	require.Equal(t, model.InstructionId, "Scenario01InstrId001")
-	require.Equal(t, model.EndToEndId, "Scenario01EtoEId001")
-	require.NotNil(t, model.Transaction)
-	require.Equal(t, model.Transaction.UniqueEndToEndTransactionRef, "8a562c67-ca16-48ba-b074-65581be6f011")
-	require.Equal(t, model.InstrumentPropCode, models.InstrumentPropCodeType("CTRC"))
-	require.Equal(t, model.InterBankSettAmount.Amount, 510000.74)
-	require.Equal(t, model.InterBankSettAmount.Currency, "USD")
-	require.NotNil(t, model.InterBankSettDate)
-	require.Equal(t, model.InstructedAmount.Amount, 510000.74)
-	require.Equal(t, model.InstructedAmount.Currency, "USD")
-	require.Equal(t, model.ChargeBearer, models.ChargeBearerSLEV)
-	require.Equal(t, model.ChargesInfo[0].Amount.Amount, 90.00)
-	require.Equal(t, model.ChargesInfo[0].Amount.Currency, "USD")
-	require.Equal(t, model.ChargesInfo[0].BusinessIdCode, "BANZBEBB")
-	require.Equal(t, model.ChargesInfo[1].Amount.Amount, 40.00)
-	require.Equal(t, model.ChargesInfo[1].Amount.Currency, "USD")
-	require.Equal(t, model.ChargesInfo[1].BusinessIdCode, "BANCUS33")
-	require.Equal(t, model.InstructingAgent.PaymentSysCode, models.PaymentSysUSABA)
-	require.Equal(t, model.InstructingAgent.PaymentSysMemberId, "011104238")
-	require.Equal(t, model.InstructedAgent.PaymentSysCode, models.PaymentSysUSABA)
-	require.Equal(t, model.InstructedAgent.PaymentSysMemberId, "021040078")
-	require.Equal(t, model.DebtorName, "Corporation A")
-	require.Equal(t, model.DebtorAddress.StreetName, "Avenue of the Fountains")
-	require.Equal(t, model.DebtorAddress.BuildingNumber, "167565")
-	require.Equal(t, model.DebtorAddress.RoomNumber, "Suite D110")
-	require.Equal(t, model.DebtorAddress.PostalCode, "85268")
-	require.Equal(t, model.DebtorAddress.TownName, "Fountain Hills")
-	require.Equal(t, model.DebtorAddress.Subdivision, "AZ")
-	require.Equal(t, model.DebtorAddress.Country, "US")
-	require.Equal(t, model.DebtorOtherTypeId, "5647772655")
-	require.Equal(t, model.DebtorAgent.PaymentSysCode, models.PaymentSysUSABA)
-	require.Equal(t, model.DebtorAgent.PaymentSysMemberId, "011104238")
-	require.Equal(t, model.DebtorAgent.BankName, "Bank A")
-	require.Equal(t, model.DebtorAgent.PostalAddress.StreetName, "Avenue A")
-	require.Equal(t, model.DebtorAgent.PostalAddress.BuildingNumber, "66")
-	require.Equal(t, model.DebtorAgent.PostalAddress.PostalCode, "60532")
-	require.Equal(t, model.DebtorAgent.PostalAddress.TownName, "Lisle")
-	require.Equal(t, model.DebtorAgent.PostalAddress.Subdivision, "IL")
-	require.Equal(t, model.DebtorAgent.PostalAddress.Country, "US")
-	require.Equal(t, model.CreditorAgent.PaymentSysCode, models.PaymentSysUSABA)
-	require.Equal(t, model.CreditorAgent.PaymentSysMemberId, "021040078")
-	require.Equal(t, model.CreditorAgent.BankName, "Bank B")
-	require.Equal(t, model.CreditorAgent.PostalAddress.StreetName, "Avenue B")
-	require.Equal(t, model.CreditorAgent.PostalAddress.BuildingNumber, "25")
-	require.Equal(t, model.CreditorAgent.PostalAddress.PostalCode, "19067")
-	require.Equal(t, model.CreditorAgent.PostalAddress.TownName, "Yardley")
-	require.Equal(t, model.CreditorAgent.PostalAddress.Subdivision, "PA")
-	require.Equal(t, model.CreditorAgent.PostalAddress.Country, "US")
-	require.Equal(t, model.CreditorName, "Corporation B")
-	require.Equal(t, model.CreditorPostalAddress.StreetName, "Desert View Street")
-	require.Equal(t, model.CreditorPostalAddress.BuildingNumber, "1")
-	require.Equal(t, model.CreditorPostalAddress.Floor, "33")
-	require.Equal(t, model.CreditorPostalAddress.PostalCode, "19067")
-	require.Equal(t, model.CreditorPostalAddress.TownName, "Palm Springs")
-	require.Equal(t, model.CreditorPostalAddress.Subdivision, "CA")
-	require.Equal(t, model.CreditorPostalAddress.Country, "US")
-	require.Equal(t, model.CreditorOtherTypeId, "567876543")
-	require.Equal(t, model.RemittanceInfor.CodeOrProprietary, models.CodeCINV)
-	require.Equal(t, model.RemittanceInfor.Number, "INV34563")
-	require.NotNil(t, model.RemittanceInfor.RelatedDate)
-	require.Equal(t, model.RemittanceInfor.TaxDetail.TaxId, "123456789")
-	require.Equal(t, model.RemittanceInfor.TaxDetail.TaxTypeCode, "09455")
-	require.NotNil(t, model.RemittanceInfor.TaxDetail.TaxPeriodYear)
-	require.Equal(t, model.RemittanceInfor.TaxDetail.TaxperiodTimeFrame, "MM04")
-	require.Equal(t, model.RelatedRemittanceInfo.RemittanceId, "Scenario01Var2RemittanceId001")
-	require.Equal(t, model.RelatedRemittanceInfo.Method, models.Email)
-	require.Equal(t, model.RelatedRemittanceInfo.ElectronicAddress, "CustomerService@CorporationB.com")
+	require.Equal(t, model.Transactions[0].InstructionId, "Scenario01InstrId001")
+	require.Equal(t, model.Transactions[0].EndToEndId, "Scenario01EtoEId001")
+	require.NotNil(t, model.Transactions[0].Transaction)
+	require.Equal(t, model.Transactions[0].Transaction.UniqueEndToEndTransactionRef, "8a562c67-ca16-48ba-b074-65581be6f011")
+	require.Equal(t, model.Transactions[0].InstrumentPropCode, models.InstrumentPropCodeType("CTRC"))
+	require.Equal(t, model.Transactions[0].InterBankSettAmount.Amount, models.Decimal("510000.74"))
+	require.Equal(t, model.Transactions[0].InterBankSettAmount.Currency, "USD")
+	require.NotNil(t, model.Transactions[0].InterBankSettDate)
+	require.Equal(t, model.Transactions[0].InstructedAmount.Amount, models.Decimal("510000.74"))
+	require.Equal(t, model.Transactions[0].InstructedAmount.Currency, "USD")
+	require.Equal(t, model.Transactions[0].ChargeBearer, models.ChargeBearerSLEV)
+	require.Equal(t, model.Transactions[0].ChargesInfo[0].Amount.Amount, models.Decimal("90.00"))
+	require.Equal(t, model.Transactions[0].ChargesInfo[0].Amount.Currency, "USD")
+	require.Equal(t, model.Transactions[0].ChargesInfo[0].BusinessIdCode, "BANZBEBB")
+	require.Equal(t, model.Transactions[0].ChargesInfo[1].Amount.Amount, models.Decimal("40.00"))
+	require.Equal(t, model.Transactions[0].ChargesInfo[1].Amount.Currency, "USD")
+	require.Equal(t, model.Transactions[0].ChargesInfo[1].BusinessIdCode, "BANCUS33")
+	require.Equal(t, model.Transactions[0].InstructingAgent.PaymentSysCode, models.PaymentSysUSABA)
+	require.Equal(t, model.Transactions[0].InstructingAgent.PaymentSysMemberId, "011104238")
+	require.Equal(t, model.Transactions[0].InstructedAgent.PaymentSysCode, models.PaymentSysUSABA)
+	require.Equal(t, model.Transactions[0].InstructedAgent.PaymentSysMemberId, "021040078")
+	require.Equal(t, model.Transactions[0].DebtorName, "Corporation A")
+	require.Equal(t, model.Transactions[0].DebtorAddress.StreetName, "Avenue of the Fountains")
+	require.Equal(t, model.Transactions[0].DebtorAddress.BuildingNumber, "167565")
+	require.Equal(t, model.Transactions[0].DebtorAddress.RoomNumber, "Suite D110")
+	require.Equal(t, model.Transactions[0].DebtorAddress.PostalCode, "85268")
+	require.Equal(t, model.Transactions[0].DebtorAddress.TownName, "Fountain Hills")
+	require.Equal(t, model.Transactions[0].DebtorAddress.Subdivision, "AZ")
+	require.Equal(t, model.Transactions[0].DebtorAddress.Country, "US")
+	require.Equal(t, model.Transactions[0].DebtorOtherTypeId, "5647772655")
+	require.Equal(t, model.Transactions[0].DebtorAgent.PaymentSysCode, models.PaymentSysUSABA)
+	require.Equal(t, model.Transactions[0].DebtorAgent.PaymentSysMemberId, "011104238")
+	require.Equal(t, model.Transactions[0].DebtorAgent.BankName, "Bank A")
+	require.Equal(t, model.Transactions[0].DebtorAgent.PostalAddress.StreetName, "Avenue A")
+	require.Equal(t, model.Transactions[0].DebtorAgent.PostalAddress.BuildingNumber, "66")
+	require.Equal(t, model.Transactions[0].DebtorAgent.PostalAddress.PostalCode, "60532")
+	require.Equal(t, model.Transactions[0].DebtorAgent.PostalAddress.TownName, "Lisle")
+	require.Equal(t, model.Transactions[0].DebtorAgent.PostalAddress.Subdivision, "IL")
+	require.Equal(t, model.Transactions[0].DebtorAgent.PostalAddress.Country, "US")
+	require.Equal(t, model.Transactions[0].CreditorAgent.PaymentSysCode, models.PaymentSysUSABA)
+	require.Equal(t, model.Transactions[0].CreditorAgent.PaymentSysMemberId, "021040078")
+	require.Equal(t, model.Transactions[0].CreditorAgent.BankName, "Bank B")
+	require.Equal(t, model.Transactions[0].CreditorAgent.PostalAddress.StreetName, "Avenue B")
+	require.Equal(t, model.Transactions[0].CreditorAgent.PostalAddress.BuildingNumber, "25")
+	require.Equal(t, model.Transactions[0].CreditorAgent.PostalAddress.PostalCode, "19067")
+	require.Equal(t, model.Transactions[0].CreditorAgent.PostalAddress.TownName, "Yardley")
+	require.Equal(t, model.Transactions[0].CreditorAgent.PostalAddress.Subdivision, "PA")
+	require.Equal(t, model.Transactions[0].CreditorAgent.PostalAddress.Country, "US")
+	require.Equal(t, model.Transactions[0].CreditorName, "Corporation B")
+	require.Equal(t, model.Transactions[0].CreditorPostalAddress.StreetName, "Desert View Street")
+	require.Equal(t, model.Transactions[0].CreditorPostalAddress.BuildingNumber, "1")
+	require.Equal(t, model.Transactions[0].CreditorPostalAddress.Floor, "33")
+	require.Equal(t, model.Transactions[0].CreditorPostalAddress.PostalCode, "19067")
+	require.Equal(t, model.Transactions[0].CreditorPostalAddress.TownName, "Palm Springs")
+	require.Equal(t, model.Transactions[0].CreditorPostalAddress.Subdivision, "CA")
+	require.Equal(t, model.Transactions[0].CreditorPostalAddress.Country, "US")
+	require.Equal(t, model.Transactions[0].CreditorOtherTypeId, "567876543")
+	require.Equal(t, model.Transactions[0].RemittanceInfor.CodeOrProprietary, models.CodeCINV)
+	require.Equal(t, model.Transactions[0].RemittanceInfor.Number, "INV34563")
+	require.NotNil(t, model.Transactions[0].RemittanceInfor.RelatedDate)
+	require.Equal(t, model.Transactions[0].RemittanceInfor.TaxDetail.TaxId, "123456789")
+	require.Equal(t, model.Transactions[0].RemittanceInfor.TaxDetail.TaxTypeCode, "09455")
+	require.NotNil(t, model.Transactions[0].RemittanceInfor.TaxDetail.TaxPeriodYear)
+	require.Equal(t, model.Transactions[0].RemittanceInfor.TaxDetail.TaxperiodTimeFrame, "MM04")
+	require.Equal(t, model.Transactions[0].RelatedRemittanceInfo.RemittanceId, "Scenario01Var2RemittanceId001")
+	require.Equal(t, model.Transactions[0].RelatedRemittanceInfo.Method, models.Email)
+	require.Equal(t, model.Transactions[0].RelatedRemittanceInfo.ElectronicAddress, "CustomerService@CorporationB.com")
 
 	/*Validation check*/
 	model.MessageId = "InvalideMessageIdLength5012345678901234567890"
@@ -912,73 +912,73 @@ func TestVersion10(t *testing.T) {
 	require.Equal(t, model.NumberOfTransactions, "1")
 	require.Equal(t, model.SettlementMethod, models.SettlementMethodType("CLRG"))
 	require.Equal(t, model.CommonClearingSysCode, models.CommonClearingSysCodeType("FDW"))
-	require.Equal(t, model.InstructionId, "Scenario01InstrId001")
-	require.Equal(t, model.EndToEndId, "Scenario01EtoEId001")
-	require.NotNil(t, model.Transaction)
-	require.Equal(t, model.Transaction.UniqueEndToEndTransactionRef, "8a562c67-ca16-48ba-b074-65581be6f011")
-	require.Equal(t, model.InstrumentPropCode, models.InstrumentPropCodeType("CTRC"))
-	require.Equal(t, model.InterBankSettAmount.Amount, 510000.74)
-	require.Equal(t, model.InterBankSettAmount.Currency, "USD")
-	require.NotNil(t, model.InterBankSettDate)
-	require.Equal(t, model.InstructedAmount.Amount, 510000.74)
-	require.Equal(t, model.InstructedAmount.Currency, "USD")
-	require.Equal(t, model.ChargeBearer, models.ChargeBearerSLEV)
-	require.Equal(t, model.ChargesInfo[0].Amount.Amount, 90.00)
-	require.Equal(t, model.ChargesInfo[0].Amount.Currency, "USD")
-	require.Equal(t, model.ChargesInfo[0].BusinessIdCode, "BANZBEBB")
-	require.Equal(t, model.ChargesInfo[1].Amount.Amount, 40.00)
-	require.Equal(t, model.ChargesInfo[1].Amount.Currency, "USD")
-	require.Equal(t, model.ChargesInfo[1].BusinessIdCode, "BANCUS33")
-	require.Equal(t, model.InstructingAgent.PaymentSysCode, models.PaymentSysUSABA)
-	require.Equal(t, model.InstructingAgent.PaymentSysMemberId, "011104238")
-	require.Equal(t, model.InstructedAgent.PaymentSysCode, models.PaymentSysUSABA)
-	require.Equal(t, model.InstructedAgent.PaymentSysMemberId, "021040078")
-	require.Equal(t, model.DebtorName, "Corporation A")
-	require.Equal(t, model.DebtorAddress.StreetName, "Avenue of the Fountains")
-	require.Equal(t, model.DebtorAddress.BuildingNumber, "167565")
-	require.Equal(t, model.DebtorAddress.RoomNumber, "Suite D110")
-	require.Equal(t, model.DebtorAddress.PostalCode, "85268")
-	require.Equal(t, model.DebtorAddress.TownName, "Fountain Hills")
-	require.Equal(t, model.DebtorAddress.Subdivision, "AZ")
-	require.Equal(t, model.DebtorAddress.Country, "US")
-	require.Equal(t, model.DebtorOtherTypeId, "5647772655")
-	require.Equal(t, model.DebtorAgent.PaymentSysCode, models.PaymentSysUSABA)
-	require.Equal(t, model.DebtorAgent.PaymentSysMemberId, "011104238")
-	require.Equal(t, model.DebtorAgent.BankName, "Bank A")
-	require.Equal(t, model.DebtorAgent.PostalAddress.StreetName, "Avenue A")
-	require.Equal(t, model.DebtorAgent.PostalAddress.BuildingNumber, "66")
-	require.Equal(t, model.DebtorAgent.PostalAddress.PostalCode, "60532")
-	require.Equal(t, model.DebtorAgent.PostalAddress.TownName, "Lisle")
-	require.Equal(t, model.DebtorAgent.PostalAddress.Subdivision, "IL")
-	require.Equal(t, model.DebtorAgent.PostalAddress.Country, "US")
-	require.Equal(t, model.CreditorAgent.PaymentSysCode, models.PaymentSysUSABA)
-	require.Equal(t, model.CreditorAgent.PaymentSysMemberId, "021040078")
-	require.Equal(t, model.CreditorAgent.BankName, "Bank B")
-	require.Equal(t, model.CreditorAgent.PostalAddress.StreetName, "Avenue B")
-	require.Equal(t, model.CreditorAgent.PostalAddress.BuildingNumber, "25")
-	require.Equal(t, model.CreditorAgent.PostalAddress.PostalCode, "19067")
-	require.Equal(t, model.CreditorAgent.PostalAddress.TownName, "Yardley")
-	require.Equal(t, model.CreditorAgent.PostalAddress.Subdivision, "PA")
-	require.Equal(t, model.CreditorAgent.PostalAddress.Country, "US")
-	require.Equal(t, model.CreditorName, "Corporation B")
-	require.Equal(t, model.CreditorPostalAddress.StreetName, "Desert View Street")
-	require.Equal(t, model.CreditorPostalAddress.BuildingNumber, "1")
-	require.Equal(t, model.CreditorPostalAddress.Floor, "33")
-	require.Equal(t, model.CreditorPostalAddress.PostalCode, "19067")
-	require.Equal(t, model.CreditorPostalAddress.TownName, "Palm Springs")
-	require.Equal(t, model.CreditorPostalAddress.Subdivision, "CA")
-	require.Equal(t, model.CreditorPostalAddress.Country, "US")
-	require.Equal(t, model.CreditorOtherTypeId, "567876543")
-	require.Equal(t, model.RemittanceInfor.CodeOrProprietary, models.CodeCINV)
-	require.Equal(t, model.RemittanceInfor.Number, "INV34563")
-	require.NotNil(t, model.RemittanceInfor.RelatedDate)
-	require.Equal(t, model.RemittanceInfor.TaxDetail.TaxId, "123456789")
-	require.Equal(t, model.RemittanceInfor.TaxDetail.TaxTypeCode, "09455")
-	require.NotNil(t, model.RemittanceInfor.TaxDetail.TaxPeriodYear)
-	require.Equal(t, model.RemittanceInfor.TaxDetail.TaxperiodTimeFrame, "MM04")
-	require.Equal(t, model.RelatedRemittanceInfo.RemittanceId, "Scenario01Var2RemittanceId001")
-	require.Equal(t, model.RelatedRemittanceInfo.Method, models.Email)
-	require.Equal(t, model.RelatedRemittanceInfo.ElectronicAddress, "CustomerService@CorporationB.com")
+	require.Equal(t, model.Transactions[0].InstructionId, "Scenario01InstrId001")
+	require.Equal(t, model.Transactions[0].EndToEndId, "Scenario01EtoEId001")
+	require.NotNil(t, model.Transactions[0].Transaction)
+	require.Equal(t, model.Transactions[0].Transaction.UniqueEndToEndTransactionRef, "8a562c67-ca16-48ba-b074-65581be6f011")
+	require.Equal(t, model.Transactions[0].InstrumentPropCode, models.InstrumentPropCodeType("CTRC"))
+	require.Equal(t, model.Transactions[0].InterBankSettAmount.Amount, models.Decimal("510000.74"))
+	require.Equal(t, model.Transactions[0].InterBankSettAmount.Currency, "USD")
+	require.NotNil(t, model.Transactions[0].InterBankSettDate)
+	require.Equal(t, model.Transactions[0].InstructedAmount.Amount, models.Decimal("510000.74"))
+	require.Equal(t, model.Transactions[0].InstructedAmount.Currency, "USD")
+	require.Equal(t, model.Transactions[0].ChargeBearer, models.ChargeBearerSLEV)
+	require.Equal(t, model.Transactions[0].ChargesInfo[0].Amount.Amount, models.Decimal("90.00"))
+	require.Equal(t, model.Transactions[0].ChargesInfo[0].Amount.Currency, "USD")
+	require.Equal(t, model.Transactions[0].ChargesInfo[0].BusinessIdCode, "BANZBEBB")
+	require.Equal(t, model.Transactions[0].ChargesInfo[1].Amount.Amount, models.Decimal("40.00"))
+	require.Equal(t, model.Transactions[0].ChargesInfo[1].Amount.Currency, "USD")
+	require.Equal(t, model.Transactions[0].ChargesInfo[1].BusinessIdCode, "BANCUS33")
+	require.Equal(t, model.Transactions[0].InstructingAgent.PaymentSysCode, models.PaymentSysUSABA)
+	require.Equal(t, model.Transactions[0].InstructingAgent.PaymentSysMemberId, "011104238")
+	require.Equal(t, model.Transactions[0].InstructedAgent.PaymentSysCode, models.PaymentSysUSABA)
+	require.Equal(t, model.Transactions[0].InstructedAgent.PaymentSysMemberId, "021040078")
+	require.Equal(t, model.Transactions[0].DebtorName, "Corporation A")
+	require.Equal(t, model.Transactions[0].DebtorAddress.StreetName, "Avenue of the Fountains")
+	require.Equal(t, model.Transactions[0].DebtorAddress.BuildingNumber, "167565")
+	require.Equal(t, model.Transactions[0].DebtorAddress.RoomNumber, "Suite D110")
+	require.Equal(t, model.Transactions[0].DebtorAddress.PostalCode, "85268")
+	require.Equal(t, model.Transactions[0].DebtorAddress.TownName, "Fountain Hills")
+	require.Equal(t, model.Transactions[0].DebtorAddress.Subdivision, "AZ")
+	require.Equal(t, model.Transactions[0].DebtorAddress.Country, "US")
+	require.Equal(t, model.Transactions[0].DebtorOtherTypeId, "5647772655")
+	require.Equal(t, model.Transactions[0].DebtorAgent.PaymentSysCode, models.PaymentSysUSABA)
+	require.Equal(t, model.Transactions[0].DebtorAgent.PaymentSysMemberId, "011104238")
+	require.Equal(t, model.Transactions[0].DebtorAgent.BankName, "Bank A")
+	require.Equal(t, model.Transactions[0].DebtorAgent.PostalAddress.StreetName, "Avenue A")
+	require.Equal(t, model.Transactions[0].DebtorAgent.PostalAddress.BuildingNumber, "66")
+	require.Equal(t, model.Transactions[0].DebtorAgent.PostalAddress.PostalCode, "60532")
+	require.Equal(t, model.Transactions[0].DebtorAgent.PostalAddress.TownName, "Lisle")
+	require.Equal(t, model.Transactions[0].DebtorAgent.PostalAddress.Subdivision, "IL")
+	require.Equal(t, model.Transactions[0].DebtorAgent.PostalAddress.Country, "US")
+	require.Equal(t, model.Transactions[0].CreditorAgent.PaymentSysCode, models.PaymentSysUSABA)
+	require.Equal(t, model.Transactions[0].CreditorAgent.PaymentSysMemberId, "021040078")
+	require.Equal(t, model.Transactions[0].CreditorAgent.BankName, "Bank B")
+	require.Equal(t, model.Transactions[0].CreditorAgent.PostalAddress.StreetName, "Avenue B")
+	require.Equal(t, model.Transactions[0].CreditorAgent.PostalAddress.BuildingNumber, "25")
+	require.Equal(t, model.Transactions[0].CreditorAgent.PostalAddress.PostalCode, "19067")
+	require.Equal(t, model.Transactions[0].CreditorAgent.PostalAddress.TownName, "Yardley")
+	require.Equal(t, model.Transactions[0].CreditorAgent.PostalAddress.Subdivision, "PA")
+	require.Equal(t, model.Transactions[0].CreditorAgent.PostalAddress.Country, "US")
+	require.Equal(t, model.Transactions[0].CreditorName, "Corporation B")
+	require.Equal(t, model.Transactions[0].CreditorPostalAddress.StreetName, "Desert View Street")
+	require.Equal(t, model.Transactions[0].CreditorPostalAddress.BuildingNumber, "1")
+	require.Equal(t, model.Transactions[0].CreditorPostalAddress.Floor, "33")
+	require.Equal(t, model.Transactions[0].CreditorPostalAddress.PostalCode, "19067")
+	require.Equal(t, model.Transactions[0].CreditorPostalAddress.TownName, "Palm Springs")
+	require.Equal(t, model.Transactions[0].CreditorPostalAddress.Subdivision, "CA")
+	require.Equal(t, model.Transactions[0].CreditorPostalAddress.Country, "US")
+	require.Equal(t, model.Transactions[0].CreditorOtherTypeId, "567876543")
+	require.Equal(t, model.Transactions[0].RemittanceInfor.CodeOrProprietary, models.CodeCINV)
+	require.Equal(t, model.Transactions[0].RemittanceInfor.Number, "INV34563")
+	require.NotNil(t, model.Transactions[0].RemittanceInfor.RelatedDate)
+	require.Equal(t, model.Transactions[0].RemittanceInfor.TaxDetail.TaxId, "123456789")
+	require.Equal(t, model.Transactions[0].RemittanceInfor.TaxDetail.TaxTypeCode, "09455")
+	require.NotNil(t, model.Transactions[0].RemittanceInfor.TaxDetail.TaxPeriodYear)
+	require.Equal(t, model.Transactions[0].RemittanceInfor.TaxDetail.TaxperiodTimeFrame, "MM04")
+	require.Equal(t, model.Transactions[0].RelatedRemittanceInfo.RemittanceId, "Scenario01Var2RemittanceId001")
+	require.Equal(t, model.Transactions[0].RelatedRemittanceInfo.Method, models.Email)
+	require.Equal(t, model.Transactions[0].RelatedRemittanceInfo.ElectronicAddress, "CustomerService@CorporationB.com")
 
 	/*Validation check*/
 	model.MessageId = "InvalideMessageIdLength5012345678901234567890"
@@ -1025,73 +1025,73 @@ func TestVersion11(t *testing.T) {
 	require.Equal(t, model.NumberOfTransactions, "1")
 	require.Equal(t, model.SettlementMethod, models.SettlementMethodType("CLRG"))
 	require.Equal(t, model.CommonClearingSysCode, models.CommonClearingSysCodeType("FDW"))
-	require.Equal(t, model.InstructionId, "Scenario01InstrId001")
-	require.Equal(t, model.EndToEndId, "Scenario01EtoEId001")
-	require.NotNil(t, model.Transaction)
-	require.Equal(t, model.Transaction.UniqueEndToEndTransactionRef, "8a562c67-ca16-48ba-b074-65581be6f011")
-	require.Equal(t, model.InstrumentPropCode, models.InstrumentPropCodeType("CTRC"))
-	require.Equal(t, model.InterBankSettAmount.Amount, 510000.74)
-	require.Equal(t, model.InterBankSettAmount.Currency, "USD")
-	require.NotNil(t, model.InterBankSettDate)
-	require.Equal(t, model.InstructedAmount.Amount, 510000.74)
-	require.Equal(t, model.InstructedAmount.Currency, "USD")
-	require.Equal(t, model.ChargeBearer, models.ChargeBearerSLEV)
-	require.Equal(t, model.ChargesInfo[0].Amount.Amount, 90.00)
-	require.Equal(t, model.ChargesInfo[0].Amount.Currency, "USD")
-	require.Equal(t, model.ChargesInfo[0].BusinessIdCode, "BANZBEBB")
-	require.Equal(t, model.ChargesInfo[1].Amount.Amount, 40.00)
-	require.Equal(t, model.ChargesInfo[1].Amount.Currency, "USD")
-	require.Equal(t, model.ChargesInfo[1].BusinessIdCode, "BANCUS33")
-	require.Equal(t, model.InstructingAgent.PaymentSysCode, models.PaymentSysUSABA)
-	require.Equal(t, model.InstructingAgent.PaymentSysMemberId, "011104238")
-	require.Equal(t, model.InstructedAgent.PaymentSysCode, models.PaymentSysUSABA)
-	require.Equal(t, model.InstructedAgent.PaymentSysMemberId, "021040078")
-	require.Equal(t, model.DebtorName, "Corporation A")
-	require.Equal(t, model.DebtorAddress.StreetName, "Avenue of the Fountains")
-	require.Equal(t, model.DebtorAddress.BuildingNumber, "167565")
-	require.Equal(t, model.DebtorAddress.RoomNumber, "Suite D110")
-	require.Equal(t, model.DebtorAddress.PostalCode, "85268")
-	require.Equal(t, model.DebtorAddress.TownName, "Fountain Hills")
-	require.Equal(t, model.DebtorAddress.Subdivision, "AZ")
-	require.Equal(t, model.DebtorAddress.Country, "US")
-	require.Equal(t, model.DebtorOtherTypeId, "5647772655")
-	require.Equal(t, model.DebtorAgent.PaymentSysCode, models.PaymentSysUSABA)
-	require.Equal(t, model.DebtorAgent.PaymentSysMemberId, "011104238")
-	require.Equal(t, model.DebtorAgent.BankName, "Bank A")
-	require.Equal(t, model.DebtorAgent.PostalAddress.StreetName, "Avenue A")
-	require.Equal(t, model.DebtorAgent.PostalAddress.BuildingNumber, "66")
-	require.Equal(t, model.DebtorAgent.PostalAddress.PostalCode, "60532")
-	require.Equal(t, model.DebtorAgent.PostalAddress.TownName, "Lisle")
-	require.Equal(t, model.DebtorAgent.PostalAddress.Subdivision, "IL")
-	require.Equal(t, model.DebtorAgent.PostalAddress.Country, "US")
-	require.Equal(t, model.CreditorAgent.PaymentSysCode, models.PaymentSysUSABA)
-	require.Equal(t, model.CreditorAgent.PaymentSysMemberId, "021040078")
-	require.Equal(t, model.CreditorAgent.BankName, "Bank B")
-	require.Equal(t, model.CreditorAgent.PostalAddress.StreetName, "Avenue B")
-	require.Equal(t, model.CreditorAgent.PostalAddress.BuildingNumber, "25")
-	require.Equal(t, model.CreditorAgent.PostalAddress.PostalCode, "19067")
-	require.Equal(t, model.CreditorAgent.PostalAddress.TownName, "Yardley")
-	require.Equal(t, model.CreditorAgent.PostalAddress.Subdivision, "PA")
-	require.Equal(t, model.CreditorAgent.PostalAddress.Country, "US")
-	require.Equal(t, model.CreditorName, "Corporation B")
-	require.Equal(t, model.CreditorPostalAddress.StreetName, "Desert View Street")
-	require.Equal(t, model.CreditorPostalAddress.BuildingNumber, "1")
-	require.Equal(t, model.CreditorPostalAddress.Floor, "33")
-	require.Equal(t, model.CreditorPostalAddress.PostalCode, "19067")
-	require.Equal(t, model.CreditorPostalAddress.TownName, "Palm Springs")
-	require.Equal(t, model.CreditorPostalAddress.Subdivision, "CA")
-	require.Equal(t, model.CreditorPostalAddress.Country, "US")
-	require.Equal(t, model.CreditorOtherTypeId, "567876543")
-	require.Equal(t, model.RemittanceInfor.CodeOrProprietary, models.CodeCINV)
-	require.Equal(t, model.RemittanceInfor.Number, "INV34563")
-	require.NotNil(t, model.RemittanceInfor.RelatedDate)
-	require.Equal(t, model.RemittanceInfor.TaxDetail.TaxId, "123456789")
-	require.Equal(t, model.RemittanceInfor.TaxDetail.TaxTypeCode, "09455")
-	require.NotNil(t, model.RemittanceInfor.TaxDetail.TaxPeriodYear)
-	require.Equal(t, model.RemittanceInfor.TaxDetail.TaxperiodTimeFrame, "MM04")
-	require.Equal(t, model.RelatedRemittanceInfo.RemittanceId, "Scenario01Var2RemittanceId001")
-	require.Equal(t, model.RelatedRemittanceInfo.Method, models.Email)
-	require.Equal(t, model.RelatedRemittanceInfo.ElectronicAddress, "CustomerService@CorporationB.com")
+	require.Equal(t, model.Transactions[0].InstructionId, "Scenario01InstrId001")
+	require.Equal(t, model.Transactions[0].EndToEndId, "Scenario01EtoEId001")
+	require.NotNil(t, model.Transactions[0].Transaction)
+	require.Equal(t, model.Transactions[0].Transaction.UniqueEndToEndTransactionRef, "8a562c67-ca16-48ba-b074-65581be6f011")
+	require.Equal(t, model.Transactions[0].InstrumentPropCode, models.InstrumentPropCodeType("CTRC"))
+	require.Equal(t, model.Transactions[0].InterBankSettAmount.Amount, models.Decimal("510000.74"))
+	require.Equal(t, model.Transactions[0].InterBankSettAmount.Currency, "USD")
+	require.NotNil(t, model.Transactions[0].InterBankSettDate)
+	require.Equal(t, model.Transactions[0].InstructedAmount.Amount, models.Decimal("510000.74"))
+	require.Equal(t, model.Transactions[0].InstructedAmount.Currency, "USD")
+	require.Equal(t, model.Transactions[0].ChargeBearer, models.ChargeBearerSLEV)
+	require.Equal(t, model.Transactions[0].ChargesInfo[0].Amount.Amount, models.Decimal("90.00"))
+	require.Equal(t, model.Transactions[0].ChargesInfo[0].Amount.Currency, "USD")
+	require.Equal(t, model.Transactions[0].ChargesInfo[0].BusinessIdCode, "BANZBEBB")
+	require.Equal(t, model.Transactions[0].ChargesInfo[1].Amount.Amount, models.Decimal("40.00"))
+	require.Equal(t, model.Transactions[0].ChargesInfo[1].Amount.Currency, "USD")
+	require.Equal(t, model.Transactions[0].ChargesInfo[1].BusinessIdCode, "BANCUS33")
+	require.Equal(t, model.Transactions[0].InstructingAgent.PaymentSysCode, models.PaymentSysUSABA)
+	require.Equal(t, model.Transactions[0].InstructingAgent.PaymentSysMemberId, "011104238")
+	require.Equal(t, model.Transactions[0].InstructedAgent.PaymentSysCode, models.PaymentSysUSABA)
+	require.Equal(t, model.Transactions[0].InstructedAgent.PaymentSysMemberId, "021040078")
+	require.Equal(t, model.Transactions[0].DebtorName, "Corporation A")
+	require.Equal(t, model.Transactions[0].DebtorAddress.StreetName, "Avenue of the Fountains")
+	require.Equal(t, model.Transactions[0].DebtorAddress.BuildingNumber, "167565")
+	require.Equal(t, model.Transactions[0].DebtorAddress.RoomNumber, "Suite D110")
+	require.Equal(t, model.Transactions[0].DebtorAddress.PostalCode, "85268")
+	require.Equal(t, model.Transactions[0].DebtorAddress.TownName, "Fountain Hills")
+	require.Equal(t, model.Transactions[0].DebtorAddress.Subdivision, "AZ")
+	require.Equal(t, model.Transactions[0].DebtorAddress.Country, "US")
+	require.Equal(t, model.Transactions[0].DebtorOtherTypeId, "5647772655")
+	require.Equal(t, model.Transactions[0].DebtorAgent.PaymentSysCode, models.PaymentSysUSABA)
+	require.Equal(t, model.Transactions[0].DebtorAgent.PaymentSysMemberId, "011104238")
+	require.Equal(t, model.Transactions[0].DebtorAgent.BankName, "Bank A")
+	require.Equal(t, model.Transactions[0].DebtorAgent.PostalAddress.StreetName, "Avenue A")
+	require.Equal(t, model.Transactions[0].DebtorAgent.PostalAddress.BuildingNumber, "66")
+	require.Equal(t, model.Transactions[0].DebtorAgent.PostalAddress.PostalCode, "60532")
+	require.Equal(t, model.Transactions[0].DebtorAgent.PostalAddress.TownName, "Lisle")
+	require.Equal(t, model.Transactions[0].DebtorAgent.PostalAddress.Subdivision, "IL")
+	require.Equal(t, model.Transactions[0].DebtorAgent.PostalAddress.Country, "US")
+	require.Equal(t, model.Transactions[0].CreditorAgent.PaymentSysCode, models.PaymentSysUSABA)
+	require.Equal(t, model.Transactions[0].CreditorAgent.PaymentSysMemberId, "021040078")
+	require.Equal(t, model.Transactions[0].CreditorAgent.BankName, "Bank B")
+	require.Equal(t, model.Transactions[0].CreditorAgent.PostalAddress.StreetName, "Avenue B")
+	require.Equal(t, model.Transactions[0].CreditorAgent.PostalAddress.BuildingNumber, "25")
+	require.Equal(t, model.Transactions[0].CreditorAgent.PostalAddress.PostalCode, "19067")
+	require.Equal(t, model.Transactions[0].CreditorAgent.PostalAddress.TownName, "Yardley")
+	require.Equal(t, model.Transactions[0].CreditorAgent.PostalAddress.Subdivision, "PA")
+	require.Equal(t, model.Transactions[0].CreditorAgent.PostalAddress.Country, "US")
+	require.Equal(t, model.Transactions[0].CreditorName, "Corporation B")
+	require.Equal(t, model.Transactions[0].CreditorPostalAddress.StreetName, "Desert View Street")
+	require.Equal(t, model.Transactions[0].CreditorPostalAddress.BuildingNumber, "1")
+	require.Equal(t, model.Transactions[0].CreditorPostalAddress.Floor, "33")
+	require.Equal(t, model.Transactions[0].CreditorPostalAddress.PostalCode, "19067")
+	require.Equal(t, model.Transactions[0].CreditorPostalAddress.TownName, "Palm Springs")
+	require.Equal(t, model.Transactions[0].CreditorPostalAddress.Subdivision, "CA")
+	require.Equal(t, model.Transactions[0].CreditorPostalAddress.Country, "US")
+	require.Equal(t, model.Transactions[0].CreditorOtherTypeId, "567876543")
+	require.Equal(t, model.Transactions[0].RemittanceInfor.CodeOrProprietary, models.CodeCINV)
+	require.Equal(t, model.Transactions[0].RemittanceInfor.Number, "INV34563")
+	require.NotNil(t, model.Transactions[0].RemittanceInfor.RelatedDate)
+	require.Equal(t, model.Transactions[0].RemittanceInfor.TaxDetail.TaxId, "123456789")
+	require.Equal(t, model.Transactions[0].RemittanceInfor.TaxDetail.TaxTypeCode, "09455")
+	require.NotNil(t, model.Transactions[0].RemittanceInfor.TaxDetail.TaxPeriodYear)
+	require.Equal(t, model.Transactions[0].RemittanceInfor.TaxDetail.TaxperiodTimeFrame, "MM04")
+	require.Equal(t, model.Transactions[0].RelatedRemittanceInfo.RemittanceId, "Scenario01Var2RemittanceId001")
+	require.Equal(t, model.Transactions[0].RelatedRemittanceInfo.Method, models.Email)
+	require.Equal(t, model.Transactions[0].RelatedRemittanceInfo.ElectronicAddress, "CustomerService@CorporationB.com")
 
 	/*Validation check*/
 	model.MessageId = "InvalideMessageIdLength5012345678901234567890"
@@ -1138,73 +1138,73 @@ func TestVersion12(t *testing.T) {
 	require.Equal(t, model.NumberOfTransactions, "1")
 	require.Equal(t, model.SettlementMethod, models.SettlementMethodType("CLRG"))
 	require.Equal(t, model.CommonClearingSysCode, models.CommonClearingSysCodeType("FDW"))
-	require.Equal(t, model.InstructionId, "Scenario01InstrId001")
-	require.Equal(t, model.EndToEndId, "Scenario01EtoEId001")
-	require.NotNil(t, model.Transaction)
-	require.Equal(t, model.Transaction.UniqueEndToEndTransactionRef, "8a562c67-ca16-48ba-b074-65581be6f011")
-	require.Equal(t, model.InstrumentPropCode, models.InstrumentPropCodeType("CTRC"))
-	require.Equal(t, model.InterBankSettAmount.Amount, 510000.74)
-	require.Equal(t, model.InterBankSettAmount.Currency, "USD")
-	require.NotNil(t, model.InterBankSettDate)
-	require.Equal(t, model.InstructedAmount.Amount, 510000.74)
-	require.Equal(t, model.InstructedAmount.Currency, "USD")
-	require.Equal(t, model.ChargeBearer, models.ChargeBearerSLEV)
-	require.Equal(t, model.ChargesInfo[0].Amount.Amount, 90.00)
-	require.Equal(t, model.ChargesInfo[0].Amount.Currency, "USD")
-	require.Equal(t, model.ChargesInfo[0].BusinessIdCode, "BANZBEBB")
-	require.Equal(t, model.ChargesInfo[1].Amount.Amount, 40.00)
-	require.Equal(t, model.ChargesInfo[1].Amount.Currency, "USD")
-	require.Equal(t, model.ChargesInfo[1].BusinessIdCode, "BANCUS33")
-	require.Equal(t, model.InstructingAgent.PaymentSysCode, models.PaymentSysUSABA)
-	require.Equal(t, model.InstructingAgent.PaymentSysMemberId, "011104238")
-	require.Equal(t, model.InstructedAgent.PaymentSysCode, models.PaymentSysUSABA)
-	require.Equal(t, model.InstructedAgent.PaymentSysMemberId, "021040078")
-	require.Equal(t, model.DebtorName, "Corporation A")
-	require.Equal(t, model.DebtorAddress.StreetName, "Avenue of the Fountains")
-	require.Equal(t, model.DebtorAddress.BuildingNumber, "167565")
-	require.Equal(t, model.DebtorAddress.RoomNumber, "Suite D110")
-	require.Equal(t, model.DebtorAddress.PostalCode, "85268")
-	require.Equal(t, model.DebtorAddress.TownName, "Fountain Hills")
-	require.Equal(t, model.DebtorAddress.Subdivision, "AZ")
-	require.Equal(t, model.DebtorAddress.Country, "US")
-	require.Equal(t, model.DebtorOtherTypeId, "5647772655")
-	require.Equal(t, model.DebtorAgent.PaymentSysCode, models.PaymentSysUSABA)
-	require.Equal(t, model.DebtorAgent.PaymentSysMemberId, "011104238")
-	require.Equal(t, model.DebtorAgent.BankName, "Bank A")
-	require.Equal(t, model.DebtorAgent.PostalAddress.StreetName, "Avenue A")
-	require.Equal(t, model.DebtorAgent.PostalAddress.BuildingNumber, "66")
-	require.Equal(t, model.DebtorAgent.PostalAddress.PostalCode, "60532")
-	require.Equal(t, model.DebtorAgent.PostalAddress.TownName, "Lisle")
-	require.Equal(t, model.DebtorAgent.PostalAddress.Subdivision, "IL")
-	require.Equal(t, model.DebtorAgent.PostalAddress.Country, "US")
-	require.Equal(t, model.CreditorAgent.PaymentSysCode, models.PaymentSysUSABA)
-	require.Equal(t, model.CreditorAgent.PaymentSysMemberId, "021040078")
-	require.Equal(t, model.CreditorAgent.BankName, "Bank B")
-	require.Equal(t, model.CreditorAgent.PostalAddress.StreetName, "Avenue B")
-	require.Equal(t, model.CreditorAgent.PostalAddress.BuildingNumber, "25")
-	require.Equal(t, model.CreditorAgent.PostalAddress.PostalCode, "19067")
-	require.Equal(t, model.CreditorAgent.PostalAddress.TownName, "Yardley")
-	require.Equal(t, model.CreditorAgent.PostalAddress.Subdivision, "PA")
-	require.Equal(t, model.CreditorAgent.PostalAddress.Country, "US")
-	require.Equal(t, model.CreditorName, "Corporation B")
-	require.Equal(t, model.CreditorPostalAddress.StreetName, "Desert View Street")
-	require.Equal(t, model.CreditorPostalAddress.BuildingNumber, "1")
-	require.Equal(t, model.CreditorPostalAddress.Floor, "33")
-	require.Equal(t, model.CreditorPostalAddress.PostalCode, "19067")
-	require.Equal(t, model.CreditorPostalAddress.TownName, "Palm Springs")
-	require.Equal(t, model.CreditorPostalAddress.Subdivision, "CA")
-	require.Equal(t, model.CreditorPostalAddress.Country, "US")
-	require.Equal(t, model.CreditorOtherTypeId, "567876543")
-	require.Equal(t, model.RemittanceInfor.CodeOrProprietary, models.CodeCINV)
-	require.Equal(t, model.RemittanceInfor.Number, "INV34563")
-	require.NotNil(t, model.RemittanceInfor.RelatedDate)
-	require.Equal(t, model.RemittanceInfor.TaxDetail.TaxId, "123456789")
-	require.Equal(t, model.RemittanceInfor.TaxDetail.TaxTypeCode, "09455")
-	require.NotNil(t, model.RemittanceInfor.TaxDetail.TaxPeriodYear)
-	require.Equal(t, model.RemittanceInfor.TaxDetail.TaxperiodTimeFrame, "MM04")
-	require.Equal(t, model.RelatedRemittanceInfo.RemittanceId, "Scenario01Var2RemittanceId001")
-	require.Equal(t, model.RelatedRemittanceInfo.Method, models.Email)
-	require.Equal(t, model.RelatedRemittanceInfo.ElectronicAddress, "CustomerService@CorporationB.com")
+	require.Equal(t, model.Transactions[0].InstructionId, "Scenario01InstrId001")
+	require.Equal(t, model.Transactions[0].EndToEndId, "Scenario01EtoEId001")
+	require.NotNil(t, model.Transactions[0].Transaction)
+	require.Equal(t, model.Transactions[0].Transaction.UniqueEndToEndTransactionRef, "8a562c67-ca16-48ba-b074-65581be6f011")
+	require.Equal(t, model.Transactions[0].InstrumentPropCode, models.InstrumentPropCodeType("CTRC"))
+	require.Equal(t, model.Transactions[0].InterBankSettAmount.Amount, models.Decimal("510000.74"))
+	require.Equal(t, model.Transactions[0].InterBankSettAmount.Currency, "USD")
+	require.NotNil(t, model.Transactions[0].InterBankSettDate)
+	require.Equal(t, model.Transactions[0].InstructedAmount.Amount, models.Decimal("510000.74"))
+	require.Equal(t, model.Transactions[0].InstructedAmount.Currency, "USD")
+	require.Equal(t, model.Transactions[0].ChargeBearer, models.ChargeBearerSLEV)
+	require.Equal(t, model.Transactions[0].ChargesInfo[0].Amount.Amount, models.Decimal("90.00"))
+	require.Equal(t, model.Transactions[0].ChargesInfo[0].Amount.Currency, "USD")
+	require.Equal(t, model.Transactions[0].ChargesInfo[0].BusinessIdCode, "BANZBEBB")
+	require.Equal(t, model.Transactions[0].ChargesInfo[1].Amount.Amount, models.Decimal("40.00"))
+	require.Equal(t, model.Transactions[0].ChargesInfo[1].Amount.Currency, "USD")
+	require.Equal(t, model.Transactions[0].ChargesInfo[1].BusinessIdCode, "BANCUS33")
+	require.Equal(t, model.Transactions[0].InstructingAgent.PaymentSysCode, models.PaymentSysUSABA)
+	require.Equal(t, model.Transactions[0].InstructingAgent.PaymentSysMemberId, "011104238")
+	require.Equal(t, model.Transactions[0].InstructedAgent.PaymentSysCode, models.PaymentSysUSABA)
+	require.Equal(t, model.Transactions[0].InstructedAgent.PaymentSysMemberId, "021040078")
+	require.Equal(t, model.Transactions[0].DebtorName, "Corporation A")
+	require.Equal(t, model.Transactions[0].DebtorAddress.StreetName, "Avenue of the Fountains")
+	require.Equal(t, model.Transactions[0].DebtorAddress.BuildingNumber, "167565")
+	require.Equal(t, model.Transactions[0].DebtorAddress.RoomNumber, "Suite D110")
+	require.Equal(t, model.Transactions[0].DebtorAddress.PostalCode, "85268")
+	require.Equal(t, model.Transactions[0].DebtorAddress.TownName, "Fountain Hills")
+	require.Equal(t, model.Transactions[0].DebtorAddress.Subdivision, "AZ")
+	require.Equal(t, model.Transactions[0].DebtorAddress.Country, "US")
+	require.Equal(t, model.Transactions[0].DebtorOtherTypeId, "5647772655")
+	require.Equal(t, model.Transactions[0].DebtorAgent.PaymentSysCode, models.PaymentSysUSABA)
+	require.Equal(t, model.Transactions[0].DebtorAgent.PaymentSysMemberId, "011104238")
+	require.Equal(t, model.Transactions[0].DebtorAgent.BankName, "Bank A")
+	require.Equal(t, model.Transactions[0].DebtorAgent.PostalAddress.StreetName, "Avenue A")
+	require.Equal(t, model.Transactions[0].DebtorAgent.PostalAddress.BuildingNumber, "66")
+	require.Equal(t, model.Transactions[0].DebtorAgent.PostalAddress.PostalCode, "60532")
+	require.Equal(t, model.Transactions[0].DebtorAgent.PostalAddress.TownName, "Lisle")
+	require.Equal(t, model.Transactions[0].DebtorAgent.PostalAddress.Subdivision, "IL")
+	require.Equal(t, model.Transactions[0].DebtorAgent.PostalAddress.Country, "US")
+	require.Equal(t, model.Transactions[0].CreditorAgent.PaymentSysCode, models.PaymentSysUSABA)
+	require.Equal(t, model.Transactions[0].CreditorAgent.PaymentSysMemberId, "021040078")
+	require.Equal(t, model.Transactions[0].CreditorAgent.BankName, "Bank B")
+	require.Equal(t, model.Transactions[0].CreditorAgent.PostalAddress.StreetName, "Avenue B")
+	require.Equal(t, model.Transactions[0].CreditorAgent.PostalAddress.BuildingNumber, "25")
+	require.Equal(t, model.Transactions[0].CreditorAgent.PostalAddress.PostalCode, "19067")
+	require.Equal(t, model.Transactions[0].CreditorAgent.PostalAddress.TownName, "Yardley")
+	require.Equal(t, model.Transactions[0].CreditorAgent.PostalAddress.Subdivision, "PA")
+	require.Equal(t, model.Transactions[0].CreditorAgent.PostalAddress.Country, "US")
+	require.Equal(t, model.Transactions[0].CreditorName, "Corporation B")
+	require.Equal(t, model.Transactions[0].CreditorPostalAddress.StreetName, "Desert View Street")
+	require.Equal(t, model.Transactions[0].CreditorPostalAddress.BuildingNumber, "1")
+	require.Equal(t, model.Transactions[0].CreditorPostalAddress.Floor, "33")
+	require.Equal(t, model.Transactions[0].CreditorPostalAddress.PostalCode, "19067")
+	require.Equal(t, model.Transactions[0].CreditorPostalAddress.TownName, "Palm Springs")
+	require.Equal(t, model.Transactions[0].CreditorPostalAddress.Subdivision, "CA")
+	require.Equal(t, model.Transactions[0].CreditorPostalAddress.Country, "US")
+	require.Equal(t, model.Transactions[0].CreditorOtherTypeId, "567876543")
+	require.Equal(t, model.Transactions[0].RemittanceInfor.CodeOrProprietary, models.CodeCINV)
+	require.Equal(t, model.Transactions[0].RemittanceInfor.Number, "INV34563")
+	require.NotNil(t, model.Transactions[0].RemittanceInfor.RelatedDate)
+	require.Equal(t, model.Transactions[0].RemittanceInfor.TaxDetail.TaxId, "123456789")
+	require.Equal(t, model.Transactions[0].RemittanceInfor.TaxDetail.TaxTypeCode, "09455")
+	require.NotNil(t, model.Transactions[0].RemittanceInfor.TaxDetail.TaxPeriodYear)
+	require.Equal(t, model.Transactions[0].RemittanceInfor.TaxDetail.TaxperiodTimeFrame, "MM04")
+	require.Equal(t, model.Transactions[0].RelatedRemittanceInfo.RemittanceId, "Scenario01Var2RemittanceId001")
+	require.Equal(t, model.Transactions[0].RelatedRemittanceInfo.Method, models.Email)
+	require.Equal(t, model.Transactions[0].RelatedRemittanceInfo.ElectronicAddress, "CustomerService@CorporationB.com")
 
 	/*Validation check*/
 	model.MessageId = "InvalideMessageIdLength5012345678901234567890"
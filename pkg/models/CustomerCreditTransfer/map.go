@@ -1,84 +1,94 @@
 package CustomerCreditTransfer
 
+import "github.com/moov-io/wire20022/pkg/models"
+
 func pathMapV2() map[string]any {
 	return map[string]any{
-		"FIToFICstmrCdtTrf.GrpHdr.MsgId":                            "MessageId",
-		"FIToFICstmrCdtTrf.GrpHdr.CreDtTm":                          "CreatedDateTime",
-		"FIToFICstmrCdtTrf.GrpHdr.NbOfTxs":                          "NumberOfTransactions",
-		"FIToFICstmrCdtTrf.GrpHdr.SttlmInf.SttlmMtd":                "SettlementMethod",
-		"FIToFICstmrCdtTrf.GrpHdr.SttlmInf.ClrSys.Cd":               "CommonClearingSysCode",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].PmtId.InstrId":            "InstructionId",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].PmtId.EndToEndId":         "EndToEndId",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].PmtId.TxId":               "TaxId",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].PmtTpInf.SvcLvl.Cd":       "ServiceLevel",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].PmtTpInf.LclInstrm.Prtry": "InstrumentPropCode",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].IntrBkSttlmAmt.Value":     "InterBankSettAmount.Amount",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].IntrBkSttlmAmt.Ccy":       "InterBankSettAmount.Currency",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].IntrBkSttlmDt":            "InterBankSettDate",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].InstdAmt.Value":           "InstructedAmount.Amount",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].InstdAmt.Ccy":             "InstructedAmount.Currency",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].XchgRate":                 "ExchangeRate",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].ChrgBr":                   "ChargeBearer",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].ChrgsInf : ChargesInfo": map[string]string{
-			"Amt.Value": "Amount.Amount",
-			"Amt.Ccy":   "Amount.Currency",
+		"FIToFICstmrCdtTrf.GrpHdr.MsgId":              "MessageId",
+		"FIToFICstmrCdtTrf.GrpHdr.CreDtTm":            "CreatedDateTime",
+		"FIToFICstmrCdtTrf.GrpHdr.NbOfTxs":            "NumberOfTransactions",
+		"FIToFICstmrCdtTrf.GrpHdr.SttlmInf.SttlmMtd":  "SettlementMethod",
+		"FIToFICstmrCdtTrf.GrpHdr.SttlmInf.ClrSys.Cd": "CommonClearingSysCode",
+		"FIToFICstmrCdtTrf.CdtTrfTxInf : Transactions": map[string]any{
+			"PmtId.InstrId":            "InstructionId",
+			"PmtId.EndToEndId":         "EndToEndId",
+			"PmtId.TxId":               "TaxId",
+			"PmtTpInf.SvcLvl.Cd":       "ServiceLevel",
+			"PmtTpInf.LclInstrm.Prtry": "InstrumentPropCode",
+			"IntrBkSttlmAmt.Value":     "InterBankSettAmount.Amount",
+			"IntrBkSttlmAmt.Ccy":       "InterBankSettAmount.Currency",
+			"IntrBkSttlmDt":            "InterBankSettDate",
+			"InstdAmt.Value":           "InstructedAmount.Amount",
+			"InstdAmt.Ccy":             "InstructedAmount.Currency",
+			"XchgRate":                 "ExchangeRate",
+			"ChrgBr":                   "ChargeBearer",
+			"ChrgsInf : ChargesInfo": map[string]string{
+				"Amt.Value": "Amount.Amount",
+				"Amt.Ccy":   "Amount.Currency",
+			},
+			"InstgAgt.FinInstnId.ClrSysMmbId.ClrSysId.Cd": "InstructingAgent.PaymentSysCode",
+			"InstgAgt.FinInstnId.ClrSysMmbId.MmbId":       "InstructingAgent.PaymentSysMemberId",
+			"InstdAgt.FinInstnId.ClrSysMmbId.ClrSysId.Cd": "InstructedAgent.PaymentSysCode",
+			"InstdAgt.FinInstnId.ClrSysMmbId.MmbId":       "InstructedAgent.PaymentSysMemberId",
+			"IntrmyAgt1.FinInstnId.ClrSysMmbId.MmbId":     "IntermediaryAgent1Id",
+			"Dbtr.Nm":                                      "DebtorName",
+			"Dbtr.PstlAdr.StrtNm":                          "DebtorAddress.StreetName",
+			"Dbtr.PstlAdr.BldgNb":                          "DebtorAddress.BuildingNumber",
+			"Dbtr.PstlAdr.PstCd":                           "DebtorAddress.PostalCode",
+			"Dbtr.PstlAdr.TwnNm":                           "DebtorAddress.TownName",
+			"Dbtr.PstlAdr.CtrySubDvsn":                     "DebtorAddress.Subdivision",
+			"Dbtr.PstlAdr.Ctry":                            "DebtorAddress.Country",
+			"DbtrAcct.Id.IBAN":                             "DebtorIBAN",
+			"DbtrAcct.Id.Othr.Id":                          "DebtorOtherTypeId",
+			"DbtrAgt.FinInstnId.ClrSysMmbId.ClrSysId.Cd":   "DebtorAgent.PaymentSysCode",
+			"DbtrAgt.FinInstnId.ClrSysMmbId.MmbId":         "DebtorAgent.PaymentSysMemberId",
+			"DbtrAgt.FinInstnId.Nm":                        "DebtorAgent.BankName",
+			"DbtrAgt.FinInstnId.PstlAdr.StrtNm":            "DebtorAgent.PostalAddress.StreetName",
+			"DbtrAgt.FinInstnId.PstlAdr.BldgNb":            "DebtorAgent.PostalAddress.BuildingNumber",
+			"DbtrAgt.FinInstnId.PstlAdr.PstCd":             "DebtorAgent.PostalAddress.PostalCode",
+			"DbtrAgt.FinInstnId.PstlAdr.TwnNm":             "DebtorAgent.PostalAddress.TownName",
+			"DbtrAgt.FinInstnId.PstlAdr.CtrySubDvsn":       "DebtorAgent.PostalAddress.Subdivision",
+			"DbtrAgt.FinInstnId.PstlAdr.Ctry":              "DebtorAgent.PostalAddress.Country",
+			"CdtrAgt.FinInstnId.ClrSysMmbId.ClrSysId.Cd":   "CreditorAgent.PaymentSysCode",
+			"CdtrAgt.FinInstnId.ClrSysMmbId.MmbId":         "CreditorAgent.PaymentSysMemberId",
+			"CdtrAgt.FinInstnId.Nm":                        "CreditorAgent.BankName",
+			"CdtrAgt.FinInstnId.PstlAdr.StrtNm":            "CreditorAgent.PostalAddress.StreetName",
+			"CdtrAgt.FinInstnId.PstlAdr.BldgNb":            "CreditorAgent.PostalAddress.BuildingNumber",
+			"CdtrAgt.FinInstnId.PstlAdr.PstCd":             "CreditorAgent.PostalAddress.PostalCode",
+			"CdtrAgt.FinInstnId.PstlAdr.TwnNm":             "CreditorAgent.PostalAddress.TownName",
+			"CdtrAgt.FinInstnId.PstlAdr.CtrySubDvsn":       "CreditorAgent.PostalAddress.Subdivision",
+			"CdtrAgt.FinInstnId.PstlAdr.Ctry":              "CreditorAgent.PostalAddress.Country",
+			"Cdtr.Nm":                                      "CreditorName",
+			"Cdtr.PstlAdr.StrtNm":                          "CreditorPostalAddress.StreetName",
+			"Cdtr.PstlAdr.BldgNb":                          "CreditorPostalAddress.BuildingNumber",
+			"Cdtr.PstlAdr.PstCd":                           "CreditorPostalAddress.PostalCode",
+			"Cdtr.PstlAdr.TwnNm":                           "CreditorPostalAddress.TownName",
+			"Cdtr.PstlAdr.CtrySubDvsn":                     "CreditorPostalAddress.Subdivision",
+			"Cdtr.PstlAdr.Ctry":                            "CreditorPostalAddress.Country",
+			"UltmtCdtr.Nm":                                 "UltimateCreditorName",
+			"UltmtCdtr.PstlAdr.StrtNm":                     "UltimateCreditorAddress.StreetName",
+			"UltmtCdtr.PstlAdr.BldgNb":                     "UltimateCreditorAddress.BuildingNumber",
+			"UltmtCdtr.PstlAdr.PstCd":                      "UltimateCreditorAddress.PostalCode",
+			"UltmtCdtr.PstlAdr.TwnNm":                      "UltimateCreditorAddress.TownName",
+			"UltmtCdtr.PstlAdr.CtrySubDvsn":                "UltimateCreditorAddress.Subdivision",
+			"UltmtCdtr.PstlAdr.Ctry":                       "UltimateCreditorAddress.Country",
+			"CdtrAcct.Id.IBAN":                             "CreditorIBAN",
+			"CdtrAcct.Id.Othr.Id":                          "CreditorOtherTypeId",
+			"Purp.Cd":                                      "PurposeOfPayment",
+			"RltdRmtInf[0].RmtId":                          "RelatedRemittanceInfo.RemittanceId",
+			"RltdRmtInf[0].RmtLctnMtd":                     "RelatedRemittanceInfo.Method",
+			"RltdRmtInf[0].RmtLctnElctrncAdr":              "RelatedRemittanceInfo.ElectronicAddress",
+			"RmtInf.Ustrd[0]":                              "RemittanceInfor.UnstructuredRemitInfo",
+			"RmtInf.Strd[0].RfrdDocInf[0].Tp.CdOrPrtry.Cd": "RemittanceInfor.CodeOrProprietary",
+			"RmtInf.Strd[0].RfrdDocInf[0].Nb":              "RemittanceInfor.Number",
+			"RmtInf.Strd[0].RfrdDocInf[0].RltdDt":          "RemittanceInfor.RelatedDate",
+
+			"Dbtr.PstlAdr.AdrLine : DebtorAddress.AddressLine":                             models.ListItems,
+			"DbtrAgt.FinInstnId.PstlAdr.AdrLine : DebtorAgent.PostalAddress.AddressLine":   models.ListItems,
+			"CdtrAgt.FinInstnId.PstlAdr.AdrLine : CreditorAgent.PostalAddress.AddressLine": models.ListItems,
+			"Cdtr.PstlAdr.AdrLine : CreditorPostalAddress.AddressLine":                     models.ListItems,
+			"UltmtCdtr.PstlAdr.AdrLine : UltimateCreditorAddress.AddressLine":              models.ListItems,
 		},
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].InstgAgt.FinInstnId.ClrSysMmbId.ClrSysId.Cd":  "InstructingAgent.PaymentSysCode",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].InstgAgt.FinInstnId.ClrSysMmbId.MmbId":        "InstructingAgent.PaymentSysMemberId",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].InstdAgt.FinInstnId.ClrSysMmbId.ClrSysId.Cd":  "InstructedAgent.PaymentSysCode",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].InstdAgt.FinInstnId.ClrSysMmbId.MmbId":        "InstructedAgent.PaymentSysMemberId",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].IntrmyAgt1.FinInstnId.ClrSysMmbId.MmbId":      "IntermediaryAgent1Id",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].Dbtr.Nm":                                      "DebtorName",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].Dbtr.PstlAdr.StrtNm":                          "DebtorAddress.StreetName",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].Dbtr.PstlAdr.BldgNb":                          "DebtorAddress.BuildingNumber",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].Dbtr.PstlAdr.PstCd":                           "DebtorAddress.PostalCode",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].Dbtr.PstlAdr.TwnNm":                           "DebtorAddress.TownName",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].Dbtr.PstlAdr.CtrySubDvsn":                     "DebtorAddress.Subdivision",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].Dbtr.PstlAdr.Ctry":                            "DebtorAddress.Country",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].DbtrAcct.Id.IBAN":                             "DebtorIBAN",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].DbtrAcct.Id.Othr.Id":                          "DebtorOtherTypeId",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].DbtrAgt.FinInstnId.ClrSysMmbId.ClrSysId.Cd":   "DebtorAgent.PaymentSysCode",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].DbtrAgt.FinInstnId.ClrSysMmbId.MmbId":         "DebtorAgent.PaymentSysMemberId",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].DbtrAgt.FinInstnId.Nm":                        "DebtorAgent.BankName",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].DbtrAgt.FinInstnId.PstlAdr.StrtNm":            "DebtorAgent.PostalAddress.StreetName",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].DbtrAgt.FinInstnId.PstlAdr.BldgNb":            "DebtorAgent.PostalAddress.BuildingNumber",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].DbtrAgt.FinInstnId.PstlAdr.PstCd":             "DebtorAgent.PostalAddress.PostalCode",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].DbtrAgt.FinInstnId.PstlAdr.TwnNm":             "DebtorAgent.PostalAddress.TownName",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].DbtrAgt.FinInstnId.PstlAdr.CtrySubDvsn":       "DebtorAgent.PostalAddress.Subdivision",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].DbtrAgt.FinInstnId.PstlAdr.Ctry":              "DebtorAgent.PostalAddress.Country",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].CdtrAgt.FinInstnId.ClrSysMmbId.ClrSysId.Cd":   "CreditorAgent.PaymentSysCode",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].CdtrAgt.FinInstnId.ClrSysMmbId.MmbId":         "CreditorAgent.PaymentSysMemberId",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].CdtrAgt.FinInstnId.Nm":                        "CreditorAgent.BankName",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].CdtrAgt.FinInstnId.PstlAdr.StrtNm":            "CreditorAgent.PostalAddress.StreetName",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].CdtrAgt.FinInstnId.PstlAdr.BldgNb":            "CreditorAgent.PostalAddress.BuildingNumber",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].CdtrAgt.FinInstnId.PstlAdr.PstCd":             "CreditorAgent.PostalAddress.PostalCode",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].CdtrAgt.FinInstnId.PstlAdr.TwnNm":             "CreditorAgent.PostalAddress.TownName",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].CdtrAgt.FinInstnId.PstlAdr.CtrySubDvsn":       "CreditorAgent.PostalAddress.Subdivision",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].CdtrAgt.FinInstnId.PstlAdr.Ctry":              "CreditorAgent.PostalAddress.Country",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].Cdtr.Nm":                                      "CreditorName",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].Cdtr.PstlAdr.StrtNm":                          "CreditorPostalAddress.StreetName",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].Cdtr.PstlAdr.BldgNb":                          "CreditorPostalAddress.BuildingNumber",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].Cdtr.PstlAdr.PstCd":                           "CreditorPostalAddress.PostalCode",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].Cdtr.PstlAdr.TwnNm":                           "CreditorPostalAddress.TownName",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].Cdtr.PstlAdr.CtrySubDvsn":                     "CreditorPostalAddress.Subdivision",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].Cdtr.PstlAdr.Ctry":                            "CreditorPostalAddress.Country",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].UltmtCdtr.Nm":                                 "UltimateCreditorName",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].UltmtCdtr.PstlAdr.StrtNm":                     "UltimateCreditorAddress.StreetName",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].UltmtCdtr.PstlAdr.BldgNb":                     "UltimateCreditorAddress.BuildingNumber",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].UltmtCdtr.PstlAdr.PstCd":                      "UltimateCreditorAddress.PostalCode",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].UltmtCdtr.PstlAdr.TwnNm":                      "UltimateCreditorAddress.TownName",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].UltmtCdtr.PstlAdr.CtrySubDvsn":                "UltimateCreditorAddress.Subdivision",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].UltmtCdtr.PstlAdr.Ctry":                       "UltimateCreditorAddress.Country",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].CdtrAcct.Id.IBAN":                             "CreditorIBAN",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].CdtrAcct.Id.Othr.Id":                          "CreditorOtherTypeId",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].Purp.Cd":                                      "PurposeOfPayment",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].RltdRmtInf[0].RmtId":                          "RelatedRemittanceInfo.RemittanceId",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].RltdRmtInf[0].RmtLctnMtd":                     "RelatedRemittanceInfo.Method",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].RltdRmtInf[0].RmtLctnElctrncAdr":              "RelatedRemittanceInfo.ElectronicAddress",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].RmtInf.Ustrd[0]":                              "RemittanceInfor.UnstructuredRemitInfo",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].RmtInf.Strd[0].RfrdDocInf[0].Tp.CdOrPrtry.Cd": "RemittanceInfor.CodeOrProprietary",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].RmtInf.Strd[0].RfrdDocInf[0].Nb":              "RemittanceInfor.Number",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].RmtInf.Strd[0].RfrdDocInf[0].RltdDt":          "RemittanceInfor.RelatedDate",
 	}
 }
 func pathMapV3() map[string]any {
@@ -86,84 +96,92 @@ func pathMapV3() map[string]any {
 }
 func pathMapV4() map[string]any {
 	return map[string]any{
-		"FIToFICstmrCdtTrf.GrpHdr.MsgId":                            "MessageId",
-		"FIToFICstmrCdtTrf.GrpHdr.CreDtTm":                          "CreatedDateTime",
-		"FIToFICstmrCdtTrf.GrpHdr.NbOfTxs":                          "NumberOfTransactions",
-		"FIToFICstmrCdtTrf.GrpHdr.SttlmInf.SttlmMtd":                "SettlementMethod",
-		"FIToFICstmrCdtTrf.GrpHdr.SttlmInf.ClrSys.Cd":               "CommonClearingSysCode",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].PmtId.InstrId":            "InstructionId",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].PmtId.EndToEndId":         "EndToEndId",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].PmtId.TxId":               "TaxId",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].PmtTpInf.SvcLvl.Cd":       "ServiceLevel",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].PmtTpInf.LclInstrm.Prtry": "InstrumentPropCode",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].IntrBkSttlmAmt.Value":     "InterBankSettAmount.Amount",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].IntrBkSttlmAmt.Ccy":       "InterBankSettAmount.Currency",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].IntrBkSttlmDt":            "InterBankSettDate",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].InstdAmt.Value":           "InstructedAmount.Amount",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].InstdAmt.Ccy":             "InstructedAmount.Currency",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].XchgRate":                 "ExchangeRate",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].ChrgBr":                   "ChargeBearer",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].ChrgsInf : ChargesInfo": map[string]string{
-			"Amt.Value":            "Amount.Amount",
-			"Amt.Ccy":              "Amount.Currency",
-			"Agt.FinInstnId.BICFI": "BusinessIdCode",
+		"FIToFICstmrCdtTrf.GrpHdr.MsgId":              "MessageId",
+		"FIToFICstmrCdtTrf.GrpHdr.CreDtTm":            "CreatedDateTime",
+		"FIToFICstmrCdtTrf.GrpHdr.NbOfTxs":            "NumberOfTransactions",
+		"FIToFICstmrCdtTrf.GrpHdr.SttlmInf.SttlmMtd":  "SettlementMethod",
+		"FIToFICstmrCdtTrf.GrpHdr.SttlmInf.ClrSys.Cd": "CommonClearingSysCode",
+		"FIToFICstmrCdtTrf.CdtTrfTxInf : Transactions": map[string]any{
+			"PmtId.InstrId":            "InstructionId",
+			"PmtId.EndToEndId":         "EndToEndId",
+			"PmtId.TxId":               "TaxId",
+			"PmtTpInf.SvcLvl.Cd":       "ServiceLevel",
+			"PmtTpInf.LclInstrm.Prtry": "InstrumentPropCode",
+			"IntrBkSttlmAmt.Value":     "InterBankSettAmount.Amount",
+			"IntrBkSttlmAmt.Ccy":       "InterBankSettAmount.Currency",
+			"IntrBkSttlmDt":            "InterBankSettDate",
+			"InstdAmt.Value":           "InstructedAmount.Amount",
+			"InstdAmt.Ccy":             "InstructedAmount.Currency",
+			"XchgRate":                 "ExchangeRate",
+			"ChrgBr":                   "ChargeBearer",
+			"ChrgsInf : ChargesInfo": map[string]string{
+				"Amt.Value":            "Amount.Amount",
+				"Amt.Ccy":              "Amount.Currency",
+				"Agt.FinInstnId.BICFI": "BusinessIdCode",
+			},
+			"InstgAgt.FinInstnId.ClrSysMmbId.ClrSysId.Cd": "InstructingAgent.PaymentSysCode",
+			"InstgAgt.FinInstnId.ClrSysMmbId.MmbId":       "InstructingAgent.PaymentSysMemberId",
+			"InstdAgt.FinInstnId.ClrSysMmbId.ClrSysId.Cd": "InstructedAgent.PaymentSysCode",
+			"InstdAgt.FinInstnId.ClrSysMmbId.MmbId":       "InstructedAgent.PaymentSysMemberId",
+			"IntrmyAgt1.FinInstnId.ClrSysMmbId.MmbId":     "IntermediaryAgent1Id",
+			"Dbtr.Nm":                                      "DebtorName",
+			"Dbtr.PstlAdr.StrtNm":                          "DebtorAddress.StreetName",
+			"Dbtr.PstlAdr.BldgNb":                          "DebtorAddress.BuildingNumber",
+			"Dbtr.PstlAdr.PstCd":                           "DebtorAddress.PostalCode",
+			"Dbtr.PstlAdr.TwnNm":                           "DebtorAddress.TownName",
+			"Dbtr.PstlAdr.CtrySubDvsn":                     "DebtorAddress.Subdivision",
+			"Dbtr.PstlAdr.Ctry":                            "DebtorAddress.Country",
+			"DbtrAcct.Id.IBAN":                             "DebtorIBAN",
+			"DbtrAcct.Id.Othr.Id":                          "DebtorOtherTypeId",
+			"DbtrAgt.FinInstnId.ClrSysMmbId.ClrSysId.Cd":   "DebtorAgent.PaymentSysCode",
+			"DbtrAgt.FinInstnId.ClrSysMmbId.MmbId":         "DebtorAgent.PaymentSysMemberId",
+			"DbtrAgt.FinInstnId.Nm":                        "DebtorAgent.BankName",
+			"DbtrAgt.FinInstnId.PstlAdr.StrtNm":            "DebtorAgent.PostalAddress.StreetName",
+			"DbtrAgt.FinInstnId.PstlAdr.BldgNb":            "DebtorAgent.PostalAddress.BuildingNumber",
+			"DbtrAgt.FinInstnId.PstlAdr.PstCd":             "DebtorAgent.PostalAddress.PostalCode",
+			"DbtrAgt.FinInstnId.PstlAdr.TwnNm":             "DebtorAgent.PostalAddress.TownName",
+			"DbtrAgt.FinInstnId.PstlAdr.CtrySubDvsn":       "DebtorAgent.PostalAddress.Subdivision",
+			"DbtrAgt.FinInstnId.PstlAdr.Ctry":              "DebtorAgent.PostalAddress.Country",
+			"CdtrAgt.FinInstnId.ClrSysMmbId.ClrSysId.Cd":   "CreditorAgent.PaymentSysCode",
+			"CdtrAgt.FinInstnId.ClrSysMmbId.MmbId":         "CreditorAgent.PaymentSysMemberId",
+			"CdtrAgt.FinInstnId.Nm":                        "CreditorAgent.BankName",
+			"CdtrAgt.FinInstnId.PstlAdr.StrtNm":            "CreditorAgent.PostalAddress.StreetName",
+			"CdtrAgt.FinInstnId.PstlAdr.BldgNb":            "CreditorAgent.PostalAddress.BuildingNumber",
+			"CdtrAgt.FinInstnId.PstlAdr.PstCd":             "CreditorAgent.PostalAddress.PostalCode",
+			"CdtrAgt.FinInstnId.PstlAdr.TwnNm":             "CreditorAgent.PostalAddress.TownName",
+			"CdtrAgt.FinInstnId.PstlAdr.CtrySubDvsn":       "CreditorAgent.PostalAddress.Subdivision",
+			"CdtrAgt.FinInstnId.PstlAdr.Ctry":              "CreditorAgent.PostalAddress.Country",
+			"Cdtr.Nm":                                      "CreditorName",
+			"Cdtr.PstlAdr.StrtNm":                          "CreditorPostalAddress.StreetName",
+			"Cdtr.PstlAdr.BldgNb":                          "CreditorPostalAddress.BuildingNumber",
+			"Cdtr.PstlAdr.PstCd":                           "CreditorPostalAddress.PostalCode",
+			"Cdtr.PstlAdr.TwnNm":                           "CreditorPostalAddress.TownName",
+			"Cdtr.PstlAdr.CtrySubDvsn":                     "CreditorPostalAddress.Subdivision",
+			"Cdtr.PstlAdr.Ctry":                            "CreditorPostalAddress.Country",
+			"UltmtCdtr.Nm":                                 "UltimateCreditorName",
+			"UltmtCdtr.PstlAdr.StrtNm":                     "UltimateCreditorAddress.StreetName",
+			"UltmtCdtr.PstlAdr.BldgNb":                     "UltimateCreditorAddress.BuildingNumber",
+			"UltmtCdtr.PstlAdr.PstCd":                      "UltimateCreditorAddress.PostalCode",
+			"UltmtCdtr.PstlAdr.TwnNm":                      "UltimateCreditorAddress.TownName",
+			"UltmtCdtr.PstlAdr.CtrySubDvsn":                "UltimateCreditorAddress.Subdivision",
+			"UltmtCdtr.PstlAdr.Ctry":                       "UltimateCreditorAddress.Country",
+			"CdtrAcct.Id.IBAN":                             "CreditorIBAN",
+			"CdtrAcct.Id.Othr.Id":                          "CreditorOtherTypeId",
+			"Purp.Cd":                                      "PurposeOfPayment",
+			"RltdRmtInf[0].RmtId":                          "RelatedRemittanceInfo.RemittanceId",
+			"RltdRmtInf[0].RmtLctnMtd":                     "RelatedRemittanceInfo.Method",
+			"RltdRmtInf[0].RmtLctnElctrncAdr":              "RelatedRemittanceInfo.ElectronicAddress",
+			"RmtInf.Ustrd[0]":                              "RemittanceInfor.UnstructuredRemitInfo",
+			"RmtInf.Strd[0].RfrdDocInf[0].Tp.CdOrPrtry.Cd": "RemittanceInfor.CodeOrProprietary",
+			"RmtInf.Strd[0].RfrdDocInf[0].Nb":              "RemittanceInfor.Number",
+			"RmtInf.Strd[0].RfrdDocInf[0].RltdDt":          "RemittanceInfor.RelatedDate",
+
+			"Dbtr.PstlAdr.AdrLine : DebtorAddress.AddressLine":                             models.ListItems,
+			"DbtrAgt.FinInstnId.PstlAdr.AdrLine : DebtorAgent.PostalAddress.AddressLine":   models.ListItems,
+			"CdtrAgt.FinInstnId.PstlAdr.AdrLine : CreditorAgent.PostalAddress.AddressLine": models.ListItems,
+			"Cdtr.PstlAdr.AdrLine : CreditorPostalAddress.AddressLine":                     models.ListItems,
+			"UltmtCdtr.PstlAdr.AdrLine : UltimateCreditorAddress.AddressLine":              models.ListItems,
 		},
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].InstgAgt.FinInstnId.ClrSysMmbId.ClrSysId.Cd":  "InstructingAgent.PaymentSysCode",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].InstgAgt.FinInstnId.ClrSysMmbId.MmbId":        "InstructingAgent.PaymentSysMemberId",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].InstdAgt.FinInstnId.ClrSysMmbId.ClrSysId.Cd":  "InstructedAgent.PaymentSysCode",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].InstdAgt.FinInstnId.ClrSysMmbId.MmbId":        "InstructedAgent.PaymentSysMemberId",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].IntrmyAgt1.FinInstnId.ClrSysMmbId.MmbId":      "IntermediaryAgent1Id",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].Dbtr.Nm":                                      "DebtorName",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].Dbtr.PstlAdr.StrtNm":                          "DebtorAddress.StreetName",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].Dbtr.PstlAdr.BldgNb":                          "DebtorAddress.BuildingNumber",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].Dbtr.PstlAdr.PstCd":                           "DebtorAddress.PostalCode",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].Dbtr.PstlAdr.TwnNm":                           "DebtorAddress.TownName",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].Dbtr.PstlAdr.CtrySubDvsn":                     "DebtorAddress.Subdivision",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].Dbtr.PstlAdr.Ctry":                            "DebtorAddress.Country",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].DbtrAcct.Id.IBAN":                             "DebtorIBAN",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].DbtrAcct.Id.Othr.Id":                          "DebtorOtherTypeId",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].DbtrAgt.FinInstnId.ClrSysMmbId.ClrSysId.Cd":   "DebtorAgent.PaymentSysCode",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].DbtrAgt.FinInstnId.ClrSysMmbId.MmbId":         "DebtorAgent.PaymentSysMemberId",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].DbtrAgt.FinInstnId.Nm":                        "DebtorAgent.BankName",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].DbtrAgt.FinInstnId.PstlAdr.StrtNm":            "DebtorAgent.PostalAddress.StreetName",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].DbtrAgt.FinInstnId.PstlAdr.BldgNb":            "DebtorAgent.PostalAddress.BuildingNumber",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].DbtrAgt.FinInstnId.PstlAdr.PstCd":             "DebtorAgent.PostalAddress.PostalCode",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].DbtrAgt.FinInstnId.PstlAdr.TwnNm":             "DebtorAgent.PostalAddress.TownName",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].DbtrAgt.FinInstnId.PstlAdr.CtrySubDvsn":       "DebtorAgent.PostalAddress.Subdivision",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].DbtrAgt.FinInstnId.PstlAdr.Ctry":              "DebtorAgent.PostalAddress.Country",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].CdtrAgt.FinInstnId.ClrSysMmbId.ClrSysId.Cd":   "CreditorAgent.PaymentSysCode",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].CdtrAgt.FinInstnId.ClrSysMmbId.MmbId":         "CreditorAgent.PaymentSysMemberId",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].CdtrAgt.FinInstnId.Nm":                        "CreditorAgent.BankName",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].CdtrAgt.FinInstnId.PstlAdr.StrtNm":            "CreditorAgent.PostalAddress.StreetName",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].CdtrAgt.FinInstnId.PstlAdr.BldgNb":            "CreditorAgent.PostalAddress.BuildingNumber",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].CdtrAgt.FinInstnId.PstlAdr.PstCd":             "CreditorAgent.PostalAddress.PostalCode",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].CdtrAgt.FinInstnId.PstlAdr.TwnNm":             "CreditorAgent.PostalAddress.TownName",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].CdtrAgt.FinInstnId.PstlAdr.CtrySubDvsn":       "CreditorAgent.PostalAddress.Subdivision",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].CdtrAgt.FinInstnId.PstlAdr.Ctry":              "CreditorAgent.PostalAddress.Country",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].Cdtr.Nm":                                      "CreditorName",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].Cdtr.PstlAdr.StrtNm":                          "CreditorPostalAddress.StreetName",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].Cdtr.PstlAdr.BldgNb":                          "CreditorPostalAddress.BuildingNumber",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].Cdtr.PstlAdr.PstCd":                           "CreditorPostalAddress.PostalCode",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].Cdtr.PstlAdr.TwnNm":                           "CreditorPostalAddress.TownName",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].Cdtr.PstlAdr.CtrySubDvsn":                     "CreditorPostalAddress.Subdivision",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].Cdtr.PstlAdr.Ctry":                            "CreditorPostalAddress.Country",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].UltmtCdtr.Nm":                                 "UltimateCreditorName",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].UltmtCdtr.PstlAdr.StrtNm":                     "UltimateCreditorAddress.StreetName",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].UltmtCdtr.PstlAdr.BldgNb":                     "UltimateCreditorAddress.BuildingNumber",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].UltmtCdtr.PstlAdr.PstCd":                      "UltimateCreditorAddress.PostalCode",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].UltmtCdtr.PstlAdr.TwnNm":                      "UltimateCreditorAddress.TownName",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].UltmtCdtr.PstlAdr.CtrySubDvsn":                "UltimateCreditorAddress.Subdivision",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].UltmtCdtr.PstlAdr.Ctry":                       "UltimateCreditorAddress.Country",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].CdtrAcct.Id.IBAN":                             "CreditorIBAN",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].CdtrAcct.Id.Othr.Id":                          "CreditorOtherTypeId",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].Purp.Cd":                                      "PurposeOfPayment",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].RltdRmtInf[0].RmtId":                          "RelatedRemittanceInfo.RemittanceId",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].RltdRmtInf[0].RmtLctnMtd":                     "RelatedRemittanceInfo.Method",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].RltdRmtInf[0].RmtLctnElctrncAdr":              "RelatedRemittanceInfo.ElectronicAddress",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].RmtInf.Ustrd[0]":                              "RemittanceInfor.UnstructuredRemitInfo",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].RmtInf.Strd[0].RfrdDocInf[0].Tp.CdOrPrtry.Cd": "RemittanceInfor.CodeOrProprietary",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].RmtInf.Strd[0].RfrdDocInf[0].Nb":              "RemittanceInfor.Number",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].RmtInf.Strd[0].RfrdDocInf[0].RltdDt":          "RemittanceInfor.RelatedDate",
 	}
 }
 func pathMapV5() map[string]any {
@@ -174,189 +192,207 @@ func pathMapV6() map[string]any {
 }
 func pathMapV7() map[string]any {
 	return map[string]any{
-		"FIToFICstmrCdtTrf.GrpHdr.MsgId":                            "MessageId",
-		"FIToFICstmrCdtTrf.GrpHdr.CreDtTm":                          "CreatedDateTime",
-		"FIToFICstmrCdtTrf.GrpHdr.NbOfTxs":                          "NumberOfTransactions",
-		"FIToFICstmrCdtTrf.GrpHdr.SttlmInf.SttlmMtd":                "SettlementMethod",
-		"FIToFICstmrCdtTrf.GrpHdr.SttlmInf.ClrSys.Cd":               "CommonClearingSysCode",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].PmtId.InstrId":            "InstructionId",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].PmtId.EndToEndId":         "EndToEndId",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].PmtId.TxId":               "TaxId",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].PmtTpInf.SvcLvl.Cd":       "ServiceLevel",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].PmtTpInf.LclInstrm.Prtry": "InstrumentPropCode",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].IntrBkSttlmAmt.Value":     "InterBankSettAmount.Amount",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].IntrBkSttlmAmt.Ccy":       "InterBankSettAmount.Currency",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].IntrBkSttlmDt":            "InterBankSettDate",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].InstdAmt.Value":           "InstructedAmount.Amount",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].InstdAmt.Ccy":             "InstructedAmount.Currency",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].XchgRate":                 "ExchangeRate",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].ChrgBr":                   "ChargeBearer",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].ChrgsInf : ChargesInfo": map[string]string{
-			"Amt.Value":            "Amount.Amount",
-			"Amt.Ccy":              "Amount.Currency",
-			"Agt.FinInstnId.BICFI": "BusinessIdCode",
+		"FIToFICstmrCdtTrf.GrpHdr.MsgId":              "MessageId",
+		"FIToFICstmrCdtTrf.GrpHdr.CreDtTm":            "CreatedDateTime",
+		"FIToFICstmrCdtTrf.GrpHdr.NbOfTxs":            "NumberOfTransactions",
+		"FIToFICstmrCdtTrf.GrpHdr.SttlmInf.SttlmMtd":  "SettlementMethod",
+		"FIToFICstmrCdtTrf.GrpHdr.SttlmInf.ClrSys.Cd": "CommonClearingSysCode",
+		"FIToFICstmrCdtTrf.CdtTrfTxInf : Transactions": map[string]any{
+			"PmtId.InstrId":            "InstructionId",
+			"PmtId.EndToEndId":         "EndToEndId",
+			"PmtId.TxId":               "TaxId",
+			"PmtTpInf.SvcLvl.Cd":       "ServiceLevel",
+			"PmtTpInf.LclInstrm.Prtry": "InstrumentPropCode",
+			"IntrBkSttlmAmt.Value":     "InterBankSettAmount.Amount",
+			"IntrBkSttlmAmt.Ccy":       "InterBankSettAmount.Currency",
+			"IntrBkSttlmDt":            "InterBankSettDate",
+			"InstdAmt.Value":           "InstructedAmount.Amount",
+			"InstdAmt.Ccy":             "InstructedAmount.Currency",
+			"XchgRate":                 "ExchangeRate",
+			"ChrgBr":                   "ChargeBearer",
+			"ChrgsInf : ChargesInfo": map[string]string{
+				"Amt.Value":            "Amount.Amount",
+				"Amt.Ccy":              "Amount.Currency",
+				"Agt.FinInstnId.BICFI": "BusinessIdCode",
+			},
+			"InstgAgt.FinInstnId.ClrSysMmbId.ClrSysId.Cd": "InstructingAgent.PaymentSysCode",
+			"InstgAgt.FinInstnId.ClrSysMmbId.MmbId":       "InstructingAgent.PaymentSysMemberId",
+			"InstdAgt.FinInstnId.ClrSysMmbId.ClrSysId.Cd": "InstructedAgent.PaymentSysCode",
+			"InstdAgt.FinInstnId.ClrSysMmbId.MmbId":       "InstructedAgent.PaymentSysMemberId",
+			"IntrmyAgt1.FinInstnId.ClrSysMmbId.MmbId":     "IntermediaryAgent1Id",
+			"Dbtr.Nm":                                      "DebtorName",
+			"Dbtr.PstlAdr.StrtNm":                          "DebtorAddress.StreetName",
+			"Dbtr.PstlAdr.BldgNb":                          "DebtorAddress.BuildingNumber",
+			"Dbtr.PstlAdr.PstCd":                           "DebtorAddress.PostalCode",
+			"Dbtr.PstlAdr.TwnNm":                           "DebtorAddress.TownName",
+			"Dbtr.PstlAdr.CtrySubDvsn":                     "DebtorAddress.Subdivision",
+			"Dbtr.PstlAdr.Ctry":                            "DebtorAddress.Country",
+			"DbtrAcct.Id.IBAN":                             "DebtorIBAN",
+			"DbtrAcct.Id.Othr.Id":                          "DebtorOtherTypeId",
+			"DbtrAgt.FinInstnId.ClrSysMmbId.ClrSysId.Cd":   "DebtorAgent.PaymentSysCode",
+			"DbtrAgt.FinInstnId.ClrSysMmbId.MmbId":         "DebtorAgent.PaymentSysMemberId",
+			"DbtrAgt.FinInstnId.Nm":                        "DebtorAgent.BankName",
+			"DbtrAgt.FinInstnId.PstlAdr.StrtNm":            "DebtorAgent.PostalAddress.StreetName",
+			"DbtrAgt.FinInstnId.PstlAdr.BldgNb":            "DebtorAgent.PostalAddress.BuildingNumber",
+			"DbtrAgt.FinInstnId.PstlAdr.PstCd":             "DebtorAgent.PostalAddress.PostalCode",
+			"DbtrAgt.FinInstnId.PstlAdr.TwnNm":             "DebtorAgent.PostalAddress.TownName",
+			"DbtrAgt.FinInstnId.PstlAdr.CtrySubDvsn":       "DebtorAgent.PostalAddress.Subdivision",
+			"DbtrAgt.FinInstnId.PstlAdr.Ctry":              "DebtorAgent.PostalAddress.Country",
+			"CdtrAgt.FinInstnId.ClrSysMmbId.ClrSysId.Cd":   "CreditorAgent.PaymentSysCode",
+			"CdtrAgt.FinInstnId.ClrSysMmbId.MmbId":         "CreditorAgent.PaymentSysMemberId",
+			"CdtrAgt.FinInstnId.Nm":                        "CreditorAgent.BankName",
+			"CdtrAgt.FinInstnId.PstlAdr.StrtNm":            "CreditorAgent.PostalAddress.StreetName",
+			"CdtrAgt.FinInstnId.PstlAdr.BldgNb":            "CreditorAgent.PostalAddress.BuildingNumber",
+			"CdtrAgt.FinInstnId.PstlAdr.PstCd":             "CreditorAgent.PostalAddress.PostalCode",
+			"CdtrAgt.FinInstnId.PstlAdr.TwnNm":             "CreditorAgent.PostalAddress.TownName",
+			"CdtrAgt.FinInstnId.PstlAdr.CtrySubDvsn":       "CreditorAgent.PostalAddress.Subdivision",
+			"CdtrAgt.FinInstnId.PstlAdr.Ctry":              "CreditorAgent.PostalAddress.Country",
+			"Cdtr.Nm":                                      "CreditorName",
+			"Cdtr.PstlAdr.StrtNm":                          "CreditorPostalAddress.StreetName",
+			"Cdtr.PstlAdr.BldgNb":                          "CreditorPostalAddress.BuildingNumber",
+			"Cdtr.PstlAdr.PstCd":                           "CreditorPostalAddress.PostalCode",
+			"Cdtr.PstlAdr.TwnNm":                           "CreditorPostalAddress.TownName",
+			"Cdtr.PstlAdr.CtrySubDvsn":                     "CreditorPostalAddress.Subdivision",
+			"Cdtr.PstlAdr.Ctry":                            "CreditorPostalAddress.Country",
+			"UltmtCdtr.Nm":                                 "UltimateCreditorName",
+			"UltmtCdtr.PstlAdr.StrtNm":                     "UltimateCreditorAddress.StreetName",
+			"UltmtCdtr.PstlAdr.BldgNb":                     "UltimateCreditorAddress.BuildingNumber",
+			"UltmtCdtr.PstlAdr.PstCd":                      "UltimateCreditorAddress.PostalCode",
+			"UltmtCdtr.PstlAdr.TwnNm":                      "UltimateCreditorAddress.TownName",
+			"UltmtCdtr.PstlAdr.CtrySubDvsn":                "UltimateCreditorAddress.Subdivision",
+			"UltmtCdtr.PstlAdr.Ctry":                       "UltimateCreditorAddress.Country",
+			"CdtrAcct.Id.IBAN":                             "CreditorIBAN",
+			"CdtrAcct.Id.Othr.Id":                          "CreditorOtherTypeId",
+			"Purp.Cd":                                      "PurposeOfPayment",
+			"RltdRmtInf[0].RmtId":                          "RelatedRemittanceInfo.RemittanceId",
+			"RltdRmtInf[0].RmtLctnDtls[0].Mtd":             "RelatedRemittanceInfo.Method",
+			"RltdRmtInf[0].RmtLctnDtls[0].ElctrncAdr":      "RelatedRemittanceInfo.ElectronicAddress",
+			"RmtInf.Ustrd[0]":                              "RemittanceInfor.UnstructuredRemitInfo",
+			"RmtInf.Strd[0].RfrdDocInf[0].Tp.CdOrPrtry.Cd": "RemittanceInfor.CodeOrProprietary",
+			"RmtInf.Strd[0].RfrdDocInf[0].Nb":              "RemittanceInfor.Number",
+			"RmtInf.Strd[0].RfrdDocInf[0].RltdDt":          "RemittanceInfor.RelatedDate",
+			"RmtInf.Strd[0].TaxRmt.Cdtr.TaxId":             "RemittanceInfor.TaxDetail.TaxId",
+			"RmtInf.Strd[0].TaxRmt.Rcrd[0].Tp":             "RemittanceInfor.TaxDetail.TaxTypeCode",
+			"RmtInf.Strd[0].TaxRmt.Rcrd[0].Prd.Yr":         "RemittanceInfor.TaxDetail.TaxPeriodYear",
+			"RmtInf.Strd[0].TaxRmt.Rcrd[0].Prd.Tp":         "RemittanceInfor.TaxDetail.TaxperiodTimeFrame",
+
+			"Dbtr.PstlAdr.AdrLine : DebtorAddress.AddressLine":                             models.ListItems,
+			"DbtrAgt.FinInstnId.PstlAdr.AdrLine : DebtorAgent.PostalAddress.AddressLine":   models.ListItems,
+			"CdtrAgt.FinInstnId.PstlAdr.AdrLine : CreditorAgent.PostalAddress.AddressLine": models.ListItems,
+			"Cdtr.PstlAdr.AdrLine : CreditorPostalAddress.AddressLine":                     models.ListItems,
+			"UltmtCdtr.PstlAdr.AdrLine : UltimateCreditorAddress.AddressLine":              models.ListItems,
 		},
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].InstgAgt.FinInstnId.ClrSysMmbId.ClrSysId.Cd":  "InstructingAgent.PaymentSysCode",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].InstgAgt.FinInstnId.ClrSysMmbId.MmbId":        "InstructingAgent.PaymentSysMemberId",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].InstdAgt.FinInstnId.ClrSysMmbId.ClrSysId.Cd":  "InstructedAgent.PaymentSysCode",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].InstdAgt.FinInstnId.ClrSysMmbId.MmbId":        "InstructedAgent.PaymentSysMemberId",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].IntrmyAgt1.FinInstnId.ClrSysMmbId.MmbId":      "IntermediaryAgent1Id",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].Dbtr.Nm":                                      "DebtorName",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].Dbtr.PstlAdr.StrtNm":                          "DebtorAddress.StreetName",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].Dbtr.PstlAdr.BldgNb":                          "DebtorAddress.BuildingNumber",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].Dbtr.PstlAdr.PstCd":                           "DebtorAddress.PostalCode",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].Dbtr.PstlAdr.TwnNm":                           "DebtorAddress.TownName",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].Dbtr.PstlAdr.CtrySubDvsn":                     "DebtorAddress.Subdivision",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].Dbtr.PstlAdr.Ctry":                            "DebtorAddress.Country",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].DbtrAcct.Id.IBAN":                             "DebtorIBAN",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].DbtrAcct.Id.Othr.Id":                          "DebtorOtherTypeId",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].DbtrAgt.FinInstnId.ClrSysMmbId.ClrSysId.Cd":   "DebtorAgent.PaymentSysCode",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].DbtrAgt.FinInstnId.ClrSysMmbId.MmbId":         "DebtorAgent.PaymentSysMemberId",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].DbtrAgt.FinInstnId.Nm":                        "DebtorAgent.BankName",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].DbtrAgt.FinInstnId.PstlAdr.StrtNm":            "DebtorAgent.PostalAddress.StreetName",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].DbtrAgt.FinInstnId.PstlAdr.BldgNb":            "DebtorAgent.PostalAddress.BuildingNumber",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].DbtrAgt.FinInstnId.PstlAdr.PstCd":             "DebtorAgent.PostalAddress.PostalCode",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].DbtrAgt.FinInstnId.PstlAdr.TwnNm":             "DebtorAgent.PostalAddress.TownName",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].DbtrAgt.FinInstnId.PstlAdr.CtrySubDvsn":       "DebtorAgent.PostalAddress.Subdivision",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].DbtrAgt.FinInstnId.PstlAdr.Ctry":              "DebtorAgent.PostalAddress.Country",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].CdtrAgt.FinInstnId.ClrSysMmbId.ClrSysId.Cd":   "CreditorAgent.PaymentSysCode",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].CdtrAgt.FinInstnId.ClrSysMmbId.MmbId":         "CreditorAgent.PaymentSysMemberId",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].CdtrAgt.FinInstnId.Nm":                        "CreditorAgent.BankName",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].CdtrAgt.FinInstnId.PstlAdr.StrtNm":            "CreditorAgent.PostalAddress.StreetName",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].CdtrAgt.FinInstnId.PstlAdr.BldgNb":            "CreditorAgent.PostalAddress.BuildingNumber",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].CdtrAgt.FinInstnId.PstlAdr.PstCd":             "CreditorAgent.PostalAddress.PostalCode",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].CdtrAgt.FinInstnId.PstlAdr.TwnNm":             "CreditorAgent.PostalAddress.TownName",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].CdtrAgt.FinInstnId.PstlAdr.CtrySubDvsn":       "CreditorAgent.PostalAddress.Subdivision",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].CdtrAgt.FinInstnId.PstlAdr.Ctry":              "CreditorAgent.PostalAddress.Country",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].Cdtr.Nm":                                      "CreditorName",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].Cdtr.PstlAdr.StrtNm":                          "CreditorPostalAddress.StreetName",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].Cdtr.PstlAdr.BldgNb":                          "CreditorPostalAddress.BuildingNumber",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].Cdtr.PstlAdr.PstCd":                           "CreditorPostalAddress.PostalCode",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].Cdtr.PstlAdr.TwnNm":                           "CreditorPostalAddress.TownName",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].Cdtr.PstlAdr.CtrySubDvsn":                     "CreditorPostalAddress.Subdivision",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].Cdtr.PstlAdr.Ctry":                            "CreditorPostalAddress.Country",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].UltmtCdtr.Nm":                                 "UltimateCreditorName",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].UltmtCdtr.PstlAdr.StrtNm":                     "UltimateCreditorAddress.StreetName",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].UltmtCdtr.PstlAdr.BldgNb":                     "UltimateCreditorAddress.BuildingNumber",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].UltmtCdtr.PstlAdr.PstCd":                      "UltimateCreditorAddress.PostalCode",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].UltmtCdtr.PstlAdr.TwnNm":                      "UltimateCreditorAddress.TownName",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].UltmtCdtr.PstlAdr.CtrySubDvsn":                "UltimateCreditorAddress.Subdivision",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].UltmtCdtr.PstlAdr.Ctry":                       "UltimateCreditorAddress.Country",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].CdtrAcct.Id.IBAN":                             "CreditorIBAN",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].CdtrAcct.Id.Othr.Id":                          "CreditorOtherTypeId",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].Purp.Cd":                                      "PurposeOfPayment",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].RltdRmtInf[0].RmtId":                          "RelatedRemittanceInfo.RemittanceId",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].RltdRmtInf[0].RmtLctnDtls[0].Mtd":             "RelatedRemittanceInfo.Method",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].RltdRmtInf[0].RmtLctnDtls[0].ElctrncAdr":      "RelatedRemittanceInfo.ElectronicAddress",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].RmtInf.Ustrd[0]":                              "RemittanceInfor.UnstructuredRemitInfo",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].RmtInf.Strd[0].RfrdDocInf[0].Tp.CdOrPrtry.Cd": "RemittanceInfor.CodeOrProprietary",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].RmtInf.Strd[0].RfrdDocInf[0].Nb":              "RemittanceInfor.Number",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].RmtInf.Strd[0].RfrdDocInf[0].RltdDt":          "RemittanceInfor.RelatedDate",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].RmtInf.Strd[0].TaxRmt.Cdtr.TaxId":             "RemittanceInfor.TaxDetail.TaxId",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].RmtInf.Strd[0].TaxRmt.Rcrd[0].Tp":             "RemittanceInfor.TaxDetail.TaxTypeCode",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].RmtInf.Strd[0].TaxRmt.Rcrd[0].Prd.Yr":         "RemittanceInfor.TaxDetail.TaxPeriodYear",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].RmtInf.Strd[0].TaxRmt.Rcrd[0].Prd.Tp":         "RemittanceInfor.TaxDetail.TaxperiodTimeFrame",
 	}
 }
 func pathMapV8() map[string]any {
 	return map[string]any{
-		"FIToFICstmrCdtTrf.GrpHdr.MsgId":                            "MessageId",
-		"FIToFICstmrCdtTrf.GrpHdr.CreDtTm":                          "CreatedDateTime",
-		"FIToFICstmrCdtTrf.GrpHdr.NbOfTxs":                          "NumberOfTransactions",
-		"FIToFICstmrCdtTrf.GrpHdr.SttlmInf.SttlmMtd":                "SettlementMethod",
-		"FIToFICstmrCdtTrf.GrpHdr.SttlmInf.ClrSys.Cd":               "CommonClearingSysCode",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].PmtId.InstrId":            "InstructionId",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].PmtId.EndToEndId":         "EndToEndId",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].PmtId.UETR":               "Transaction.UniqueEndToEndTransactionRef",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].PmtTpInf.SvcLvl[0].Cd":    "ServiceLevel",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].PmtTpInf.LclInstrm.Prtry": "InstrumentPropCode",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].IntrBkSttlmAmt.Value":     "InterBankSettAmount.Amount",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].IntrBkSttlmAmt.Ccy":       "InterBankSettAmount.Currency",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].IntrBkSttlmDt":            "InterBankSettDate",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].InstdAmt.Value":           "InstructedAmount.Amount",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].InstdAmt.Ccy":             "InstructedAmount.Currency",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].XchgRate":                 "ExchangeRate",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].ChrgBr":                   "ChargeBearer",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].ChrgsInf : ChargesInfo": map[string]string{
-			"Amt.Value":            "Amount.Amount",
-			"Amt.Ccy":              "Amount.Currency",
-			"Agt.FinInstnId.BICFI": "BusinessIdCode",
+		"FIToFICstmrCdtTrf.GrpHdr.MsgId":              "MessageId",
+		"FIToFICstmrCdtTrf.GrpHdr.CreDtTm":            "CreatedDateTime",
+		"FIToFICstmrCdtTrf.GrpHdr.NbOfTxs":            "NumberOfTransactions",
+		"FIToFICstmrCdtTrf.GrpHdr.SttlmInf.SttlmMtd":  "SettlementMethod",
+		"FIToFICstmrCdtTrf.GrpHdr.SttlmInf.ClrSys.Cd": "CommonClearingSysCode",
+		"FIToFICstmrCdtTrf.CdtTrfTxInf : Transactions": map[string]any{
+			"PmtId.InstrId":            "InstructionId",
+			"PmtId.EndToEndId":         "EndToEndId",
+			"PmtId.UETR":               "Transaction.UniqueEndToEndTransactionRef",
+			"PmtTpInf.SvcLvl[0].Cd":    "ServiceLevel",
+			"PmtTpInf.LclInstrm.Prtry": "InstrumentPropCode",
+			"IntrBkSttlmAmt.Value":     "InterBankSettAmount.Amount",
+			"IntrBkSttlmAmt.Ccy":       "InterBankSettAmount.Currency",
+			"IntrBkSttlmDt":            "InterBankSettDate",
+			"InstdAmt.Value":           "InstructedAmount.Amount",
+			"InstdAmt.Ccy":             "InstructedAmount.Currency",
+			"XchgRate":                 "ExchangeRate",
+			"ChrgBr":                   "ChargeBearer",
+			"ChrgsInf : ChargesInfo": map[string]string{
+				"Amt.Value":            "Amount.Amount",
+				"Amt.Ccy":              "Amount.Currency",
+				"Agt.FinInstnId.BICFI": "BusinessIdCode",
+			},
+			"InstgAgt.FinInstnId.ClrSysMmbId.ClrSysId.Cd": "InstructingAgent.PaymentSysCode",
+			"InstgAgt.FinInstnId.ClrSysMmbId.MmbId":       "InstructingAgent.PaymentSysMemberId",
+			"InstdAgt.FinInstnId.ClrSysMmbId.ClrSysId.Cd": "InstructedAgent.PaymentSysCode",
+			"InstdAgt.FinInstnId.ClrSysMmbId.MmbId":       "InstructedAgent.PaymentSysMemberId",
+			"IntrmyAgt1.FinInstnId.ClrSysMmbId.MmbId":     "IntermediaryAgent1Id",
+			"Dbtr.Nm":                                      "DebtorName",
+			"Dbtr.Id.OrgId.LEI":                            "Transaction.DebtorLEI",
+			"Dbtr.PstlAdr.StrtNm":                          "DebtorAddress.StreetName",
+			"Dbtr.PstlAdr.BldgNb":                          "DebtorAddress.BuildingNumber",
+			"Dbtr.PstlAdr.BldgNm":                          "DebtorAddress.BuildingName",
+			"Dbtr.PstlAdr.Flr":                             "DebtorAddress.Floor",
+			"Dbtr.PstlAdr.Room":                            "DebtorAddress.RoomNumber",
+			"Dbtr.PstlAdr.PstCd":                           "DebtorAddress.PostalCode",
+			"Dbtr.PstlAdr.TwnNm":                           "DebtorAddress.TownName",
+			"Dbtr.PstlAdr.CtrySubDvsn":                     "DebtorAddress.Subdivision",
+			"Dbtr.PstlAdr.Ctry":                            "DebtorAddress.Country",
+			"DbtrAcct.Id.IBAN":                             "DebtorIBAN",
+			"DbtrAcct.Id.Othr.Id":                          "DebtorOtherTypeId",
+			"DbtrAgt.FinInstnId.ClrSysMmbId.ClrSysId.Cd":   "DebtorAgent.PaymentSysCode",
+			"DbtrAgt.FinInstnId.ClrSysMmbId.MmbId":         "DebtorAgent.PaymentSysMemberId",
+			"DbtrAgt.FinInstnId.Nm":                        "DebtorAgent.BankName",
+			"DbtrAgt.FinInstnId.PstlAdr.StrtNm":            "DebtorAgent.PostalAddress.StreetName",
+			"DbtrAgt.FinInstnId.PstlAdr.BldgNb":            "DebtorAgent.PostalAddress.BuildingNumber",
+			"DbtrAgt.FinInstnId.PstlAdr.BldgNm":            "DebtorAgent.PostalAddress.BuildingName",
+			"DbtrAgt.FinInstnId.PstlAdr.Flr":               "DebtorAgent.PostalAddress.Floor",
+			"DbtrAgt.FinInstnId.PstlAdr.Room":              "DebtorAgent.PostalAddress.RoomNumber",
+			"DbtrAgt.FinInstnId.PstlAdr.PstCd":             "DebtorAgent.PostalAddress.PostalCode",
+			"DbtrAgt.FinInstnId.PstlAdr.TwnNm":             "DebtorAgent.PostalAddress.TownName",
+			"DbtrAgt.FinInstnId.PstlAdr.CtrySubDvsn":       "DebtorAgent.PostalAddress.Subdivision",
+			"DbtrAgt.FinInstnId.PstlAdr.Ctry":              "DebtorAgent.PostalAddress.Country",
+			"CdtrAgt.FinInstnId.ClrSysMmbId.ClrSysId.Cd":   "CreditorAgent.PaymentSysCode",
+			"CdtrAgt.FinInstnId.ClrSysMmbId.MmbId":         "CreditorAgent.PaymentSysMemberId",
+			"CdtrAgt.FinInstnId.Nm":                        "CreditorAgent.BankName",
+			"CdtrAgt.FinInstnId.PstlAdr.StrtNm":            "CreditorAgent.PostalAddress.StreetName",
+			"CdtrAgt.FinInstnId.PstlAdr.BldgNb":            "CreditorAgent.PostalAddress.BuildingNumber",
+			"CdtrAgt.FinInstnId.PstlAdr.BldgNm":            "CreditorAgent.PostalAddress.BuildingName",
+			"CdtrAgt.FinInstnId.PstlAdr.Flr":               "CreditorAgent.PostalAddress.Floor",
+			"CdtrAgt.FinInstnId.PstlAdr.Room":              "CreditorAgent.PostalAddress.RoomNumber",
+			"CdtrAgt.FinInstnId.PstlAdr.PstCd":             "CreditorAgent.PostalAddress.PostalCode",
+			"CdtrAgt.FinInstnId.PstlAdr.TwnNm":             "CreditorAgent.PostalAddress.TownName",
+			"CdtrAgt.FinInstnId.PstlAdr.CtrySubDvsn":       "CreditorAgent.PostalAddress.Subdivision",
+			"CdtrAgt.FinInstnId.PstlAdr.Ctry":              "CreditorAgent.PostalAddress.Country",
+			"Cdtr.Nm":                                      "CreditorName",
+			"Cdtr.Id.OrgId.LEI":                            "Transaction.CreditorLEI",
+			"Cdtr.PstlAdr.StrtNm":                          "CreditorPostalAddress.StreetName",
+			"Cdtr.PstlAdr.BldgNb":                          "CreditorPostalAddress.BuildingNumber",
+			"Cdtr.PstlAdr.BldgNm":                          "CreditorPostalAddress.BuildingName",
+			"Cdtr.PstlAdr.Flr":                             "CreditorPostalAddress.Floor",
+			"Cdtr.PstlAdr.Room":                            "CreditorPostalAddress.RoomNumber",
+			"Cdtr.PstlAdr.PstCd":                           "CreditorPostalAddress.PostalCode",
+			"Cdtr.PstlAdr.TwnNm":                           "CreditorPostalAddress.TownName",
+			"Cdtr.PstlAdr.CtrySubDvsn":                     "CreditorPostalAddress.Subdivision",
+			"Cdtr.PstlAdr.Ctry":                            "CreditorPostalAddress.Country",
+			"UltmtCdtr.Nm":                                 "UltimateCreditorName",
+			"UltmtCdtr.PstlAdr.StrtNm":                     "UltimateCreditorAddress.StreetName",
+			"UltmtCdtr.PstlAdr.BldgNb":                     "UltimateCreditorAddress.BuildingNumber",
+			"UltmtCdtr.PstlAdr.BldgNm":                     "UltimateCreditorAddress.BuildingName",
+			"UltmtCdtr.PstlAdr.Flr":                        "UltimateCreditorAddress.Floor",
+			"UltmtCdtr.PstlAdr.Room":                       "UltimateCreditorAddress.RoomNumber",
+			"UltmtCdtr.PstlAdr.PstCd":                      "UltimateCreditorAddress.PostalCode",
+			"UltmtCdtr.PstlAdr.TwnNm":                      "UltimateCreditorAddress.TownName",
+			"UltmtCdtr.PstlAdr.CtrySubDvsn":                "UltimateCreditorAddress.Subdivision",
+			"UltmtCdtr.PstlAdr.Ctry":                       "UltimateCreditorAddress.Country",
+			"CdtrAcct.Id.IBAN":                             "CreditorIBAN",
+			"CdtrAcct.Id.Othr.Id":                          "CreditorOtherTypeId",
+			"Purp.Cd":                                      "PurposeOfPayment",
+			"RltdRmtInf[0].RmtId":                          "RelatedRemittanceInfo.RemittanceId",
+			"RltdRmtInf[0].RmtLctnDtls[0].Mtd":             "RelatedRemittanceInfo.Method",
+			"RltdRmtInf[0].RmtLctnDtls[0].ElctrncAdr":      "RelatedRemittanceInfo.ElectronicAddress",
+			"RmtInf.Ustrd[0]":                              "RemittanceInfor.UnstructuredRemitInfo",
+			"RmtInf.Strd[0].RfrdDocInf[0].Tp.CdOrPrtry.Cd": "RemittanceInfor.CodeOrProprietary",
+			"RmtInf.Strd[0].RfrdDocInf[0].Nb":              "RemittanceInfor.Number",
+			"RmtInf.Strd[0].RfrdDocInf[0].RltdDt":          "RemittanceInfor.RelatedDate",
+			"RmtInf.Strd[0].TaxRmt.Cdtr.TaxId":             "RemittanceInfor.TaxDetail.TaxId",
+			"RmtInf.Strd[0].TaxRmt.Rcrd[0].Tp":             "RemittanceInfor.TaxDetail.TaxTypeCode",
+			"RmtInf.Strd[0].TaxRmt.Rcrd[0].Prd.Yr":         "RemittanceInfor.TaxDetail.TaxPeriodYear",
+			"RmtInf.Strd[0].TaxRmt.Rcrd[0].Prd.Tp":         "RemittanceInfor.TaxDetail.TaxperiodTimeFrame",
+
+			"Dbtr.PstlAdr.AdrLine : DebtorAddress.AddressLine":                             models.ListItems,
+			"DbtrAgt.FinInstnId.PstlAdr.AdrLine : DebtorAgent.PostalAddress.AddressLine":   models.ListItems,
+			"CdtrAgt.FinInstnId.PstlAdr.AdrLine : CreditorAgent.PostalAddress.AddressLine": models.ListItems,
+			"Cdtr.PstlAdr.AdrLine : CreditorPostalAddress.AddressLine":                     models.ListItems,
+			"UltmtCdtr.PstlAdr.AdrLine : UltimateCreditorAddress.AddressLine":              models.ListItems,
 		},
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].InstgAgt.FinInstnId.ClrSysMmbId.ClrSysId.Cd":  "InstructingAgent.PaymentSysCode",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].InstgAgt.FinInstnId.ClrSysMmbId.MmbId":        "InstructingAgent.PaymentSysMemberId",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].InstdAgt.FinInstnId.ClrSysMmbId.ClrSysId.Cd":  "InstructedAgent.PaymentSysCode",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].InstdAgt.FinInstnId.ClrSysMmbId.MmbId":        "InstructedAgent.PaymentSysMemberId",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].IntrmyAgt1.FinInstnId.ClrSysMmbId.MmbId":      "IntermediaryAgent1Id",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].Dbtr.Nm":                                      "DebtorName",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].Dbtr.PstlAdr.StrtNm":                          "DebtorAddress.StreetName",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].Dbtr.PstlAdr.BldgNb":                          "DebtorAddress.BuildingNumber",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].Dbtr.PstlAdr.BldgNm":                          "DebtorAddress.BuildingName",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].Dbtr.PstlAdr.Flr":                             "DebtorAddress.Floor",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].Dbtr.PstlAdr.Room":                            "DebtorAddress.RoomNumber",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].Dbtr.PstlAdr.PstCd":                           "DebtorAddress.PostalCode",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].Dbtr.PstlAdr.TwnNm":                           "DebtorAddress.TownName",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].Dbtr.PstlAdr.CtrySubDvsn":                     "DebtorAddress.Subdivision",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].Dbtr.PstlAdr.Ctry":                            "DebtorAddress.Country",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].DbtrAcct.Id.IBAN":                             "DebtorIBAN",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].DbtrAcct.Id.Othr.Id":                          "DebtorOtherTypeId",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].DbtrAgt.FinInstnId.ClrSysMmbId.ClrSysId.Cd":   "DebtorAgent.PaymentSysCode",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].DbtrAgt.FinInstnId.ClrSysMmbId.MmbId":         "DebtorAgent.PaymentSysMemberId",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].DbtrAgt.FinInstnId.Nm":                        "DebtorAgent.BankName",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].DbtrAgt.FinInstnId.PstlAdr.StrtNm":            "DebtorAgent.PostalAddress.StreetName",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].DbtrAgt.FinInstnId.PstlAdr.BldgNb":            "DebtorAgent.PostalAddress.BuildingNumber",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].DbtrAgt.FinInstnId.PstlAdr.BldgNm":            "DebtorAgent.PostalAddress.BuildingName",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].DbtrAgt.FinInstnId.PstlAdr.Flr":               "DebtorAgent.PostalAddress.Floor",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].DbtrAgt.FinInstnId.PstlAdr.Room":              "DebtorAgent.PostalAddress.RoomNumber",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].DbtrAgt.FinInstnId.PstlAdr.PstCd":             "DebtorAgent.PostalAddress.PostalCode",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].DbtrAgt.FinInstnId.PstlAdr.TwnNm":             "DebtorAgent.PostalAddress.TownName",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].DbtrAgt.FinInstnId.PstlAdr.CtrySubDvsn":       "DebtorAgent.PostalAddress.Subdivision",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].DbtrAgt.FinInstnId.PstlAdr.Ctry":              "DebtorAgent.PostalAddress.Country",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].CdtrAgt.FinInstnId.ClrSysMmbId.ClrSysId.Cd":   "CreditorAgent.PaymentSysCode",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].CdtrAgt.FinInstnId.ClrSysMmbId.MmbId":         "CreditorAgent.PaymentSysMemberId",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].CdtrAgt.FinInstnId.Nm":                        "CreditorAgent.BankName",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].CdtrAgt.FinInstnId.PstlAdr.StrtNm":            "CreditorAgent.PostalAddress.StreetName",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].CdtrAgt.FinInstnId.PstlAdr.BldgNb":            "CreditorAgent.PostalAddress.BuildingNumber",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].CdtrAgt.FinInstnId.PstlAdr.BldgNm":            "CreditorAgent.PostalAddress.BuildingName",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].CdtrAgt.FinInstnId.PstlAdr.Flr":               "CreditorAgent.PostalAddress.Floor",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].CdtrAgt.FinInstnId.PstlAdr.Room":              "CreditorAgent.PostalAddress.RoomNumber",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].CdtrAgt.FinInstnId.PstlAdr.PstCd":             "CreditorAgent.PostalAddress.PostalCode",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].CdtrAgt.FinInstnId.PstlAdr.TwnNm":             "CreditorAgent.PostalAddress.TownName",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].CdtrAgt.FinInstnId.PstlAdr.CtrySubDvsn":       "CreditorAgent.PostalAddress.Subdivision",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].CdtrAgt.FinInstnId.PstlAdr.Ctry":              "CreditorAgent.PostalAddress.Country",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].Cdtr.Nm":                                      "CreditorName",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].Cdtr.PstlAdr.StrtNm":                          "CreditorPostalAddress.StreetName",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].Cdtr.PstlAdr.BldgNb":                          "CreditorPostalAddress.BuildingNumber",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].Cdtr.PstlAdr.BldgNm":                          "CreditorPostalAddress.BuildingName",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].Cdtr.PstlAdr.Flr":                             "CreditorPostalAddress.Floor",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].Cdtr.PstlAdr.Room":                            "CreditorPostalAddress.RoomNumber",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].Cdtr.PstlAdr.PstCd":                           "CreditorPostalAddress.PostalCode",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].Cdtr.PstlAdr.TwnNm":                           "CreditorPostalAddress.TownName",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].Cdtr.PstlAdr.CtrySubDvsn":                     "CreditorPostalAddress.Subdivision",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].Cdtr.PstlAdr.Ctry":                            "CreditorPostalAddress.Country",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].UltmtCdtr.Nm":                                 "UltimateCreditorName",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].UltmtCdtr.PstlAdr.StrtNm":                     "UltimateCreditorAddress.StreetName",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].UltmtCdtr.PstlAdr.BldgNb":                     "UltimateCreditorAddress.BuildingNumber",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].UltmtCdtr.PstlAdr.BldgNm":                     "UltimateCreditorAddress.BuildingName",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].UltmtCdtr.PstlAdr.Flr":                        "UltimateCreditorAddress.Floor",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].UltmtCdtr.PstlAdr.Room":                       "UltimateCreditorAddress.RoomNumber",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].UltmtCdtr.PstlAdr.PstCd":                      "UltimateCreditorAddress.PostalCode",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].UltmtCdtr.PstlAdr.TwnNm":                      "UltimateCreditorAddress.TownName",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].UltmtCdtr.PstlAdr.CtrySubDvsn":                "UltimateCreditorAddress.Subdivision",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].UltmtCdtr.PstlAdr.Ctry":                       "UltimateCreditorAddress.Country",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].CdtrAcct.Id.IBAN":                             "CreditorIBAN",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].CdtrAcct.Id.Othr.Id":                          "CreditorOtherTypeId",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].Purp.Cd":                                      "PurposeOfPayment",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].RltdRmtInf[0].RmtId":                          "RelatedRemittanceInfo.RemittanceId",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].RltdRmtInf[0].RmtLctnDtls[0].Mtd":             "RelatedRemittanceInfo.Method",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].RltdRmtInf[0].RmtLctnDtls[0].ElctrncAdr":      "RelatedRemittanceInfo.ElectronicAddress",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].RmtInf.Ustrd[0]":                              "RemittanceInfor.UnstructuredRemitInfo",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].RmtInf.Strd[0].RfrdDocInf[0].Tp.CdOrPrtry.Cd": "RemittanceInfor.CodeOrProprietary",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].RmtInf.Strd[0].RfrdDocInf[0].Nb":              "RemittanceInfor.Number",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].RmtInf.Strd[0].RfrdDocInf[0].RltdDt":          "RemittanceInfor.RelatedDate",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].RmtInf.Strd[0].TaxRmt.Cdtr.TaxId":             "RemittanceInfor.TaxDetail.TaxId",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].RmtInf.Strd[0].TaxRmt.Rcrd[0].Tp":             "RemittanceInfor.TaxDetail.TaxTypeCode",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].RmtInf.Strd[0].TaxRmt.Rcrd[0].Prd.Yr":         "RemittanceInfor.TaxDetail.TaxPeriodYear",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].RmtInf.Strd[0].TaxRmt.Rcrd[0].Prd.Tp":         "RemittanceInfor.TaxDetail.TaxperiodTimeFrame",
 	}
 }
 func pathMapV9() map[string]any {
@@ -370,102 +406,112 @@ func pathMapV11() map[string]any {
 }
 func pathMapV12() map[string]any {
 	return map[string]any{
-		"FIToFICstmrCdtTrf.GrpHdr.MsgId":                            "MessageId",
-		"FIToFICstmrCdtTrf.GrpHdr.CreDtTm":                          "CreatedDateTime",
-		"FIToFICstmrCdtTrf.GrpHdr.NbOfTxs":                          "NumberOfTransactions",
-		"FIToFICstmrCdtTrf.GrpHdr.SttlmInf.SttlmMtd":                "SettlementMethod",
-		"FIToFICstmrCdtTrf.GrpHdr.SttlmInf.ClrSys.Cd":               "CommonClearingSysCode",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].PmtId.InstrId":            "InstructionId",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].PmtId.EndToEndId":         "EndToEndId",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].PmtId.UETR":               "Transaction.UniqueEndToEndTransactionRef",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].PmtTpInf.SvcLvl[0].Cd":    "ServiceLevel",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].PmtTpInf.LclInstrm.Prtry": "InstrumentPropCode",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].IntrBkSttlmAmt.Value":     "InterBankSettAmount.Amount",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].IntrBkSttlmAmt.Ccy":       "InterBankSettAmount.Currency",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].IntrBkSttlmDt":            "InterBankSettDate",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].InstdAmt.Value":           "InstructedAmount.Amount",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].InstdAmt.Ccy":             "InstructedAmount.Currency",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].XchgRate":                 "ExchangeRate",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].ChrgBr":                   "ChargeBearer",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].ChrgsInf : ChargesInfo": map[string]string{
-			"Amt.Value":            "Amount.Amount",
-			"Amt.Ccy":              "Amount.Currency",
-			"Agt.FinInstnId.BICFI": "BusinessIdCode",
+		"FIToFICstmrCdtTrf.GrpHdr.MsgId":              "MessageId",
+		"FIToFICstmrCdtTrf.GrpHdr.CreDtTm":            "CreatedDateTime",
+		"FIToFICstmrCdtTrf.GrpHdr.NbOfTxs":            "NumberOfTransactions",
+		"FIToFICstmrCdtTrf.GrpHdr.SttlmInf.SttlmMtd":  "SettlementMethod",
+		"FIToFICstmrCdtTrf.GrpHdr.SttlmInf.ClrSys.Cd": "CommonClearingSysCode",
+		"FIToFICstmrCdtTrf.CdtTrfTxInf : Transactions": map[string]any{
+			"PmtId.InstrId":            "InstructionId",
+			"PmtId.EndToEndId":         "EndToEndId",
+			"PmtId.UETR":               "Transaction.UniqueEndToEndTransactionRef",
+			"PmtTpInf.SvcLvl[0].Cd":    "ServiceLevel",
+			"PmtTpInf.LclInstrm.Prtry": "InstrumentPropCode",
+			"IntrBkSttlmAmt.Value":     "InterBankSettAmount.Amount",
+			"IntrBkSttlmAmt.Ccy":       "InterBankSettAmount.Currency",
+			"IntrBkSttlmDt":            "InterBankSettDate",
+			"InstdAmt.Value":           "InstructedAmount.Amount",
+			"InstdAmt.Ccy":             "InstructedAmount.Currency",
+			"XchgRate":                 "ExchangeRate",
+			"ChrgBr":                   "ChargeBearer",
+			"ChrgsInf : ChargesInfo": map[string]string{
+				"Amt.Value":            "Amount.Amount",
+				"Amt.Ccy":              "Amount.Currency",
+				"Agt.FinInstnId.BICFI": "BusinessIdCode",
+			},
+			"InstgAgt.FinInstnId.ClrSysMmbId.ClrSysId.Cd": "InstructingAgent.PaymentSysCode",
+			"InstgAgt.FinInstnId.ClrSysMmbId.MmbId":       "InstructingAgent.PaymentSysMemberId",
+			"InstdAgt.FinInstnId.ClrSysMmbId.ClrSysId.Cd": "InstructedAgent.PaymentSysCode",
+			"InstdAgt.FinInstnId.ClrSysMmbId.MmbId":       "InstructedAgent.PaymentSysMemberId",
+			"IntrmyAgt1.FinInstnId.ClrSysMmbId.MmbId":     "IntermediaryAgent1Id",
+			"Dbtr.Nm":                                      "DebtorName",
+			"Dbtr.Id.OrgId.LEI":                            "Transaction.DebtorLEI",
+			"Dbtr.PstlAdr.StrtNm":                          "DebtorAddress.StreetName",
+			"Dbtr.PstlAdr.BldgNb":                          "DebtorAddress.BuildingNumber",
+			"Dbtr.PstlAdr.BldgNm":                          "DebtorAddress.BuildingName",
+			"Dbtr.PstlAdr.Flr":                             "DebtorAddress.Floor",
+			"Dbtr.PstlAdr.Room":                            "DebtorAddress.RoomNumber",
+			"Dbtr.PstlAdr.PstCd":                           "DebtorAddress.PostalCode",
+			"Dbtr.PstlAdr.TwnNm":                           "DebtorAddress.TownName",
+			"Dbtr.PstlAdr.CtrySubDvsn":                     "DebtorAddress.Subdivision",
+			"Dbtr.PstlAdr.Ctry":                            "DebtorAddress.Country",
+			"DbtrAcct.Id.IBAN":                             "DebtorIBAN",
+			"DbtrAcct.Id.Othr.Id":                          "DebtorOtherTypeId",
+			"DbtrAgt.FinInstnId.ClrSysMmbId.ClrSysId.Cd":   "DebtorAgent.PaymentSysCode",
+			"DbtrAgt.FinInstnId.ClrSysMmbId.MmbId":         "DebtorAgent.PaymentSysMemberId",
+			"DbtrAgt.FinInstnId.Nm":                        "DebtorAgent.BankName",
+			"DbtrAgt.FinInstnId.PstlAdr.StrtNm":            "DebtorAgent.PostalAddress.StreetName",
+			"DbtrAgt.FinInstnId.PstlAdr.BldgNb":            "DebtorAgent.PostalAddress.BuildingNumber",
+			"DbtrAgt.FinInstnId.PstlAdr.BldgNm":            "DebtorAgent.PostalAddress.BuildingName",
+			"DbtrAgt.FinInstnId.PstlAdr.Flr":               "DebtorAgent.PostalAddress.Floor",
+			"DbtrAgt.FinInstnId.PstlAdr.Room":              "DebtorAgent.PostalAddress.RoomNumber",
+			"DbtrAgt.FinInstnId.PstlAdr.PstCd":             "DebtorAgent.PostalAddress.PostalCode",
+			"DbtrAgt.FinInstnId.PstlAdr.TwnNm":             "DebtorAgent.PostalAddress.TownName",
+			"DbtrAgt.FinInstnId.PstlAdr.CtrySubDvsn":       "DebtorAgent.PostalAddress.Subdivision",
+			"DbtrAgt.FinInstnId.PstlAdr.Ctry":              "DebtorAgent.PostalAddress.Country",
+			"CdtrAgt.FinInstnId.ClrSysMmbId.ClrSysId.Cd":   "CreditorAgent.PaymentSysCode",
+			"CdtrAgt.FinInstnId.ClrSysMmbId.MmbId":         "CreditorAgent.PaymentSysMemberId",
+			"CdtrAgt.FinInstnId.Nm":                        "CreditorAgent.BankName",
+			"CdtrAgt.FinInstnId.PstlAdr.StrtNm":            "CreditorAgent.PostalAddress.StreetName",
+			"CdtrAgt.FinInstnId.PstlAdr.BldgNb":            "CreditorAgent.PostalAddress.BuildingNumber",
+			"CdtrAgt.FinInstnId.PstlAdr.BldgNm":            "CreditorAgent.PostalAddress.BuildingName",
+			"CdtrAgt.FinInstnId.PstlAdr.Flr":               "CreditorAgent.PostalAddress.Floor",
+			"CdtrAgt.FinInstnId.PstlAdr.Room":              "CreditorAgent.PostalAddress.RoomNumber",
+			"CdtrAgt.FinInstnId.PstlAdr.PstCd":             "CreditorAgent.PostalAddress.PostalCode",
+			"CdtrAgt.FinInstnId.PstlAdr.TwnNm":             "CreditorAgent.PostalAddress.TownName",
+			"CdtrAgt.FinInstnId.PstlAdr.CtrySubDvsn":       "CreditorAgent.PostalAddress.Subdivision",
+			"CdtrAgt.FinInstnId.PstlAdr.Ctry":              "CreditorAgent.PostalAddress.Country",
+			"Cdtr.Nm":                                      "CreditorName",
+			"Cdtr.Id.OrgId.LEI":                            "Transaction.CreditorLEI",
+			"Cdtr.PstlAdr.StrtNm":                          "CreditorPostalAddress.StreetName",
+			"Cdtr.PstlAdr.BldgNb":                          "CreditorPostalAddress.BuildingNumber",
+			"Cdtr.PstlAdr.BldgNm":                          "CreditorPostalAddress.BuildingName",
+			"Cdtr.PstlAdr.Flr":                             "CreditorPostalAddress.Floor",
+			"Cdtr.PstlAdr.Room":                            "CreditorPostalAddress.RoomNumber",
+			"Cdtr.PstlAdr.PstCd":                           "CreditorPostalAddress.PostalCode",
+			"Cdtr.PstlAdr.TwnNm":                           "CreditorPostalAddress.TownName",
+			"Cdtr.PstlAdr.CtrySubDvsn":                     "CreditorPostalAddress.Subdivision",
+			"Cdtr.PstlAdr.Ctry":                            "CreditorPostalAddress.Country",
+			"UltmtCdtr.Nm":                                 "UltimateCreditorName",
+			"UltmtCdtr.PstlAdr.StrtNm":                     "UltimateCreditorAddress.StreetName",
+			"UltmtCdtr.PstlAdr.BldgNb":                     "UltimateCreditorAddress.BuildingNumber",
+			"UltmtCdtr.PstlAdr.BldgNm":                     "UltimateCreditorAddress.BuildingName",
+			"UltmtCdtr.PstlAdr.Flr":                        "UltimateCreditorAddress.Floor",
+			"UltmtCdtr.PstlAdr.Room":                       "UltimateCreditorAddress.RoomNumber",
+			"UltmtCdtr.PstlAdr.PstCd":                      "UltimateCreditorAddress.PostalCode",
+			"UltmtCdtr.PstlAdr.TwnNm":                      "UltimateCreditorAddress.TownName",
+			"UltmtCdtr.PstlAdr.CtrySubDvsn":                "UltimateCreditorAddress.Subdivision",
+			"UltmtCdtr.PstlAdr.Ctry":                       "UltimateCreditorAddress.Country",
+			"CdtrAcct.Id.IBAN":                             "CreditorIBAN",
+			"CdtrAcct.Id.Othr.Id":                          "CreditorOtherTypeId",
+			"Purp.Cd":                                      "PurposeOfPayment",
+			"RltdRmtInf[0].RmtId":                          "RelatedRemittanceInfo.RemittanceId",
+			"RltdRmtInf[0].RmtLctnDtls[0].Mtd":             "RelatedRemittanceInfo.Method",
+			"RltdRmtInf[0].RmtLctnDtls[0].ElctrncAdr":      "RelatedRemittanceInfo.ElectronicAddress",
+			"RmtInf.Ustrd[0]":                              "RemittanceInfor.UnstructuredRemitInfo",
+			"RmtInf.Strd[0].RfrdDocInf[0].Tp.CdOrPrtry.Cd": "RemittanceInfor.CodeOrProprietary",
+			"RmtInf.Strd[0].RfrdDocInf[0].Nb":              "RemittanceInfor.Number",
+			"RmtInf.Strd[0].RfrdDocInf[0].RltdDt.Dt":       "RemittanceInfor.RelatedDate",
+			"RmtInf.Strd[0].TaxRmt.Cdtr.TaxId":             "RemittanceInfor.TaxDetail.TaxId",
+			"RmtInf.Strd[0].TaxRmt.Rcrd[0].Tp":             "RemittanceInfor.TaxDetail.TaxTypeCode",
+			"RmtInf.Strd[0].TaxRmt.Rcrd[0].Prd.Yr":         "RemittanceInfor.TaxDetail.TaxPeriodYear",
+			"RmtInf.Strd[0].TaxRmt.Rcrd[0].Prd.Tp":         "RemittanceInfor.TaxDetail.TaxperiodTimeFrame",
+
+			"Dbtr.PstlAdr.AdrLine : DebtorAddress.AddressLine":                             models.ListItems,
+			"DbtrAgt.FinInstnId.PstlAdr.AdrLine : DebtorAgent.PostalAddress.AddressLine":   models.ListItems,
+			"CdtrAgt.FinInstnId.PstlAdr.AdrLine : CreditorAgent.PostalAddress.AddressLine": models.ListItems,
+			"Cdtr.PstlAdr.AdrLine : CreditorPostalAddress.AddressLine":                     models.ListItems,
+			"UltmtCdtr.PstlAdr.AdrLine : UltimateCreditorAddress.AddressLine":              models.ListItems,
 		},
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].InstgAgt.FinInstnId.ClrSysMmbId.ClrSysId.Cd":  "InstructingAgent.PaymentSysCode",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].InstgAgt.FinInstnId.ClrSysMmbId.MmbId":        "InstructingAgent.PaymentSysMemberId",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].InstdAgt.FinInstnId.ClrSysMmbId.ClrSysId.Cd":  "InstructedAgent.PaymentSysCode",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].InstdAgt.FinInstnId.ClrSysMmbId.MmbId":        "InstructedAgent.PaymentSysMemberId",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].IntrmyAgt1.FinInstnId.ClrSysMmbId.MmbId":      "IntermediaryAgent1Id",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].Dbtr.Nm":                                      "DebtorName",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].Dbtr.PstlAdr.StrtNm":                          "DebtorAddress.StreetName",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].Dbtr.PstlAdr.BldgNb":                          "DebtorAddress.BuildingNumber",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].Dbtr.PstlAdr.BldgNm":                          "DebtorAddress.BuildingName",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].Dbtr.PstlAdr.Flr":                             "DebtorAddress.Floor",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].Dbtr.PstlAdr.Room":                            "DebtorAddress.RoomNumber",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].Dbtr.PstlAdr.PstCd":                           "DebtorAddress.PostalCode",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].Dbtr.PstlAdr.TwnNm":                           "DebtorAddress.TownName",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].Dbtr.PstlAdr.CtrySubDvsn":                     "DebtorAddress.Subdivision",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].Dbtr.PstlAdr.Ctry":                            "DebtorAddress.Country",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].DbtrAcct.Id.IBAN":                             "DebtorIBAN",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].DbtrAcct.Id.Othr.Id":                          "DebtorOtherTypeId",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].DbtrAgt.FinInstnId.ClrSysMmbId.ClrSysId.Cd":   "DebtorAgent.PaymentSysCode",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].DbtrAgt.FinInstnId.ClrSysMmbId.MmbId":         "DebtorAgent.PaymentSysMemberId",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].DbtrAgt.FinInstnId.Nm":                        "DebtorAgent.BankName",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].DbtrAgt.FinInstnId.PstlAdr.StrtNm":            "DebtorAgent.PostalAddress.StreetName",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].DbtrAgt.FinInstnId.PstlAdr.BldgNb":            "DebtorAgent.PostalAddress.BuildingNumber",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].DbtrAgt.FinInstnId.PstlAdr.BldgNm":            "DebtorAgent.PostalAddress.BuildingName",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].DbtrAgt.FinInstnId.PstlAdr.Flr":               "DebtorAgent.PostalAddress.Floor",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].DbtrAgt.FinInstnId.PstlAdr.Room":              "DebtorAgent.PostalAddress.RoomNumber",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].DbtrAgt.FinInstnId.PstlAdr.PstCd":             "DebtorAgent.PostalAddress.PostalCode",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].DbtrAgt.FinInstnId.PstlAdr.TwnNm":             "DebtorAgent.PostalAddress.TownName",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].DbtrAgt.FinInstnId.PstlAdr.CtrySubDvsn":       "DebtorAgent.PostalAddress.Subdivision",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].DbtrAgt.FinInstnId.PstlAdr.Ctry":              "DebtorAgent.PostalAddress.Country",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].CdtrAgt.FinInstnId.ClrSysMmbId.ClrSysId.Cd":   "CreditorAgent.PaymentSysCode",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].CdtrAgt.FinInstnId.ClrSysMmbId.MmbId":         "CreditorAgent.PaymentSysMemberId",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].CdtrAgt.FinInstnId.Nm":                        "CreditorAgent.BankName",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].CdtrAgt.FinInstnId.PstlAdr.StrtNm":            "CreditorAgent.PostalAddress.StreetName",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].CdtrAgt.FinInstnId.PstlAdr.BldgNb":            "CreditorAgent.PostalAddress.BuildingNumber",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].CdtrAgt.FinInstnId.PstlAdr.BldgNm":            "CreditorAgent.PostalAddress.BuildingName",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].CdtrAgt.FinInstnId.PstlAdr.Flr":               "CreditorAgent.PostalAddress.Floor",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].CdtrAgt.FinInstnId.PstlAdr.Room":              "CreditorAgent.PostalAddress.RoomNumber",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].CdtrAgt.FinInstnId.PstlAdr.PstCd":             "CreditorAgent.PostalAddress.PostalCode",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].CdtrAgt.FinInstnId.PstlAdr.TwnNm":             "CreditorAgent.PostalAddress.TownName",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].CdtrAgt.FinInstnId.PstlAdr.CtrySubDvsn":       "CreditorAgent.PostalAddress.Subdivision",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].CdtrAgt.FinInstnId.PstlAdr.Ctry":              "CreditorAgent.PostalAddress.Country",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].Cdtr.Nm":                                      "CreditorName",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].Cdtr.PstlAdr.StrtNm":                          "CreditorPostalAddress.StreetName",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].Cdtr.PstlAdr.BldgNb":                          "CreditorPostalAddress.BuildingNumber",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].Cdtr.PstlAdr.BldgNm":                          "CreditorPostalAddress.BuildingName",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].Cdtr.PstlAdr.Flr":                             "CreditorPostalAddress.Floor",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].Cdtr.PstlAdr.Room":                            "CreditorPostalAddress.RoomNumber",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].Cdtr.PstlAdr.PstCd":                           "CreditorPostalAddress.PostalCode",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].Cdtr.PstlAdr.TwnNm":                           "CreditorPostalAddress.TownName",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].Cdtr.PstlAdr.CtrySubDvsn":                     "CreditorPostalAddress.Subdivision",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].Cdtr.PstlAdr.Ctry":                            "CreditorPostalAddress.Country",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].UltmtCdtr.Nm":                                 "UltimateCreditorName",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].UltmtCdtr.PstlAdr.StrtNm":                     "UltimateCreditorAddress.StreetName",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].UltmtCdtr.PstlAdr.BldgNb":                     "UltimateCreditorAddress.BuildingNumber",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].UltmtCdtr.PstlAdr.BldgNm":                     "UltimateCreditorAddress.BuildingName",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].UltmtCdtr.PstlAdr.Flr":                        "UltimateCreditorAddress.Floor",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].UltmtCdtr.PstlAdr.Room":                       "UltimateCreditorAddress.RoomNumber",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].UltmtCdtr.PstlAdr.PstCd":                      "UltimateCreditorAddress.PostalCode",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].UltmtCdtr.PstlAdr.TwnNm":                      "UltimateCreditorAddress.TownName",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].UltmtCdtr.PstlAdr.CtrySubDvsn":                "UltimateCreditorAddress.Subdivision",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].UltmtCdtr.PstlAdr.Ctry":                       "UltimateCreditorAddress.Country",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].CdtrAcct.Id.IBAN":                             "CreditorIBAN",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].CdtrAcct.Id.Othr.Id":                          "CreditorOtherTypeId",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].Purp.Cd":                                      "PurposeOfPayment",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].RltdRmtInf[0].RmtId":                          "RelatedRemittanceInfo.RemittanceId",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].RltdRmtInf[0].RmtLctnDtls[0].Mtd":             "RelatedRemittanceInfo.Method",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].RltdRmtInf[0].RmtLctnDtls[0].ElctrncAdr":      "RelatedRemittanceInfo.ElectronicAddress",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].RmtInf.Ustrd[0]":                              "RemittanceInfor.UnstructuredRemitInfo",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].RmtInf.Strd[0].RfrdDocInf[0].Tp.CdOrPrtry.Cd": "RemittanceInfor.CodeOrProprietary",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].RmtInf.Strd[0].RfrdDocInf[0].Nb":              "RemittanceInfor.Number",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].RmtInf.Strd[0].RfrdDocInf[0].RltdDt.Dt":       "RemittanceInfor.RelatedDate",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].RmtInf.Strd[0].TaxRmt.Cdtr.TaxId":             "RemittanceInfor.TaxDetail.TaxId",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].RmtInf.Strd[0].TaxRmt.Rcrd[0].Tp":             "RemittanceInfor.TaxDetail.TaxTypeCode",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].RmtInf.Strd[0].TaxRmt.Rcrd[0].Prd.Yr":         "RemittanceInfor.TaxDetail.TaxPeriodYear",
-		"FIToFICstmrCdtTrf.CdtTrfTxInf[0].RmtInf.Strd[0].TaxRmt.Rcrd[0].Prd.Tp":         "RemittanceInfor.TaxDetail.TaxperiodTimeFrame",
 	}
 }
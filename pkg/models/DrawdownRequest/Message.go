@@ -18,7 +18,9 @@ import (
 	"github.com/moov-io/fedwire20022/pkg/fedwire"
 	"github.com/moov-io/wire20022/pkg/base"
 	"github.com/moov-io/wire20022/pkg/models"
+	"github.com/moov-io/wire20022/pkg/models/BusinessApplicationHeader"
 	"io"
+	"time"
 )
 
 // AccountEnhancementFields available in V5+ versions
@@ -133,6 +135,18 @@ type MessageModel struct {
 	// Version-specific field groups (type-safe, nil when not applicable)
 	AccountEnhancement *AccountEnhancementFields `json:",inline,omitempty"` // V5+ only
 	AddressEnhancement *AddressEnhancementFields `json:",inline,omitempty"` // V7+ only
+
+	// Optional business application header, written and read next to the Document
+	AppHdr *BusinessApplicationHeader.MessageModel `json:"appHdr,omitempty"`
+
+	// Document content not covered by the path map, kept when parsed with base.PreserveUnmapped
+	Unmapped *models.Unmapped `json:"unmapped,omitempty"`
+
+	// Version of the document the message was parsed from; empty for messages built in code
+	ParsedVersion PAIN_013_001_VERSION `json:"parsedVersion,omitempty"`
+
+	// Values Fedwire would refuse that the document held, which WriteXML writes back as read
+	ReadValues models.ReadValues `json:"-"`
 }
 
 // UnmarshalJSON implements custom JSON unmarshaling to properly handle grouped fields
@@ -253,21 +267,22 @@ func init() {
 		VersionPathMap,
 		RequiredFields,
 	)
+	processor.SetMessageFactory(NewMessageForVersion)
 }
 
 // ReadXML reads XML data from an io.Reader into the MessageModel
-func (m *MessageModel) ReadXML(r io.Reader) error {
+func (m *MessageModel) ReadXML(r io.Reader, opts ...base.ParseOption) error {
 	data, err := io.ReadAll(r)
 	if err != nil {
 		return fmt.Errorf("reading XML: %w", err)
 	}
 
-	model, err := processor.ProcessMessage(data)
+	model, err := ParseXML(data, opts...)
 	if err != nil {
 		return err
 	}
 
-	*m = model
+	*m = *model
 	return nil
 }
 
@@ -279,7 +294,8 @@ func (m *MessageModel) ReadXML(r io.Reader) error {
 //   - Properly formatted with indentation
 //   - Automatic namespace handling
 //   - Validates required fields before writing
-//   - Defaults to latest version if not specified
+//   - Defaults to the version the message was parsed from, else the latest, if not specified
+//   - Writes the AppHdr ahead of the Document when set, with MsgDefIdr matching the version
 //
 // Example:
 //
@@ -294,8 +310,8 @@ func (m *MessageModel) ReadXML(r io.Reader) error {
 //
 // For advanced use cases requiring document inspection before serialization, see DocumentWith.
 func (m *MessageModel) WriteXML(w io.Writer, version ...PAIN_013_001_VERSION) error {
-	// Default to latest version
-	ver := PAIN_013_001_10
+	// Default to the version the message was read in, else latest
+	ver := m.documentVersion()
 	if len(version) > 0 {
 		ver = version[0]
 	}
@@ -316,14 +332,88 @@ func (m *MessageModel) WriteXML(w io.Writer, version ...PAIN_013_001_VERSION) er
 		return fmt.Errorf("writing XML header: %w", err)
 	}
 
+	// Write the business application header, if any, ahead of the document
+	if err := BusinessApplicationHeader.Encode(encoder, m.AppHdr, VersionNameSpaceMap[ver]); err != nil {
+		return err
+	}
+
 	// Encode document
-	if err := encoder.Encode(doc); err != nil {
+	if err := processor.EncodeDocument(encoder, doc, *m, ver); err != nil {
 		return fmt.Errorf("encoding XML: %w", err)
 	}
 
 	return encoder.Flush()
 }
 
+// GetMessageId returns the message identification (MsgId)
+func (m *MessageModel) GetMessageId() string {
+	return m.MessageId
+}
+
+// CreatedAt returns when the message was created (CreDtTm)
+func (m *MessageModel) CreatedAt() time.Time {
+	return m.CreatedDateTime
+}
+
+// ISOMessageName returns the ISO 20022 message the model represents, "pain.013"
+func (m *MessageModel) ISOMessageName() string {
+	return "pain.013"
+}
+
+// Version returns the version WriteDocument writes: the one the message was parsed from or its
+// AppHdr names, otherwise PAIN_013_001_10
+func (m *MessageModel) Version() string {
+	return string(m.documentVersion())
+}
+
+// Validate checks the required fields, the values models.ValidateValues covers and the rules of
+// the message's Version
+func (m *MessageModel) Validate() error {
+	if err := CheckRequiredFields(*m); err != nil {
+		return err
+	}
+	if err := models.ValidateValues(*m); err != nil {
+		return err
+	}
+	return m.ValidateForVersion(m.documentVersion())
+}
+
+// WriteDocument writes the message like WriteXML, in its Version
+func (m *MessageModel) WriteDocument(w io.Writer) error {
+	return m.WriteXML(w, m.documentVersion())
+}
+
+// documentVersion returns the version WriteXML writes when none is given
+func (m *MessageModel) documentVersion() PAIN_013_001_VERSION {
+	if m.ParsedVersion != "" {
+		return m.ParsedVersion
+	}
+	var msgDefIdr string
+	if m.AppHdr != nil {
+		msgDefIdr = m.AppHdr.MessageDefinitionId
+	}
+	return processor.DocumentVersion(m.Unmapped, msgDefIdr, PAIN_013_001_10)
+}
+
+// ConvertToVersion returns a copy of the message for another version of pain.013, e.g. for a
+// counterparty on an older release. Values the version has no element for are dropped and the
+// field groups it needs are added; the report lists both. The message is left unchanged.
+func (m *MessageModel) ConvertToVersion(version PAIN_013_001_VERSION) (*MessageModel, *base.ConversionReport, error) {
+	converted, report, err := processor.ConvertMessage(*m, m.documentVersion(), version)
+	if err != nil {
+		return nil, nil, err
+	}
+	namespace := VersionNameSpaceMap[version]
+	if converted.Unmapped, err = processor.CarryUnmapped(report, converted.Unmapped, version); err != nil {
+		return nil, nil, err
+	}
+	if converted.AppHdr != nil {
+		converted.AppHdr.MessageDefinitionId = BusinessApplicationHeader.MessageDefinitionIdFor(namespace)
+	}
+	converted.ParsedVersion = version
+	return &converted, report, nil
+}
+
 var RequiredFields = []string{
 	"MessageId", "CreatedDateTime", "NumberofTransaction", "InitiatingParty", "PaymentInfoId", "PaymentMethod",
 	"RequestedExecutDate", "Debtor", "DebtorAgent", "CreditTransTransaction",
@@ -331,11 +421,22 @@ var RequiredFields = []string{
 
 // ParseXML reads XML data into the MessageModel
 // This is the primary function for parsing XML from byte data
-func ParseXML(data []byte) (*MessageModel, error) {
-	model, err := processor.ProcessMessage(data)
+// An AppHdr sent ahead of the Document is read into the AppHdr field
+// Pass base.PreserveUnmapped() to keep elements the model does not cover for WriteXML
+func ParseXML(data []byte, opts ...base.ParseOption) (*MessageModel, error) {
+	appHdr, data, err := BusinessApplicationHeader.Split(data)
 	if err != nil {
 		return nil, err
 	}
+
+	model, version, unmapped, err := processor.ParseMessage(data, opts...)
+	if err != nil {
+		return nil, err
+	}
+	model.AppHdr = appHdr
+	model.Unmapped = unmapped
+	model.ParsedVersion = version
+	model.ReadValues = models.NewReadValues(&model)
 	return &model, nil
 }
 
@@ -367,7 +468,13 @@ func DocumentWith(model MessageModel, version PAIN_013_001_VERSION) (models.ISOD
 	if err := processor.ValidateRequiredFields(model); err != nil {
 		return nil, err
 	}
-	return processor.CreateDocument(model, version)
+	return processor.CreateDocumentWith(model, version, model.Unmapped, model.ReadValues)
+}
+
+// EncodeDocument writes a document DocumentWith created from the MessageModel, with each amount
+// in the digits of its Decimal rather than those of the document's binary number
+func EncodeDocument(encoder *xml.Encoder, doc models.ISODocument, model MessageModel, version PAIN_013_001_VERSION) error {
+	return processor.EncodeDocument(encoder, doc, model, version)
 }
 
 // CheckRequiredFields uses base abstractions to replace 30+ lines with a single call
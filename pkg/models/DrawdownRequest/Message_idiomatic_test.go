@@ -3,6 +3,7 @@ package DrawdownRequest
 import (
 	"bytes"
 	"encoding/json"
+	"os"
 	"strings"
 	"testing"
 	"time"
@@ -708,3 +709,21 @@ func BenchmarkParseXML(b *testing.B) {
 		_, _ = ParseXML(xmlData)
 	}
 }
+
+// TestAddressLines tests that the AdrLine lines of the parties survive a write/read round trip
+func TestAddressLines(t *testing.T) {
+	data, err := os.ReadFile("./swiftSample/Drawdowns_Scenario1_Step1_pain.013")
+	require.NoError(t, err)
+	model, err := ParseXML(data)
+	require.NoError(t, err)
+	model.Debtor.Address.AddressLine = []string{"Attn Treasury"}
+	model.CreditTransTransaction.Creditor.Address.AddressLine = []string{"Accounts Receivable", "Suite 300"}
+
+	var buf bytes.Buffer
+	require.NoError(t, model.WriteXML(&buf, PAIN_013_001_07))
+	reread, err := ParseXML(buf.Bytes())
+	require.NoError(t, err)
+	assert.Equal(t, model.Debtor.Address, reread.Debtor.Address)
+	assert.Equal(t, model.CreditTransTransaction.Creditor.Address, reread.CreditTransTransaction.Creditor.Address)
+	assert.NoError(t, models.CheckAddressCompliance(reread))
+}
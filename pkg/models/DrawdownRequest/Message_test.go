@@ -51,7 +51,7 @@ func TestDocumentElementToModelOne(t *testing.T) {
 	require.Equal(t, model.CreditTransTransaction.PaymentUniqueId, "8a562c67-ca16-48ba-b074-65581be6f066")
 	require.Equal(t, model.CreditTransTransaction.PayCategoryType, models.IntraCompanyPayment)
 	require.Equal(t, model.CreditTransTransaction.PayRequestType, models.DrawDownRequestCredit)
-	require.Equal(t, model.CreditTransTransaction.Amount.Amount, 6000000.00)
+	require.Equal(t, model.CreditTransTransaction.Amount.Amount, models.Decimal("6000000.00"))
 	require.Equal(t, model.CreditTransTransaction.Amount.Currency, "USD")
 	require.Equal(t, model.CreditTransTransaction.ChargeBearer, models.ChargeBearerSLEV)
 	require.Equal(t, model.CreditTransTransaction.Creditor.Name, "Corporation A")
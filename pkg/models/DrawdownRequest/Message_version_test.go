@@ -63,7 +63,7 @@ func TestVersion01(t *testing.T) {
 	require.Equal(t, model.CreditTransTransaction.PaymentEndToEndId, "Scenario1EndToEndId001")
 	require.Equal(t, model.CreditTransTransaction.PayCategoryType, models.IntraCompanyPayment)
 	require.Equal(t, model.CreditTransTransaction.PayRequestType, models.DrawDownRequestCredit)
-	require.Equal(t, model.CreditTransTransaction.Amount.Amount, 6000000.00)
+	require.Equal(t, model.CreditTransTransaction.Amount.Amount, models.Decimal("6000000.00"))
 	require.Equal(t, model.CreditTransTransaction.Amount.Currency, "USD")
 	require.Equal(t, model.CreditTransTransaction.ChargeBearer, models.ChargeBearerSLEV)
 	require.Equal(t, model.CreditTransTransaction.Creditor.Name, "Corporation A")
@@ -146,7 +146,7 @@ func TestVersion02(t *testing.T) {
 	require.Equal(t, model.CreditTransTransaction.PaymentEndToEndId, "Scenario1EndToEndId001")
 	require.Equal(t, model.CreditTransTransaction.PayCategoryType, models.IntraCompanyPayment)
 	require.Equal(t, model.CreditTransTransaction.PayRequestType, models.DrawDownRequestCredit)
-	require.Equal(t, model.CreditTransTransaction.Amount.Amount, 6000000.00)
+	require.Equal(t, model.CreditTransTransaction.Amount.Amount, models.Decimal("6000000.00"))
 	require.Equal(t, model.CreditTransTransaction.Amount.Currency, "USD")
 	require.Equal(t, model.CreditTransTransaction.ChargeBearer, models.ChargeBearerSLEV)
 	require.Equal(t, model.CreditTransTransaction.Creditor.Name, "Corporation A")
@@ -229,7 +229,7 @@ func TestVersion03(t *testing.T) {
 	require.Equal(t, model.CreditTransTransaction.PaymentEndToEndId, "Scenario1EndToEndId001")
 	require.Equal(t, model.CreditTransTransaction.PayCategoryType, models.IntraCompanyPayment)
 	require.Equal(t, model.CreditTransTransaction.PayRequestType, models.DrawDownRequestCredit)
-	require.Equal(t, model.CreditTransTransaction.Amount.Amount, 6000000.00)
+	require.Equal(t, model.CreditTransTransaction.Amount.Amount, models.Decimal("6000000.00"))
 	require.Equal(t, model.CreditTransTransaction.Amount.Currency, "USD")
 	require.Equal(t, model.CreditTransTransaction.ChargeBearer, models.ChargeBearerSLEV)
 	require.Equal(t, model.CreditTransTransaction.Creditor.Name, "Corporation A")
@@ -312,7 +312,7 @@ func TestVersion04(t *testing.T) {
 	require.Equal(t, model.CreditTransTransaction.PaymentEndToEndId, "Scenario1EndToEndId001")
 	require.Equal(t, model.CreditTransTransaction.PayCategoryType, models.IntraCompanyPayment)
 	require.Equal(t, model.CreditTransTransaction.PayRequestType, models.DrawDownRequestCredit)
-	require.Equal(t, model.CreditTransTransaction.Amount.Amount, 6000000.00)
+	require.Equal(t, model.CreditTransTransaction.Amount.Amount, models.Decimal("6000000.00"))
 	require.Equal(t, model.CreditTransTransaction.Amount.Currency, "USD")
 	require.Equal(t, model.CreditTransTransaction.ChargeBearer, models.ChargeBearerSLEV)
 	require.Equal(t, model.CreditTransTransaction.Creditor.Name, "Corporation A")
@@ -395,7 +395,7 @@ func TestVersion05(t *testing.T) {
 	require.Equal(t, model.CreditTransTransaction.PaymentEndToEndId, "Scenario1EndToEndId001")
 	require.Equal(t, model.CreditTransTransaction.PayCategoryType, models.IntraCompanyPayment)
 	require.Equal(t, model.CreditTransTransaction.PayRequestType, models.DrawDownRequestCredit)
-	require.Equal(t, model.CreditTransTransaction.Amount.Amount, 6000000.00)
+	require.Equal(t, model.CreditTransTransaction.Amount.Amount, models.Decimal("6000000.00"))
 	require.Equal(t, model.CreditTransTransaction.Amount.Currency, "USD")
 	require.Equal(t, model.CreditTransTransaction.ChargeBearer, models.ChargeBearerSLEV)
 	require.Equal(t, model.CreditTransTransaction.Creditor.Name, "Corporation A")
@@ -478,7 +478,7 @@ func TestVersion06(t *testing.T) {
 	require.Equal(t, model.CreditTransTransaction.PaymentEndToEndId, "Scenario1EndToEndId001")
 	require.Equal(t, model.CreditTransTransaction.PayCategoryType, models.IntraCompanyPayment)
 	require.Equal(t, model.CreditTransTransaction.PayRequestType, models.DrawDownRequestCredit)
-	require.Equal(t, model.CreditTransTransaction.Amount.Amount, 6000000.00)
+	require.Equal(t, model.CreditTransTransaction.Amount.Amount, models.Decimal("6000000.00"))
 	require.Equal(t, model.CreditTransTransaction.Amount.Currency, "USD")
 	require.Equal(t, model.CreditTransTransaction.ChargeBearer, models.ChargeBearerSLEV)
 	require.Equal(t, model.CreditTransTransaction.Creditor.Name, "Corporation A")
@@ -564,7 +564,7 @@ func TestVersion07(t *testing.T) {
 	require.Equal(t, model.CreditTransTransaction.PaymentUniqueId, "8a562c67-ca16-48ba-b074-65581be6f066")
 	require.Equal(t, model.CreditTransTransaction.PayCategoryType, models.IntraCompanyPayment)
 	require.Equal(t, model.CreditTransTransaction.PayRequestType, models.DrawDownRequestCredit)
-	require.Equal(t, model.CreditTransTransaction.Amount.Amount, 6000000.00)
+	require.Equal(t, model.CreditTransTransaction.Amount.Amount, models.Decimal("6000000.00"))
 	require.Equal(t, model.CreditTransTransaction.Amount.Currency, "USD")
 	require.Equal(t, model.CreditTransTransaction.ChargeBearer, models.ChargeBearerSLEV)
 	require.Equal(t, model.CreditTransTransaction.Creditor.Name, "Corporation A")
@@ -651,7 +651,7 @@ func TestVersion08(t *testing.T) {
 	require.Equal(t, model.CreditTransTransaction.PaymentUniqueId, "8a562c67-ca16-48ba-b074-65581be6f066")
 	require.Equal(t, model.CreditTransTransaction.PayCategoryType, models.IntraCompanyPayment)
 	require.Equal(t, model.CreditTransTransaction.PayRequestType, models.DrawDownRequestCredit)
-	require.Equal(t, model.CreditTransTransaction.Amount.Amount, 6000000.00)
+	require.Equal(t, model.CreditTransTransaction.Amount.Amount, models.Decimal("6000000.00"))
 	require.Equal(t, model.CreditTransTransaction.Amount.Currency, "USD")
 	require.Equal(t, model.CreditTransTransaction.ChargeBearer, models.ChargeBearerSLEV)
 	require.Equal(t, model.CreditTransTransaction.Creditor.Name, "Corporation A")
@@ -738,7 +738,7 @@ func TestVersion09(t *testing.T) {
 	require.Equal(t, model.CreditTransTransaction.PaymentUniqueId, "8a562c67-ca16-48ba-b074-65581be6f066")
 	require.Equal(t, model.CreditTransTransaction.PayCategoryType, models.IntraCompanyPayment)
 	require.Equal(t, model.CreditTransTransaction.PayRequestType, models.DrawDownRequestCredit)
-	require.Equal(t, model.CreditTransTransaction.Amount.Amount, 6000000.00)
+	require.Equal(t, model.CreditTransTransaction.Amount.Amount, models.Decimal("6000000.00"))
 	require.Equal(t, model.CreditTransTransaction.Amount.Currency, "USD")
 	require.Equal(t, model.CreditTransTransaction.ChargeBearer, models.ChargeBearerSLEV)
 	require.Equal(t, model.CreditTransTransaction.Creditor.Name, "Corporation A")
@@ -825,7 +825,7 @@ func TestVersion10(t *testing.T) {
 	require.Equal(t, model.CreditTransTransaction.PaymentUniqueId, "8a562c67-ca16-48ba-b074-65581be6f066")
 	require.Equal(t, model.CreditTransTransaction.PayCategoryType, models.IntraCompanyPayment)
 	require.Equal(t, model.CreditTransTransaction.PayRequestType, models.DrawDownRequestCredit)
-	require.Equal(t, model.CreditTransTransaction.Amount.Amount, 6000000.00)
+	require.Equal(t, model.CreditTransTransaction.Amount.Amount, models.Decimal("6000000.00"))
 	require.Equal(t, model.CreditTransTransaction.Amount.Currency, "USD")
 	require.Equal(t, model.CreditTransTransaction.ChargeBearer, models.ChargeBearerSLEV)
 	require.Equal(t, model.CreditTransTransaction.Creditor.Name, "Corporation A")
@@ -903,7 +903,7 @@ func DrawdownRequestDataModel() MessageModel {
 		PayCategoryType:      models.IntraCompanyPayment,
 		PayRequestType:       models.DrawDownRequestCredit,
 		Amount: models.CurrencyAndAmount{
-			Amount:   6000000.00,
+			Amount:   "6000000.00",
 			Currency: "USD",
 		},
 		ChargeBearer: models.ChargeBearerSLEV,
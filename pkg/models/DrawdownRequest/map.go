@@ -1,5 +1,7 @@
 package DrawdownRequest
 
+import "github.com/moov-io/wire20022/pkg/models"
+
 func pathMapV1() map[string]any {
 	return map[string]any{
 		"CdtrPmtActvtnReq.GrpHdr.MsgId":                                                       "MessageId",
@@ -45,6 +47,10 @@ func pathMapV1() map[string]any {
 		"CdtrPmtActvtnReq.PmtInf[0].CdtTrfTx[0].RmtInf.Strd[0].RfrdDocInf[0].Tp.CdOrPrtry.Cd": "CreditTransTransaction.Document.CodeOrProprietary",
 		"CdtrPmtActvtnReq.PmtInf[0].CdtTrfTx[0].RmtInf.Strd[0].RfrdDocInf[0].Nb":              "CreditTransTransaction.Document.Number",
 		"CdtrPmtActvtnReq.PmtInf[0].CdtTrfTx[0].RmtInf.Strd[0].RfrdDocInf[0].RltdDt":          "CreditTransTransaction.Document.RelatedDate",
+
+		"CdtrPmtActvtnReq.GrpHdr.InitgPty.PstlAdr.AdrLine : InitiatingParty.Address.AddressLine":                            models.ListItems,
+		"CdtrPmtActvtnReq.PmtInf[0].Dbtr.PstlAdr.AdrLine : Debtor.Address.AddressLine":                                      models.ListItems,
+		"CdtrPmtActvtnReq.PmtInf[0].CdtTrfTx[0].Cdtr.PstlAdr.AdrLine : CreditTransTransaction.Creditor.Address.AddressLine": models.ListItems,
 	}
 }
 func pathMapV2() map[string]any {
@@ -102,6 +108,10 @@ func pathMapV5() map[string]any {
 		"CdtrPmtActvtnReq.PmtInf[0].CdtTrfTx[0].RmtInf.Strd[0].RfrdDocInf[0].Tp.CdOrPrtry.Cd": "CreditTransTransaction.Document.CodeOrProprietary",
 		"CdtrPmtActvtnReq.PmtInf[0].CdtTrfTx[0].RmtInf.Strd[0].RfrdDocInf[0].Nb":              "CreditTransTransaction.Document.Number",
 		"CdtrPmtActvtnReq.PmtInf[0].CdtTrfTx[0].RmtInf.Strd[0].RfrdDocInf[0].RltdDt":          "CreditTransTransaction.Document.RelatedDate",
+
+		"CdtrPmtActvtnReq.GrpHdr.InitgPty.PstlAdr.AdrLine : InitiatingParty.Address.AddressLine":                            models.ListItems,
+		"CdtrPmtActvtnReq.PmtInf[0].Dbtr.PstlAdr.AdrLine : Debtor.Address.AddressLine":                                      models.ListItems,
+		"CdtrPmtActvtnReq.PmtInf[0].CdtTrfTx[0].Cdtr.PstlAdr.AdrLine : CreditTransTransaction.Creditor.Address.AddressLine": models.ListItems,
 	}
 }
 func pathMapV6() map[string]any {
@@ -150,6 +160,10 @@ func pathMapV6() map[string]any {
 		"CdtrPmtActvtnReq.PmtInf[0].CdtTrfTx[0].RmtInf.Strd[0].RfrdDocInf[0].Tp.CdOrPrtry.Cd": "CreditTransTransaction.Document.CodeOrProprietary",
 		"CdtrPmtActvtnReq.PmtInf[0].CdtTrfTx[0].RmtInf.Strd[0].RfrdDocInf[0].Nb":              "CreditTransTransaction.Document.Number",
 		"CdtrPmtActvtnReq.PmtInf[0].CdtTrfTx[0].RmtInf.Strd[0].RfrdDocInf[0].RltdDt":          "CreditTransTransaction.Document.RelatedDate",
+
+		"CdtrPmtActvtnReq.GrpHdr.InitgPty.PstlAdr.AdrLine : InitiatingParty.Address.AddressLine":                            models.ListItems,
+		"CdtrPmtActvtnReq.PmtInf[0].Dbtr.PstlAdr.AdrLine : Debtor.Address.AddressLine":                                      models.ListItems,
+		"CdtrPmtActvtnReq.PmtInf[0].CdtTrfTx[0].Cdtr.PstlAdr.AdrLine : CreditTransTransaction.Creditor.Address.AddressLine": models.ListItems,
 	}
 }
 func pathMapV7() map[string]any {
@@ -202,6 +216,10 @@ func pathMapV7() map[string]any {
 		"CdtrPmtActvtnReq.PmtInf[0].CdtTrfTx[0].RmtInf.Strd[0].RfrdDocInf[0].Tp.CdOrPrtry.Cd": "CreditTransTransaction.Document.CodeOrProprietary",
 		"CdtrPmtActvtnReq.PmtInf[0].CdtTrfTx[0].RmtInf.Strd[0].RfrdDocInf[0].Nb":              "CreditTransTransaction.Document.Number",
 		"CdtrPmtActvtnReq.PmtInf[0].CdtTrfTx[0].RmtInf.Strd[0].RfrdDocInf[0].RltdDt":          "CreditTransTransaction.Document.RelatedDate",
+
+		"CdtrPmtActvtnReq.GrpHdr.InitgPty.PstlAdr.AdrLine : InitiatingParty.Address.AddressLine":                            models.ListItems,
+		"CdtrPmtActvtnReq.PmtInf[0].Dbtr.PstlAdr.AdrLine : Debtor.Address.AddressLine":                                      models.ListItems,
+		"CdtrPmtActvtnReq.PmtInf[0].CdtTrfTx[0].Cdtr.PstlAdr.AdrLine : CreditTransTransaction.Creditor.Address.AddressLine": models.ListItems,
 	}
 }
 func pathMapV8() map[string]any {
@@ -32,7 +32,7 @@ func TestDocumentToModel08(t *testing.T) {
 	require.NotNil(t, model.ReportCreateDateTime)
 	require.Equal(t, model.AccountOtherId, "B1QDRCQR")
 	require.Equal(t, model.TotalDebitEntries.NumberOfEntries, "100")
-	require.Equal(t, model.TotalDebitEntries.Sum, 8307111.56)
+	require.Equal(t, model.TotalDebitEntries.Sum, models.Decimal("8307111.56"))
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[0].NumberOfEntries, "0")
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[0].BankTransactionCode, models.Rejected)
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[1].NumberOfEntries, "0")
@@ -41,7 +41,7 @@ func TestDocumentToModel08(t *testing.T) {
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[2].BankTransactionCode, models.MessagesIntercepted)
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[3].NumberOfEntries, "0")
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[3].BankTransactionCode, models.Sent)
-	require.Equal(t, model.EntryDetails[0].Amount.Amount, 50000.00)
+	require.Equal(t, model.EntryDetails[0].Amount.Amount, models.Decimal("50000.00"))
 	require.Equal(t, model.EntryDetails[0].Amount.Currency, "USD")
 	require.Equal(t, model.EntryDetails[0].CreditDebitIndicator, models.Debit)
 	require.Equal(t, model.EntryDetails[0].Status, models.Book)
@@ -56,7 +56,7 @@ func TestDocumentToModel08(t *testing.T) {
 	require.Equal(t, model.EntryDetails[0].EntryDetails.InstructedAgent.PaymentSysCode, models.PaymentSysUSABA)
 	require.Equal(t, model.EntryDetails[0].EntryDetails.InstructedAgent.PaymentSysMemberId, "011104238")
 	require.Equal(t, model.EntryDetails[0].EntryDetails.LocalInstrumentChoice, models.InstrumentCTRC)
-	require.Equal(t, model.EntryDetails[1].Amount.Amount, 8000.00)
+	require.Equal(t, model.EntryDetails[1].Amount.Amount, models.Decimal("8000.00"))
 	require.Equal(t, model.EntryDetails[1].Amount.Currency, "USD")
 	require.Equal(t, model.EntryDetails[1].CreditDebitIndicator, models.Debit)
 	require.Equal(t, model.EntryDetails[1].Status, models.Book)
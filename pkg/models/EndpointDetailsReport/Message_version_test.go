@@ -45,9 +45,9 @@ func TestVersion02(t *testing.T) {
 	require.NotNil(t, model.ReportCreateDateTime)
 	require.Equal(t, model.AccountOtherId, "B1QDRCQR")
 	require.Equal(t, model.TotalCreditEntries.NumberOfEntries, "94")
-	require.Equal(t, model.TotalCreditEntries.Sum, 2871734.98)
+	require.Equal(t, model.TotalCreditEntries.Sum, models.Decimal("2871734.98"))
 	require.Equal(t, model.TotalDebitEntries.NumberOfEntries, "100")
-	require.Equal(t, model.TotalDebitEntries.Sum, 8307111.56)
+	require.Equal(t, model.TotalDebitEntries.Sum, models.Decimal("8307111.56"))
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[0].NumberOfEntries, "0")
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[0].BankTransactionCode, models.Rejected)
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[1].NumberOfEntries, "0")
@@ -56,7 +56,7 @@ func TestVersion02(t *testing.T) {
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[2].BankTransactionCode, models.MessagesIntercepted)
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[3].NumberOfEntries, "0")
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[3].BankTransactionCode, models.Sent)
-	require.Equal(t, model.EntryDetails[0].Amount.Amount, 50000.00)
+	require.Equal(t, model.EntryDetails[0].Amount.Amount, models.Decimal("50000.00"))
 	require.Equal(t, model.EntryDetails[0].Amount.Currency, "USD")
 	require.Equal(t, model.EntryDetails[0].CreditDebitIndicator, models.Debit)
 	require.Equal(t, model.EntryDetails[0].Status, models.Book)
@@ -69,7 +69,7 @@ func TestVersion02(t *testing.T) {
 	require.Equal(t, model.EntryDetails[0].EntryDetails.InstructingAgent.PaymentSysMemberId, "231981435")
 	require.Equal(t, model.EntryDetails[0].EntryDetails.InstructedAgent.PaymentSysCode, models.PaymentSysUSABA)
 	require.Equal(t, model.EntryDetails[0].EntryDetails.InstructedAgent.PaymentSysMemberId, "011104238")
-	require.Equal(t, model.EntryDetails[1].Amount.Amount, 8000.00)
+	require.Equal(t, model.EntryDetails[1].Amount.Amount, models.Decimal("8000.00"))
 	require.Equal(t, model.EntryDetails[1].Amount.Currency, "USD")
 	require.Equal(t, model.EntryDetails[1].CreditDebitIndicator, models.Debit)
 	require.Equal(t, model.EntryDetails[1].Status, models.Book)
@@ -135,9 +135,9 @@ func TestVersion03(t *testing.T) {
 	require.NotNil(t, model.ReportCreateDateTime)
 	require.Equal(t, model.AccountOtherId, "B1QDRCQR")
 	require.Equal(t, model.TotalCreditEntries.NumberOfEntries, "94")
-	require.Equal(t, model.TotalCreditEntries.Sum, 2871734.98)
+	require.Equal(t, model.TotalCreditEntries.Sum, models.Decimal("2871734.98"))
 	require.Equal(t, model.TotalDebitEntries.NumberOfEntries, "100")
-	require.Equal(t, model.TotalDebitEntries.Sum, 8307111.56)
+	require.Equal(t, model.TotalDebitEntries.Sum, models.Decimal("8307111.56"))
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[0].NumberOfEntries, "0")
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[0].BankTransactionCode, models.Rejected)
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[1].NumberOfEntries, "0")
@@ -146,7 +146,7 @@ func TestVersion03(t *testing.T) {
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[2].BankTransactionCode, models.MessagesIntercepted)
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[3].NumberOfEntries, "0")
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[3].BankTransactionCode, models.Sent)
-	require.Equal(t, model.EntryDetails[0].Amount.Amount, 50000.00)
+	require.Equal(t, model.EntryDetails[0].Amount.Amount, models.Decimal("50000.00"))
 	require.Equal(t, model.EntryDetails[0].Amount.Currency, "USD")
 	require.Equal(t, model.EntryDetails[0].CreditDebitIndicator, models.Debit)
 	require.Equal(t, model.EntryDetails[0].Status, models.Book)
@@ -159,7 +159,7 @@ func TestVersion03(t *testing.T) {
 	require.Equal(t, model.EntryDetails[0].EntryDetails.InstructingAgent.PaymentSysMemberId, "231981435")
 	require.Equal(t, model.EntryDetails[0].EntryDetails.InstructedAgent.PaymentSysCode, models.PaymentSysUSABA)
 	require.Equal(t, model.EntryDetails[0].EntryDetails.InstructedAgent.PaymentSysMemberId, "011104238")
-	require.Equal(t, model.EntryDetails[1].Amount.Amount, 8000.00)
+	require.Equal(t, model.EntryDetails[1].Amount.Amount, models.Decimal("8000.00"))
 	require.Equal(t, model.EntryDetails[1].Amount.Currency, "USD")
 	require.Equal(t, model.EntryDetails[1].CreditDebitIndicator, models.Debit)
 	require.Equal(t, model.EntryDetails[1].Status, models.Book)
@@ -225,9 +225,9 @@ func TestVersion04(t *testing.T) {
 	require.NotNil(t, model.ReportCreateDateTime)
 	require.Equal(t, model.AccountOtherId, "B1QDRCQR")
 	require.Equal(t, model.TotalCreditEntries.NumberOfEntries, "94")
-	require.Equal(t, model.TotalCreditEntries.Sum, 2871734.98)
+	require.Equal(t, model.TotalCreditEntries.Sum, models.Decimal("2871734.98"))
 	require.Equal(t, model.TotalDebitEntries.NumberOfEntries, "100")
-	require.Equal(t, model.TotalDebitEntries.Sum, 8307111.56)
+	require.Equal(t, model.TotalDebitEntries.Sum, models.Decimal("8307111.56"))
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[0].NumberOfEntries, "0")
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[0].BankTransactionCode, models.Rejected)
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[1].NumberOfEntries, "0")
@@ -236,7 +236,7 @@ func TestVersion04(t *testing.T) {
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[2].BankTransactionCode, models.MessagesIntercepted)
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[3].NumberOfEntries, "0")
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[3].BankTransactionCode, models.Sent)
-	require.Equal(t, model.EntryDetails[0].Amount.Amount, 50000.00)
+	require.Equal(t, model.EntryDetails[0].Amount.Amount, models.Decimal("50000.00"))
 	require.Equal(t, model.EntryDetails[0].Amount.Currency, "USD")
 	require.Equal(t, model.EntryDetails[0].CreditDebitIndicator, models.Debit)
 	require.Equal(t, model.EntryDetails[0].Status, models.Book)
@@ -249,7 +249,7 @@ func TestVersion04(t *testing.T) {
 	require.Equal(t, model.EntryDetails[0].EntryDetails.InstructingAgent.PaymentSysMemberId, "231981435")
 	require.Equal(t, model.EntryDetails[0].EntryDetails.InstructedAgent.PaymentSysCode, models.PaymentSysUSABA)
 	require.Equal(t, model.EntryDetails[0].EntryDetails.InstructedAgent.PaymentSysMemberId, "011104238")
-	require.Equal(t, model.EntryDetails[1].Amount.Amount, 8000.00)
+	require.Equal(t, model.EntryDetails[1].Amount.Amount, models.Decimal("8000.00"))
 	require.Equal(t, model.EntryDetails[1].Amount.Currency, "USD")
 	require.Equal(t, model.EntryDetails[1].CreditDebitIndicator, models.Debit)
 	require.Equal(t, model.EntryDetails[1].Status, models.Book)
@@ -315,9 +315,9 @@ func TestVersion05(t *testing.T) {
 	require.NotNil(t, model.ReportCreateDateTime)
 	require.Equal(t, model.AccountOtherId, "B1QDRCQR")
 	require.Equal(t, model.TotalCreditEntries.NumberOfEntries, "94")
-	require.Equal(t, model.TotalCreditEntries.Sum, 2871734.98)
+	require.Equal(t, model.TotalCreditEntries.Sum, models.Decimal("2871734.98"))
 	require.Equal(t, model.TotalDebitEntries.NumberOfEntries, "100")
-	require.Equal(t, model.TotalDebitEntries.Sum, 8307111.56)
+	require.Equal(t, model.TotalDebitEntries.Sum, models.Decimal("8307111.56"))
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[0].NumberOfEntries, "0")
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[0].BankTransactionCode, models.Rejected)
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[1].NumberOfEntries, "0")
@@ -326,7 +326,7 @@ func TestVersion05(t *testing.T) {
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[2].BankTransactionCode, models.MessagesIntercepted)
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[3].NumberOfEntries, "0")
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[3].BankTransactionCode, models.Sent)
-	require.Equal(t, model.EntryDetails[0].Amount.Amount, 50000.00)
+	require.Equal(t, model.EntryDetails[0].Amount.Amount, models.Decimal("50000.00"))
 	require.Equal(t, model.EntryDetails[0].Amount.Currency, "USD")
 	require.Equal(t, model.EntryDetails[0].CreditDebitIndicator, models.Debit)
 	require.Equal(t, model.EntryDetails[0].Status, models.Book)
@@ -339,7 +339,7 @@ func TestVersion05(t *testing.T) {
 	require.Equal(t, model.EntryDetails[0].EntryDetails.InstructingAgent.PaymentSysMemberId, "231981435")
 	require.Equal(t, model.EntryDetails[0].EntryDetails.InstructedAgent.PaymentSysCode, models.PaymentSysUSABA)
 	require.Equal(t, model.EntryDetails[0].EntryDetails.InstructedAgent.PaymentSysMemberId, "011104238")
-	require.Equal(t, model.EntryDetails[1].Amount.Amount, 8000.00)
+	require.Equal(t, model.EntryDetails[1].Amount.Amount, models.Decimal("8000.00"))
 	require.Equal(t, model.EntryDetails[1].Amount.Currency, "USD")
 	require.Equal(t, model.EntryDetails[1].CreditDebitIndicator, models.Debit)
 	require.Equal(t, model.EntryDetails[1].Status, models.Book)
@@ -405,9 +405,9 @@ func TestVersion06(t *testing.T) {
 	require.NotNil(t, model.ReportCreateDateTime)
 	require.Equal(t, model.AccountOtherId, "B1QDRCQR")
 	require.Equal(t, model.TotalCreditEntries.NumberOfEntries, "94")
-	require.Equal(t, model.TotalCreditEntries.Sum, 2871734.98)
+	require.Equal(t, model.TotalCreditEntries.Sum, models.Decimal("2871734.98"))
 	require.Equal(t, model.TotalDebitEntries.NumberOfEntries, "100")
-	require.Equal(t, model.TotalDebitEntries.Sum, 8307111.56)
+	require.Equal(t, model.TotalDebitEntries.Sum, models.Decimal("8307111.56"))
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[0].NumberOfEntries, "0")
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[0].BankTransactionCode, models.Rejected)
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[1].NumberOfEntries, "0")
@@ -416,7 +416,7 @@ func TestVersion06(t *testing.T) {
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[2].BankTransactionCode, models.MessagesIntercepted)
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[3].NumberOfEntries, "0")
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[3].BankTransactionCode, models.Sent)
-	require.Equal(t, model.EntryDetails[0].Amount.Amount, 50000.00)
+	require.Equal(t, model.EntryDetails[0].Amount.Amount, models.Decimal("50000.00"))
 	require.Equal(t, model.EntryDetails[0].Amount.Currency, "USD")
 	require.Equal(t, model.EntryDetails[0].CreditDebitIndicator, models.Debit)
 	require.Equal(t, model.EntryDetails[0].Status, models.Book)
@@ -429,7 +429,7 @@ func TestVersion06(t *testing.T) {
 	require.Equal(t, model.EntryDetails[0].EntryDetails.InstructingAgent.PaymentSysMemberId, "231981435")
 	require.Equal(t, model.EntryDetails[0].EntryDetails.InstructedAgent.PaymentSysCode, models.PaymentSysUSABA)
 	require.Equal(t, model.EntryDetails[0].EntryDetails.InstructedAgent.PaymentSysMemberId, "011104238")
-	require.Equal(t, model.EntryDetails[1].Amount.Amount, 8000.00)
+	require.Equal(t, model.EntryDetails[1].Amount.Amount, models.Decimal("8000.00"))
 	require.Equal(t, model.EntryDetails[1].Amount.Currency, "USD")
 	require.Equal(t, model.EntryDetails[1].CreditDebitIndicator, models.Debit)
 	require.Equal(t, model.EntryDetails[1].Status, models.Book)
@@ -498,9 +498,9 @@ func TestVersion07(t *testing.T) {
 	require.NotNil(t, model.ReportCreateDateTime)
 	require.Equal(t, model.AccountOtherId, "B1QDRCQR")
 	require.Equal(t, model.TotalCreditEntries.NumberOfEntries, "94")
-	require.Equal(t, model.TotalCreditEntries.Sum, 2871734.98)
+	require.Equal(t, model.TotalCreditEntries.Sum, models.Decimal("2871734.98"))
 	require.Equal(t, model.TotalDebitEntries.NumberOfEntries, "100")
-	require.Equal(t, model.TotalDebitEntries.Sum, 8307111.56)
+	require.Equal(t, model.TotalDebitEntries.Sum, models.Decimal("8307111.56"))
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[0].NumberOfEntries, "0")
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[0].BankTransactionCode, models.Rejected)
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[1].NumberOfEntries, "0")
@@ -509,7 +509,7 @@ func TestVersion07(t *testing.T) {
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[2].BankTransactionCode, models.MessagesIntercepted)
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[3].NumberOfEntries, "0")
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[3].BankTransactionCode, models.Sent)
-	require.Equal(t, model.EntryDetails[0].Amount.Amount, 50000.00)
+	require.Equal(t, model.EntryDetails[0].Amount.Amount, models.Decimal("50000.00"))
 	require.Equal(t, model.EntryDetails[0].Amount.Currency, "USD")
 	require.Equal(t, model.EntryDetails[0].CreditDebitIndicator, models.Debit)
 	require.Equal(t, model.EntryDetails[0].Status, models.Book)
@@ -523,7 +523,7 @@ func TestVersion07(t *testing.T) {
 	require.Equal(t, model.EntryDetails[0].EntryDetails.InstructedAgent.PaymentSysCode, models.PaymentSysUSABA)
 	require.Equal(t, model.EntryDetails[0].EntryDetails.InstructedAgent.PaymentSysMemberId, "011104238")
 	require.Equal(t, model.EntryDetails[0].EntryDetails.LocalInstrumentChoice, models.InstrumentCTRC)
-	require.Equal(t, model.EntryDetails[1].Amount.Amount, 8000.00)
+	require.Equal(t, model.EntryDetails[1].Amount.Amount, models.Decimal("8000.00"))
 	require.Equal(t, model.EntryDetails[1].Amount.Currency, "USD")
 	require.Equal(t, model.EntryDetails[1].CreditDebitIndicator, models.Debit)
 	require.Equal(t, model.EntryDetails[1].Status, models.Book)
@@ -593,9 +593,9 @@ func TestVersion08(t *testing.T) {
 	require.NotNil(t, model.ReportCreateDateTime)
 	require.Equal(t, model.AccountOtherId, "B1QDRCQR")
 	require.Equal(t, model.TotalCreditEntries.NumberOfEntries, "94")
-	require.Equal(t, model.TotalCreditEntries.Sum, 2871734.98)
+	require.Equal(t, model.TotalCreditEntries.Sum, models.Decimal("2871734.98"))
 	require.Equal(t, model.TotalDebitEntries.NumberOfEntries, "100")
-	require.Equal(t, model.TotalDebitEntries.Sum, 8307111.56)
+	require.Equal(t, model.TotalDebitEntries.Sum, models.Decimal("8307111.56"))
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[0].NumberOfEntries, "0")
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[0].BankTransactionCode, models.Rejected)
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[1].NumberOfEntries, "0")
@@ -604,7 +604,7 @@ func TestVersion08(t *testing.T) {
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[2].BankTransactionCode, models.MessagesIntercepted)
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[3].NumberOfEntries, "0")
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[3].BankTransactionCode, models.Sent)
-	require.Equal(t, model.EntryDetails[0].Amount.Amount, 50000.00)
+	require.Equal(t, model.EntryDetails[0].Amount.Amount, models.Decimal("50000.00"))
 	require.Equal(t, model.EntryDetails[0].Amount.Currency, "USD")
 	require.Equal(t, model.EntryDetails[0].CreditDebitIndicator, models.Debit)
 	require.Equal(t, model.EntryDetails[0].Status, models.Book)
@@ -619,7 +619,7 @@ func TestVersion08(t *testing.T) {
 	require.Equal(t, model.EntryDetails[0].EntryDetails.InstructedAgent.PaymentSysCode, models.PaymentSysUSABA)
 	require.Equal(t, model.EntryDetails[0].EntryDetails.InstructedAgent.PaymentSysMemberId, "011104238")
 	require.Equal(t, model.EntryDetails[0].EntryDetails.LocalInstrumentChoice, models.InstrumentCTRC)
-	require.Equal(t, model.EntryDetails[1].Amount.Amount, 8000.00)
+	require.Equal(t, model.EntryDetails[1].Amount.Amount, models.Decimal("8000.00"))
 	require.Equal(t, model.EntryDetails[1].Amount.Currency, "USD")
 	require.Equal(t, model.EntryDetails[1].CreditDebitIndicator, models.Debit)
 	require.Equal(t, model.EntryDetails[1].Status, models.Book)
@@ -690,9 +690,9 @@ func TestVersion09(t *testing.T) {
 	require.NotNil(t, model.ReportCreateDateTime)
 	require.Equal(t, model.AccountOtherId, "B1QDRCQR")
 	require.Equal(t, model.TotalCreditEntries.NumberOfEntries, "94")
-	require.Equal(t, model.TotalCreditEntries.Sum, 2871734.98)
+	require.Equal(t, model.TotalCreditEntries.Sum, models.Decimal("2871734.98"))
 	require.Equal(t, model.TotalDebitEntries.NumberOfEntries, "100")
-	require.Equal(t, model.TotalDebitEntries.Sum, 8307111.56)
+	require.Equal(t, model.TotalDebitEntries.Sum, models.Decimal("8307111.56"))
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[0].NumberOfEntries, "0")
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[0].BankTransactionCode, models.Rejected)
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[1].NumberOfEntries, "0")
@@ -701,7 +701,7 @@ func TestVersion09(t *testing.T) {
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[2].BankTransactionCode, models.MessagesIntercepted)
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[3].NumberOfEntries, "0")
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[3].BankTransactionCode, models.Sent)
-	require.Equal(t, model.EntryDetails[0].Amount.Amount, 50000.00)
+	require.Equal(t, model.EntryDetails[0].Amount.Amount, models.Decimal("50000.00"))
 	require.Equal(t, model.EntryDetails[0].Amount.Currency, "USD")
 	require.Equal(t, model.EntryDetails[0].CreditDebitIndicator, models.Debit)
 	require.Equal(t, model.EntryDetails[0].Status, models.Book)
@@ -716,7 +716,7 @@ func TestVersion09(t *testing.T) {
 	require.Equal(t, model.EntryDetails[0].EntryDetails.InstructedAgent.PaymentSysCode, models.PaymentSysUSABA)
 	require.Equal(t, model.EntryDetails[0].EntryDetails.InstructedAgent.PaymentSysMemberId, "011104238")
 	require.Equal(t, model.EntryDetails[0].EntryDetails.LocalInstrumentChoice, models.InstrumentCTRC)
-	require.Equal(t, model.EntryDetails[1].Amount.Amount, 8000.00)
+	require.Equal(t, model.EntryDetails[1].Amount.Amount, models.Decimal("8000.00"))
 	require.Equal(t, model.EntryDetails[1].Amount.Currency, "USD")
 	require.Equal(t, model.EntryDetails[1].CreditDebitIndicator, models.Debit)
 	require.Equal(t, model.EntryDetails[1].Status, models.Book)
@@ -787,9 +787,9 @@ func TestVersion10(t *testing.T) {
 	require.NotNil(t, model.ReportCreateDateTime)
 	require.Equal(t, model.AccountOtherId, "B1QDRCQR")
 	require.Equal(t, model.TotalCreditEntries.NumberOfEntries, "94")
-	require.Equal(t, model.TotalCreditEntries.Sum, 2871734.98)
+	require.Equal(t, model.TotalCreditEntries.Sum, models.Decimal("2871734.98"))
 	require.Equal(t, model.TotalDebitEntries.NumberOfEntries, "100")
-	require.Equal(t, model.TotalDebitEntries.Sum, 8307111.56)
+	require.Equal(t, model.TotalDebitEntries.Sum, models.Decimal("8307111.56"))
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[0].NumberOfEntries, "0")
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[0].BankTransactionCode, models.Rejected)
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[1].NumberOfEntries, "0")
@@ -798,7 +798,7 @@ func TestVersion10(t *testing.T) {
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[2].BankTransactionCode, models.MessagesIntercepted)
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[3].NumberOfEntries, "0")
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[3].BankTransactionCode, models.Sent)
-	require.Equal(t, model.EntryDetails[0].Amount.Amount, 50000.00)
+	require.Equal(t, model.EntryDetails[0].Amount.Amount, models.Decimal("50000.00"))
 	require.Equal(t, model.EntryDetails[0].Amount.Currency, "USD")
 	require.Equal(t, model.EntryDetails[0].CreditDebitIndicator, models.Debit)
 	require.Equal(t, model.EntryDetails[0].Status, models.Book)
@@ -813,7 +813,7 @@ func TestVersion10(t *testing.T) {
 	require.Equal(t, model.EntryDetails[0].EntryDetails.InstructedAgent.PaymentSysCode, models.PaymentSysUSABA)
 	require.Equal(t, model.EntryDetails[0].EntryDetails.InstructedAgent.PaymentSysMemberId, "011104238")
 	require.Equal(t, model.EntryDetails[0].EntryDetails.LocalInstrumentChoice, models.InstrumentCTRC)
-	require.Equal(t, model.EntryDetails[1].Amount.Amount, 8000.00)
+	require.Equal(t, model.EntryDetails[1].Amount.Amount, models.Decimal("8000.00"))
 	require.Equal(t, model.EntryDetails[1].Amount.Currency, "USD")
 	require.Equal(t, model.EntryDetails[1].CreditDebitIndicator, models.Debit)
 	require.Equal(t, model.EntryDetails[1].Status, models.Book)
@@ -884,9 +884,9 @@ func TestVersion11(t *testing.T) {
 	require.NotNil(t, model.ReportCreateDateTime)
 	require.Equal(t, model.AccountOtherId, "B1QDRCQR")
 	require.Equal(t, model.TotalCreditEntries.NumberOfEntries, "94")
-	require.Equal(t, model.TotalCreditEntries.Sum, 2871734.98)
+	require.Equal(t, model.TotalCreditEntries.Sum, models.Decimal("2871734.98"))
 	require.Equal(t, model.TotalDebitEntries.NumberOfEntries, "100")
-	require.Equal(t, model.TotalDebitEntries.Sum, 8307111.56)
+	require.Equal(t, model.TotalDebitEntries.Sum, models.Decimal("8307111.56"))
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[0].NumberOfEntries, "0")
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[0].BankTransactionCode, models.Rejected)
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[1].NumberOfEntries, "0")
@@ -895,7 +895,7 @@ func TestVersion11(t *testing.T) {
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[2].BankTransactionCode, models.MessagesIntercepted)
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[3].NumberOfEntries, "0")
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[3].BankTransactionCode, models.Sent)
-	require.Equal(t, model.EntryDetails[0].Amount.Amount, 50000.00)
+	require.Equal(t, model.EntryDetails[0].Amount.Amount, models.Decimal("50000.00"))
 	require.Equal(t, model.EntryDetails[0].Amount.Currency, "USD")
 	require.Equal(t, model.EntryDetails[0].CreditDebitIndicator, models.Debit)
 	require.Equal(t, model.EntryDetails[0].Status, models.Book)
@@ -910,7 +910,7 @@ func TestVersion11(t *testing.T) {
 	require.Equal(t, model.EntryDetails[0].EntryDetails.InstructedAgent.PaymentSysCode, models.PaymentSysUSABA)
 	require.Equal(t, model.EntryDetails[0].EntryDetails.InstructedAgent.PaymentSysMemberId, "011104238")
 	require.Equal(t, model.EntryDetails[0].EntryDetails.LocalInstrumentChoice, models.InstrumentCTRC)
-	require.Equal(t, model.EntryDetails[1].Amount.Amount, 8000.00)
+	require.Equal(t, model.EntryDetails[1].Amount.Amount, models.Decimal("8000.00"))
 	require.Equal(t, model.EntryDetails[1].Amount.Currency, "USD")
 	require.Equal(t, model.EntryDetails[1].CreditDebitIndicator, models.Debit)
 	require.Equal(t, model.EntryDetails[1].Status, models.Book)
@@ -981,9 +981,9 @@ func TestVersion12(t *testing.T) {
 	require.NotNil(t, model.ReportCreateDateTime)
 	require.Equal(t, model.AccountOtherId, "B1QDRCQR")
 	require.Equal(t, model.TotalCreditEntries.NumberOfEntries, "94")
-	require.Equal(t, model.TotalCreditEntries.Sum, 2871734.98)
+	require.Equal(t, model.TotalCreditEntries.Sum, models.Decimal("2871734.98"))
 	require.Equal(t, model.TotalDebitEntries.NumberOfEntries, "100")
-	require.Equal(t, model.TotalDebitEntries.Sum, 8307111.56)
+	require.Equal(t, model.TotalDebitEntries.Sum, models.Decimal("8307111.56"))
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[0].NumberOfEntries, "0")
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[0].BankTransactionCode, models.Rejected)
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[1].NumberOfEntries, "0")
@@ -992,7 +992,7 @@ func TestVersion12(t *testing.T) {
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[2].BankTransactionCode, models.MessagesIntercepted)
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[3].NumberOfEntries, "0")
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[3].BankTransactionCode, models.Sent)
-	require.Equal(t, model.EntryDetails[0].Amount.Amount, 50000.00)
+	require.Equal(t, model.EntryDetails[0].Amount.Amount, models.Decimal("50000.00"))
 	require.Equal(t, model.EntryDetails[0].Amount.Currency, "USD")
 	require.Equal(t, model.EntryDetails[0].CreditDebitIndicator, models.Debit)
 	require.Equal(t, model.EntryDetails[0].Status, models.Book)
@@ -1007,7 +1007,7 @@ func TestVersion12(t *testing.T) {
 	require.Equal(t, model.EntryDetails[0].EntryDetails.InstructedAgent.PaymentSysCode, models.PaymentSysUSABA)
 	require.Equal(t, model.EntryDetails[0].EntryDetails.InstructedAgent.PaymentSysMemberId, "011104238")
 	require.Equal(t, model.EntryDetails[0].EntryDetails.LocalInstrumentChoice, models.InstrumentCTRC)
-	require.Equal(t, model.EntryDetails[1].Amount.Amount, 8000.00)
+	require.Equal(t, model.EntryDetails[1].Amount.Amount, models.Decimal("8000.00"))
 	require.Equal(t, model.EntryDetails[1].Amount.Currency, "USD")
 	require.Equal(t, model.EntryDetails[1].CreditDebitIndicator, models.Debit)
 	require.Equal(t, model.EntryDetails[1].Status, models.Book)
@@ -1058,11 +1058,11 @@ func ActivityReportDataModel() MessageModel {
 	message.AccountOtherId = "B1QDRCQR"
 	message.TotalCreditEntries = models.NumberAndSumOfTransactions{
 		NumberOfEntries: "94",
-		Sum:             2871734.98,
+		Sum:             "2871734.98",
 	}
 	message.TotalDebitEntries = models.NumberAndSumOfTransactions{
 		NumberOfEntries: "100",
-		Sum:             8307111.56,
+		Sum:             "8307111.56",
 	}
 	message.TotalEntriesPerBankTransactionCode = []models.TotalsPerBankTransactionCode{
 		{
@@ -1085,7 +1085,7 @@ func ActivityReportDataModel() MessageModel {
 	message.EntryDetails = []models.Entry{
 		{
 			Amount: models.CurrencyAndAmount{
-				Amount:   50000.00,
+				Amount:   "50000.00",
 				Currency: "USD",
 			},
 			CreditDebitIndicator: models.Debit,
@@ -1110,7 +1110,7 @@ func ActivityReportDataModel() MessageModel {
 		},
 		{
 			Amount: models.CurrencyAndAmount{
-				Amount:   8000.00,
+				Amount:   "8000.00",
 				Currency: "USD",
 			},
 			CreditDebitIndicator: models.Debit,
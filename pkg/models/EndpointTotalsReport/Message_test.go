@@ -26,9 +26,9 @@ func TestDocumentToModel08(t *testing.T) {
 	require.NotNil(t, model.ReportCreateDateTime)
 	require.Equal(t, model.AccountOtherId, "B1QDRCQR")
 	require.Equal(t, model.TotalCreditEntries.NumberOfEntries, "1268")
-	require.Equal(t, model.TotalCreditEntries.Sum, 18423923492.15)
+	require.Equal(t, model.TotalCreditEntries.Sum, models.Decimal("18423923492.15"))
 	require.Equal(t, model.TotalDebitEntries.NumberOfEntries, "4433")
-	require.Equal(t, model.TotalDebitEntries.Sum, 12378489145.96)
+	require.Equal(t, model.TotalDebitEntries.Sum, models.Decimal("12378489145.96"))
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[0].NumberOfEntries, "1")
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[0].BankTransactionCode, models.Rejected)
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[1].NumberOfEntries, "0")
@@ -45,9 +45,9 @@ func TestVersion02(t *testing.T) {
 	require.NotNil(t, model.ReportCreateDateTime)
 	require.Equal(t, model.AccountOtherId, "B1QDRCQR")
 	require.Equal(t, model.TotalCreditEntries.NumberOfEntries, "1268")
-	require.Equal(t, model.TotalCreditEntries.Sum, 18423923492.15)
+	require.Equal(t, model.TotalCreditEntries.Sum, models.Decimal("18423923492.15"))
 	require.Equal(t, model.TotalDebitEntries.NumberOfEntries, "4433")
-	require.Equal(t, model.TotalDebitEntries.Sum, 12378489145.96)
+	require.Equal(t, model.TotalDebitEntries.Sum, models.Decimal("12378489145.96"))
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[0].NumberOfEntries, "1")
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[0].BankTransactionCode, models.Rejected)
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[1].NumberOfEntries, "0")
@@ -110,9 +110,9 @@ func TestVersion03(t *testing.T) {
 	require.NotNil(t, model.ReportCreateDateTime)
 	require.Equal(t, model.AccountOtherId, "B1QDRCQR")
 	require.Equal(t, model.TotalCreditEntries.NumberOfEntries, "1268")
-	require.Equal(t, model.TotalCreditEntries.Sum, 18423923492.15)
+	require.Equal(t, model.TotalCreditEntries.Sum, models.Decimal("18423923492.15"))
 	require.Equal(t, model.TotalDebitEntries.NumberOfEntries, "4433")
-	require.Equal(t, model.TotalDebitEntries.Sum, 12378489145.96)
+	require.Equal(t, model.TotalDebitEntries.Sum, models.Decimal("12378489145.96"))
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[0].NumberOfEntries, "1")
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[0].BankTransactionCode, models.Rejected)
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[1].NumberOfEntries, "0")
@@ -175,9 +175,9 @@ func TestVersion04(t *testing.T) {
 	require.NotNil(t, model.ReportCreateDateTime)
 	require.Equal(t, model.AccountOtherId, "B1QDRCQR")
 	require.Equal(t, model.TotalCreditEntries.NumberOfEntries, "1268")
-	require.Equal(t, model.TotalCreditEntries.Sum, 18423923492.15)
+	require.Equal(t, model.TotalCreditEntries.Sum, models.Decimal("18423923492.15"))
 	require.Equal(t, model.TotalDebitEntries.NumberOfEntries, "4433")
-	require.Equal(t, model.TotalDebitEntries.Sum, 12378489145.96)
+	require.Equal(t, model.TotalDebitEntries.Sum, models.Decimal("12378489145.96"))
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[0].NumberOfEntries, "1")
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[0].BankTransactionCode, models.Rejected)
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[1].NumberOfEntries, "0")
@@ -240,9 +240,9 @@ func TestVersion05(t *testing.T) {
 	require.NotNil(t, model.ReportCreateDateTime)
 	require.Equal(t, model.AccountOtherId, "B1QDRCQR")
 	require.Equal(t, model.TotalCreditEntries.NumberOfEntries, "1268")
-	require.Equal(t, model.TotalCreditEntries.Sum, 18423923492.15)
+	require.Equal(t, model.TotalCreditEntries.Sum, models.Decimal("18423923492.15"))
 	require.Equal(t, model.TotalDebitEntries.NumberOfEntries, "4433")
-	require.Equal(t, model.TotalDebitEntries.Sum, 12378489145.96)
+	require.Equal(t, model.TotalDebitEntries.Sum, models.Decimal("12378489145.96"))
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[0].NumberOfEntries, "1")
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[0].BankTransactionCode, models.Rejected)
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[1].NumberOfEntries, "0")
@@ -305,9 +305,9 @@ func TestVersion06(t *testing.T) {
 	require.NotNil(t, model.ReportCreateDateTime)
 	require.Equal(t, model.AccountOtherId, "B1QDRCQR")
 	require.Equal(t, model.TotalCreditEntries.NumberOfEntries, "1268")
-	require.Equal(t, model.TotalCreditEntries.Sum, 18423923492.15)
+	require.Equal(t, model.TotalCreditEntries.Sum, models.Decimal("18423923492.15"))
 	require.Equal(t, model.TotalDebitEntries.NumberOfEntries, "4433")
-	require.Equal(t, model.TotalDebitEntries.Sum, 12378489145.96)
+	require.Equal(t, model.TotalDebitEntries.Sum, models.Decimal("12378489145.96"))
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[0].NumberOfEntries, "1")
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[0].BankTransactionCode, models.Rejected)
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[1].NumberOfEntries, "0")
@@ -370,9 +370,9 @@ func TestVersion07(t *testing.T) {
 	require.NotNil(t, model.ReportCreateDateTime)
 	require.Equal(t, model.AccountOtherId, "B1QDRCQR")
 	require.Equal(t, model.TotalCreditEntries.NumberOfEntries, "1268")
-	require.Equal(t, model.TotalCreditEntries.Sum, 18423923492.15)
+	require.Equal(t, model.TotalCreditEntries.Sum, models.Decimal("18423923492.15"))
 	require.Equal(t, model.TotalDebitEntries.NumberOfEntries, "4433")
-	require.Equal(t, model.TotalDebitEntries.Sum, 12378489145.96)
+	require.Equal(t, model.TotalDebitEntries.Sum, models.Decimal("12378489145.96"))
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[0].NumberOfEntries, "1")
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[0].BankTransactionCode, models.Rejected)
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[1].NumberOfEntries, "0")
@@ -436,9 +436,9 @@ func TestVersion08(t *testing.T) {
 	require.NotNil(t, model.ReportCreateDateTime)
 	require.Equal(t, model.AccountOtherId, "B1QDRCQR")
 	require.Equal(t, model.TotalCreditEntries.NumberOfEntries, "1268")
-	require.Equal(t, model.TotalCreditEntries.Sum, 18423923492.15)
+	require.Equal(t, model.TotalCreditEntries.Sum, models.Decimal("18423923492.15"))
 	require.Equal(t, model.TotalDebitEntries.NumberOfEntries, "4433")
-	require.Equal(t, model.TotalDebitEntries.Sum, 12378489145.96)
+	require.Equal(t, model.TotalDebitEntries.Sum, models.Decimal("12378489145.96"))
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[0].NumberOfEntries, "1")
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[0].BankTransactionCode, models.Rejected)
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[1].NumberOfEntries, "0")
@@ -501,9 +501,9 @@ func TestVersion09(t *testing.T) {
 	require.NotNil(t, model.ReportCreateDateTime)
 	require.Equal(t, model.AccountOtherId, "B1QDRCQR")
 	require.Equal(t, model.TotalCreditEntries.NumberOfEntries, "1268")
-	require.Equal(t, model.TotalCreditEntries.Sum, 18423923492.15)
+	require.Equal(t, model.TotalCreditEntries.Sum, models.Decimal("18423923492.15"))
 	require.Equal(t, model.TotalDebitEntries.NumberOfEntries, "4433")
-	require.Equal(t, model.TotalDebitEntries.Sum, 12378489145.96)
+	require.Equal(t, model.TotalDebitEntries.Sum, models.Decimal("12378489145.96"))
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[0].NumberOfEntries, "1")
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[0].BankTransactionCode, models.Rejected)
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[1].NumberOfEntries, "0")
@@ -566,9 +566,9 @@ func TestVersion10(t *testing.T) {
 	require.NotNil(t, model.ReportCreateDateTime)
 	require.Equal(t, model.AccountOtherId, "B1QDRCQR")
 	require.Equal(t, model.TotalCreditEntries.NumberOfEntries, "1268")
-	require.Equal(t, model.TotalCreditEntries.Sum, 18423923492.15)
+	require.Equal(t, model.TotalCreditEntries.Sum, models.Decimal("18423923492.15"))
 	require.Equal(t, model.TotalDebitEntries.NumberOfEntries, "4433")
-	require.Equal(t, model.TotalDebitEntries.Sum, 12378489145.96)
+	require.Equal(t, model.TotalDebitEntries.Sum, models.Decimal("12378489145.96"))
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[0].NumberOfEntries, "1")
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[0].BankTransactionCode, models.Rejected)
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[1].NumberOfEntries, "0")
@@ -631,9 +631,9 @@ func TestVersion11(t *testing.T) {
 	require.NotNil(t, model.ReportCreateDateTime)
 	require.Equal(t, model.AccountOtherId, "B1QDRCQR")
 	require.Equal(t, model.TotalCreditEntries.NumberOfEntries, "1268")
-	require.Equal(t, model.TotalCreditEntries.Sum, 18423923492.15)
+	require.Equal(t, model.TotalCreditEntries.Sum, models.Decimal("18423923492.15"))
 	require.Equal(t, model.TotalDebitEntries.NumberOfEntries, "4433")
-	require.Equal(t, model.TotalDebitEntries.Sum, 12378489145.96)
+	require.Equal(t, model.TotalDebitEntries.Sum, models.Decimal("12378489145.96"))
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[0].NumberOfEntries, "1")
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[0].BankTransactionCode, models.Rejected)
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[1].NumberOfEntries, "0")
@@ -696,9 +696,9 @@ func TestVersion12(t *testing.T) {
 	require.NotNil(t, model.ReportCreateDateTime)
 	require.Equal(t, model.AccountOtherId, "B1QDRCQR")
 	require.Equal(t, model.TotalCreditEntries.NumberOfEntries, "1268")
-	require.Equal(t, model.TotalCreditEntries.Sum, 18423923492.15)
+	require.Equal(t, model.TotalCreditEntries.Sum, models.Decimal("18423923492.15"))
 	require.Equal(t, model.TotalDebitEntries.NumberOfEntries, "4433")
-	require.Equal(t, model.TotalDebitEntries.Sum, 12378489145.96)
+	require.Equal(t, model.TotalDebitEntries.Sum, models.Decimal("12378489145.96"))
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[0].NumberOfEntries, "1")
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[0].BankTransactionCode, models.Rejected)
 	require.Equal(t, model.TotalEntriesPerBankTransactionCode[1].NumberOfEntries, "0")
@@ -749,11 +749,11 @@ func EndpointTotalsReportDataModel() MessageModel {
 	message.AccountOtherId = "B1QDRCQR"
 	message.TotalCreditEntries = models.NumberAndSumOfTransactions{
 		NumberOfEntries: "1268",
-		Sum:             18423923492.15,
+		Sum:             "18423923492.15",
 	}
 	message.TotalDebitEntries = models.NumberAndSumOfTransactions{
 		NumberOfEntries: "4433",
-		Sum:             12378489145.96,
+		Sum:             "12378489145.96",
 	}
 	message.TotalEntriesPerBankTransactionCode = []models.TotalsPerBankTransactionCode{
 		{
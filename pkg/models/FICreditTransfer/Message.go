@@ -0,0 +1,425 @@
+package FICreditTransfer
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"reflect"
+	"time"
+
+	"cloud.google.com/go/civil"
+	pacs_009_001_08 "github.com/moov-io/fedwire20022/gen/FinancialInstitutionCreditTransfer_pacs_009_001_08"
+	"github.com/moov-io/fedwire20022/pkg/fedwire"
+	"github.com/moov-io/wire20022/pkg/base"
+	"github.com/moov-io/wire20022/pkg/models"
+	"github.com/moov-io/wire20022/pkg/models/BusinessApplicationHeader"
+)
+
+// NewMessageForVersion creates a MessageModel with appropriate version-specific fields initialized
+func NewMessageForVersion(version PACS_009_001_VERSION) MessageModel {
+	model := MessageModel{
+		PaymentCore: base.PaymentCore{},
+		// Core fields initialized to zero values
+	}
+
+	// No version-specific fields for FICreditTransfer - single version message
+
+	return model
+}
+
+// ValidateForVersion performs type-safe validation for a specific version
+func (m MessageModel) ValidateForVersion(version PACS_009_001_VERSION) error {
+	// Base field validation (always required)
+	if err := m.validateCoreFields(); err != nil {
+		return fmt.Errorf("core field validation failed: %w", err)
+	}
+
+	// No version-specific validation needed - single version message
+
+	return nil
+}
+
+// validateCoreFields checks required core fields present in all versions
+func (m MessageModel) validateCoreFields() error {
+	// Direct field access - compile-time verified, no reflection
+	if m.MessageId == "" {
+		return fmt.Errorf("MessageId is required")
+	}
+	if m.CreatedDateTime.IsZero() {
+		return fmt.Errorf("CreatedDateTime is required")
+	}
+	if m.NumberOfTransactions == "" {
+		return fmt.Errorf("NumberOfTransactions is required")
+	}
+	if m.EndToEndId == "" {
+		return fmt.Errorf("EndToEndId is required")
+	}
+	if m.UniqueEndToEndTransactionRef == "" {
+		return fmt.Errorf("UniqueEndToEndTransactionRef is required")
+	}
+	if m.InstrumentPropCode == "" {
+		return fmt.Errorf("InstrumentPropCode is required")
+	}
+	if m.InterBankSettAmount.Amount.IsZero() {
+		return fmt.Errorf("InterBankSettAmount is required")
+	}
+	return nil
+}
+
+// GetVersionCapabilities returns which version-specific features are available
+func (m MessageModel) GetVersionCapabilities() map[string]bool {
+	return map[string]bool{
+		"UnderlyingCustomerCreditTransfer": m.IsCoverPayment(),
+	}
+}
+
+// IsCoverPayment reports whether the message is a pacs.009 COV, i.e. it carries an
+// underlying customer credit transfer block (UndrlygCstmrCdtTrf)
+func (m MessageModel) IsCoverPayment() bool {
+	return !reflect.ValueOf(m.UnderlyingCustomerCreditTransfer).IsZero()
+}
+
+// MessageModel uses base abstractions to eliminate duplicate field definitions
+type MessageModel struct {
+	// Embed common payment fields instead of duplicating them
+	base.PaymentCore `json:",inline"`
+
+	// FICreditTransfer-specific fields
+	InstructionId                string                        `json:"instructionId"`
+	EndToEndId                   string                        `json:"endToEndId"`
+	TransactionId                string                        `json:"transactionId"`
+	UniqueEndToEndTransactionRef string                        `json:"uniqueEndToEndTransactionRef"`
+	ServiceLevel                 string                        `json:"serviceLevel"`
+	InstrumentPropCode           models.InstrumentPropCodeType `json:"instrumentPropCode"`
+	InterBankSettAmount          models.CurrencyAndAmount      `json:"interBankSettAmount"`
+	InterBankSettDate            fedwire.ISODate               `json:"interBankSettDate"`
+
+	// Use embedded agent pairs
+	base.AgentPair          `json:",inline"`
+	base.DebtorCreditorPair `json:",inline"`
+
+	// Financial institution parties
+	IntermediaryAgent1  models.Agent                `json:"intermediaryAgent1"`
+	Debtor              models.Agent                `json:"debtor"`
+	DebtorIBAN          string                      `json:"debtorIBAN"`
+	DebtorOtherTypeId   string                      `json:"debtorOtherTypeId"`
+	Creditor            models.Agent                `json:"creditor"`
+	CreditorIBAN        string                      `json:"creditorIBAN"`
+	CreditorOtherTypeId string                      `json:"creditorOtherTypeId"`
+	PurposeOfPayment    models.PurposeOfPaymentType `json:"purposeOfPayment"`
+	RemittanceInfo      string                      `json:"remittanceInfo"`
+
+	// Cover payment (COV) details, empty for a plain bank transfer
+	UnderlyingCustomerCreditTransfer UnderlyingCustomerCreditTransfer `json:"underlyingCustomerCreditTransfer"`
+
+	// Optional business application header, written and read next to the Document
+	AppHdr *BusinessApplicationHeader.MessageModel `json:"appHdr,omitempty"`
+
+	// Document content not covered by the path map, kept when parsed with base.PreserveUnmapped
+	Unmapped *models.Unmapped `json:"unmapped,omitempty"`
+
+	// Version of the document the message was parsed from; empty for messages built in code
+	ParsedVersion PACS_009_001_VERSION `json:"parsedVersion,omitempty"`
+
+	// Values Fedwire would refuse that the document held, which WriteXML writes back as read
+	ReadValues models.ReadValues `json:"-"`
+}
+
+// ReadXML reads XML data from an io.Reader into the MessageModel
+func (m *MessageModel) ReadXML(r io.Reader, opts ...base.ParseOption) error {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return fmt.Errorf("reading XML: %w", err)
+	}
+
+	model, err := ParseXML(data, opts...)
+	if err != nil {
+		return err
+	}
+
+	*m = *model
+	return nil
+}
+
+// WriteXML writes the MessageModel as XML to an io.Writer.
+// This is the primary method for XML serialization and handles the complete XML generation process.
+//
+// Features:
+//   - Writes XML declaration (<?xml version="1.0" encoding="UTF-8"?>)
+//   - Properly formatted with indentation
+//   - Automatic namespace handling
+//   - Validates required fields before writing
+//   - Defaults to the version the message was parsed from, else the latest, if not specified
+//   - Writes the AppHdr ahead of the Document when set, with MsgDefIdr matching the version
+//
+// Example:
+//
+//	// Write to file
+//	file, _ := os.Create("transfer.xml")
+//	defer file.Close()
+//	err := model.WriteXML(file, FICreditTransfer.PACS_009_001_08)
+//
+//	// Write to buffer
+//	var buf bytes.Buffer
+//	err := model.WriteXML(&buf)
+//
+// For advanced use cases requiring document inspection before serialization, see DocumentWith.
+func (m *MessageModel) WriteXML(w io.Writer, version ...PACS_009_001_VERSION) error {
+	// Default to the version the message was read in, else latest
+	ver := m.documentVersion()
+	if len(version) > 0 {
+		ver = version[0]
+	}
+
+	// Create versioned document
+	doc, err := DocumentWith(*m, ver)
+	if err != nil {
+		return fmt.Errorf("creating document: %w", err)
+	}
+
+	// Write XML with proper formatting
+	encoder := xml.NewEncoder(w)
+	defer encoder.Close()
+	encoder.Indent("", "  ")
+
+	// Write XML declaration
+	if _, err := w.Write([]byte(xml.Header)); err != nil {
+		return fmt.Errorf("writing XML header: %w", err)
+	}
+
+	// Write the business application header, if any, ahead of the document
+	if err := BusinessApplicationHeader.Encode(encoder, m.AppHdr, VersionNameSpaceMap[ver]); err != nil {
+		return err
+	}
+
+	// Encode document
+	if err := processor.EncodeDocument(encoder, doc, *m, ver); err != nil {
+		return fmt.Errorf("encoding XML: %w", err)
+	}
+
+	return encoder.Flush()
+}
+
+// GetMessageId returns the message identification (MsgId)
+func (m *MessageModel) GetMessageId() string {
+	return m.MessageId
+}
+
+// CreatedAt returns when the message was created (CreDtTm)
+func (m *MessageModel) CreatedAt() time.Time {
+	return m.CreatedDateTime
+}
+
+// ISOMessageName returns the ISO 20022 message the model represents, "pacs.009"
+func (m *MessageModel) ISOMessageName() string {
+	return "pacs.009"
+}
+
+// Version returns the version WriteDocument writes: the one the message was parsed from or its
+// AppHdr names, otherwise PACS_009_001_08
+func (m *MessageModel) Version() string {
+	return string(m.documentVersion())
+}
+
+// Validate checks the required fields, the values models.ValidateValues covers and the rules of
+// the message's Version
+func (m *MessageModel) Validate() error {
+	if err := CheckRequiredFields(*m); err != nil {
+		return err
+	}
+	if err := models.ValidateValues(*m); err != nil {
+		return err
+	}
+	return m.ValidateForVersion(m.documentVersion())
+}
+
+// WriteDocument writes the message like WriteXML, in its Version
+func (m *MessageModel) WriteDocument(w io.Writer) error {
+	return m.WriteXML(w, m.documentVersion())
+}
+
+// documentVersion returns the version WriteXML writes when none is given
+func (m *MessageModel) documentVersion() PACS_009_001_VERSION {
+	if m.ParsedVersion != "" {
+		return m.ParsedVersion
+	}
+	var msgDefIdr string
+	if m.AppHdr != nil {
+		msgDefIdr = m.AppHdr.MessageDefinitionId
+	}
+	return processor.DocumentVersion(m.Unmapped, msgDefIdr, PACS_009_001_08)
+}
+
+// ConvertToVersion returns a copy of the message for another version of pacs.009, e.g. for a
+// counterparty on an older release. Values the version has no element for are dropped and the
+// field groups it needs are added; the report lists both. The message is left unchanged.
+func (m *MessageModel) ConvertToVersion(version PACS_009_001_VERSION) (*MessageModel, *base.ConversionReport, error) {
+	converted, report, err := processor.ConvertMessage(*m, m.documentVersion(), version)
+	if err != nil {
+		return nil, nil, err
+	}
+	namespace := VersionNameSpaceMap[version]
+	if converted.Unmapped, err = processor.CarryUnmapped(report, converted.Unmapped, version); err != nil {
+		return nil, nil, err
+	}
+	if converted.AppHdr != nil {
+		converted.AppHdr.MessageDefinitionId = BusinessApplicationHeader.MessageDefinitionIdFor(namespace)
+	}
+	converted.ParsedVersion = version
+	return &converted, report, nil
+}
+
+var RequiredFields = []string{
+	"MessageId", "CreatedDateTime", "NumberOfTransactions", "SettlementMethod",
+	"CommonClearingSysCode", "EndToEndId", "UniqueEndToEndTransactionRef",
+	"InstrumentPropCode", "InterBankSettAmount", "InterBankSettDate",
+	"InstructingAgent", "InstructedAgent", "Debtor", "Creditor",
+}
+
+// Global processor instance using the base abstraction
+var processor *base.MessageProcessor[MessageModel, PACS_009_001_VERSION]
+
+// init sets up the processor using base abstractions
+func init() {
+	// Register version using factory registration pattern
+	registrations := []base.FactoryRegistration[models.ISODocument, PACS_009_001_VERSION]{
+		{
+			Namespace: "urn:iso:std:iso:20022:tech:xsd:pacs.009.001.08",
+			Version:   PACS_009_001_08,
+			Factory: func() models.ISODocument {
+				return &pacs_009_001_08.Document{XMLName: xml.Name{Space: VersionNameSpaceMap[PACS_009_001_08], Local: "Document"}}
+			},
+		},
+	}
+
+	versionedFactory := base.BuildFactoryFromRegistrations(registrations)
+
+	// Create the processor using base abstractions
+	processor = base.NewMessageProcessor[MessageModel, PACS_009_001_VERSION](
+		versionedFactory.BuildNameSpaceModelMap(),
+		versionedFactory.GetVersionMap(),
+		VersionPathMap,
+		RequiredFields,
+	)
+	processor.SetMessageFactory(NewMessageForVersion)
+}
+
+// ParseXML reads XML data into the MessageModel
+// This is the primary function for parsing XML from byte data
+// An AppHdr sent ahead of the Document is read into the AppHdr field
+// Pass base.PreserveUnmapped() to keep elements the model does not cover for WriteXML
+func ParseXML(data []byte, opts ...base.ParseOption) (*MessageModel, error) {
+	appHdr, data, err := BusinessApplicationHeader.Split(data)
+	if err != nil {
+		return nil, err
+	}
+
+	model, version, unmapped, err := processor.ParseMessage(data, opts...)
+	if err != nil {
+		return nil, err
+	}
+	model.AppHdr = appHdr
+	model.Unmapped = unmapped
+	model.ParsedVersion = version
+	model.ReadValues = models.NewReadValues(&model)
+	return &model, nil
+}
+
+// DocumentWith creates a versioned ISO 20022 document from the MessageModel.
+// This is a lower-level API that returns the raw document structure for advanced use cases.
+//
+// When to use DocumentWith vs WriteXML:
+//   - Use WriteXML for standard XML output to files, network connections, or buffers
+//   - Use DocumentWith when you need to:
+//   - Inspect or modify the document structure before serialization
+//   - Integrate with other XML processing libraries
+//   - Perform custom validation on the document level
+//   - Access version-specific document types directly
+//
+// Example:
+//
+//	doc, err := FICreditTransfer.DocumentWith(model, FICreditTransfer.PACS_009_001_08)
+//	if err != nil {
+//	    return err
+//	}
+//	// Now you can inspect or modify doc before serializing
+//	xmlBytes, err := xml.Marshal(doc)
+func DocumentWith(model MessageModel, version PACS_009_001_VERSION) (models.ISODocument, error) {
+	// Validate required fields before creating document
+	if err := CheckRequiredFields(model); err != nil {
+		return nil, err
+	}
+	return processor.CreateDocumentWith(model, version, model.Unmapped, model.ReadValues)
+}
+
+// EncodeDocument writes a document DocumentWith created from the MessageModel, with each amount
+// in the digits of its Decimal rather than those of the document's binary number
+func EncodeDocument(encoder *xml.Encoder, doc models.ISODocument, model MessageModel, version PACS_009_001_VERSION) error {
+	return processor.EncodeDocument(encoder, doc, model, version)
+}
+
+// CheckRequiredFields uses base abstractions to replace 30+ lines with a single call
+func CheckRequiredFields(model MessageModel) error {
+	return processor.ValidateRequiredFields(model)
+}
+
+// FICreditTransferDataModel returns a sample bank-to-bank transfer (BTRS) that can be used
+// as a starting point for building pacs.009 messages
+func FICreditTransferDataModel() MessageModel {
+	return MessageModel{
+		PaymentCore: base.PaymentCore{
+			MessageHeader: base.MessageHeader{
+				MessageId:       "20250310B1QDRCQR000501",
+				CreatedDateTime: time.Now(),
+			},
+			NumberOfTransactions:  "1",
+			SettlementMethod:      models.SettlementCLRG,
+			CommonClearingSysCode: models.ClearingSysFDW,
+		},
+		InstructionId:                "Scenario01FIInstrId001",
+		EndToEndId:                   "Scenario01FIEtoEId001",
+		UniqueEndToEndTransactionRef: "8a562c67-ca16-48ba-b074-65581be6f055",
+		InstrumentPropCode:           models.InstrumentBTRS,
+		InterBankSettAmount: models.CurrencyAndAmount{
+			Currency: "USD", Amount: "1000000.00",
+		},
+		InterBankSettDate: fedwire.ISODate(civil.DateOf(time.Now())),
+		AgentPair: base.AgentPair{
+			InstructingAgent: models.Agent{
+				PaymentSysCode:     models.PaymentSysUSABA,
+				PaymentSysMemberId: "011104238",
+			},
+			InstructedAgent: models.Agent{
+				PaymentSysCode:     models.PaymentSysUSABA,
+				PaymentSysMemberId: "021040078",
+			},
+		},
+		Debtor: models.Agent{
+			PaymentSysCode:     models.PaymentSysUSABA,
+			PaymentSysMemberId: "011104238",
+			BankName:           "Bank A",
+			PostalAddress: models.PostalAddress{
+				StreetName:     "Avenue A",
+				BuildingNumber: "66",
+				PostalCode:     "60532",
+				TownName:       "Lisle",
+				Subdivision:    "IL",
+				Country:        "US",
+			},
+		},
+		Creditor: models.Agent{
+			PaymentSysCode:     models.PaymentSysUSABA,
+			PaymentSysMemberId: "021040078",
+			BankName:           "Bank B",
+			PostalAddress: models.PostalAddress{
+				StreetName:     "Avenue B",
+				BuildingNumber: "25",
+				PostalCode:     "19067",
+				TownName:       "Yardley",
+				Subdivision:    "PA",
+				Country:        "US",
+			},
+		},
+		RemittanceInfo: "Overnight funding",
+	}
+}
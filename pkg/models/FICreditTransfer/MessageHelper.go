@@ -0,0 +1,241 @@
+package FICreditTransfer
+
+import "github.com/moov-io/wire20022/pkg/models"
+
+type UnderlyingCustomerCreditTransferHelper struct {
+	DebtorName          models.ElementHelper
+	DebtorLEI           models.ElementHelper
+	DebtorAddress       models.PostalAddressHelper
+	DebtorIBAN          models.ElementHelper
+	DebtorOtherTypeId   models.ElementHelper
+	DebtorAgent         models.AgentHelper
+	CreditorAgent       models.AgentHelper
+	CreditorName        models.ElementHelper
+	CreditorLEI         models.ElementHelper
+	CreditorAddress     models.PostalAddressHelper
+	CreditorIBAN        models.ElementHelper
+	CreditorOtherTypeId models.ElementHelper
+	RemittanceInfo      models.ElementHelper
+	InstructedAmount    models.CurrencyAndAmountHelper
+}
+
+// BuildUnderlyingCustomerCreditTransferHelper creates a helper structure for the underlying customer
+// credit transfer block (UndrlygCstmrCdtTrf) carried by cover payments.
+func BuildUnderlyingCustomerCreditTransferHelper() UnderlyingCustomerCreditTransferHelper {
+	return UnderlyingCustomerCreditTransferHelper{
+		DebtorName: models.ElementHelper{
+			Title:         "Debtor Name",
+			Rules:         "",
+			Type:          `Max140Text (based on string) minLength: 1 maxLength: 140`,
+			Documentation: `Party that owes an amount of money to the (ultimate) creditor.`,
+		},
+		DebtorLEI: models.ElementHelper{
+			Title:         "Debtor LEI",
+			Rules:         "",
+			Type:          `LEIIdentifier (based on string) pattern: [A-Z0-9]{18,18}[0-9]{2,2}`,
+			Documentation: `Legal entity identifier of the debtor organisation.`,
+		},
+		DebtorAddress: models.BuildPostalAddressHelper(),
+		DebtorIBAN: models.ElementHelper{
+			Title:         "Debtor IBAN",
+			Rules:         "",
+			Type:          `IBAN2007Identifier (based on string) pattern: [A-Z]{2,2}[0-9]{2,2}[a-zA-Z0-9]{1,30}`,
+			Documentation: `International Bank Account Number (IBAN) of the debtor account.`,
+		},
+		DebtorOtherTypeId: models.ElementHelper{
+			Title:         "Debtor Other Type Id",
+			Rules:         "",
+			Type:          `Max34Text (based on string) minLength: 1 maxLength: 34`,
+			Documentation: `Unique identification of the debtor account, as assigned by the account servicer, using an identification scheme.`,
+		},
+		DebtorAgent:   models.BuildAgentHelper(),
+		CreditorAgent: models.BuildAgentHelper(),
+		CreditorName: models.ElementHelper{
+			Title:         "Creditor Name",
+			Rules:         "",
+			Type:          `Max140Text (based on string) minLength: 1 maxLength: 140`,
+			Documentation: `Party to which an amount of money is due.`,
+		},
+		CreditorLEI: models.ElementHelper{
+			Title:         "Creditor LEI",
+			Rules:         "",
+			Type:          `LEIIdentifier (based on string) pattern: [A-Z0-9]{18,18}[0-9]{2,2}`,
+			Documentation: `Legal entity identifier of the creditor organisation.`,
+		},
+		CreditorAddress: models.BuildPostalAddressHelper(),
+		CreditorIBAN: models.ElementHelper{
+			Title:         "Creditor IBAN",
+			Rules:         "",
+			Type:          `IBAN2007Identifier (based on string) pattern: [A-Z]{2,2}[0-9]{2,2}[a-zA-Z0-9]{1,30}`,
+			Documentation: `International Bank Account Number (IBAN) of the creditor account.`,
+		},
+		CreditorOtherTypeId: models.ElementHelper{
+			Title:         "Creditor Other Type Id",
+			Rules:         "",
+			Type:          `Max34Text (based on string) minLength: 1 maxLength: 34`,
+			Documentation: `Unique identification of the creditor account, as assigned by the account servicer, using an identification scheme.`,
+		},
+		RemittanceInfo: models.ElementHelper{
+			Title:         "Unstructured Remittance Information",
+			Rules:         "",
+			Type:          `Max140Text (based on string) minLength: 1 maxLength: 140`,
+			Documentation: `Information supplied to enable the matching of an entry with the items that the transfer is intended to settle, in an unstructured form.`,
+		},
+		InstructedAmount: models.BuildCurrencyAndAmountHelper(),
+	}
+}
+
+type MessageHelper struct {
+	MessageId                        models.ElementHelper
+	CreatedDateTime                  models.ElementHelper
+	NumberOfTransactions             models.ElementHelper
+	SettlementMethod                 models.ElementHelper
+	CommonClearingSysCode            models.ElementHelper
+	InstructionId                    models.ElementHelper
+	EndToEndId                       models.ElementHelper
+	TransactionId                    models.ElementHelper
+	UniqueEndToEndTransactionRef     models.ElementHelper
+	ServiceLevel                     models.ElementHelper
+	InstrumentPropCode               models.ElementHelper
+	InterBankSettAmount              models.CurrencyAndAmountHelper
+	InterBankSettDate                models.ElementHelper
+	InstructingAgent                 models.AgentHelper
+	InstructedAgent                  models.AgentHelper
+	IntermediaryAgent1               models.AgentHelper
+	Debtor                           models.AgentHelper
+	DebtorIBAN                       models.ElementHelper
+	DebtorOtherTypeId                models.ElementHelper
+	DebtorAgent                      models.AgentHelper
+	CreditorAgent                    models.AgentHelper
+	Creditor                         models.AgentHelper
+	CreditorIBAN                     models.ElementHelper
+	CreditorOtherTypeId              models.ElementHelper
+	PurposeOfPayment                 models.ElementHelper
+	RemittanceInfo                   models.ElementHelper
+	UnderlyingCustomerCreditTransfer UnderlyingCustomerCreditTransferHelper
+}
+
+// BuildMessageHelper creates a comprehensive helper structure for FICreditTransfer message fields.
+// Returns a MessageHelper with field metadata for all ISO 20022 pacs.009 message elements including
+// message identification, settlement information, financial institution parties and cover payment details.
+func BuildMessageHelper() MessageHelper {
+	return MessageHelper{
+		MessageId: models.ElementHelper{
+			Title:         "Message Identification",
+			Rules:         "Must be the Fedwire Funds Input Message Accountability Data (IMAD).",
+			Type:          `IMAD_FedwireFunds_1 (based on string) minLength: 22 maxLength: 22 pattern: [0-9]{8}[A-Z0-9]{8}[0-9]{6}`,
+			Documentation: `Point to point reference, as assigned by the instructing party, and sent to the next party in the chain to unambiguously identify the message. Usage: The instructing party has to make sure that MessageIdentification is unique per instructed party for a pre-agreed period.`,
+		},
+		CreatedDateTime: models.ElementHelper{
+			Title:         "Creation Date Time",
+			Rules:         "Must be date and time when the message is created by the Fedwire Sender. Time must be in 24-hour clock format and either in Coordinated Universal Time (UTC) or in local time with offset against UTC.",
+			Type:          `ISODateTime (based on dateTime)`,
+			Documentation: `Date and time at which the message was created.`,
+		},
+		NumberOfTransactions: models.ElementHelper{
+			Title:         "Number Of Transactions",
+			Rules:         "Must be 1.",
+			Type:          `Max15NumericText_fixed (based on string) pattern: [0-9]{1,15} fixed: 1`,
+			Documentation: `Number of individual transactions contained in the message.`,
+		},
+		SettlementMethod: models.ElementHelper{
+			Title:         "Settlement Method",
+			Rules:         "Must be CLRG.",
+			Type:          `SettlementMethodType(SettlementCLRG, SettlementINDA, SettlementCOVE, SettlementTDSO, SettlementTDSA)`,
+			Documentation: `Method used to settle the (batch of) payment instructions.`,
+		},
+		CommonClearingSysCode: models.ElementHelper{
+			Title:         "Common Clearing System Code",
+			Rules:         "Must be FDW.",
+			Type:          `CommonClearingSysCodeType(ClearingSysFDW, ClearingSysCHIPS, ClearingSysSEPA ...)`,
+			Documentation: `Infrastructure through which the payment instruction is processed, as published in an external clearing system identification code list.`,
+		},
+		InstructionId: models.ElementHelper{
+			Title:         "Instruction Identification",
+			Rules:         "Fedwire Funds Tag {3320} Sender Reference",
+			Type:          `Max35Text (based on string) minLength: 1 maxLength: 35`,
+			Documentation: `Unique identification, as assigned by an instructing party for an instructed party, to unambiguously identify the instruction.`,
+		},
+		EndToEndId: models.ElementHelper{
+			Title:         "End To End Identification",
+			Rules:         "If no End To End Identification is available, then `NOTPROVIDED` should be used. ",
+			Type:          `Max35Text (based on string) minLength: 1 maxLength: 35`,
+			Documentation: `Unique identification, as assigned by the initiating party, to unambiguously identify the transaction. This identification is passed on, unchanged, throughout the entire end-to-end chain.`,
+		},
+		TransactionId: models.ElementHelper{
+			Title:         "Transaction Identification",
+			Rules:         "",
+			Type:          `Max35Text (based on string) minLength: 1 maxLength: 35`,
+			Documentation: `Unique identification, as assigned by the first instructing agent, to unambiguously identify the transaction that is passed on, unchanged, throughout the entire interbank chain.`,
+		},
+		UniqueEndToEndTransactionRef: models.ElementHelper{
+			Title:         "UETR",
+			Rules:         "",
+			Type:          `UUIDv4Identifier (based on string) pattern: [a-f0-9]{8}-[a-f0-9]{4}-4[a-f0-9]{3}-[89ab][a-f0-9]{3}-[a-f0-9]{12} identificationScheme: RFC4122; UUIDv4`,
+			Documentation: `Universally unique identifier to provide an end-to-end reference of a payment transaction.`,
+		},
+		ServiceLevel: models.ElementHelper{
+			Title:         "Service Level",
+			Rules:         "",
+			Type:          `ExternalServiceLevel1Code (based on string) minLength: 1 maxLength: 4`,
+			Documentation: `Agreement under which or rules under which the transaction should be processed.`,
+		},
+		InstrumentPropCode: models.ElementHelper{
+			Title:         "Local Instrument Proprietary",
+			Rules:         "Cover payments (COVS) must include the underlying customer credit transfer.",
+			Type:          `InstrumentPropCodeType(InstrumentBTRS, InstrumentBTRD, InstrumentCOVS)`,
+			Documentation: `Specifies the local instrument, as a proprietary code.`,
+		},
+		InterBankSettAmount: models.BuildCurrencyAndAmountHelper(),
+		InterBankSettDate: models.ElementHelper{
+			Title:         "Interbank Settlement Date",
+			Rules:         "Must be the date of the current Fedwire funds-transfer business day in local date format (YYYY-MM-DD).",
+			Type:          `ISODate (based on date)`,
+			Documentation: `Date on which the amount of money ceases to be available to the agent that owes it and when the amount of money becomes available to the agent to which it is due.`,
+		},
+		InstructingAgent:   models.BuildAgentHelper(),
+		InstructedAgent:    models.BuildAgentHelper(),
+		IntermediaryAgent1: models.BuildAgentHelper(),
+		Debtor:             models.BuildAgentHelper(),
+		DebtorIBAN: models.ElementHelper{
+			Title:         "Debtor IBAN",
+			Rules:         "",
+			Type:          `IBAN2007Identifier (based on string) pattern: [A-Z]{2,2}[0-9]{2,2}[a-zA-Z0-9]{1,30}`,
+			Documentation: `International Bank Account Number (IBAN) of the debtor account.`,
+		},
+		DebtorOtherTypeId: models.ElementHelper{
+			Title:         "Debtor Other Type Id",
+			Rules:         "",
+			Type:          `Max34Text (based on string) minLength: 1 maxLength: 34`,
+			Documentation: `Unique identification of the debtor account, as assigned by the account servicer, using an identification scheme.`,
+		},
+		DebtorAgent:   models.BuildAgentHelper(),
+		CreditorAgent: models.BuildAgentHelper(),
+		Creditor:      models.BuildAgentHelper(),
+		CreditorIBAN: models.ElementHelper{
+			Title:         "Creditor IBAN",
+			Rules:         "",
+			Type:          `IBAN2007Identifier (based on string) pattern: [A-Z]{2,2}[0-9]{2,2}[a-zA-Z0-9]{1,30}`,
+			Documentation: `International Bank Account Number (IBAN) of the creditor account.`,
+		},
+		CreditorOtherTypeId: models.ElementHelper{
+			Title:         "Creditor Other Type Id",
+			Rules:         "",
+			Type:          `Max34Text (based on string) minLength: 1 maxLength: 34`,
+			Documentation: `Unique identification of the creditor account, as assigned by the account servicer, using an identification scheme.`,
+		},
+		PurposeOfPayment: models.ElementHelper{
+			Title:         "Purpose",
+			Rules:         "",
+			Type:          `ExternalPurpose1Code (based on string) minLength: 1 maxLength: 4`,
+			Documentation: `Underlying reason for the payment transaction.`,
+		},
+		RemittanceInfo: models.ElementHelper{
+			Title:         "Unstructured Remittance Information",
+			Rules:         "",
+			Type:          `Max140Text (based on string) minLength: 1 maxLength: 140`,
+			Documentation: `Information supplied to enable the matching of an entry with the items that the transfer is intended to settle, in an unstructured form.`,
+		},
+		UnderlyingCustomerCreditTransfer: BuildUnderlyingCustomerCreditTransferHelper(),
+	}
+}
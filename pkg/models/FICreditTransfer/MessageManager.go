@@ -0,0 +1,30 @@
+package FICreditTransfer
+
+import (
+	"github.com/moov-io/wire20022/pkg/models"
+)
+
+// UnderlyingCustomerCreditTransfer carries the customer credit transfer details that a
+// cover payment (pacs.009 COV) settles between the financial institutions
+type UnderlyingCustomerCreditTransfer struct {
+	//Party that owes the amount of money to the (ultimate) creditor
+	DebtorName        string
+	DebtorLEI         string
+	DebtorAddress     models.PostalAddress
+	DebtorIBAN        string
+	DebtorOtherTypeId string
+	//Financial institution servicing an account for the debtor
+	DebtorAgent models.Agent
+	//Financial institution servicing an account for the creditor
+	CreditorAgent models.Agent
+	//Party to which an amount of money is due
+	CreditorName        string
+	CreditorLEI         string
+	CreditorAddress     models.PostalAddress
+	CreditorIBAN        string
+	CreditorOtherTypeId string
+	//Unstructured remittance information passed on from the customer credit transfer
+	RemittanceInfo string
+	//Amount of money to be moved between the debtor and creditor, before deduction of charges
+	InstructedAmount models.CurrencyAndAmount
+}
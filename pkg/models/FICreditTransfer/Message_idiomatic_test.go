@@ -0,0 +1,91 @@
+package FICreditTransfer_test
+
+import (
+	"bytes"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/moov-io/wire20022/pkg/models/FICreditTransfer"
+)
+
+// TestReadXML tests the idiomatic ReadXML method
+func TestReadXML(t *testing.T) {
+	xmlFile, err := os.Open("./swiftSample/FICreditTransfer_Scenario3_Step1_pacs.009")
+	require.NoError(t, err)
+	defer xmlFile.Close()
+
+	var model FICreditTransfer.MessageModel
+	err = model.ReadXML(xmlFile)
+	assert.NoError(t, err)
+
+	assert.Equal(t, "20250310B1QDRCQR000503", model.MessageId)
+	assert.True(t, model.IsCoverPayment())
+	assert.Equal(t, "Corporation Z", model.UnderlyingCustomerCreditTransfer.DebtorName)
+}
+
+// TestWriteXML tests the idiomatic WriteXML method
+func TestWriteXML(t *testing.T) {
+	model := FICreditTransfer.FICreditTransferDataModel()
+
+	var buf bytes.Buffer
+	err := model.WriteXML(&buf)
+	assert.NoError(t, err)
+
+	xmlOutput := buf.String()
+	assert.Contains(t, xmlOutput, "<?xml")
+	assert.Contains(t, xmlOutput, "pacs.009.001.08")
+	assert.Contains(t, xmlOutput, model.MessageId)
+	assert.NotContains(t, xmlOutput, "UndrlygCstmrCdtTrf")
+
+	var parsedModel FICreditTransfer.MessageModel
+	err = parsedModel.ReadXML(strings.NewReader(xmlOutput))
+	assert.NoError(t, err)
+	assert.Equal(t, model.MessageId, parsedModel.MessageId)
+	assert.Equal(t, model.Creditor.BankName, parsedModel.Creditor.BankName)
+}
+
+// TestParseXML tests the ParseXML function
+func TestParseXML(t *testing.T) {
+	xmlData, err := os.ReadFile("./swiftSample/FICreditTransfer_Scenario1_Step1_pacs.009")
+	require.NoError(t, err)
+
+	model, err := FICreditTransfer.ParseXML(xmlData)
+	assert.NoError(t, err)
+	assert.NotNil(t, model)
+	assert.Equal(t, "Scenario01FIEtoEId001", model.EndToEndId)
+}
+
+// TestIdentifiers tests that Validate checks the IBANs of the parties and the LEIs of agents and parties
+func TestIdentifiers(t *testing.T) {
+	model := FICreditTransfer.FICreditTransferDataModel()
+	model.CreditorIBAN = "DE89370400440532013000"
+	_, err := FICreditTransfer.DocumentWith(model, FICreditTransfer.PACS_009_001_08)
+	require.NoError(t, err)
+
+	model.CreditorIBAN = "DE8937040044053201300"
+	model.UnderlyingCustomerCreditTransfer.DebtorIBAN = "US64SVBKUS6S3300958879"
+	err = model.Validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `"CreditorIBAN": IBAN "DE8937040044053201300" must be 22 characters for DE`)
+	assert.Contains(t, err.Error(), `"UnderlyingCustomerCreditTransfer.DebtorIBAN": IBAN "US64SVBKUS6S3300958879" has country code US, which does not use IBANs`)
+
+	// Agent and party LEIs are read from and written to FinInstnId/LEI and Id/OrgId/LEI
+	model = FICreditTransfer.FICreditTransferDataModel()
+	model.DebtorAgent.LEI = "5493001KJTIIGC8Y1R12"
+	model.UnderlyingCustomerCreditTransfer.CreditorLEI = "HWUPKR0MPOU8FGXBT394"
+	var output bytes.Buffer
+	require.NoError(t, model.WriteXML(&output, FICreditTransfer.PACS_009_001_08))
+	reread, err := FICreditTransfer.ParseXML(output.Bytes())
+	require.NoError(t, err)
+	assert.Equal(t, "5493001KJTIIGC8Y1R12", reread.DebtorAgent.LEI)
+	assert.Equal(t, "HWUPKR0MPOU8FGXBT394", reread.UnderlyingCustomerCreditTransfer.CreditorLEI)
+
+	model.DebtorAgent.LEI = "5493001KJTIIGC8Y1R21"
+	err = model.Validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `"DebtorAgent.LEI": LEI "5493001KJTIIGC8Y1R21" fails the mod-97 check`)
+}
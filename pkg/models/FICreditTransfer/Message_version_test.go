@@ -0,0 +1,132 @@
+package FICreditTransfer
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/moov-io/wire20022/pkg/models"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVersion08(t *testing.T) {
+	modelName := PACS_009_001_08
+	xmlName := "FICreditTransfer_08.xml"
+
+	dataModel := FICreditTransferDataModel()
+	/*Create Document from Model*/
+	var doc08, err = DocumentWith(dataModel, modelName)
+	require.NoError(t, err, "Failed to create document")
+	/*Validate Check for created Document*/
+	vErr := doc08.Validate()
+	require.NoError(t, vErr, "Failed to validate document")
+	/*Create XML file from Document*/
+	xmlData, err := xml.MarshalIndent(doc08, "", "  ")
+	require.NoError(t, err)
+	err = models.WriteXMLToGenerate(xmlName, xmlData)
+	require.NoError(t, err)
+
+	/*Create Date Model from XML (Read XML)*/
+	var xmlDoc, xmlErr = models.ReadXMLFile("./generated/" + xmlName)
+	require.NoError(t, xmlErr, "Failed to read XML file")
+
+	/*Compare*/
+	model, err := ParseXML(xmlDoc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	require.NoError(t, err, "Failed to make XML structure")
+	require.Equal(t, model.MessageId, "20250310B1QDRCQR000501")
+	require.NotNil(t, model.CreatedDateTime)
+	require.Equal(t, model.NumberOfTransactions, "1")
+	require.Equal(t, model.SettlementMethod, models.SettlementCLRG)
+	require.Equal(t, model.CommonClearingSysCode, models.ClearingSysFDW)
+	require.Equal(t, model.InstructionId, "Scenario01FIInstrId001")
+	require.Equal(t, model.EndToEndId, "Scenario01FIEtoEId001")
+	require.Equal(t, model.UniqueEndToEndTransactionRef, "8a562c67-ca16-48ba-b074-65581be6f055")
+	require.Equal(t, model.InstrumentPropCode, models.InstrumentBTRS)
+	require.Equal(t, model.InterBankSettAmount.Amount, models.Decimal("1000000"))
+	require.Equal(t, model.InterBankSettAmount.Currency, "USD")
+	require.NotNil(t, model.InterBankSettDate)
+	require.Equal(t, model.InstructingAgent.PaymentSysMemberId, "011104238")
+	require.Equal(t, model.InstructedAgent.PaymentSysMemberId, "021040078")
+	require.Equal(t, model.Debtor.BankName, "Bank A")
+	require.Equal(t, model.Debtor.PostalAddress.PostalCode, "60532")
+	require.Equal(t, model.Creditor.BankName, "Bank B")
+	require.Equal(t, model.Creditor.PostalAddress.PostalCode, "19067")
+	require.Equal(t, model.RemittanceInfo, "Overnight funding")
+	require.False(t, model.IsCoverPayment())
+
+	/*Validation check*/
+	model.MessageId = "InvalideMessageIdLength5012345678901234567890"
+	_, err = DocumentWith(*model, modelName)
+	require.NotNil(t, err, "Expected error but got nil")
+	require.Equal(t, err.Error(), "field copy FICdtTrf.GrpHdr.MsgId failed: failed to set MessageId: InvalideMessageIdLength5012345678901234567890 fails validation with length 45 <= required maxLength 22")
+	model.MessageId = "20250310B1QDRCQR000501"
+
+	/*Require field check*/
+	model.MessageId = ""
+	_, err = DocumentWith(*model, modelName)
+	require.NotNil(t, err, "Expected error but got nil")
+	require.Equal(t, err.Error(), "validation failed for field \"MessageId\": is required: required field missing")
+	model.MessageId = "20250310B1QDRCQR000501"
+}
+
+func TestVersion08CoverPayment(t *testing.T) {
+	modelName := PACS_009_001_08
+	xmlName := "FICreditTransfer_COV_08.xml"
+
+	dataModel := FICreditTransferDataModel()
+	dataModel.InstrumentPropCode = models.InstrumentCOVS
+	dataModel.UnderlyingCustomerCreditTransfer = UnderlyingCustomerCreditTransfer{
+		DebtorName: "Corporation Z",
+		DebtorAddress: models.PostalAddress{
+			StreetName:     "Avenue Moliere",
+			BuildingNumber: "70",
+			PostalCode:     "1180",
+			TownName:       "Brussels",
+			Country:        "BE",
+		},
+		DebtorIBAN: "BE68539007547034",
+		DebtorAgent: models.Agent{
+			BusinessIdCode: "BANZBEBB",
+		},
+		CreditorAgent: models.Agent{
+			BusinessIdCode: "BANCUS33",
+		},
+		CreditorName: "Corporation C",
+		CreditorAddress: models.PostalAddress{
+			TownName: "New York",
+			Country:  "US",
+		},
+		CreditorOtherTypeId: "5647772655",
+		RemittanceInfo:      "Invoice INV-2025-0310",
+		InstructedAmount: models.CurrencyAndAmount{
+			Currency: "USD", Amount: "1000000.00",
+		},
+	}
+	/*Create Document from Model*/
+	var doc08, err = DocumentWith(dataModel, modelName)
+	require.NoError(t, err, "Failed to create document")
+	/*Validate Check for created Document*/
+	vErr := doc08.Validate()
+	require.NoError(t, vErr, "Failed to validate document")
+	/*Create XML file from Document*/
+	xmlData, err := xml.MarshalIndent(doc08, "", "  ")
+	require.NoError(t, err)
+	require.Contains(t, string(xmlData), "UndrlygCstmrCdtTrf")
+	err = models.WriteXMLToGenerate(xmlName, xmlData)
+	require.NoError(t, err)
+
+	/*Create Date Model from XML (Read XML)*/
+	var xmlDoc, xmlErr = models.ReadXMLFile("./generated/" + xmlName)
+	require.NoError(t, xmlErr, "Failed to read XML file")
+
+	/*Compare*/
+	model, err := ParseXML(xmlDoc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	require.True(t, model.IsCoverPayment())
+	require.Equal(t, model.InstrumentPropCode, models.InstrumentCOVS)
+	require.Equal(t, model.UnderlyingCustomerCreditTransfer, dataModel.UnderlyingCustomerCreditTransfer)
+}
@@ -0,0 +1,148 @@
+package FICreditTransfer
+
+func pathMapV8() map[string]any {
+	return map[string]any{
+		"FICdtTrf.GrpHdr.MsgId":                                                              "MessageId",
+		"FICdtTrf.GrpHdr.CreDtTm":                                                            "CreatedDateTime",
+		"FICdtTrf.GrpHdr.NbOfTxs":                                                            "NumberOfTransactions",
+		"FICdtTrf.GrpHdr.SttlmInf.SttlmMtd":                                                  "SettlementMethod",
+		"FICdtTrf.GrpHdr.SttlmInf.ClrSys.Cd":                                                 "CommonClearingSysCode",
+		"FICdtTrf.CdtTrfTxInf.PmtId.InstrId":                                                 "InstructionId",
+		"FICdtTrf.CdtTrfTxInf.PmtId.EndToEndId":                                              "EndToEndId",
+		"FICdtTrf.CdtTrfTxInf.PmtId.TxId":                                                    "TransactionId",
+		"FICdtTrf.CdtTrfTxInf.PmtId.UETR":                                                    "UniqueEndToEndTransactionRef",
+		"FICdtTrf.CdtTrfTxInf.PmtTpInf.SvcLvl[0].Cd":                                         "ServiceLevel",
+		"FICdtTrf.CdtTrfTxInf.PmtTpInf.LclInstrm.Prtry":                                      "InstrumentPropCode",
+		"FICdtTrf.CdtTrfTxInf.IntrBkSttlmAmt.Value":                                          "InterBankSettAmount.Amount",
+		"FICdtTrf.CdtTrfTxInf.IntrBkSttlmAmt.Ccy":                                            "InterBankSettAmount.Currency",
+		"FICdtTrf.CdtTrfTxInf.IntrBkSttlmDt":                                                 "InterBankSettDate",
+		"FICdtTrf.CdtTrfTxInf.InstgAgt.FinInstnId.ClrSysMmbId.ClrSysId.Cd":                   "InstructingAgent.PaymentSysCode",
+		"FICdtTrf.CdtTrfTxInf.InstgAgt.FinInstnId.ClrSysMmbId.MmbId":                         "InstructingAgent.PaymentSysMemberId",
+		"FICdtTrf.CdtTrfTxInf.InstdAgt.FinInstnId.ClrSysMmbId.ClrSysId.Cd":                   "InstructedAgent.PaymentSysCode",
+		"FICdtTrf.CdtTrfTxInf.InstdAgt.FinInstnId.ClrSysMmbId.MmbId":                         "InstructedAgent.PaymentSysMemberId",
+		"FICdtTrf.CdtTrfTxInf.IntrmyAgt1.FinInstnId.BICFI":                                   "IntermediaryAgent1.BusinessIdCode",
+		"FICdtTrf.CdtTrfTxInf.IntrmyAgt1.FinInstnId.LEI":                                     "IntermediaryAgent1.LEI",
+		"FICdtTrf.CdtTrfTxInf.IntrmyAgt1.FinInstnId.ClrSysMmbId.ClrSysId.Cd":                 "IntermediaryAgent1.PaymentSysCode",
+		"FICdtTrf.CdtTrfTxInf.IntrmyAgt1.FinInstnId.ClrSysMmbId.MmbId":                       "IntermediaryAgent1.PaymentSysMemberId",
+		"FICdtTrf.CdtTrfTxInf.IntrmyAgt1.FinInstnId.Nm":                                      "IntermediaryAgent1.BankName",
+		"FICdtTrf.CdtTrfTxInf.Dbtr.FinInstnId.BICFI":                                         "Debtor.BusinessIdCode",
+		"FICdtTrf.CdtTrfTxInf.Dbtr.FinInstnId.LEI":                                           "Debtor.LEI",
+		"FICdtTrf.CdtTrfTxInf.Dbtr.FinInstnId.ClrSysMmbId.ClrSysId.Cd":                       "Debtor.PaymentSysCode",
+		"FICdtTrf.CdtTrfTxInf.Dbtr.FinInstnId.ClrSysMmbId.MmbId":                             "Debtor.PaymentSysMemberId",
+		"FICdtTrf.CdtTrfTxInf.Dbtr.FinInstnId.Nm":                                            "Debtor.BankName",
+		"FICdtTrf.CdtTrfTxInf.Dbtr.FinInstnId.PstlAdr.StrtNm":                                "Debtor.PostalAddress.StreetName",
+		"FICdtTrf.CdtTrfTxInf.Dbtr.FinInstnId.PstlAdr.BldgNb":                                "Debtor.PostalAddress.BuildingNumber",
+		"FICdtTrf.CdtTrfTxInf.Dbtr.FinInstnId.PstlAdr.BldgNm":                                "Debtor.PostalAddress.BuildingName",
+		"FICdtTrf.CdtTrfTxInf.Dbtr.FinInstnId.PstlAdr.Flr":                                   "Debtor.PostalAddress.Floor",
+		"FICdtTrf.CdtTrfTxInf.Dbtr.FinInstnId.PstlAdr.Room":                                  "Debtor.PostalAddress.RoomNumber",
+		"FICdtTrf.CdtTrfTxInf.Dbtr.FinInstnId.PstlAdr.PstCd":                                 "Debtor.PostalAddress.PostalCode",
+		"FICdtTrf.CdtTrfTxInf.Dbtr.FinInstnId.PstlAdr.TwnNm":                                 "Debtor.PostalAddress.TownName",
+		"FICdtTrf.CdtTrfTxInf.Dbtr.FinInstnId.PstlAdr.CtrySubDvsn":                           "Debtor.PostalAddress.Subdivision",
+		"FICdtTrf.CdtTrfTxInf.Dbtr.FinInstnId.PstlAdr.Ctry":                                  "Debtor.PostalAddress.Country",
+		"FICdtTrf.CdtTrfTxInf.DbtrAcct.Id.IBAN":                                              "DebtorIBAN",
+		"FICdtTrf.CdtTrfTxInf.DbtrAcct.Id.Othr.Id":                                           "DebtorOtherTypeId",
+		"FICdtTrf.CdtTrfTxInf.DbtrAgt.FinInstnId.BICFI":                                      "DebtorAgent.BusinessIdCode",
+		"FICdtTrf.CdtTrfTxInf.DbtrAgt.FinInstnId.LEI":                                        "DebtorAgent.LEI",
+		"FICdtTrf.CdtTrfTxInf.DbtrAgt.FinInstnId.ClrSysMmbId.ClrSysId.Cd":                    "DebtorAgent.PaymentSysCode",
+		"FICdtTrf.CdtTrfTxInf.DbtrAgt.FinInstnId.ClrSysMmbId.MmbId":                          "DebtorAgent.PaymentSysMemberId",
+		"FICdtTrf.CdtTrfTxInf.DbtrAgt.FinInstnId.Nm":                                         "DebtorAgent.BankName",
+		"FICdtTrf.CdtTrfTxInf.DbtrAgt.FinInstnId.PstlAdr.StrtNm":                             "DebtorAgent.PostalAddress.StreetName",
+		"FICdtTrf.CdtTrfTxInf.DbtrAgt.FinInstnId.PstlAdr.BldgNb":                             "DebtorAgent.PostalAddress.BuildingNumber",
+		"FICdtTrf.CdtTrfTxInf.DbtrAgt.FinInstnId.PstlAdr.BldgNm":                             "DebtorAgent.PostalAddress.BuildingName",
+		"FICdtTrf.CdtTrfTxInf.DbtrAgt.FinInstnId.PstlAdr.Flr":                                "DebtorAgent.PostalAddress.Floor",
+		"FICdtTrf.CdtTrfTxInf.DbtrAgt.FinInstnId.PstlAdr.Room":                               "DebtorAgent.PostalAddress.RoomNumber",
+		"FICdtTrf.CdtTrfTxInf.DbtrAgt.FinInstnId.PstlAdr.PstCd":                              "DebtorAgent.PostalAddress.PostalCode",
+		"FICdtTrf.CdtTrfTxInf.DbtrAgt.FinInstnId.PstlAdr.TwnNm":                              "DebtorAgent.PostalAddress.TownName",
+		"FICdtTrf.CdtTrfTxInf.DbtrAgt.FinInstnId.PstlAdr.CtrySubDvsn":                        "DebtorAgent.PostalAddress.Subdivision",
+		"FICdtTrf.CdtTrfTxInf.DbtrAgt.FinInstnId.PstlAdr.Ctry":                               "DebtorAgent.PostalAddress.Country",
+		"FICdtTrf.CdtTrfTxInf.CdtrAgt.FinInstnId.BICFI":                                      "CreditorAgent.BusinessIdCode",
+		"FICdtTrf.CdtTrfTxInf.CdtrAgt.FinInstnId.LEI":                                        "CreditorAgent.LEI",
+		"FICdtTrf.CdtTrfTxInf.CdtrAgt.FinInstnId.ClrSysMmbId.ClrSysId.Cd":                    "CreditorAgent.PaymentSysCode",
+		"FICdtTrf.CdtTrfTxInf.CdtrAgt.FinInstnId.ClrSysMmbId.MmbId":                          "CreditorAgent.PaymentSysMemberId",
+		"FICdtTrf.CdtTrfTxInf.CdtrAgt.FinInstnId.Nm":                                         "CreditorAgent.BankName",
+		"FICdtTrf.CdtTrfTxInf.CdtrAgt.FinInstnId.PstlAdr.StrtNm":                             "CreditorAgent.PostalAddress.StreetName",
+		"FICdtTrf.CdtTrfTxInf.CdtrAgt.FinInstnId.PstlAdr.BldgNb":                             "CreditorAgent.PostalAddress.BuildingNumber",
+		"FICdtTrf.CdtTrfTxInf.CdtrAgt.FinInstnId.PstlAdr.BldgNm":                             "CreditorAgent.PostalAddress.BuildingName",
+		"FICdtTrf.CdtTrfTxInf.CdtrAgt.FinInstnId.PstlAdr.Flr":                                "CreditorAgent.PostalAddress.Floor",
+		"FICdtTrf.CdtTrfTxInf.CdtrAgt.FinInstnId.PstlAdr.Room":                               "CreditorAgent.PostalAddress.RoomNumber",
+		"FICdtTrf.CdtTrfTxInf.CdtrAgt.FinInstnId.PstlAdr.PstCd":                              "CreditorAgent.PostalAddress.PostalCode",
+		"FICdtTrf.CdtTrfTxInf.CdtrAgt.FinInstnId.PstlAdr.TwnNm":                              "CreditorAgent.PostalAddress.TownName",
+		"FICdtTrf.CdtTrfTxInf.CdtrAgt.FinInstnId.PstlAdr.CtrySubDvsn":                        "CreditorAgent.PostalAddress.Subdivision",
+		"FICdtTrf.CdtTrfTxInf.CdtrAgt.FinInstnId.PstlAdr.Ctry":                               "CreditorAgent.PostalAddress.Country",
+		"FICdtTrf.CdtTrfTxInf.Cdtr.FinInstnId.BICFI":                                         "Creditor.BusinessIdCode",
+		"FICdtTrf.CdtTrfTxInf.Cdtr.FinInstnId.LEI":                                           "Creditor.LEI",
+		"FICdtTrf.CdtTrfTxInf.Cdtr.FinInstnId.ClrSysMmbId.ClrSysId.Cd":                       "Creditor.PaymentSysCode",
+		"FICdtTrf.CdtTrfTxInf.Cdtr.FinInstnId.ClrSysMmbId.MmbId":                             "Creditor.PaymentSysMemberId",
+		"FICdtTrf.CdtTrfTxInf.Cdtr.FinInstnId.Nm":                                            "Creditor.BankName",
+		"FICdtTrf.CdtTrfTxInf.Cdtr.FinInstnId.PstlAdr.StrtNm":                                "Creditor.PostalAddress.StreetName",
+		"FICdtTrf.CdtTrfTxInf.Cdtr.FinInstnId.PstlAdr.BldgNb":                                "Creditor.PostalAddress.BuildingNumber",
+		"FICdtTrf.CdtTrfTxInf.Cdtr.FinInstnId.PstlAdr.BldgNm":                                "Creditor.PostalAddress.BuildingName",
+		"FICdtTrf.CdtTrfTxInf.Cdtr.FinInstnId.PstlAdr.Flr":                                   "Creditor.PostalAddress.Floor",
+		"FICdtTrf.CdtTrfTxInf.Cdtr.FinInstnId.PstlAdr.Room":                                  "Creditor.PostalAddress.RoomNumber",
+		"FICdtTrf.CdtTrfTxInf.Cdtr.FinInstnId.PstlAdr.PstCd":                                 "Creditor.PostalAddress.PostalCode",
+		"FICdtTrf.CdtTrfTxInf.Cdtr.FinInstnId.PstlAdr.TwnNm":                                 "Creditor.PostalAddress.TownName",
+		"FICdtTrf.CdtTrfTxInf.Cdtr.FinInstnId.PstlAdr.CtrySubDvsn":                           "Creditor.PostalAddress.Subdivision",
+		"FICdtTrf.CdtTrfTxInf.Cdtr.FinInstnId.PstlAdr.Ctry":                                  "Creditor.PostalAddress.Country",
+		"FICdtTrf.CdtTrfTxInf.CdtrAcct.Id.IBAN":                                              "CreditorIBAN",
+		"FICdtTrf.CdtTrfTxInf.CdtrAcct.Id.Othr.Id":                                           "CreditorOtherTypeId",
+		"FICdtTrf.CdtTrfTxInf.Purp.Cd":                                                       "PurposeOfPayment",
+		"FICdtTrf.CdtTrfTxInf.RmtInf.Ustrd":                                                  "RemittanceInfo",
+		"FICdtTrf.CdtTrfTxInf.UndrlygCstmrCdtTrf.Dbtr.Nm":                                    "UnderlyingCustomerCreditTransfer.DebtorName",
+		"FICdtTrf.CdtTrfTxInf.UndrlygCstmrCdtTrf.Dbtr.Id.OrgId.LEI":                          "UnderlyingCustomerCreditTransfer.DebtorLEI",
+		"FICdtTrf.CdtTrfTxInf.UndrlygCstmrCdtTrf.Dbtr.PstlAdr.StrtNm":                        "UnderlyingCustomerCreditTransfer.DebtorAddress.StreetName",
+		"FICdtTrf.CdtTrfTxInf.UndrlygCstmrCdtTrf.Dbtr.PstlAdr.BldgNb":                        "UnderlyingCustomerCreditTransfer.DebtorAddress.BuildingNumber",
+		"FICdtTrf.CdtTrfTxInf.UndrlygCstmrCdtTrf.Dbtr.PstlAdr.BldgNm":                        "UnderlyingCustomerCreditTransfer.DebtorAddress.BuildingName",
+		"FICdtTrf.CdtTrfTxInf.UndrlygCstmrCdtTrf.Dbtr.PstlAdr.Flr":                           "UnderlyingCustomerCreditTransfer.DebtorAddress.Floor",
+		"FICdtTrf.CdtTrfTxInf.UndrlygCstmrCdtTrf.Dbtr.PstlAdr.Room":                          "UnderlyingCustomerCreditTransfer.DebtorAddress.RoomNumber",
+		"FICdtTrf.CdtTrfTxInf.UndrlygCstmrCdtTrf.Dbtr.PstlAdr.PstCd":                         "UnderlyingCustomerCreditTransfer.DebtorAddress.PostalCode",
+		"FICdtTrf.CdtTrfTxInf.UndrlygCstmrCdtTrf.Dbtr.PstlAdr.TwnNm":                         "UnderlyingCustomerCreditTransfer.DebtorAddress.TownName",
+		"FICdtTrf.CdtTrfTxInf.UndrlygCstmrCdtTrf.Dbtr.PstlAdr.CtrySubDvsn":                   "UnderlyingCustomerCreditTransfer.DebtorAddress.Subdivision",
+		"FICdtTrf.CdtTrfTxInf.UndrlygCstmrCdtTrf.Dbtr.PstlAdr.Ctry":                          "UnderlyingCustomerCreditTransfer.DebtorAddress.Country",
+		"FICdtTrf.CdtTrfTxInf.UndrlygCstmrCdtTrf.DbtrAcct.Id.IBAN":                           "UnderlyingCustomerCreditTransfer.DebtorIBAN",
+		"FICdtTrf.CdtTrfTxInf.UndrlygCstmrCdtTrf.DbtrAcct.Id.Othr.Id":                        "UnderlyingCustomerCreditTransfer.DebtorOtherTypeId",
+		"FICdtTrf.CdtTrfTxInf.UndrlygCstmrCdtTrf.DbtrAgt.FinInstnId.BICFI":                   "UnderlyingCustomerCreditTransfer.DebtorAgent.BusinessIdCode",
+		"FICdtTrf.CdtTrfTxInf.UndrlygCstmrCdtTrf.DbtrAgt.FinInstnId.LEI":                     "UnderlyingCustomerCreditTransfer.DebtorAgent.LEI",
+		"FICdtTrf.CdtTrfTxInf.UndrlygCstmrCdtTrf.DbtrAgt.FinInstnId.ClrSysMmbId.ClrSysId.Cd": "UnderlyingCustomerCreditTransfer.DebtorAgent.PaymentSysCode",
+		"FICdtTrf.CdtTrfTxInf.UndrlygCstmrCdtTrf.DbtrAgt.FinInstnId.ClrSysMmbId.MmbId":       "UnderlyingCustomerCreditTransfer.DebtorAgent.PaymentSysMemberId",
+		"FICdtTrf.CdtTrfTxInf.UndrlygCstmrCdtTrf.DbtrAgt.FinInstnId.Nm":                      "UnderlyingCustomerCreditTransfer.DebtorAgent.BankName",
+		"FICdtTrf.CdtTrfTxInf.UndrlygCstmrCdtTrf.DbtrAgt.FinInstnId.PstlAdr.StrtNm":          "UnderlyingCustomerCreditTransfer.DebtorAgent.PostalAddress.StreetName",
+		"FICdtTrf.CdtTrfTxInf.UndrlygCstmrCdtTrf.DbtrAgt.FinInstnId.PstlAdr.BldgNb":          "UnderlyingCustomerCreditTransfer.DebtorAgent.PostalAddress.BuildingNumber",
+		"FICdtTrf.CdtTrfTxInf.UndrlygCstmrCdtTrf.DbtrAgt.FinInstnId.PstlAdr.BldgNm":          "UnderlyingCustomerCreditTransfer.DebtorAgent.PostalAddress.BuildingName",
+		"FICdtTrf.CdtTrfTxInf.UndrlygCstmrCdtTrf.DbtrAgt.FinInstnId.PstlAdr.Flr":             "UnderlyingCustomerCreditTransfer.DebtorAgent.PostalAddress.Floor",
+		"FICdtTrf.CdtTrfTxInf.UndrlygCstmrCdtTrf.DbtrAgt.FinInstnId.PstlAdr.Room":            "UnderlyingCustomerCreditTransfer.DebtorAgent.PostalAddress.RoomNumber",
+		"FICdtTrf.CdtTrfTxInf.UndrlygCstmrCdtTrf.DbtrAgt.FinInstnId.PstlAdr.PstCd":           "UnderlyingCustomerCreditTransfer.DebtorAgent.PostalAddress.PostalCode",
+		"FICdtTrf.CdtTrfTxInf.UndrlygCstmrCdtTrf.DbtrAgt.FinInstnId.PstlAdr.TwnNm":           "UnderlyingCustomerCreditTransfer.DebtorAgent.PostalAddress.TownName",
+		"FICdtTrf.CdtTrfTxInf.UndrlygCstmrCdtTrf.DbtrAgt.FinInstnId.PstlAdr.CtrySubDvsn":     "UnderlyingCustomerCreditTransfer.DebtorAgent.PostalAddress.Subdivision",
+		"FICdtTrf.CdtTrfTxInf.UndrlygCstmrCdtTrf.DbtrAgt.FinInstnId.PstlAdr.Ctry":            "UnderlyingCustomerCreditTransfer.DebtorAgent.PostalAddress.Country",
+		"FICdtTrf.CdtTrfTxInf.UndrlygCstmrCdtTrf.CdtrAgt.FinInstnId.BICFI":                   "UnderlyingCustomerCreditTransfer.CreditorAgent.BusinessIdCode",
+		"FICdtTrf.CdtTrfTxInf.UndrlygCstmrCdtTrf.CdtrAgt.FinInstnId.LEI":                     "UnderlyingCustomerCreditTransfer.CreditorAgent.LEI",
+		"FICdtTrf.CdtTrfTxInf.UndrlygCstmrCdtTrf.CdtrAgt.FinInstnId.ClrSysMmbId.ClrSysId.Cd": "UnderlyingCustomerCreditTransfer.CreditorAgent.PaymentSysCode",
+		"FICdtTrf.CdtTrfTxInf.UndrlygCstmrCdtTrf.CdtrAgt.FinInstnId.ClrSysMmbId.MmbId":       "UnderlyingCustomerCreditTransfer.CreditorAgent.PaymentSysMemberId",
+		"FICdtTrf.CdtTrfTxInf.UndrlygCstmrCdtTrf.CdtrAgt.FinInstnId.Nm":                      "UnderlyingCustomerCreditTransfer.CreditorAgent.BankName",
+		"FICdtTrf.CdtTrfTxInf.UndrlygCstmrCdtTrf.CdtrAgt.FinInstnId.PstlAdr.StrtNm":          "UnderlyingCustomerCreditTransfer.CreditorAgent.PostalAddress.StreetName",
+		"FICdtTrf.CdtTrfTxInf.UndrlygCstmrCdtTrf.CdtrAgt.FinInstnId.PstlAdr.BldgNb":          "UnderlyingCustomerCreditTransfer.CreditorAgent.PostalAddress.BuildingNumber",
+		"FICdtTrf.CdtTrfTxInf.UndrlygCstmrCdtTrf.CdtrAgt.FinInstnId.PstlAdr.BldgNm":          "UnderlyingCustomerCreditTransfer.CreditorAgent.PostalAddress.BuildingName",
+		"FICdtTrf.CdtTrfTxInf.UndrlygCstmrCdtTrf.CdtrAgt.FinInstnId.PstlAdr.Flr":             "UnderlyingCustomerCreditTransfer.CreditorAgent.PostalAddress.Floor",
+		"FICdtTrf.CdtTrfTxInf.UndrlygCstmrCdtTrf.CdtrAgt.FinInstnId.PstlAdr.Room":            "UnderlyingCustomerCreditTransfer.CreditorAgent.PostalAddress.RoomNumber",
+		"FICdtTrf.CdtTrfTxInf.UndrlygCstmrCdtTrf.CdtrAgt.FinInstnId.PstlAdr.PstCd":           "UnderlyingCustomerCreditTransfer.CreditorAgent.PostalAddress.PostalCode",
+		"FICdtTrf.CdtTrfTxInf.UndrlygCstmrCdtTrf.CdtrAgt.FinInstnId.PstlAdr.TwnNm":           "UnderlyingCustomerCreditTransfer.CreditorAgent.PostalAddress.TownName",
+		"FICdtTrf.CdtTrfTxInf.UndrlygCstmrCdtTrf.CdtrAgt.FinInstnId.PstlAdr.CtrySubDvsn":     "UnderlyingCustomerCreditTransfer.CreditorAgent.PostalAddress.Subdivision",
+		"FICdtTrf.CdtTrfTxInf.UndrlygCstmrCdtTrf.CdtrAgt.FinInstnId.PstlAdr.Ctry":            "UnderlyingCustomerCreditTransfer.CreditorAgent.PostalAddress.Country",
+		"FICdtTrf.CdtTrfTxInf.UndrlygCstmrCdtTrf.Cdtr.Nm":                                    "UnderlyingCustomerCreditTransfer.CreditorName",
+		"FICdtTrf.CdtTrfTxInf.UndrlygCstmrCdtTrf.Cdtr.Id.OrgId.LEI":                          "UnderlyingCustomerCreditTransfer.CreditorLEI",
+		"FICdtTrf.CdtTrfTxInf.UndrlygCstmrCdtTrf.Cdtr.PstlAdr.StrtNm":                        "UnderlyingCustomerCreditTransfer.CreditorAddress.StreetName",
+		"FICdtTrf.CdtTrfTxInf.UndrlygCstmrCdtTrf.Cdtr.PstlAdr.BldgNb":                        "UnderlyingCustomerCreditTransfer.CreditorAddress.BuildingNumber",
+		"FICdtTrf.CdtTrfTxInf.UndrlygCstmrCdtTrf.Cdtr.PstlAdr.BldgNm":                        "UnderlyingCustomerCreditTransfer.CreditorAddress.BuildingName",
+		"FICdtTrf.CdtTrfTxInf.UndrlygCstmrCdtTrf.Cdtr.PstlAdr.Flr":                           "UnderlyingCustomerCreditTransfer.CreditorAddress.Floor",
+		"FICdtTrf.CdtTrfTxInf.UndrlygCstmrCdtTrf.Cdtr.PstlAdr.Room":                          "UnderlyingCustomerCreditTransfer.CreditorAddress.RoomNumber",
+		"FICdtTrf.CdtTrfTxInf.UndrlygCstmrCdtTrf.Cdtr.PstlAdr.PstCd":                         "UnderlyingCustomerCreditTransfer.CreditorAddress.PostalCode",
+		"FICdtTrf.CdtTrfTxInf.UndrlygCstmrCdtTrf.Cdtr.PstlAdr.TwnNm":                         "UnderlyingCustomerCreditTransfer.CreditorAddress.TownName",
+		"FICdtTrf.CdtTrfTxInf.UndrlygCstmrCdtTrf.Cdtr.PstlAdr.CtrySubDvsn":                   "UnderlyingCustomerCreditTransfer.CreditorAddress.Subdivision",
+		"FICdtTrf.CdtTrfTxInf.UndrlygCstmrCdtTrf.Cdtr.PstlAdr.Ctry":                          "UnderlyingCustomerCreditTransfer.CreditorAddress.Country",
+		"FICdtTrf.CdtTrfTxInf.UndrlygCstmrCdtTrf.CdtrAcct.Id.IBAN":                           "UnderlyingCustomerCreditTransfer.CreditorIBAN",
+		"FICdtTrf.CdtTrfTxInf.UndrlygCstmrCdtTrf.CdtrAcct.Id.Othr.Id":                        "UnderlyingCustomerCreditTransfer.CreditorOtherTypeId",
+		"FICdtTrf.CdtTrfTxInf.UndrlygCstmrCdtTrf.RmtInf.Ustrd":                               "UnderlyingCustomerCreditTransfer.RemittanceInfo",
+		"FICdtTrf.CdtTrfTxInf.UndrlygCstmrCdtTrf.InstdAmt.Value":                             "UnderlyingCustomerCreditTransfer.InstructedAmount.Amount",
+		"FICdtTrf.CdtTrfTxInf.UndrlygCstmrCdtTrf.InstdAmt.Ccy":                               "UnderlyingCustomerCreditTransfer.InstructedAmount.Currency",
+	}
+}
@@ -0,0 +1,17 @@
+package FICreditTransfer
+
+type PACS_009_001_VERSION string
+
+const (
+	PACS_009_001_08 PACS_009_001_VERSION = "pacs.009.001.08"
+)
+
+var VersionNameSpaceMap = map[PACS_009_001_VERSION]string{
+	PACS_009_001_08: "urn:iso:std:iso:20022:tech:xsd:pacs.009.001.08",
+}
+var NameSpaceVersionMap = map[string]PACS_009_001_VERSION{
+	"urn:iso:std:iso:20022:tech:xsd:pacs.009.001.08": PACS_009_001_08,
+}
+var VersionPathMap = map[PACS_009_001_VERSION]map[string]any{
+	PACS_009_001_08: pathMapV8(),
+}
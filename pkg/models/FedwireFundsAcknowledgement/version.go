@@ -3,7 +3,7 @@ package FedwireFundsAcknowledgement
 type ADMI_007_001_VERSION string
 
 const (
-	ADMI_007_001_01 ADMI_007_001_VERSION = "ADMI.007.001.01"
+	ADMI_007_001_01 ADMI_007_001_VERSION = "admi.007.001.01"
 )
 
 var VersionNameSpaceMap = map[ADMI_007_001_VERSION]string{
@@ -3,18 +3,18 @@ package FedwireFundsPaymentStatus
 type PACS_002_001_VERSION string
 
 const (
-	PACS_002_001_03 PACS_002_001_VERSION = "PACS.002.001.03"
-	PACS_002_001_04 PACS_002_001_VERSION = "PACS.002.001.04"
-	PACS_002_001_05 PACS_002_001_VERSION = "PACS.002.001.05"
-	PACS_002_001_06 PACS_002_001_VERSION = "PACS.002.001.06"
-	PACS_002_001_07 PACS_002_001_VERSION = "PACS.002.001.07"
-	PACS_002_001_08 PACS_002_001_VERSION = "PACS.002.001.08"
-	PACS_002_001_09 PACS_002_001_VERSION = "PACS.002.001.09"
-	PACS_002_001_10 PACS_002_001_VERSION = "PACS.002.001.10"
-	PACS_002_001_11 PACS_002_001_VERSION = "PACS.002.001.11"
-	PACS_002_001_12 PACS_002_001_VERSION = "PACS.002.001.12"
-	PACS_002_001_13 PACS_002_001_VERSION = "PACS.002.001.13"
-	PACS_002_001_14 PACS_002_001_VERSION = "PACS.002.001.14"
+	PACS_002_001_03 PACS_002_001_VERSION = "pacs.002.001.03"
+	PACS_002_001_04 PACS_002_001_VERSION = "pacs.002.001.04"
+	PACS_002_001_05 PACS_002_001_VERSION = "pacs.002.001.05"
+	PACS_002_001_06 PACS_002_001_VERSION = "pacs.002.001.06"
+	PACS_002_001_07 PACS_002_001_VERSION = "pacs.002.001.07"
+	PACS_002_001_08 PACS_002_001_VERSION = "pacs.002.001.08"
+	PACS_002_001_09 PACS_002_001_VERSION = "pacs.002.001.09"
+	PACS_002_001_10 PACS_002_001_VERSION = "pacs.002.001.10"
+	PACS_002_001_11 PACS_002_001_VERSION = "pacs.002.001.11"
+	PACS_002_001_12 PACS_002_001_VERSION = "pacs.002.001.12"
+	PACS_002_001_13 PACS_002_001_VERSION = "pacs.002.001.13"
+	PACS_002_001_14 PACS_002_001_VERSION = "pacs.002.001.14"
 )
 
 var VersionNameSpaceMap = map[PACS_002_001_VERSION]string{
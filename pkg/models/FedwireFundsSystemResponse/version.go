@@ -3,7 +3,7 @@ package FedwireFundsSystemResponse
 type ADMI_011_001_VERSION string
 
 const (
-	ADMI_011_001_01 ADMI_011_001_VERSION = "ADMI.011.001.01"
+	ADMI_011_001_01 ADMI_011_001_VERSION = "admi.011.001.01"
 )
 
 var VersionNameSpaceMap = map[ADMI_011_001_VERSION]string{
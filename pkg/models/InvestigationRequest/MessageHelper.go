@@ -0,0 +1,139 @@
+package InvestigationRequest
+
+import "github.com/moov-io/wire20022/pkg/models"
+
+type InvestigationReasonHelper struct {
+	Reason    models.ElementHelper
+	SubType   models.ElementHelper
+	Narrative models.ElementHelper
+}
+
+func BuildInvestigationReasonHelper() InvestigationReasonHelper {
+	return InvestigationReasonHelper{
+		Reason: models.ElementHelper{
+			Title:         "Reason",
+			Rules:         "",
+			Type:          `ExternalInvestigationReason1Code (based on string) minLength: 1 maxLength: 4`,
+			Documentation: `Specifies the reason for the investigation.`,
+		},
+		SubType: models.ElementHelper{
+			Title:         "Sub Type",
+			Rules:         "",
+			Type:          `ExternalInvestigationReasonSubType1Code (based on string) minLength: 1 maxLength: 4`,
+			Documentation: `Further specifies the reason for the investigation.`,
+		},
+		Narrative: models.ElementHelper{
+			Title:         "Narrative",
+			Rules:         "",
+			Type:          `Max500Text (based on string) minLength: 1 maxLength: 500`,
+			Documentation: `Free-form details of the information requested by the investigation.`,
+		},
+	}
+}
+
+type MessageHelper struct {
+	MessageId                         models.ElementHelper
+	RequestorInvestigationId          models.ElementHelper
+	ResponderInvestigationId          models.ElementHelper
+	InvestigationType                 models.ElementHelper
+	InvestigationSubType              models.ElementHelper
+	OriginalMessageId                 models.ElementHelper
+	OriginalMessageNameId             models.ElementHelper
+	OriginalMessageCreateTime         models.ElementHelper
+	OriginalInstructionId             models.ElementHelper
+	OriginalEndToEndId                models.ElementHelper
+	OriginalTransactionId             models.ElementHelper
+	OriginalUETR                      models.ElementHelper
+	OriginalInterbankSettlementAmount models.CurrencyAndAmountHelper
+	OriginalInterbankSettlementDate   models.ElementHelper
+	Requestor                         models.AgentHelper
+	Responder                         models.AgentHelper
+	InvestigationData                 InvestigationReasonHelper
+}
+
+func BuildMessageHelper() MessageHelper {
+	return MessageHelper{
+		MessageId: models.ElementHelper{
+			Title:         "Message Id",
+			Rules:         "",
+			Type:          `IMADFedwireFunds1 (based on string) exactLength: 22 pattern: [0-9]{8}[A-Z0-9]{8}[0-9]{6}`,
+			Documentation: `Input message accountability data of the investigation request.`,
+		},
+		RequestorInvestigationId: models.ElementHelper{
+			Title:         "Requestor Investigation Id",
+			Rules:         "",
+			Type:          `Max35Text (based on string) minLength: 1 maxLength: 35`,
+			Documentation: `Identification of the investigation, as assigned by the requestor.`,
+		},
+		ResponderInvestigationId: models.ElementHelper{
+			Title:         "Responder Investigation Id",
+			Rules:         "",
+			Type:          `Max35Text (based on string) minLength: 1 maxLength: 35`,
+			Documentation: `Identification of the investigation, as assigned by the responder.`,
+		},
+		InvestigationType: models.ElementHelper{
+			Title:         "Investigation Type",
+			Rules:         "",
+			Type:          `ExternalInvestigationType1Code (based on string) minLength: 1 maxLength: 4`,
+			Documentation: `Type of investigation, for example UTAP (unable to apply) or RQFI (request for information).`,
+		},
+		InvestigationSubType: models.ElementHelper{
+			Title:         "Investigation Sub Type",
+			Rules:         "",
+			Type:          `ExternalInvestigationSubType1Code (based on string) minLength: 1 maxLength: 4`,
+			Documentation: `Further specifies the type of investigation.`,
+		},
+		OriginalMessageId: models.ElementHelper{
+			Title:         "Original Message Id",
+			Rules:         "",
+			Type:          `Max35Text (based on string) minLength: 1 maxLength: 35`,
+			Documentation: `Point to point reference assigned by the original instructing party to unambiguously identify the original message.`,
+		},
+		OriginalMessageNameId: models.ElementHelper{
+			Title:         "Original Message Name Id",
+			Rules:         "",
+			Type:          `MessageNameIdentificationFRS1 (based on string) exactLength: 15`,
+			Documentation: `Specifies the original message name identifier to which the message refers, for example, pacs.008.001.08.`,
+		},
+		OriginalMessageCreateTime: models.ElementHelper{
+			Title:         "Original Message Create Time",
+			Rules:         "",
+			Type:          `ISODateTime`,
+			Documentation: `Original date and time at which the message was created.`,
+		},
+		OriginalInstructionId: models.ElementHelper{
+			Title:         "Original Instruction Id",
+			Rules:         "",
+			Type:          `Max35Text (based on string) minLength: 1 maxLength: 35`,
+			Documentation: `Unique identification, as assigned by the original instructing party for the original instructed party, to unambiguously identify the original instruction.`,
+		},
+		OriginalEndToEndId: models.ElementHelper{
+			Title:         "Original End To End Id",
+			Rules:         "",
+			Type:          `Max35Text (based on string) minLength: 1 maxLength: 35`,
+			Documentation: `Unique identification, as assigned by the original initiating party, to unambiguously identify the original transaction.`,
+		},
+		OriginalTransactionId: models.ElementHelper{
+			Title:         "Original Transaction Id",
+			Rules:         "",
+			Type:          `Max35Text (based on string) minLength: 1 maxLength: 35`,
+			Documentation: `Unique identification, as assigned by the original first instructing agent, to unambiguously identify the transaction.`,
+		},
+		OriginalUETR: models.ElementHelper{
+			Title:         "Original UETR",
+			Rules:         "",
+			Type:          `UUIDv4 (based on string)`,
+			Documentation: `Universally unique identifier to provide the original end-to-end reference of a payment transaction.`,
+		},
+		OriginalInterbankSettlementAmount: models.BuildCurrencyAndAmountHelper(),
+		OriginalInterbankSettlementDate: models.ElementHelper{
+			Title:         "Original Interbank Settlement Date",
+			Rules:         "",
+			Type:          `ISODate (based on string)`,
+			Documentation: `Date, as provided in the original transaction, on which the amount of money ceases to be available to the agent that owes it and when the amount of money becomes available to the agent to which it is due.`,
+		},
+		Requestor:         models.BuildAgentHelper(),
+		Responder:         models.BuildAgentHelper(),
+		InvestigationData: BuildInvestigationReasonHelper(),
+	}
+}
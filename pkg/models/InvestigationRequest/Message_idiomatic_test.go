@@ -0,0 +1,66 @@
+package InvestigationRequest
+
+import (
+	"bytes"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestReadXML tests the idiomatic ReadXML method
+func TestReadXML(t *testing.T) {
+	xmlFile, err := os.Open("./swiftSample/Investigations_Scenario1_Step2_camt.110")
+	require.NoError(t, err)
+	defer xmlFile.Close()
+
+	var model MessageModel
+	err = model.ReadXML(xmlFile)
+	assert.NoError(t, err)
+
+	assert.Equal(t, "20250310QMGFNP31000001", model.MessageId)
+	assert.Equal(t, "8a562c67-ca16-48ba-b074-65581be6f011", model.OriginalUETR)
+	assert.Equal(t, "AC03", model.InvestigationData[0].Reason)
+}
+
+// TestWriteXML tests the idiomatic WriteXML method
+func TestWriteXML(t *testing.T) {
+	model := InvestigationRequestDataModel()
+
+	var buf bytes.Buffer
+	err := model.WriteXML(&buf)
+	require.NoError(t, err)
+
+	xmlOutput := buf.String()
+	assert.Contains(t, xmlOutput, "<?xml version=\"1.0\" encoding=\"UTF-8\"?>")
+	assert.Contains(t, xmlOutput, "camt.110.001.01")
+	assert.Contains(t, xmlOutput, "InvstgtnReq")
+	assert.Contains(t, xmlOutput, model.MessageId)
+
+	var parsedModel MessageModel
+	err = parsedModel.ReadXML(strings.NewReader(xmlOutput))
+	require.NoError(t, err)
+	assert.Equal(t, model.MessageId, parsedModel.MessageId)
+	assert.Equal(t, model.OriginalUETR, parsedModel.OriginalUETR)
+	assert.Equal(t, model.InvestigationData, parsedModel.InvestigationData)
+}
+
+// TestWriteXMLWithInvalidModel tests that required fields are enforced before writing
+func TestWriteXMLWithInvalidModel(t *testing.T) {
+	model := MessageModel{}
+
+	var buf bytes.Buffer
+	err := model.WriteXML(&buf)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "creating document")
+	assert.Empty(t, buf.String())
+}
+
+// TestReadXMLWithErrors tests that malformed input is rejected
+func TestReadXMLWithErrors(t *testing.T) {
+	var model MessageModel
+	err := model.ReadXML(strings.NewReader("<invalid>xml"))
+	assert.Error(t, err)
+}
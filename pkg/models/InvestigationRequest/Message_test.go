@@ -0,0 +1,106 @@
+package InvestigationRequest
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/moov-io/wire20022/pkg/models"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDocumentToModel01(t *testing.T) {
+	var sampleXML = filepath.Join("swiftSample", "Investigations_Scenario1_Step2_camt.110")
+	var xmlData, err = models.ReadXMLFile(sampleXML)
+	require.NoError(t, err, "Failed to read XML file")
+
+	model, err := ParseXML(xmlData)
+	if err != nil {
+		t.Fatal(err)
+	}
+	require.NoError(t, err, "Failed to make XML structure")
+	require.Equal(t, model.MessageId, "20250310QMGFNP31000001")
+	require.Equal(t, model.RequestorInvestigationId, "Scenario01InvstgtnId001")
+	require.Equal(t, model.InvestigationType, models.InvestigationUnableToApply)
+	require.Equal(t, model.OriginalMessageId, "20250310B1QDRCQR000001")
+	require.Equal(t, model.OriginalMessageNameId, "pacs.008.001.08")
+	require.NotNil(t, model.OriginalMessageCreateTime)
+	require.Equal(t, model.OriginalInstructionId, "Scenario01InstrId001")
+	require.Equal(t, model.OriginalEndToEndId, "Scenario01EtoEId001")
+	require.Equal(t, model.OriginalUETR, "8a562c67-ca16-48ba-b074-65581be6f011")
+	require.Equal(t, model.OriginalInterbankSettlementAmount.Amount, models.Decimal("510000.74"))
+	require.Equal(t, model.OriginalInterbankSettlementAmount.Currency, "USD")
+	require.Equal(t, model.Requestor.PaymentSysCode, models.PaymentSysUSABA)
+	require.Equal(t, model.Requestor.PaymentSysMemberId, "021040078")
+	require.Equal(t, model.Responder.PaymentSysCode, models.PaymentSysUSABA)
+	require.Equal(t, model.Responder.PaymentSysMemberId, "011104238")
+	require.Len(t, model.InvestigationData, 1)
+	require.Equal(t, model.InvestigationData[0].Reason, "AC03")
+	require.Equal(t, model.InvestigationData[0].Narrative, "Creditor account number does not exist at the creditor agent. Please provide the correct account number.")
+}
+
+func TestDocumentToModel01Scenario2(t *testing.T) {
+	var sampleXML = filepath.Join("swiftSample", "Investigations_Scenario2_Step2_camt.110")
+	var xmlData, err = models.ReadXMLFile(sampleXML)
+	require.NoError(t, err, "Failed to read XML file")
+
+	model, err := ParseXML(xmlData)
+	require.NoError(t, err, "Failed to make XML structure")
+	require.Equal(t, model.MessageId, "20250310QMGFNP31000002")
+	require.Equal(t, model.InvestigationType, models.InvestigationRequestForInformation)
+	require.Equal(t, model.OriginalMessageId, "20250310B1QDRCQR000002")
+	require.Equal(t, model.InvestigationData[0].Reason, "NARR")
+
+	sampleXML = filepath.Join("swiftSample", "Investigations_Scenario3_Step2_camt.110")
+	xmlData, err = models.ReadXMLFile(sampleXML)
+	require.NoError(t, err, "Failed to read XML file")
+
+	model, err = ParseXML(xmlData)
+	require.NoError(t, err, "Failed to make XML structure")
+	require.Equal(t, model.InvestigationType, models.InvestigationValueDateAdjustment)
+	require.Equal(t, model.OriginalInstructionId, "Variation2InstrId001")
+	require.Equal(t, model.Requestor.PaymentSysMemberId, "011104238")
+	require.Equal(t, model.Responder.PaymentSysMemberId, "021040078")
+}
+
+// Test helper functions for better coverage
+func TestHelperFunctions(t *testing.T) {
+	t.Run("NewMessageForVersion", func(t *testing.T) {
+		model := NewMessageForVersion(CAMT_110_001_01)
+		require.NotNil(t, model)
+		// Basic model should have zero values
+		require.Empty(t, model.MessageId)
+	})
+
+	t.Run("ValidateForVersion", func(t *testing.T) {
+		model := MessageModel{}
+		err := model.ValidateForVersion(CAMT_110_001_01)
+		require.Error(t, err) // Should fail validation with empty model
+		require.Contains(t, err.Error(), "MessageId")
+
+		model = InvestigationRequestDataModel()
+		require.NoError(t, model.ValidateForVersion(CAMT_110_001_01))
+
+		model.InvestigationData[0].Reason = ""
+		err = model.ValidateForVersion(CAMT_110_001_01)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "InvestigationData[0].Reason")
+	})
+
+	t.Run("GetVersionCapabilities", func(t *testing.T) {
+		model := MessageModel{}
+		capabilities := model.GetVersionCapabilities()
+		require.Empty(t, capabilities) // InvestigationRequest has no version-specific features
+	})
+
+	t.Run("CheckRequiredFields", func(t *testing.T) {
+		model := MessageModel{}
+		err := CheckRequiredFields(model)
+		require.Error(t, err) // Should fail with empty model
+	})
+
+	t.Run("BuildMessageHelper", func(t *testing.T) {
+		helper := BuildMessageHelper()
+		require.Equal(t, "Investigation Type", helper.InvestigationType.Title)
+		require.Equal(t, "Narrative", helper.InvestigationData.Narrative.Title)
+	})
+}
@@ -0,0 +1,124 @@
+package InvestigationRequest
+
+import (
+	"encoding/xml"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/civil"
+	"github.com/moov-io/fedwire20022/pkg/fedwire"
+	"github.com/moov-io/wire20022/pkg/models"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVersion01(t *testing.T) {
+	modelName := CAMT_110_001_01
+	xmlName := "InvestigationRequest_01.xml"
+
+	dataModel := InvestigationRequestDataModel()
+	/*Create Document from Model*/
+	var doc01, err = DocumentWith(dataModel, modelName)
+	require.NoError(t, err, "Failed to create document")
+	/*Validate Check for created Document*/
+	vErr := doc01.Validate()
+	require.NoError(t, vErr, "Failed to validate document")
+	/*Create XML file from Document*/
+	xmlData, err := xml.MarshalIndent(doc01, "", "  ")
+	require.NoError(t, err)
+	err = models.WriteXMLToGenerate(xmlName, xmlData)
+	require.NoError(t, err)
+
+	/*Create Date Model from XML (Read XML)*/
+	var xmlDoc, xmlErr = models.ReadXMLFile("./generated/" + xmlName)
+	require.NoError(t, xmlErr, "Failed to read XML file")
+
+	/*Compare*/
+	model, err := ParseXML(xmlDoc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	require.NoError(t, err, "Failed to make XML structure")
+	require.Equal(t, model.MessageId, "20250310QMGFNP31000001")
+	require.Equal(t, model.RequestorInvestigationId, "Scenario01InvstgtnId001")
+	require.Equal(t, model.InvestigationType, models.InvestigationUnableToApply)
+	require.Equal(t, model.OriginalMessageId, "20250310B1QDRCQR000001")
+	require.Equal(t, model.OriginalMessageNameId, "pacs.008.001.08")
+	require.NotNil(t, model.OriginalMessageCreateTime)
+	require.Equal(t, model.OriginalInstructionId, "Scenario01InstrId001")
+	require.Equal(t, model.OriginalEndToEndId, "Scenario01EtoEId001")
+	require.Equal(t, model.OriginalUETR, "8a562c67-ca16-48ba-b074-65581be6f011")
+	require.Equal(t, model.OriginalInterbankSettlementAmount.Amount, models.Decimal("510000.74"))
+	require.Equal(t, model.OriginalInterbankSettlementAmount.Currency, "USD")
+	require.Equal(t, model.OriginalInterbankSettlementDate, fedwire.ISODate(civil.Date{Year: 2025, Month: 3, Day: 10}))
+	require.Equal(t, model.Requestor.PaymentSysCode, models.PaymentSysUSABA)
+	require.Equal(t, model.Requestor.PaymentSysMemberId, "021040078")
+	require.Equal(t, model.Responder.PaymentSysCode, models.PaymentSysUSABA)
+	require.Equal(t, model.Responder.PaymentSysMemberId, "011104238")
+	require.Len(t, model.InvestigationData, 2)
+	require.Equal(t, model.InvestigationData[0].Reason, "AC03")
+	require.Equal(t, model.InvestigationData[0].Narrative, "Creditor account number does not exist at the creditor agent.")
+	require.Equal(t, model.InvestigationData[1].Reason, "BE04")
+	require.Equal(t, model.InvestigationData[1].SubType, "ADDR")
+
+	/*Validation check*/
+	model.MessageId = "InvalideMessageIdLength5012345678901234567890"
+	_, err = DocumentWith(*model, modelName)
+	require.NotNil(t, err, "Expected error but got nil")
+	require.Equal(t, err.Error(), "field copy InvstgtnReq.InvstgtnReq.MsgId failed: failed to set MessageId: InvalideMessageIdLength5012345678901234567890 fails validation with length 45 <= required maxLength 22")
+	model.MessageId = "20250310QMGFNP31000001"
+
+	model.InvestigationType = "UNABLE"
+	_, err = DocumentWith(*model, modelName)
+	require.NotNil(t, err, "Expected error but got nil")
+	require.Equal(t, err.Error(), "field copy InvstgtnReq.InvstgtnReq.InvstgtnTp.Cd failed: failed to set InvestigationType: UNABLE fails validation with length 6 <= required maxLength 4")
+	model.InvestigationType = models.InvestigationUnableToApply
+
+	/*Require field check*/
+	model.OriginalUETR = ""
+	_, err = DocumentWith(*model, modelName)
+	require.NotNil(t, err, "Expected error but got nil")
+	require.Equal(t, err.Error(), "validation failed for field \"OriginalUETR\": is required: required field missing")
+	model.OriginalUETR = "8a562c67-ca16-48ba-b074-65581be6f011"
+
+	model.InvestigationData = nil
+	_, err = DocumentWith(*model, modelName)
+	require.NotNil(t, err, "Expected error but got nil")
+	require.Equal(t, err.Error(), "validation failed for field \"InvestigationData\": is required: required field missing")
+}
+
+func InvestigationRequestDataModel() MessageModel {
+	message := MessageModel{}
+	message.MessageId = "20250310QMGFNP31000001"
+	message.RequestorInvestigationId = "Scenario01InvstgtnId001"
+	message.InvestigationType = models.InvestigationUnableToApply
+	message.OriginalMessageId = "20250310B1QDRCQR000001"
+	message.OriginalMessageNameId = "pacs.008.001.08"
+	message.OriginalMessageCreateTime = time.Now()
+	message.OriginalInstructionId = "Scenario01InstrId001"
+	message.OriginalEndToEndId = "Scenario01EtoEId001"
+	message.OriginalUETR = "8a562c67-ca16-48ba-b074-65581be6f011"
+	message.OriginalInterbankSettlementAmount = models.CurrencyAndAmount{
+		Currency: "USD", Amount: "510000.74",
+	}
+	message.OriginalInterbankSettlementDate = fedwire.ISODate(civil.Date{Year: 2025, Month: 3, Day: 10})
+	message.Requestor = models.Agent{
+		PaymentSysCode:     models.PaymentSysUSABA,
+		PaymentSysMemberId: "021040078",
+	}
+	message.Responder = models.Agent{
+		PaymentSysCode:     models.PaymentSysUSABA,
+		PaymentSysMemberId: "011104238",
+	}
+	message.InvestigationData = []models.InvestigationReason{
+		{
+			Reason:    "AC03",
+			Narrative: "Creditor account number does not exist at the creditor agent.",
+		},
+		{
+			Reason:    "BE04",
+			SubType:   "ADDR",
+			Narrative: "Creditor address is missing.",
+		},
+	}
+	return message
+}
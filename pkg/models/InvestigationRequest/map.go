@@ -0,0 +1,30 @@
+package InvestigationRequest
+
+func pathMapV1() map[string]any {
+	return map[string]any{
+		"InvstgtnReq.InvstgtnReq.MsgId":                                         "MessageId",
+		"InvstgtnReq.InvstgtnReq.RqstrInvstgtnId":                               "RequestorInvestigationId",
+		"InvstgtnReq.InvstgtnReq.RspndrInvstgtnId":                              "ResponderInvestigationId",
+		"InvstgtnReq.InvstgtnReq.InvstgtnTp.Cd":                                 "InvestigationType",
+		"InvstgtnReq.InvstgtnReq.InvstgtnSubTp.Cd":                              "InvestigationSubType",
+		"InvstgtnReq.InvstgtnReq.Undrlyg.IntrBk.OrgnlGrpInf.OrgnlMsgId":         "OriginalMessageId",
+		"InvstgtnReq.InvstgtnReq.Undrlyg.IntrBk.OrgnlGrpInf.OrgnlMsgNmId":       "OriginalMessageNameId",
+		"InvstgtnReq.InvstgtnReq.Undrlyg.IntrBk.OrgnlGrpInf.OrgnlCreDtTm":       "OriginalMessageCreateTime",
+		"InvstgtnReq.InvstgtnReq.Undrlyg.IntrBk.OrgnlInstrId":                   "OriginalInstructionId",
+		"InvstgtnReq.InvstgtnReq.Undrlyg.IntrBk.OrgnlEndToEndId":                "OriginalEndToEndId",
+		"InvstgtnReq.InvstgtnReq.Undrlyg.IntrBk.OrgnlTxId":                      "OriginalTransactionId",
+		"InvstgtnReq.InvstgtnReq.Undrlyg.IntrBk.OrgnlUETR":                      "OriginalUETR",
+		"InvstgtnReq.InvstgtnReq.Undrlyg.IntrBk.OrgnlIntrBkSttlmAmt.Value":      "OriginalInterbankSettlementAmount.Amount",
+		"InvstgtnReq.InvstgtnReq.Undrlyg.IntrBk.OrgnlIntrBkSttlmAmt.Ccy":        "OriginalInterbankSettlementAmount.Currency",
+		"InvstgtnReq.InvstgtnReq.Undrlyg.IntrBk.OrgnlIntrBkSttlmDt":             "OriginalInterbankSettlementDate",
+		"InvstgtnReq.InvstgtnReq.Rqstr.Agt.FinInstnId.ClrSysMmbId.ClrSysId.Cd":  "Requestor.PaymentSysCode",
+		"InvstgtnReq.InvstgtnReq.Rqstr.Agt.FinInstnId.ClrSysMmbId.MmbId":        "Requestor.PaymentSysMemberId",
+		"InvstgtnReq.InvstgtnReq.Rspndr.Agt.FinInstnId.ClrSysMmbId.ClrSysId.Cd": "Responder.PaymentSysCode",
+		"InvstgtnReq.InvstgtnReq.Rspndr.Agt.FinInstnId.ClrSysMmbId.MmbId":       "Responder.PaymentSysMemberId",
+		"InvstgtnReq.InvstgtnData : InvestigationData": map[string]string{
+			"Rsn.Cd":                "Reason",
+			"RsnSubTp.Cd":           "SubType",
+			"AddtlReqData.ReqNrrtv": "Narrative",
+		},
+	}
+}
@@ -0,0 +1,19 @@
+package InvestigationRequest
+
+type CAMT_110_001_VERSION string
+
+const (
+	CAMT_110_001_01 CAMT_110_001_VERSION = "camt.110.001.01"
+)
+
+var VersionNameSpaceMap = map[CAMT_110_001_VERSION]string{
+	CAMT_110_001_01: "urn:iso:std:iso:20022:tech:xsd:camt.110.001.01",
+}
+
+var NameSpaceVersionMap = map[string]CAMT_110_001_VERSION{
+	"urn:iso:std:iso:20022:tech:xsd:camt.110.001.01": CAMT_110_001_01,
+}
+
+var VersionPathMap = map[CAMT_110_001_VERSION]map[string]any{
+	CAMT_110_001_01: pathMapV1(),
+}
@@ -0,0 +1,85 @@
+package InvestigationResponse
+
+import "github.com/moov-io/wire20022/pkg/models"
+
+type MessageHelper struct {
+	MessageId                        models.ElementHelper
+	ResponderInvestigationId         models.ElementHelper
+	InvestigationStatus              models.ElementHelper
+	StatusReason                     models.ElementHelper
+	ResponseNarrative                models.ElementHelper
+	OriginalMessageId                models.ElementHelper
+	OriginalRequestorInvestigationId models.ElementHelper
+	OriginalResponderInvestigationId models.ElementHelper
+	OriginalInvestigationType        models.ElementHelper
+	OriginalInvestigationSubType     models.ElementHelper
+	Requestor                        models.AgentHelper
+	Responder                        models.AgentHelper
+}
+
+func BuildMessageHelper() MessageHelper {
+	return MessageHelper{
+		MessageId: models.ElementHelper{
+			Title:         "Message Id",
+			Rules:         "",
+			Type:          `IMADFedwireFunds1 (based on string) exactLength: 22 pattern: [0-9]{8}[A-Z0-9]{8}[0-9]{6}`,
+			Documentation: `Input message accountability data of the investigation response.`,
+		},
+		ResponderInvestigationId: models.ElementHelper{
+			Title:         "Responder Investigation Id",
+			Rules:         "",
+			Type:          `Max35Text (based on string) minLength: 1 maxLength: 35`,
+			Documentation: `Identification of the investigation, as assigned by the responder.`,
+		},
+		InvestigationStatus: models.ElementHelper{
+			Title:         "Investigation Status",
+			Rules:         "",
+			Type:          `ExternalInvestigationStatus1Code (based on string) minLength: 1 maxLength: 4`,
+			Documentation: `Status of the investigation, for example CLSD (closed).`,
+		},
+		StatusReason: models.ElementHelper{
+			Title:         "Status Reason",
+			Rules:         "",
+			Type:          `ExternalInvestigationStatusReason1Code (based on string) minLength: 1 maxLength: 4`,
+			Documentation: `Reason for the status of the investigation.`,
+		},
+		ResponseNarrative: models.ElementHelper{
+			Title:         "Response Narrative",
+			Rules:         "",
+			Type:          `Max500Text (based on string) minLength: 1 maxLength: 500`,
+			Documentation: `Free-form response to the investigation request.`,
+		},
+		OriginalMessageId: models.ElementHelper{
+			Title:         "Original Message Id",
+			Rules:         "",
+			Type:          `Max35Text (based on string) minLength: 1 maxLength: 35`,
+			Documentation: `Message identification of the original investigation request.`,
+		},
+		OriginalRequestorInvestigationId: models.ElementHelper{
+			Title:         "Original Requestor Investigation Id",
+			Rules:         "",
+			Type:          `Max35Text (based on string) minLength: 1 maxLength: 35`,
+			Documentation: `Identification of the investigation, as assigned by the requestor in the original request.`,
+		},
+		OriginalResponderInvestigationId: models.ElementHelper{
+			Title:         "Original Responder Investigation Id",
+			Rules:         "",
+			Type:          `Max35Text (based on string) minLength: 1 maxLength: 35`,
+			Documentation: `Identification of the investigation, as assigned by the responder in the original request.`,
+		},
+		OriginalInvestigationType: models.ElementHelper{
+			Title:         "Original Investigation Type",
+			Rules:         "",
+			Type:          `ExternalInvestigationType1Code (based on string) minLength: 1 maxLength: 4`,
+			Documentation: `Type of the original investigation, for example UTAP (unable to apply) or RQFI (request for information).`,
+		},
+		OriginalInvestigationSubType: models.ElementHelper{
+			Title:         "Original Investigation Sub Type",
+			Rules:         "",
+			Type:          `ExternalInvestigationSubType1Code (based on string) minLength: 1 maxLength: 4`,
+			Documentation: `Further specifies the type of the original investigation.`,
+		},
+		Requestor: models.BuildAgentHelper(),
+		Responder: models.BuildAgentHelper(),
+	}
+}
@@ -0,0 +1,88 @@
+package InvestigationResponse
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/moov-io/wire20022/pkg/base"
+	"github.com/moov-io/wire20022/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestReadXML tests the idiomatic ReadXML method
+func TestReadXML(t *testing.T) {
+	xmlFile, err := os.Open("./swiftSample/Investigations_Scenario1_Step3_camt.111")
+	require.NoError(t, err)
+	defer xmlFile.Close()
+
+	var model MessageModel
+	err = model.ReadXML(xmlFile)
+	assert.NoError(t, err)
+
+	assert.Equal(t, "20250310B1QDRCQR000011", model.MessageId)
+	assert.Equal(t, "20250310QMGFNP31000001", model.OriginalMessageId)
+	assert.Equal(t, models.InvestigationStatusClosed, model.InvestigationStatus)
+}
+
+// TestOriginalRequestMapped checks that the path map covers all of OrgnlInvstgtnReq in the samples
+func TestOriginalRequestMapped(t *testing.T) {
+	files, err := filepath.Glob("./swiftSample/*")
+	require.NoError(t, err)
+	require.NotEmpty(t, files)
+
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		require.NoError(t, err)
+
+		model, err := ParseXML(data, base.PreserveUnmapped())
+		require.NoError(t, err, file)
+		assert.Nil(t, model.Unmapped, file)
+		assert.NotEmpty(t, model.OriginalMessageId, file)
+		assert.NotEmpty(t, model.Requestor.PaymentSysMemberId, file)
+		assert.NotEmpty(t, model.Responder.PaymentSysMemberId, file)
+	}
+}
+
+// TestWriteXML tests the idiomatic WriteXML method
+func TestWriteXML(t *testing.T) {
+	model := InvestigationResponseDataModel()
+
+	var buf bytes.Buffer
+	err := model.WriteXML(&buf)
+	require.NoError(t, err)
+
+	xmlOutput := buf.String()
+	assert.Contains(t, xmlOutput, "<?xml version=\"1.0\" encoding=\"UTF-8\"?>")
+	assert.Contains(t, xmlOutput, "camt.111.001.01")
+	assert.Contains(t, xmlOutput, "InvstgtnRspn")
+	assert.Contains(t, xmlOutput, model.MessageId)
+
+	var parsedModel MessageModel
+	err = parsedModel.ReadXML(strings.NewReader(xmlOutput))
+	require.NoError(t, err)
+	assert.Equal(t, model.MessageId, parsedModel.MessageId)
+	assert.Equal(t, model.InvestigationStatus, parsedModel.InvestigationStatus)
+	assert.Equal(t, model.ResponseNarrative, parsedModel.ResponseNarrative)
+}
+
+// TestWriteXMLWithInvalidModel tests that required fields are enforced before writing
+func TestWriteXMLWithInvalidModel(t *testing.T) {
+	model := MessageModel{}
+
+	var buf bytes.Buffer
+	err := model.WriteXML(&buf)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "creating document")
+	assert.Empty(t, buf.String())
+}
+
+// TestReadXMLWithErrors tests that malformed input is rejected
+func TestReadXMLWithErrors(t *testing.T) {
+	var model MessageModel
+	err := model.ReadXML(strings.NewReader("<invalid>xml"))
+	assert.Error(t, err)
+}
@@ -0,0 +1,91 @@
+package InvestigationResponse
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/moov-io/wire20022/pkg/models"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDocumentToModel01(t *testing.T) {
+	var sampleXML = filepath.Join("swiftSample", "Investigations_Scenario1_Step3_camt.111")
+	var xmlData, err = models.ReadXMLFile(sampleXML)
+	require.NoError(t, err, "Failed to read XML file")
+
+	model, err := ParseXML(xmlData)
+	if err != nil {
+		t.Fatal(err)
+	}
+	require.NoError(t, err, "Failed to make XML structure")
+	require.Equal(t, model.MessageId, "20250310B1QDRCQR000011")
+	require.Equal(t, model.ResponderInvestigationId, "Scenario01RspndrId001")
+	require.Equal(t, model.InvestigationStatus, models.InvestigationStatusClosed)
+	require.Empty(t, model.StatusReason)
+	require.Equal(t, model.ResponseNarrative, "The correct creditor account number is 5647772655.")
+	require.Equal(t, model.OriginalMessageId, "20250310QMGFNP31000001")
+	require.Equal(t, model.OriginalRequestorInvestigationId, "Scenario01InvstgtnId001")
+	require.Equal(t, model.OriginalInvestigationType, models.InvestigationUnableToApply)
+	require.Equal(t, model.Requestor.PaymentSysCode, models.PaymentSysUSABA)
+	require.Equal(t, model.Requestor.PaymentSysMemberId, "021040078")
+	require.Equal(t, model.Responder.PaymentSysCode, models.PaymentSysUSABA)
+	require.Equal(t, model.Responder.PaymentSysMemberId, "011104238")
+}
+
+func TestDocumentToModel01Scenario2(t *testing.T) {
+	var sampleXML = filepath.Join("swiftSample", "Investigations_Scenario2_Step3_camt.111")
+	var xmlData, err = models.ReadXMLFile(sampleXML)
+	require.NoError(t, err, "Failed to read XML file")
+
+	model, err := ParseXML(xmlData)
+	require.NoError(t, err, "Failed to make XML structure")
+	require.Equal(t, model.MessageId, "20250310B1QDRCQR000012")
+	require.Equal(t, model.OriginalInvestigationType, models.InvestigationRequestForInformation)
+	require.Equal(t, model.ResponseNarrative, "The ultimate creditor is Corporation B, 7 Market Street, Fountain Hills, AZ 85268.")
+
+	sampleXML = filepath.Join("swiftSample", "Investigations_Scenario3_Step3_camt.111")
+	xmlData, err = models.ReadXMLFile(sampleXML)
+	require.NoError(t, err, "Failed to read XML file")
+
+	model, err = ParseXML(xmlData)
+	require.NoError(t, err, "Failed to make XML structure")
+	require.Equal(t, model.InvestigationStatus, models.InvestigationStatusRejected)
+	require.Equal(t, model.OriginalInvestigationType, models.InvestigationValueDateAdjustment)
+	require.Equal(t, model.Responder.PaymentSysMemberId, "021040078")
+}
+
+// Test helper functions for better coverage
+func TestHelperFunctions(t *testing.T) {
+	t.Run("NewMessageForVersion", func(t *testing.T) {
+		model := NewMessageForVersion(CAMT_111_001_01)
+		require.NotNil(t, model)
+		// Basic model should have zero values
+		require.Empty(t, model.MessageId)
+	})
+
+	t.Run("ValidateForVersion", func(t *testing.T) {
+		model := MessageModel{}
+		err := model.ValidateForVersion(CAMT_111_001_01)
+		require.Error(t, err) // Should fail validation with empty model
+		require.Contains(t, err.Error(), "MessageId")
+
+		require.NoError(t, InvestigationResponseDataModel().ValidateForVersion(CAMT_111_001_01))
+	})
+
+	t.Run("GetVersionCapabilities", func(t *testing.T) {
+		model := MessageModel{}
+		capabilities := model.GetVersionCapabilities()
+		require.Empty(t, capabilities) // InvestigationResponse has no version-specific features
+	})
+
+	t.Run("CheckRequiredFields", func(t *testing.T) {
+		model := MessageModel{}
+		err := CheckRequiredFields(model)
+		require.Error(t, err) // Should fail with empty model
+	})
+
+	t.Run("BuildMessageHelper", func(t *testing.T) {
+		helper := BuildMessageHelper()
+		require.Equal(t, "Investigation Status", helper.InvestigationStatus.Title)
+	})
+}
@@ -0,0 +1,97 @@
+package InvestigationResponse
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/moov-io/wire20022/pkg/models"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVersion01(t *testing.T) {
+	modelName := CAMT_111_001_01
+	xmlName := "InvestigationResponse_01.xml"
+
+	dataModel := InvestigationResponseDataModel()
+	/*Create Document from Model*/
+	var doc01, err = DocumentWith(dataModel, modelName)
+	require.NoError(t, err, "Failed to create document")
+	/*Validate Check for created Document*/
+	vErr := doc01.Validate()
+	require.NoError(t, vErr, "Failed to validate document")
+	/*Create XML file from Document*/
+	xmlData, err := xml.MarshalIndent(doc01, "", "  ")
+	require.NoError(t, err)
+	err = models.WriteXMLToGenerate(xmlName, xmlData)
+	require.NoError(t, err)
+
+	/*Create Date Model from XML (Read XML)*/
+	var xmlDoc, xmlErr = models.ReadXMLFile("./generated/" + xmlName)
+	require.NoError(t, xmlErr, "Failed to read XML file")
+
+	/*Compare*/
+	model, err := ParseXML(xmlDoc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	require.NoError(t, err, "Failed to make XML structure")
+	require.Equal(t, model.MessageId, "20250310B1QDRCQR000011")
+	require.Equal(t, model.ResponderInvestigationId, "Scenario01RspndrId001")
+	require.Equal(t, model.InvestigationStatus, models.InvestigationStatusClosed)
+	require.Equal(t, model.StatusReason, "NARR")
+	require.Equal(t, model.ResponseNarrative, "The correct creditor account number is 5647772655.")
+	require.Equal(t, model.OriginalMessageId, "20250310QMGFNP31000001")
+	require.Equal(t, model.OriginalRequestorInvestigationId, "Scenario01InvstgtnId001")
+	require.Equal(t, model.OriginalInvestigationType, models.InvestigationUnableToApply)
+	require.Equal(t, model.Requestor.PaymentSysCode, models.PaymentSysUSABA)
+	require.Equal(t, model.Requestor.PaymentSysMemberId, "021040078")
+	require.Equal(t, model.Responder.PaymentSysCode, models.PaymentSysUSABA)
+	require.Equal(t, model.Responder.PaymentSysMemberId, "011104238")
+
+	/*Validation check*/
+	model.MessageId = "InvalideMessageIdLength5012345678901234567890"
+	_, err = DocumentWith(*model, modelName)
+	require.NotNil(t, err, "Expected error but got nil")
+	require.Equal(t, err.Error(), "field copy InvstgtnRspn.InvstgtnRspn.MsgId failed: failed to set MessageId: InvalideMessageIdLength5012345678901234567890 fails validation with length 45 <= required maxLength 22")
+	model.MessageId = "20250310B1QDRCQR000011"
+
+	model.InvestigationStatus = "CLOSED"
+	_, err = DocumentWith(*model, modelName)
+	require.NotNil(t, err, "Expected error but got nil")
+	require.Equal(t, err.Error(), "field copy InvstgtnRspn.InvstgtnRspn.InvstgtnSts.Sts failed: failed to set InvestigationStatus: CLOSED fails validation with length 6 <= required maxLength 4")
+	model.InvestigationStatus = models.InvestigationStatusClosed
+
+	/*Require field check*/
+	model.OriginalMessageId = ""
+	_, err = DocumentWith(*model, modelName)
+	require.NotNil(t, err, "Expected error but got nil")
+	require.Equal(t, err.Error(), "validation failed for field \"OriginalMessageId\": is required: required field missing")
+	model.OriginalMessageId = "20250310QMGFNP31000001"
+
+	/*Optional narrative*/
+	model.ResponseNarrative = ""
+	doc01, err = DocumentWith(*model, modelName)
+	require.NoError(t, err)
+	require.NoError(t, doc01.Validate())
+}
+
+func InvestigationResponseDataModel() MessageModel {
+	message := MessageModel{}
+	message.MessageId = "20250310B1QDRCQR000011"
+	message.ResponderInvestigationId = "Scenario01RspndrId001"
+	message.InvestigationStatus = models.InvestigationStatusClosed
+	message.StatusReason = "NARR"
+	message.ResponseNarrative = "The correct creditor account number is 5647772655."
+	message.OriginalMessageId = "20250310QMGFNP31000001"
+	message.OriginalRequestorInvestigationId = "Scenario01InvstgtnId001"
+	message.OriginalInvestigationType = models.InvestigationUnableToApply
+	message.Requestor = models.Agent{
+		PaymentSysCode:     models.PaymentSysUSABA,
+		PaymentSysMemberId: "021040078",
+	}
+	message.Responder = models.Agent{
+		PaymentSysCode:     models.PaymentSysUSABA,
+		PaymentSysMemberId: "011104238",
+	}
+	return message
+}
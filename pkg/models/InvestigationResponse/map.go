@@ -0,0 +1,20 @@
+package InvestigationResponse
+
+func pathMapV1() map[string]any {
+	return map[string]any{
+		"InvstgtnRspn.InvstgtnRspn.MsgId":                                             "MessageId",
+		"InvstgtnRspn.InvstgtnRspn.RspndrInvstgtnId":                                  "ResponderInvestigationId",
+		"InvstgtnRspn.InvstgtnRspn.InvstgtnSts.Sts":                                   "InvestigationStatus",
+		"InvstgtnRspn.InvstgtnRspn.InvstgtnSts.StsRsn.Cd":                             "StatusReason",
+		"InvstgtnRspn.InvstgtnRspn.InvstgtnData[0].RspnData.RspnNrrtv":                "ResponseNarrative",
+		"InvstgtnRspn.OrgnlInvstgtnReq.MsgId":                                         "OriginalMessageId",
+		"InvstgtnRspn.OrgnlInvstgtnReq.RqstrInvstgtnId":                               "OriginalRequestorInvestigationId",
+		"InvstgtnRspn.OrgnlInvstgtnReq.RspndrInvstgtnId":                              "OriginalResponderInvestigationId",
+		"InvstgtnRspn.OrgnlInvstgtnReq.InvstgtnTp.Cd":                                 "OriginalInvestigationType",
+		"InvstgtnRspn.OrgnlInvstgtnReq.InvstgtnSubTp.Cd":                              "OriginalInvestigationSubType",
+		"InvstgtnRspn.OrgnlInvstgtnReq.Rqstr.Agt.FinInstnId.ClrSysMmbId.ClrSysId.Cd":  "Requestor.PaymentSysCode",
+		"InvstgtnRspn.OrgnlInvstgtnReq.Rqstr.Agt.FinInstnId.ClrSysMmbId.MmbId":        "Requestor.PaymentSysMemberId",
+		"InvstgtnRspn.OrgnlInvstgtnReq.Rspndr.Agt.FinInstnId.ClrSysMmbId.ClrSysId.Cd": "Responder.PaymentSysCode",
+		"InvstgtnRspn.OrgnlInvstgtnReq.Rspndr.Agt.FinInstnId.ClrSysMmbId.MmbId":       "Responder.PaymentSysMemberId",
+	}
+}
@@ -0,0 +1,19 @@
+package InvestigationResponse
+
+type CAMT_111_001_VERSION string
+
+const (
+	CAMT_111_001_01 CAMT_111_001_VERSION = "camt.111.001.01"
+)
+
+var VersionNameSpaceMap = map[CAMT_111_001_VERSION]string{
+	CAMT_111_001_01: "urn:iso:std:iso:20022:tech:xsd:camt.111.001.01",
+}
+
+var NameSpaceVersionMap = map[string]CAMT_111_001_VERSION{
+	"urn:iso:std:iso:20022:tech:xsd:camt.111.001.01": CAMT_111_001_01,
+}
+
+var VersionPathMap = map[CAMT_111_001_VERSION]map[string]any{
+	CAMT_111_001_01: pathMapV1(),
+}
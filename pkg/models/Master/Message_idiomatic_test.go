@@ -35,7 +35,7 @@ func TestReadWriteXML(t *testing.T) {
 			BalanceTypeId: models.BalanceType("DLOD"),
 			Amount: models.CurrencyAndAmount{
 				Currency: "USD",
-				Amount:   270458895930.79,
+				Amount:   "270458895930.79",
 			},
 			CreditDebitIndicator: models.Credit,
 			DateTime:             time.Now().UTC(),
@@ -43,15 +43,15 @@ func TestReadWriteXML(t *testing.T) {
 	}
 	model.TransactionsSummary = []models.TotalsPerBankTransaction{
 		{
-			TotalNetEntryAmount:  279595877422.72,
+			TotalNetEntryAmount:  "279595877422.72",
 			CreditDebitIndicator: models.Credit,
 			CreditEntries: models.NumberAndSumOfTransactions{
 				NumberOfEntries: "16281",
-				Sum:             420780358976.96,
+				Sum:             "420780358976.96",
 			},
 			DebitEntries: models.NumberAndSumOfTransactions{
 				NumberOfEntries: "22134",
-				Sum:             141184481554.24,
+				Sum:             "141184481554.24",
 			},
 			BankTransactionCode: models.FedwireFundsTransfers,
 			Date:                time.Now().UTC(),
@@ -112,15 +112,15 @@ func TestWriteXMLVersions(t *testing.T) {
 			model.RelatedAccountOtherId = "VERSION_TEST_REL"
 			model.TransactionsSummary = []models.TotalsPerBankTransaction{
 				{
-					TotalNetEntryAmount:  100000.00,
+					TotalNetEntryAmount:  "100000.00",
 					CreditDebitIndicator: models.Credit,
 					CreditEntries: models.NumberAndSumOfTransactions{
 						NumberOfEntries: "10",
-						Sum:             100000.00,
+						Sum:             "100000.00",
 					},
 					DebitEntries: models.NumberAndSumOfTransactions{
 						NumberOfEntries: "0",
-						Sum:             0.00,
+						Sum:             "0.00",
 					},
 					BankTransactionCode: models.FedwireFundsTransfers,
 					Date:                time.Now(),
@@ -175,15 +175,15 @@ func TestValidateForVersion(t *testing.T) {
 				RelatedAccountOtherId: "REL001",
 				TransactionsSummary: []models.TotalsPerBankTransaction{
 					{
-						TotalNetEntryAmount:  25000.00,
+						TotalNetEntryAmount:  "25000.00",
 						CreditDebitIndicator: models.Credit,
 						CreditEntries: models.NumberAndSumOfTransactions{
 							NumberOfEntries: "3",
-							Sum:             25000.00,
+							Sum:             "25000.00",
 						},
 						DebitEntries: models.NumberAndSumOfTransactions{
 							NumberOfEntries: "0",
-							Sum:             0.00,
+							Sum:             "0.00",
 						},
 						BankTransactionCode: models.FedwireFundsTransfers,
 						Date:                time.Now(),
@@ -211,15 +211,15 @@ func TestValidateForVersion(t *testing.T) {
 				RelatedAccountOtherId: "REL008",
 				TransactionsSummary: []models.TotalsPerBankTransaction{
 					{
-						TotalNetEntryAmount:  100000.00,
+						TotalNetEntryAmount:  "100000.00",
 						CreditDebitIndicator: models.Credit,
 						CreditEntries: models.NumberAndSumOfTransactions{
 							NumberOfEntries: "5",
-							Sum:             100000.00,
+							Sum:             "100000.00",
 						},
 						DebitEntries: models.NumberAndSumOfTransactions{
 							NumberOfEntries: "0",
-							Sum:             0.00,
+							Sum:             "0.00",
 						},
 						BankTransactionCode: models.FedwireFundsTransfers,
 						Date:                time.Now(),
@@ -289,15 +289,15 @@ func TestValidateForVersion(t *testing.T) {
 				RelatedAccountOtherId: "REL008",
 				TransactionsSummary: []models.TotalsPerBankTransaction{
 					{
-						TotalNetEntryAmount:  50000.00,
+						TotalNetEntryAmount:  "50000.00",
 						CreditDebitIndicator: models.Credit,
 						CreditEntries: models.NumberAndSumOfTransactions{
 							NumberOfEntries: "3",
-							Sum:             50000.00,
+							Sum:             "50000.00",
 						},
 						DebitEntries: models.NumberAndSumOfTransactions{
 							NumberOfEntries: "0",
-							Sum:             0.00,
+							Sum:             "0.00",
 						},
 						BankTransactionCode: models.FedwireFundsTransfers,
 						Date:                time.Now(),
@@ -467,15 +467,15 @@ func TestCheckRequiredFields(t *testing.T) {
 			RelatedAccountOtherId: "REL_REQ001",
 			TransactionsSummary: []models.TotalsPerBankTransaction{
 				{
-					TotalNetEntryAmount:  50000.00,
+					TotalNetEntryAmount:  "50000.00",
 					CreditDebitIndicator: models.Credit,
 					CreditEntries: models.NumberAndSumOfTransactions{
 						NumberOfEntries: "5",
-						Sum:             50000.00,
+						Sum:             "50000.00",
 					},
 					DebitEntries: models.NumberAndSumOfTransactions{
 						NumberOfEntries: "0",
-						Sum:             0.00,
+						Sum:             "0.00",
 					},
 					BankTransactionCode: models.FedwireFundsTransfers,
 					Date:                time.Now(),
@@ -724,7 +724,7 @@ func TestBalancesAndTransactionsData(t *testing.T) {
 				BalanceTypeId: models.BalanceType("DLOD"),
 				Amount: models.CurrencyAndAmount{
 					Currency: "USD",
-					Amount:   270458895930.79,
+					Amount:   "270458895930.79",
 				},
 				CreditDebitIndicator: models.Credit,
 				DateTime:             time.Now(),
@@ -733,7 +733,7 @@ func TestBalancesAndTransactionsData(t *testing.T) {
 				BalanceTypeId: models.BalanceType("BOOK"),
 				Amount: models.CurrencyAndAmount{
 					Currency: "USD",
-					Amount:   100000.00,
+					Amount:   "100000.00",
 				},
 				CreditDebitIndicator: models.Debit,
 				DateTime:             time.Now(),
@@ -752,7 +752,7 @@ func TestBalancesAndTransactionsData(t *testing.T) {
 
 		assert.Len(t, model.Balances, 2)
 		assert.Equal(t, "USD", model.Balances[0].Amount.Currency)
-		assert.Equal(t, 270458895930.79, model.Balances[0].Amount.Amount)
+		assert.Equal(t, models.Decimal("270458895930.79"), model.Balances[0].Amount.Amount)
 	})
 }
 
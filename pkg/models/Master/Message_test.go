@@ -32,59 +32,59 @@ func TestDocumentToModel0(t *testing.T) {
 	require.Equal(t, model.AccountType, "M")
 	require.Equal(t, model.RelatedAccountOtherId, "231981435")
 	require.Equal(t, model.Balances[0].BalanceTypeId, models.DaylightOverdraftBalance)
-	require.Equal(t, model.Balances[0].Amount.Amount, 270458895930.79)
+	require.Equal(t, model.Balances[0].Amount.Amount, models.Decimal("270458895930.79"))
 	require.Equal(t, model.Balances[0].Amount.Currency, "USD")
 	require.Equal(t, model.Balances[0].CreditDebitIndicator, models.Credit)
 	require.NotNil(t, model.Balances[0].DateTime)
 	require.Equal(t, model.Balances[1].BalanceTypeId, models.AccountBalance)
 	require.Equal(t, model.Balances[1].CdtLines[0].Included, true)
 	require.Equal(t, model.Balances[1].CdtLines[0].Type, models.NetDebitCap)
-	require.Equal(t, model.Balances[1].CdtLines[0].Amount.Amount, 23125500000.00)
+	require.Equal(t, model.Balances[1].CdtLines[0].Amount.Amount, models.Decimal("23125500000.00"))
 	require.Equal(t, model.Balances[1].CdtLines[0].Amount.Currency, "USD")
 	require.NotNil(t, model.Balances[1].CdtLines[0].DateTime)
 	require.Equal(t, model.Balances[1].CdtLines[1].Included, true)
 	require.Equal(t, model.Balances[1].CdtLines[1].Type, models.CollateralizedCapacity)
-	require.Equal(t, model.Balances[1].CdtLines[1].Amount.Amount, 316874500000.00)
+	require.Equal(t, model.Balances[1].CdtLines[1].Amount.Amount, models.Decimal("316874500000.00"))
 	require.Equal(t, model.Balances[1].CdtLines[1].Amount.Currency, "USD")
 	require.NotNil(t, model.Balances[1].CdtLines[1].DateTime)
 	require.Equal(t, model.Balances[1].CdtLines[2].Included, true)
 	require.Equal(t, model.Balances[1].CdtLines[2].Type, models.CollateralAvailable)
-	require.Equal(t, model.Balances[1].CdtLines[2].Amount.Amount, 82598573368.44)
+	require.Equal(t, model.Balances[1].CdtLines[2].Amount.Amount, models.Decimal("82598573368.44"))
 	require.Equal(t, model.Balances[1].CdtLines[2].Amount.Currency, "USD")
 	require.NotNil(t, model.Balances[1].CdtLines[2].DateTime)
 	require.Equal(t, model.Balances[1].CdtLines[3].Included, true)
 	require.Equal(t, model.Balances[1].CdtLines[3].Type, models.CollateralizedDaylightOverdrafts)
-	require.Equal(t, model.Balances[1].CdtLines[3].Amount.Amount, 0.00)
+	require.Equal(t, model.Balances[1].CdtLines[3].Amount.Amount, models.Decimal("0.00"))
 	require.Equal(t, model.Balances[1].CdtLines[3].Amount.Currency, "USD")
 	require.NotNil(t, model.Balances[1].CdtLines[3].DateTime)
 	require.Equal(t, model.Balances[1].CdtLines[4].Included, true)
 	require.Equal(t, model.Balances[1].CdtLines[4].Type, models.UncollateralizedDaylightOverdrafts)
-	require.Equal(t, model.Balances[1].CdtLines[4].Amount.Amount, 0.00)
+	require.Equal(t, model.Balances[1].CdtLines[4].Amount.Amount, models.Decimal("0.00"))
 	require.Equal(t, model.Balances[1].CdtLines[4].Amount.Currency, "USD")
 	require.NotNil(t, model.Balances[1].CdtLines[4].DateTime)
-	require.Equal(t, model.Balances[1].Amount.Amount, 270594506052.13)
+	require.Equal(t, model.Balances[1].Amount.Amount, models.Decimal("270594506052.13"))
 	require.Equal(t, model.Balances[1].Amount.Currency, "USD")
 	require.Equal(t, model.Balances[1].CreditDebitIndicator, models.Credit)
 	require.NotNil(t, model.Balances[1].DateTime)
 	require.Equal(t, model.Balances[2].BalanceTypeId, models.AvailableBalanceFromDaylightOverdraft)
-	require.Equal(t, model.Balances[2].Amount.Amount, 610458895930.79)
+	require.Equal(t, model.Balances[2].Amount.Amount, models.Decimal("610458895930.79"))
 	require.Equal(t, model.Balances[2].Amount.Currency, "USD")
 	require.Equal(t, model.Balances[2].CreditDebitIndicator, models.Credit)
 	require.NotNil(t, model.Balances[2].DateTime)
-	require.Equal(t, model.TransactionsSummary[0].TotalNetEntryAmount, 279595877422.72)
+	require.Equal(t, model.TransactionsSummary[0].TotalNetEntryAmount, models.Decimal("279595877422.72"))
 	require.Equal(t, model.TransactionsSummary[0].CreditDebitIndicator, models.Credit)
 	require.Equal(t, model.TransactionsSummary[0].CreditEntries.NumberOfEntries, "16281")
-	require.Equal(t, model.TransactionsSummary[0].CreditEntries.Sum, 420780358976.96)
+	require.Equal(t, model.TransactionsSummary[0].CreditEntries.Sum, models.Decimal("420780358976.96"))
 	require.Equal(t, model.TransactionsSummary[0].DebitEntries.NumberOfEntries, "22134")
-	require.Equal(t, model.TransactionsSummary[0].DebitEntries.Sum, 141184481554.24)
+	require.Equal(t, model.TransactionsSummary[0].DebitEntries.Sum, models.Decimal("141184481554.24"))
 	require.Equal(t, model.TransactionsSummary[0].BankTransactionCode, models.FedwireFundsTransfers)
 	require.NotNil(t, model.TransactionsSummary[0].Date)
-	require.Equal(t, model.TransactionsSummary[1].TotalNetEntryAmount, 608598873.60)
+	require.Equal(t, model.TransactionsSummary[1].TotalNetEntryAmount, models.Decimal("608598873.60"))
 	require.Equal(t, model.TransactionsSummary[1].CreditDebitIndicator, models.Credit)
 	require.Equal(t, model.TransactionsSummary[1].CreditEntries.NumberOfEntries, "4")
-	require.Equal(t, model.TransactionsSummary[1].CreditEntries.Sum, 993425694.01)
+	require.Equal(t, model.TransactionsSummary[1].CreditEntries.Sum, models.Decimal("993425694.01"))
 	require.Equal(t, model.TransactionsSummary[1].DebitEntries.NumberOfEntries, "6")
-	require.Equal(t, model.TransactionsSummary[1].DebitEntries.Sum, 384826820.41)
+	require.Equal(t, model.TransactionsSummary[1].DebitEntries.Sum, models.Decimal("384826820.41"))
 	require.Equal(t, model.TransactionsSummary[1].BankTransactionCode, models.NationalSettlementServiceEntries)
 	require.NotNil(t, model.TransactionsSummary[1].Date)
 }
@@ -46,25 +46,25 @@ func TestVersion02(t *testing.T) {
 	require.Equal(t, model.AccountType, "M")
 	require.Equal(t, model.RelatedAccountOtherId, "231981435")
 	require.Equal(t, model.Balances[0].BalanceTypeId, models.DaylightOverdraftBalance)
-	require.Equal(t, model.Balances[0].Amount.Amount, 270458895930.79)
+	require.Equal(t, model.Balances[0].Amount.Amount, models.Decimal("270458895930.79"))
 	require.Equal(t, model.Balances[0].Amount.Currency, "USD")
 	require.Equal(t, model.Balances[0].CreditDebitIndicator, models.Credit)
 	require.NotNil(t, model.Balances[0].DateTime)
 	require.Equal(t, model.Balances[1].BalanceTypeId, models.AccountBalance)
-	require.Equal(t, model.Balances[1].Amount.Amount, 270594506052.13)
+	require.Equal(t, model.Balances[1].Amount.Amount, models.Decimal("270594506052.13"))
 	require.Equal(t, model.Balances[1].Amount.Currency, "USD")
 	require.Equal(t, model.Balances[1].CreditDebitIndicator, models.Credit)
 	require.NotNil(t, model.Balances[1].DateTime)
 	require.Equal(t, model.Balances[2].BalanceTypeId, models.AvailableBalanceFromDaylightOverdraft)
-	require.Equal(t, model.Balances[2].Amount.Amount, 610458895930.79)
+	require.Equal(t, model.Balances[2].Amount.Amount, models.Decimal("610458895930.79"))
 	require.Equal(t, model.Balances[2].Amount.Currency, "USD")
 	require.Equal(t, model.Balances[2].CreditDebitIndicator, models.Credit)
 	require.NotNil(t, model.Balances[2].DateTime)
-	require.Equal(t, model.TransactionsSummary[0].TotalNetEntryAmount, 279595877422.72)
+	require.Equal(t, model.TransactionsSummary[0].TotalNetEntryAmount, models.Decimal("279595877422.72"))
 	require.Equal(t, model.TransactionsSummary[0].CreditDebitIndicator, models.Credit)
 	require.Equal(t, model.TransactionsSummary[0].BankTransactionCode, models.FedwireFundsTransfers)
 	require.NotNil(t, model.TransactionsSummary[0].Date)
-	require.Equal(t, model.TransactionsSummary[1].TotalNetEntryAmount, 608598873.60)
+	require.Equal(t, model.TransactionsSummary[1].TotalNetEntryAmount, models.Decimal("608598873.6"))
 	require.Equal(t, model.TransactionsSummary[1].CreditDebitIndicator, models.Credit)
 	require.Equal(t, model.TransactionsSummary[1].BankTransactionCode, models.NationalSettlementServiceEntries)
 	require.NotNil(t, model.TransactionsSummary[1].Date)
@@ -124,25 +124,25 @@ func TestVersion03(t *testing.T) {
 	require.Equal(t, model.AccountType, "M")
 	require.Equal(t, model.RelatedAccountOtherId, "231981435")
 	require.Equal(t, model.Balances[0].BalanceTypeId, models.DaylightOverdraftBalance)
-	require.Equal(t, model.Balances[0].Amount.Amount, 270458895930.79)
+	require.Equal(t, model.Balances[0].Amount.Amount, models.Decimal("270458895930.79"))
 	require.Equal(t, model.Balances[0].Amount.Currency, "USD")
 	require.Equal(t, model.Balances[0].CreditDebitIndicator, models.Credit)
 	require.NotNil(t, model.Balances[0].DateTime)
 	require.Equal(t, model.Balances[1].BalanceTypeId, models.AccountBalance)
-	require.Equal(t, model.Balances[1].Amount.Amount, 270594506052.13)
+	require.Equal(t, model.Balances[1].Amount.Amount, models.Decimal("270594506052.13"))
 	require.Equal(t, model.Balances[1].Amount.Currency, "USD")
 	require.Equal(t, model.Balances[1].CreditDebitIndicator, models.Credit)
 	require.NotNil(t, model.Balances[1].DateTime)
 	require.Equal(t, model.Balances[2].BalanceTypeId, models.AvailableBalanceFromDaylightOverdraft)
-	require.Equal(t, model.Balances[2].Amount.Amount, 610458895930.79)
+	require.Equal(t, model.Balances[2].Amount.Amount, models.Decimal("610458895930.79"))
 	require.Equal(t, model.Balances[2].Amount.Currency, "USD")
 	require.Equal(t, model.Balances[2].CreditDebitIndicator, models.Credit)
 	require.NotNil(t, model.Balances[2].DateTime)
-	require.Equal(t, model.TransactionsSummary[0].TotalNetEntryAmount, 279595877422.72)
+	require.Equal(t, model.TransactionsSummary[0].TotalNetEntryAmount, models.Decimal("279595877422.72"))
 	require.Equal(t, model.TransactionsSummary[0].CreditDebitIndicator, models.Credit)
 	require.Equal(t, model.TransactionsSummary[0].BankTransactionCode, models.FedwireFundsTransfers)
 	require.NotNil(t, model.TransactionsSummary[0].Date)
-	require.Equal(t, model.TransactionsSummary[1].TotalNetEntryAmount, 608598873.60)
+	require.Equal(t, model.TransactionsSummary[1].TotalNetEntryAmount, models.Decimal("608598873.6"))
 	require.Equal(t, model.TransactionsSummary[1].CreditDebitIndicator, models.Credit)
 	require.Equal(t, model.TransactionsSummary[1].BankTransactionCode, models.NationalSettlementServiceEntries)
 	require.NotNil(t, model.TransactionsSummary[1].Date)
@@ -202,25 +202,25 @@ func TestVersion04(t *testing.T) {
 	require.Equal(t, model.AccountType, "M")
 	require.Equal(t, model.RelatedAccountOtherId, "231981435")
 	require.Equal(t, model.Balances[0].BalanceTypeId, models.DaylightOverdraftBalance)
-	require.Equal(t, model.Balances[0].Amount.Amount, 270458895930.79)
+	require.Equal(t, model.Balances[0].Amount.Amount, models.Decimal("270458895930.79"))
 	require.Equal(t, model.Balances[0].Amount.Currency, "USD")
 	require.Equal(t, model.Balances[0].CreditDebitIndicator, models.Credit)
 	require.NotNil(t, model.Balances[0].DateTime)
 	require.Equal(t, model.Balances[1].BalanceTypeId, models.AccountBalance)
-	require.Equal(t, model.Balances[1].Amount.Amount, 270594506052.13)
+	require.Equal(t, model.Balances[1].Amount.Amount, models.Decimal("270594506052.13"))
 	require.Equal(t, model.Balances[1].Amount.Currency, "USD")
 	require.Equal(t, model.Balances[1].CreditDebitIndicator, models.Credit)
 	require.NotNil(t, model.Balances[1].DateTime)
 	require.Equal(t, model.Balances[2].BalanceTypeId, models.AvailableBalanceFromDaylightOverdraft)
-	require.Equal(t, model.Balances[2].Amount.Amount, 610458895930.79)
+	require.Equal(t, model.Balances[2].Amount.Amount, models.Decimal("610458895930.79"))
 	require.Equal(t, model.Balances[2].Amount.Currency, "USD")
 	require.Equal(t, model.Balances[2].CreditDebitIndicator, models.Credit)
 	require.NotNil(t, model.Balances[2].DateTime)
-	require.Equal(t, model.TransactionsSummary[0].TotalNetEntryAmount, 279595877422.72)
+	require.Equal(t, model.TransactionsSummary[0].TotalNetEntryAmount, models.Decimal("279595877422.72"))
 	require.Equal(t, model.TransactionsSummary[0].CreditDebitIndicator, models.Credit)
 	require.Equal(t, model.TransactionsSummary[0].BankTransactionCode, models.FedwireFundsTransfers)
 	require.NotNil(t, model.TransactionsSummary[0].Date)
-	require.Equal(t, model.TransactionsSummary[1].TotalNetEntryAmount, 608598873.60)
+	require.Equal(t, model.TransactionsSummary[1].TotalNetEntryAmount, models.Decimal("608598873.6"))
 	require.Equal(t, model.TransactionsSummary[1].CreditDebitIndicator, models.Credit)
 	require.Equal(t, model.TransactionsSummary[1].BankTransactionCode, models.NationalSettlementServiceEntries)
 	require.NotNil(t, model.TransactionsSummary[1].Date)
@@ -280,25 +280,25 @@ func TestVersion05(t *testing.T) {
 	require.Equal(t, model.AccountType, "M")
 	require.Equal(t, model.RelatedAccountOtherId, "231981435")
 	require.Equal(t, model.Balances[0].BalanceTypeId, models.DaylightOverdraftBalance)
-	require.Equal(t, model.Balances[0].Amount.Amount, 270458895930.79)
+	require.Equal(t, model.Balances[0].Amount.Amount, models.Decimal("270458895930.79"))
 	require.Equal(t, model.Balances[0].Amount.Currency, "USD")
 	require.Equal(t, model.Balances[0].CreditDebitIndicator, models.Credit)
 	require.NotNil(t, model.Balances[0].DateTime)
 	require.Equal(t, model.Balances[1].BalanceTypeId, models.AccountBalance)
-	require.Equal(t, model.Balances[1].Amount.Amount, 270594506052.13)
+	require.Equal(t, model.Balances[1].Amount.Amount, models.Decimal("270594506052.13"))
 	require.Equal(t, model.Balances[1].Amount.Currency, "USD")
 	require.Equal(t, model.Balances[1].CreditDebitIndicator, models.Credit)
 	require.NotNil(t, model.Balances[1].DateTime)
 	require.Equal(t, model.Balances[2].BalanceTypeId, models.AvailableBalanceFromDaylightOverdraft)
-	require.Equal(t, model.Balances[2].Amount.Amount, 610458895930.79)
+	require.Equal(t, model.Balances[2].Amount.Amount, models.Decimal("610458895930.79"))
 	require.Equal(t, model.Balances[2].Amount.Currency, "USD")
 	require.Equal(t, model.Balances[2].CreditDebitIndicator, models.Credit)
 	require.NotNil(t, model.Balances[2].DateTime)
-	require.Equal(t, model.TransactionsSummary[0].TotalNetEntryAmount, 279595877422.72)
+	require.Equal(t, model.TransactionsSummary[0].TotalNetEntryAmount, models.Decimal("279595877422.72"))
 	require.Equal(t, model.TransactionsSummary[0].CreditDebitIndicator, models.Credit)
 	require.Equal(t, model.TransactionsSummary[0].BankTransactionCode, models.FedwireFundsTransfers)
 	require.NotNil(t, model.TransactionsSummary[0].Date)
-	require.Equal(t, model.TransactionsSummary[1].TotalNetEntryAmount, 608598873.60)
+	require.Equal(t, model.TransactionsSummary[1].TotalNetEntryAmount, models.Decimal("608598873.6"))
 	require.Equal(t, model.TransactionsSummary[1].CreditDebitIndicator, models.Credit)
 	require.Equal(t, model.TransactionsSummary[1].BankTransactionCode, models.NationalSettlementServiceEntries)
 	require.NotNil(t, model.TransactionsSummary[1].Date)
@@ -358,25 +358,25 @@ func TestVersion06(t *testing.T) {
 	require.Equal(t, model.AccountType, "M")
 	require.Equal(t, model.RelatedAccountOtherId, "231981435")
 	require.Equal(t, model.Balances[0].BalanceTypeId, models.DaylightOverdraftBalance)
-	require.Equal(t, model.Balances[0].Amount.Amount, 270458895930.79)
+	require.Equal(t, model.Balances[0].Amount.Amount, models.Decimal("270458895930.79"))
 	require.Equal(t, model.Balances[0].Amount.Currency, "USD")
 	require.Equal(t, model.Balances[0].CreditDebitIndicator, models.Credit)
 	require.NotNil(t, model.Balances[0].DateTime)
 	require.Equal(t, model.Balances[1].BalanceTypeId, models.AccountBalance)
-	require.Equal(t, model.Balances[1].Amount.Amount, 270594506052.13)
+	require.Equal(t, model.Balances[1].Amount.Amount, models.Decimal("270594506052.13"))
 	require.Equal(t, model.Balances[1].Amount.Currency, "USD")
 	require.Equal(t, model.Balances[1].CreditDebitIndicator, models.Credit)
 	require.NotNil(t, model.Balances[1].DateTime)
 	require.Equal(t, model.Balances[2].BalanceTypeId, models.AvailableBalanceFromDaylightOverdraft)
-	require.Equal(t, model.Balances[2].Amount.Amount, 610458895930.79)
+	require.Equal(t, model.Balances[2].Amount.Amount, models.Decimal("610458895930.79"))
 	require.Equal(t, model.Balances[2].Amount.Currency, "USD")
 	require.Equal(t, model.Balances[2].CreditDebitIndicator, models.Credit)
 	require.NotNil(t, model.Balances[2].DateTime)
-	require.Equal(t, model.TransactionsSummary[0].TotalNetEntryAmount, 279595877422.72)
+	require.Equal(t, model.TransactionsSummary[0].TotalNetEntryAmount, models.Decimal("279595877422.72"))
 	require.Equal(t, model.TransactionsSummary[0].CreditDebitIndicator, models.Credit)
 	require.Equal(t, model.TransactionsSummary[0].BankTransactionCode, models.FedwireFundsTransfers)
 	require.NotNil(t, model.TransactionsSummary[0].Date)
-	require.Equal(t, model.TransactionsSummary[1].TotalNetEntryAmount, 608598873.60)
+	require.Equal(t, model.TransactionsSummary[1].TotalNetEntryAmount, models.Decimal("608598873.6"))
 	require.Equal(t, model.TransactionsSummary[1].CreditDebitIndicator, models.Credit)
 	require.Equal(t, model.TransactionsSummary[1].BankTransactionCode, models.NationalSettlementServiceEntries)
 	require.NotNil(t, model.TransactionsSummary[1].Date)
@@ -436,59 +436,59 @@ func TestVersion07(t *testing.T) {
 	require.Equal(t, model.AccountType, "M")
 	require.Equal(t, model.RelatedAccountOtherId, "231981435")
 	require.Equal(t, model.Balances[0].BalanceTypeId, models.DaylightOverdraftBalance)
-	require.Equal(t, model.Balances[0].Amount.Amount, 270458895930.79)
+	require.Equal(t, model.Balances[0].Amount.Amount, models.Decimal("270458895930.79"))
 	require.Equal(t, model.Balances[0].Amount.Currency, "USD")
 	require.Equal(t, model.Balances[0].CreditDebitIndicator, models.Credit)
 	require.NotNil(t, model.Balances[0].DateTime)
 	require.Equal(t, model.Balances[1].BalanceTypeId, models.AccountBalance)
 	require.Equal(t, model.Balances[1].CdtLines[0].Included, true)
 	require.Equal(t, model.Balances[1].CdtLines[0].Type, models.NetDebitCap)
-	require.Equal(t, model.Balances[1].CdtLines[0].Amount.Amount, 23125500000.00)
+	require.Equal(t, model.Balances[1].CdtLines[0].Amount.Amount, models.Decimal("23125500000.00"))
 	require.Equal(t, model.Balances[1].CdtLines[0].Amount.Currency, "USD")
 	require.NotNil(t, model.Balances[1].CdtLines[0].DateTime)
 	require.Equal(t, model.Balances[1].CdtLines[1].Included, true)
 	require.Equal(t, model.Balances[1].CdtLines[1].Type, models.CollateralizedCapacity)
-	require.Equal(t, model.Balances[1].CdtLines[1].Amount.Amount, 316874500000.00)
+	require.Equal(t, model.Balances[1].CdtLines[1].Amount.Amount, models.Decimal("316874500000.00"))
 	require.Equal(t, model.Balances[1].CdtLines[1].Amount.Currency, "USD")
 	require.NotNil(t, model.Balances[1].CdtLines[1].DateTime)
 	require.Equal(t, model.Balances[1].CdtLines[2].Included, true)
 	require.Equal(t, model.Balances[1].CdtLines[2].Type, models.CollateralAvailable)
-	require.Equal(t, model.Balances[1].CdtLines[2].Amount.Amount, 82598573368.44)
+	require.Equal(t, model.Balances[1].CdtLines[2].Amount.Amount, models.Decimal("82598573368.44"))
 	require.Equal(t, model.Balances[1].CdtLines[2].Amount.Currency, "USD")
 	require.NotNil(t, model.Balances[1].CdtLines[2].DateTime)
 	require.Equal(t, model.Balances[1].CdtLines[3].Included, true)
 	require.Equal(t, model.Balances[1].CdtLines[3].Type, models.CollateralizedDaylightOverdrafts)
-	require.Equal(t, model.Balances[1].CdtLines[3].Amount.Amount, 0.00)
+	require.Equal(t, model.Balances[1].CdtLines[3].Amount.Amount, models.Decimal("0.00"))
 	require.Equal(t, model.Balances[1].CdtLines[3].Amount.Currency, "USD")
 	require.NotNil(t, model.Balances[1].CdtLines[3].DateTime)
 	require.Equal(t, model.Balances[1].CdtLines[4].Included, true)
 	require.Equal(t, model.Balances[1].CdtLines[4].Type, models.UncollateralizedDaylightOverdrafts)
-	require.Equal(t, model.Balances[1].CdtLines[4].Amount.Amount, 0.00)
+	require.Equal(t, model.Balances[1].CdtLines[4].Amount.Amount, models.Decimal("0.00"))
 	require.Equal(t, model.Balances[1].CdtLines[4].Amount.Currency, "USD")
 	require.NotNil(t, model.Balances[1].CdtLines[4].DateTime)
-	require.Equal(t, model.Balances[1].Amount.Amount, 270594506052.13)
+	require.Equal(t, model.Balances[1].Amount.Amount, models.Decimal("270594506052.13"))
 	require.Equal(t, model.Balances[1].Amount.Currency, "USD")
 	require.Equal(t, model.Balances[1].CreditDebitIndicator, models.Credit)
 	require.NotNil(t, model.Balances[1].DateTime)
 	require.Equal(t, model.Balances[2].BalanceTypeId, models.AvailableBalanceFromDaylightOverdraft)
-	require.Equal(t, model.Balances[2].Amount.Amount, 610458895930.79)
+	require.Equal(t, model.Balances[2].Amount.Amount, models.Decimal("610458895930.79"))
 	require.Equal(t, model.Balances[2].Amount.Currency, "USD")
 	require.Equal(t, model.Balances[2].CreditDebitIndicator, models.Credit)
 	require.NotNil(t, model.Balances[2].DateTime)
-	require.Equal(t, model.TransactionsSummary[0].TotalNetEntryAmount, 279595877422.72)
+	require.Equal(t, model.TransactionsSummary[0].TotalNetEntryAmount, models.Decimal("279595877422.72"))
 	require.Equal(t, model.TransactionsSummary[0].CreditDebitIndicator, models.Credit)
 	require.Equal(t, model.TransactionsSummary[0].CreditEntries.NumberOfEntries, "16281")
-	require.Equal(t, model.TransactionsSummary[0].CreditEntries.Sum, 420780358976.96)
+	require.Equal(t, model.TransactionsSummary[0].CreditEntries.Sum, models.Decimal("420780358976.96"))
 	require.Equal(t, model.TransactionsSummary[0].DebitEntries.NumberOfEntries, "22134")
-	require.Equal(t, model.TransactionsSummary[0].DebitEntries.Sum, 141184481554.24)
+	require.Equal(t, model.TransactionsSummary[0].DebitEntries.Sum, models.Decimal("141184481554.24"))
 	require.Equal(t, model.TransactionsSummary[0].BankTransactionCode, models.FedwireFundsTransfers)
 	require.NotNil(t, model.TransactionsSummary[0].Date)
-	require.Equal(t, model.TransactionsSummary[1].TotalNetEntryAmount, 608598873.60)
+	require.Equal(t, model.TransactionsSummary[1].TotalNetEntryAmount, models.Decimal("608598873.6"))
 	require.Equal(t, model.TransactionsSummary[1].CreditDebitIndicator, models.Credit)
 	require.Equal(t, model.TransactionsSummary[1].CreditEntries.NumberOfEntries, "4")
-	require.Equal(t, model.TransactionsSummary[1].CreditEntries.Sum, 993425694.01)
+	require.Equal(t, model.TransactionsSummary[1].CreditEntries.Sum, models.Decimal("993425694.01"))
 	require.Equal(t, model.TransactionsSummary[1].DebitEntries.NumberOfEntries, "6")
-	require.Equal(t, model.TransactionsSummary[1].DebitEntries.Sum, 384826820.41)
+	require.Equal(t, model.TransactionsSummary[1].DebitEntries.Sum, models.Decimal("384826820.41"))
 	require.Equal(t, model.TransactionsSummary[1].BankTransactionCode, models.NationalSettlementServiceEntries)
 	require.NotNil(t, model.TransactionsSummary[1].Date)
 
@@ -547,59 +547,59 @@ func TestVersion08(t *testing.T) {
 	require.Equal(t, model.AccountType, "M")
 	require.Equal(t, model.RelatedAccountOtherId, "231981435")
 	require.Equal(t, model.Balances[0].BalanceTypeId, models.DaylightOverdraftBalance)
-	require.Equal(t, model.Balances[0].Amount.Amount, 270458895930.79)
+	require.Equal(t, model.Balances[0].Amount.Amount, models.Decimal("270458895930.79"))
 	require.Equal(t, model.Balances[0].Amount.Currency, "USD")
 	require.Equal(t, model.Balances[0].CreditDebitIndicator, models.Credit)
 	require.NotNil(t, model.Balances[0].DateTime)
 	require.Equal(t, model.Balances[1].BalanceTypeId, models.AccountBalance)
 	require.Equal(t, model.Balances[1].CdtLines[0].Included, true)
 	require.Equal(t, model.Balances[1].CdtLines[0].Type, models.NetDebitCap)
-	require.Equal(t, model.Balances[1].CdtLines[0].Amount.Amount, 23125500000.00)
+	require.Equal(t, model.Balances[1].CdtLines[0].Amount.Amount, models.Decimal("23125500000.00"))
 	require.Equal(t, model.Balances[1].CdtLines[0].Amount.Currency, "USD")
 	require.NotNil(t, model.Balances[1].CdtLines[0].DateTime)
 	require.Equal(t, model.Balances[1].CdtLines[1].Included, true)
 	require.Equal(t, model.Balances[1].CdtLines[1].Type, models.CollateralizedCapacity)
-	require.Equal(t, model.Balances[1].CdtLines[1].Amount.Amount, 316874500000.00)
+	require.Equal(t, model.Balances[1].CdtLines[1].Amount.Amount, models.Decimal("316874500000.00"))
 	require.Equal(t, model.Balances[1].CdtLines[1].Amount.Currency, "USD")
 	require.NotNil(t, model.Balances[1].CdtLines[1].DateTime)
 	require.Equal(t, model.Balances[1].CdtLines[2].Included, true)
 	require.Equal(t, model.Balances[1].CdtLines[2].Type, models.CollateralAvailable)
-	require.Equal(t, model.Balances[1].CdtLines[2].Amount.Amount, 82598573368.44)
+	require.Equal(t, model.Balances[1].CdtLines[2].Amount.Amount, models.Decimal("82598573368.44"))
 	require.Equal(t, model.Balances[1].CdtLines[2].Amount.Currency, "USD")
 	require.NotNil(t, model.Balances[1].CdtLines[2].DateTime)
 	require.Equal(t, model.Balances[1].CdtLines[3].Included, true)
 	require.Equal(t, model.Balances[1].CdtLines[3].Type, models.CollateralizedDaylightOverdrafts)
-	require.Equal(t, model.Balances[1].CdtLines[3].Amount.Amount, 0.00)
+	require.Equal(t, model.Balances[1].CdtLines[3].Amount.Amount, models.Decimal("0.00"))
 	require.Equal(t, model.Balances[1].CdtLines[3].Amount.Currency, "USD")
 	require.NotNil(t, model.Balances[1].CdtLines[3].DateTime)
 	require.Equal(t, model.Balances[1].CdtLines[4].Included, true)
 	require.Equal(t, model.Balances[1].CdtLines[4].Type, models.UncollateralizedDaylightOverdrafts)
-	require.Equal(t, model.Balances[1].CdtLines[4].Amount.Amount, 0.00)
+	require.Equal(t, model.Balances[1].CdtLines[4].Amount.Amount, models.Decimal("0.00"))
 	require.Equal(t, model.Balances[1].CdtLines[4].Amount.Currency, "USD")
 	require.NotNil(t, model.Balances[1].CdtLines[4].DateTime)
-	require.Equal(t, model.Balances[1].Amount.Amount, 270594506052.13)
+	require.Equal(t, model.Balances[1].Amount.Amount, models.Decimal("270594506052.13"))
 	require.Equal(t, model.Balances[1].Amount.Currency, "USD")
 	require.Equal(t, model.Balances[1].CreditDebitIndicator, models.Credit)
 	require.NotNil(t, model.Balances[1].DateTime)
 	require.Equal(t, model.Balances[2].BalanceTypeId, models.AvailableBalanceFromDaylightOverdraft)
-	require.Equal(t, model.Balances[2].Amount.Amount, 610458895930.79)
+	require.Equal(t, model.Balances[2].Amount.Amount, models.Decimal("610458895930.79"))
 	require.Equal(t, model.Balances[2].Amount.Currency, "USD")
 	require.Equal(t, model.Balances[2].CreditDebitIndicator, models.Credit)
 	require.NotNil(t, model.Balances[2].DateTime)
-	require.Equal(t, model.TransactionsSummary[0].TotalNetEntryAmount, 279595877422.72)
+	require.Equal(t, model.TransactionsSummary[0].TotalNetEntryAmount, models.Decimal("279595877422.72"))
 	require.Equal(t, model.TransactionsSummary[0].CreditDebitIndicator, models.Credit)
 	require.Equal(t, model.TransactionsSummary[0].CreditEntries.NumberOfEntries, "16281")
-	require.Equal(t, model.TransactionsSummary[0].CreditEntries.Sum, 420780358976.96)
+	require.Equal(t, model.TransactionsSummary[0].CreditEntries.Sum, models.Decimal("420780358976.96"))
 	require.Equal(t, model.TransactionsSummary[0].DebitEntries.NumberOfEntries, "22134")
-	require.Equal(t, model.TransactionsSummary[0].DebitEntries.Sum, 141184481554.24)
+	require.Equal(t, model.TransactionsSummary[0].DebitEntries.Sum, models.Decimal("141184481554.24"))
 	require.Equal(t, model.TransactionsSummary[0].BankTransactionCode, models.FedwireFundsTransfers)
 	require.NotNil(t, model.TransactionsSummary[0].Date)
-	require.Equal(t, model.TransactionsSummary[1].TotalNetEntryAmount, 608598873.60)
+	require.Equal(t, model.TransactionsSummary[1].TotalNetEntryAmount, models.Decimal("608598873.6"))
 	require.Equal(t, model.TransactionsSummary[1].CreditDebitIndicator, models.Credit)
 	require.Equal(t, model.TransactionsSummary[1].CreditEntries.NumberOfEntries, "4")
-	require.Equal(t, model.TransactionsSummary[1].CreditEntries.Sum, 993425694.01)
+	require.Equal(t, model.TransactionsSummary[1].CreditEntries.Sum, models.Decimal("993425694.01"))
 	require.Equal(t, model.TransactionsSummary[1].DebitEntries.NumberOfEntries, "6")
-	require.Equal(t, model.TransactionsSummary[1].DebitEntries.Sum, 384826820.41)
+	require.Equal(t, model.TransactionsSummary[1].DebitEntries.Sum, models.Decimal("384826820.41"))
 	require.Equal(t, model.TransactionsSummary[1].BankTransactionCode, models.NationalSettlementServiceEntries)
 	require.NotNil(t, model.TransactionsSummary[1].Date)
 
@@ -658,59 +658,59 @@ func TestVersion09(t *testing.T) {
 	require.Equal(t, model.AccountType, "M")
 	require.Equal(t, model.RelatedAccountOtherId, "231981435")
 	require.Equal(t, model.Balances[0].BalanceTypeId, models.DaylightOverdraftBalance)
-	require.Equal(t, model.Balances[0].Amount.Amount, 270458895930.79)
+	require.Equal(t, model.Balances[0].Amount.Amount, models.Decimal("270458895930.79"))
 	require.Equal(t, model.Balances[0].Amount.Currency, "USD")
 	require.Equal(t, model.Balances[0].CreditDebitIndicator, models.Credit)
 	require.NotNil(t, model.Balances[0].DateTime)
 	require.Equal(t, model.Balances[1].BalanceTypeId, models.AccountBalance)
 	require.Equal(t, model.Balances[1].CdtLines[0].Included, true)
 	require.Equal(t, model.Balances[1].CdtLines[0].Type, models.NetDebitCap)
-	require.Equal(t, model.Balances[1].CdtLines[0].Amount.Amount, 23125500000.00)
+	require.Equal(t, model.Balances[1].CdtLines[0].Amount.Amount, models.Decimal("23125500000.00"))
 	require.Equal(t, model.Balances[1].CdtLines[0].Amount.Currency, "USD")
 	require.NotNil(t, model.Balances[1].CdtLines[0].DateTime)
 	require.Equal(t, model.Balances[1].CdtLines[1].Included, true)
 	require.Equal(t, model.Balances[1].CdtLines[1].Type, models.CollateralizedCapacity)
-	require.Equal(t, model.Balances[1].CdtLines[1].Amount.Amount, 316874500000.00)
+	require.Equal(t, model.Balances[1].CdtLines[1].Amount.Amount, models.Decimal("316874500000.00"))
 	require.Equal(t, model.Balances[1].CdtLines[1].Amount.Currency, "USD")
 	require.NotNil(t, model.Balances[1].CdtLines[1].DateTime)
 	require.Equal(t, model.Balances[1].CdtLines[2].Included, true)
 	require.Equal(t, model.Balances[1].CdtLines[2].Type, models.CollateralAvailable)
-	require.Equal(t, model.Balances[1].CdtLines[2].Amount.Amount, 82598573368.44)
+	require.Equal(t, model.Balances[1].CdtLines[2].Amount.Amount, models.Decimal("82598573368.44"))
 	require.Equal(t, model.Balances[1].CdtLines[2].Amount.Currency, "USD")
 	require.NotNil(t, model.Balances[1].CdtLines[2].DateTime)
 	require.Equal(t, model.Balances[1].CdtLines[3].Included, true)
 	require.Equal(t, model.Balances[1].CdtLines[3].Type, models.CollateralizedDaylightOverdrafts)
-	require.Equal(t, model.Balances[1].CdtLines[3].Amount.Amount, 0.00)
+	require.Equal(t, model.Balances[1].CdtLines[3].Amount.Amount, models.Decimal("0.00"))
 	require.Equal(t, model.Balances[1].CdtLines[3].Amount.Currency, "USD")
 	require.NotNil(t, model.Balances[1].CdtLines[3].DateTime)
 	require.Equal(t, model.Balances[1].CdtLines[4].Included, true)
 	require.Equal(t, model.Balances[1].CdtLines[4].Type, models.UncollateralizedDaylightOverdrafts)
-	require.Equal(t, model.Balances[1].CdtLines[4].Amount.Amount, 0.00)
+	require.Equal(t, model.Balances[1].CdtLines[4].Amount.Amount, models.Decimal("0.00"))
 	require.Equal(t, model.Balances[1].CdtLines[4].Amount.Currency, "USD")
 	require.NotNil(t, model.Balances[1].CdtLines[4].DateTime)
-	require.Equal(t, model.Balances[1].Amount.Amount, 270594506052.13)
+	require.Equal(t, model.Balances[1].Amount.Amount, models.Decimal("270594506052.13"))
 	require.Equal(t, model.Balances[1].Amount.Currency, "USD")
 	require.Equal(t, model.Balances[1].CreditDebitIndicator, models.Credit)
 	require.NotNil(t, model.Balances[1].DateTime)
 	require.Equal(t, model.Balances[2].BalanceTypeId, models.AvailableBalanceFromDaylightOverdraft)
-	require.Equal(t, model.Balances[2].Amount.Amount, 610458895930.79)
+	require.Equal(t, model.Balances[2].Amount.Amount, models.Decimal("610458895930.79"))
 	require.Equal(t, model.Balances[2].Amount.Currency, "USD")
 	require.Equal(t, model.Balances[2].CreditDebitIndicator, models.Credit)
 	require.NotNil(t, model.Balances[2].DateTime)
-	require.Equal(t, model.TransactionsSummary[0].TotalNetEntryAmount, 279595877422.72)
+	require.Equal(t, model.TransactionsSummary[0].TotalNetEntryAmount, models.Decimal("279595877422.72"))
 	require.Equal(t, model.TransactionsSummary[0].CreditDebitIndicator, models.Credit)
 	require.Equal(t, model.TransactionsSummary[0].CreditEntries.NumberOfEntries, "16281")
-	require.Equal(t, model.TransactionsSummary[0].CreditEntries.Sum, 420780358976.96)
+	require.Equal(t, model.TransactionsSummary[0].CreditEntries.Sum, models.Decimal("420780358976.96"))
 	require.Equal(t, model.TransactionsSummary[0].DebitEntries.NumberOfEntries, "22134")
-	require.Equal(t, model.TransactionsSummary[0].DebitEntries.Sum, 141184481554.24)
+	require.Equal(t, model.TransactionsSummary[0].DebitEntries.Sum, models.Decimal("141184481554.24"))
 	require.Equal(t, model.TransactionsSummary[0].BankTransactionCode, models.FedwireFundsTransfers)
 	require.NotNil(t, model.TransactionsSummary[0].Date)
-	require.Equal(t, model.TransactionsSummary[1].TotalNetEntryAmount, 608598873.60)
+	require.Equal(t, model.TransactionsSummary[1].TotalNetEntryAmount, models.Decimal("608598873.6"))
 	require.Equal(t, model.TransactionsSummary[1].CreditDebitIndicator, models.Credit)
 	require.Equal(t, model.TransactionsSummary[1].CreditEntries.NumberOfEntries, "4")
-	require.Equal(t, model.TransactionsSummary[1].CreditEntries.Sum, 993425694.01)
+	require.Equal(t, model.TransactionsSummary[1].CreditEntries.Sum, models.Decimal("993425694.01"))
 	require.Equal(t, model.TransactionsSummary[1].DebitEntries.NumberOfEntries, "6")
-	require.Equal(t, model.TransactionsSummary[1].DebitEntries.Sum, 384826820.41)
+	require.Equal(t, model.TransactionsSummary[1].DebitEntries.Sum, models.Decimal("384826820.41"))
 	require.Equal(t, model.TransactionsSummary[1].BankTransactionCode, models.NationalSettlementServiceEntries)
 	require.NotNil(t, model.TransactionsSummary[1].Date)
 
@@ -769,59 +769,59 @@ func TestVersion10(t *testing.T) {
 	require.Equal(t, model.AccountType, "M")
 	require.Equal(t, model.RelatedAccountOtherId, "231981435")
 	require.Equal(t, model.Balances[0].BalanceTypeId, models.DaylightOverdraftBalance)
-	require.Equal(t, model.Balances[0].Amount.Amount, 270458895930.79)
+	require.Equal(t, model.Balances[0].Amount.Amount, models.Decimal("270458895930.79"))
 	require.Equal(t, model.Balances[0].Amount.Currency, "USD")
 	require.Equal(t, model.Balances[0].CreditDebitIndicator, models.Credit)
 	require.NotNil(t, model.Balances[0].DateTime)
 	require.Equal(t, model.Balances[1].BalanceTypeId, models.AccountBalance)
 	require.Equal(t, model.Balances[1].CdtLines[0].Included, true)
 	require.Equal(t, model.Balances[1].CdtLines[0].Type, models.NetDebitCap)
-	require.Equal(t, model.Balances[1].CdtLines[0].Amount.Amount, 23125500000.00)
+	require.Equal(t, model.Balances[1].CdtLines[0].Amount.Amount, models.Decimal("23125500000.00"))
 	require.Equal(t, model.Balances[1].CdtLines[0].Amount.Currency, "USD")
 	require.NotNil(t, model.Balances[1].CdtLines[0].DateTime)
 	require.Equal(t, model.Balances[1].CdtLines[1].Included, true)
 	require.Equal(t, model.Balances[1].CdtLines[1].Type, models.CollateralizedCapacity)
-	require.Equal(t, model.Balances[1].CdtLines[1].Amount.Amount, 316874500000.00)
+	require.Equal(t, model.Balances[1].CdtLines[1].Amount.Amount, models.Decimal("316874500000.00"))
 	require.Equal(t, model.Balances[1].CdtLines[1].Amount.Currency, "USD")
 	require.NotNil(t, model.Balances[1].CdtLines[1].DateTime)
 	require.Equal(t, model.Balances[1].CdtLines[2].Included, true)
 	require.Equal(t, model.Balances[1].CdtLines[2].Type, models.CollateralAvailable)
-	require.Equal(t, model.Balances[1].CdtLines[2].Amount.Amount, 82598573368.44)
+	require.Equal(t, model.Balances[1].CdtLines[2].Amount.Amount, models.Decimal("82598573368.44"))
 	require.Equal(t, model.Balances[1].CdtLines[2].Amount.Currency, "USD")
 	require.NotNil(t, model.Balances[1].CdtLines[2].DateTime)
 	require.Equal(t, model.Balances[1].CdtLines[3].Included, true)
 	require.Equal(t, model.Balances[1].CdtLines[3].Type, models.CollateralizedDaylightOverdrafts)
-	require.Equal(t, model.Balances[1].CdtLines[3].Amount.Amount, 0.00)
+	require.Equal(t, model.Balances[1].CdtLines[3].Amount.Amount, models.Decimal("0.00"))
 	require.Equal(t, model.Balances[1].CdtLines[3].Amount.Currency, "USD")
 	require.NotNil(t, model.Balances[1].CdtLines[3].DateTime)
 	require.Equal(t, model.Balances[1].CdtLines[4].Included, true)
 	require.Equal(t, model.Balances[1].CdtLines[4].Type, models.UncollateralizedDaylightOverdrafts)
-	require.Equal(t, model.Balances[1].CdtLines[4].Amount.Amount, 0.00)
+	require.Equal(t, model.Balances[1].CdtLines[4].Amount.Amount, models.Decimal("0.00"))
 	require.Equal(t, model.Balances[1].CdtLines[4].Amount.Currency, "USD")
 	require.NotNil(t, model.Balances[1].CdtLines[4].DateTime)
-	require.Equal(t, model.Balances[1].Amount.Amount, 270594506052.13)
+	require.Equal(t, model.Balances[1].Amount.Amount, models.Decimal("270594506052.13"))
 	require.Equal(t, model.Balances[1].Amount.Currency, "USD")
 	require.Equal(t, model.Balances[1].CreditDebitIndicator, models.Credit)
 	require.NotNil(t, model.Balances[1].DateTime)
 	require.Equal(t, model.Balances[2].BalanceTypeId, models.AvailableBalanceFromDaylightOverdraft)
-	require.Equal(t, model.Balances[2].Amount.Amount, 610458895930.79)
+	require.Equal(t, model.Balances[2].Amount.Amount, models.Decimal("610458895930.79"))
 	require.Equal(t, model.Balances[2].Amount.Currency, "USD")
 	require.Equal(t, model.Balances[2].CreditDebitIndicator, models.Credit)
 	require.NotNil(t, model.Balances[2].DateTime)
-	require.Equal(t, model.TransactionsSummary[0].TotalNetEntryAmount, 279595877422.72)
+	require.Equal(t, model.TransactionsSummary[0].TotalNetEntryAmount, models.Decimal("279595877422.72"))
 	require.Equal(t, model.TransactionsSummary[0].CreditDebitIndicator, models.Credit)
 	require.Equal(t, model.TransactionsSummary[0].CreditEntries.NumberOfEntries, "16281")
-	require.Equal(t, model.TransactionsSummary[0].CreditEntries.Sum, 420780358976.96)
+	require.Equal(t, model.TransactionsSummary[0].CreditEntries.Sum, models.Decimal("420780358976.96"))
 	require.Equal(t, model.TransactionsSummary[0].DebitEntries.NumberOfEntries, "22134")
-	require.Equal(t, model.TransactionsSummary[0].DebitEntries.Sum, 141184481554.24)
+	require.Equal(t, model.TransactionsSummary[0].DebitEntries.Sum, models.Decimal("141184481554.24"))
 	require.Equal(t, model.TransactionsSummary[0].BankTransactionCode, models.FedwireFundsTransfers)
 	require.NotNil(t, model.TransactionsSummary[0].Date)
-	require.Equal(t, model.TransactionsSummary[1].TotalNetEntryAmount, 608598873.60)
+	require.Equal(t, model.TransactionsSummary[1].TotalNetEntryAmount, models.Decimal("608598873.6"))
 	require.Equal(t, model.TransactionsSummary[1].CreditDebitIndicator, models.Credit)
 	require.Equal(t, model.TransactionsSummary[1].CreditEntries.NumberOfEntries, "4")
-	require.Equal(t, model.TransactionsSummary[1].CreditEntries.Sum, 993425694.01)
+	require.Equal(t, model.TransactionsSummary[1].CreditEntries.Sum, models.Decimal("993425694.01"))
 	require.Equal(t, model.TransactionsSummary[1].DebitEntries.NumberOfEntries, "6")
-	require.Equal(t, model.TransactionsSummary[1].DebitEntries.Sum, 384826820.41)
+	require.Equal(t, model.TransactionsSummary[1].DebitEntries.Sum, models.Decimal("384826820.41"))
 	require.Equal(t, model.TransactionsSummary[1].BankTransactionCode, models.NationalSettlementServiceEntries)
 	require.NotNil(t, model.TransactionsSummary[1].Date)
 
@@ -880,59 +880,59 @@ func TestVersion11(t *testing.T) {
 	require.Equal(t, model.AccountType, "M")
 	require.Equal(t, model.RelatedAccountOtherId, "231981435")
 	require.Equal(t, model.Balances[0].BalanceTypeId, models.DaylightOverdraftBalance)
-	require.Equal(t, model.Balances[0].Amount.Amount, 270458895930.79)
+	require.Equal(t, model.Balances[0].Amount.Amount, models.Decimal("270458895930.79"))
 	require.Equal(t, model.Balances[0].Amount.Currency, "USD")
 	require.Equal(t, model.Balances[0].CreditDebitIndicator, models.Credit)
 	require.NotNil(t, model.Balances[0].DateTime)
 	require.Equal(t, model.Balances[1].BalanceTypeId, models.AccountBalance)
 	require.Equal(t, model.Balances[1].CdtLines[0].Included, true)
 	require.Equal(t, model.Balances[1].CdtLines[0].Type, models.NetDebitCap)
-	require.Equal(t, model.Balances[1].CdtLines[0].Amount.Amount, 23125500000.00)
+	require.Equal(t, model.Balances[1].CdtLines[0].Amount.Amount, models.Decimal("23125500000.00"))
 	require.Equal(t, model.Balances[1].CdtLines[0].Amount.Currency, "USD")
 	require.NotNil(t, model.Balances[1].CdtLines[0].DateTime)
 	require.Equal(t, model.Balances[1].CdtLines[1].Included, true)
 	require.Equal(t, model.Balances[1].CdtLines[1].Type, models.CollateralizedCapacity)
-	require.Equal(t, model.Balances[1].CdtLines[1].Amount.Amount, 316874500000.00)
+	require.Equal(t, model.Balances[1].CdtLines[1].Amount.Amount, models.Decimal("316874500000.00"))
 	require.Equal(t, model.Balances[1].CdtLines[1].Amount.Currency, "USD")
 	require.NotNil(t, model.Balances[1].CdtLines[1].DateTime)
 	require.Equal(t, model.Balances[1].CdtLines[2].Included, true)
 	require.Equal(t, model.Balances[1].CdtLines[2].Type, models.CollateralAvailable)
-	require.Equal(t, model.Balances[1].CdtLines[2].Amount.Amount, 82598573368.44)
+	require.Equal(t, model.Balances[1].CdtLines[2].Amount.Amount, models.Decimal("82598573368.44"))
 	require.Equal(t, model.Balances[1].CdtLines[2].Amount.Currency, "USD")
 	require.NotNil(t, model.Balances[1].CdtLines[2].DateTime)
 	require.Equal(t, model.Balances[1].CdtLines[3].Included, true)
 	require.Equal(t, model.Balances[1].CdtLines[3].Type, models.CollateralizedDaylightOverdrafts)
-	require.Equal(t, model.Balances[1].CdtLines[3].Amount.Amount, 0.00)
+	require.Equal(t, model.Balances[1].CdtLines[3].Amount.Amount, models.Decimal("0.00"))
 	require.Equal(t, model.Balances[1].CdtLines[3].Amount.Currency, "USD")
 	require.NotNil(t, model.Balances[1].CdtLines[3].DateTime)
 	require.Equal(t, model.Balances[1].CdtLines[4].Included, true)
 	require.Equal(t, model.Balances[1].CdtLines[4].Type, models.UncollateralizedDaylightOverdrafts)
-	require.Equal(t, model.Balances[1].CdtLines[4].Amount.Amount, 0.00)
+	require.Equal(t, model.Balances[1].CdtLines[4].Amount.Amount, models.Decimal("0.00"))
 	require.Equal(t, model.Balances[1].CdtLines[4].Amount.Currency, "USD")
 	require.NotNil(t, model.Balances[1].CdtLines[4].DateTime)
-	require.Equal(t, model.Balances[1].Amount.Amount, 270594506052.13)
+	require.Equal(t, model.Balances[1].Amount.Amount, models.Decimal("270594506052.13"))
 	require.Equal(t, model.Balances[1].Amount.Currency, "USD")
 	require.Equal(t, model.Balances[1].CreditDebitIndicator, models.Credit)
 	require.NotNil(t, model.Balances[1].DateTime)
 	require.Equal(t, model.Balances[2].BalanceTypeId, models.AvailableBalanceFromDaylightOverdraft)
-	require.Equal(t, model.Balances[2].Amount.Amount, 610458895930.79)
+	require.Equal(t, model.Balances[2].Amount.Amount, models.Decimal("610458895930.79"))
 	require.Equal(t, model.Balances[2].Amount.Currency, "USD")
 	require.Equal(t, model.Balances[2].CreditDebitIndicator, models.Credit)
 	require.NotNil(t, model.Balances[2].DateTime)
-	require.Equal(t, model.TransactionsSummary[0].TotalNetEntryAmount, 279595877422.72)
+	require.Equal(t, model.TransactionsSummary[0].TotalNetEntryAmount, models.Decimal("279595877422.72"))
 	require.Equal(t, model.TransactionsSummary[0].CreditDebitIndicator, models.Credit)
 	require.Equal(t, model.TransactionsSummary[0].CreditEntries.NumberOfEntries, "16281")
-	require.Equal(t, model.TransactionsSummary[0].CreditEntries.Sum, 420780358976.96)
+	require.Equal(t, model.TransactionsSummary[0].CreditEntries.Sum, models.Decimal("420780358976.96"))
 	require.Equal(t, model.TransactionsSummary[0].DebitEntries.NumberOfEntries, "22134")
-	require.Equal(t, model.TransactionsSummary[0].DebitEntries.Sum, 141184481554.24)
+	require.Equal(t, model.TransactionsSummary[0].DebitEntries.Sum, models.Decimal("141184481554.24"))
 	require.Equal(t, model.TransactionsSummary[0].BankTransactionCode, models.FedwireFundsTransfers)
 	require.NotNil(t, model.TransactionsSummary[0].Date)
-	require.Equal(t, model.TransactionsSummary[1].TotalNetEntryAmount, 608598873.60)
+	require.Equal(t, model.TransactionsSummary[1].TotalNetEntryAmount, models.Decimal("608598873.6"))
 	require.Equal(t, model.TransactionsSummary[1].CreditDebitIndicator, models.Credit)
 	require.Equal(t, model.TransactionsSummary[1].CreditEntries.NumberOfEntries, "4")
-	require.Equal(t, model.TransactionsSummary[1].CreditEntries.Sum, 993425694.01)
+	require.Equal(t, model.TransactionsSummary[1].CreditEntries.Sum, models.Decimal("993425694.01"))
 	require.Equal(t, model.TransactionsSummary[1].DebitEntries.NumberOfEntries, "6")
-	require.Equal(t, model.TransactionsSummary[1].DebitEntries.Sum, 384826820.41)
+	require.Equal(t, model.TransactionsSummary[1].DebitEntries.Sum, models.Decimal("384826820.41"))
 	require.Equal(t, model.TransactionsSummary[1].BankTransactionCode, models.NationalSettlementServiceEntries)
 	require.NotNil(t, model.TransactionsSummary[1].Date)
 
@@ -991,59 +991,59 @@ func TestVersion12(t *testing.T) {
 	require.Equal(t, model.AccountType, "M")
 	require.Equal(t, model.RelatedAccountOtherId, "231981435")
 	require.Equal(t, model.Balances[0].BalanceTypeId, models.DaylightOverdraftBalance)
-	require.Equal(t, model.Balances[0].Amount.Amount, 270458895930.79)
+	require.Equal(t, model.Balances[0].Amount.Amount, models.Decimal("270458895930.79"))
 	require.Equal(t, model.Balances[0].Amount.Currency, "USD")
 	require.Equal(t, model.Balances[0].CreditDebitIndicator, models.Credit)
 	require.NotNil(t, model.Balances[0].DateTime)
 	require.Equal(t, model.Balances[1].BalanceTypeId, models.AccountBalance)
 	require.Equal(t, model.Balances[1].CdtLines[0].Included, true)
 	require.Equal(t, model.Balances[1].CdtLines[0].Type, models.NetDebitCap)
-	require.Equal(t, model.Balances[1].CdtLines[0].Amount.Amount, 23125500000.00)
+	require.Equal(t, model.Balances[1].CdtLines[0].Amount.Amount, models.Decimal("23125500000.00"))
 	require.Equal(t, model.Balances[1].CdtLines[0].Amount.Currency, "USD")
 	require.NotNil(t, model.Balances[1].CdtLines[0].DateTime)
 	require.Equal(t, model.Balances[1].CdtLines[1].Included, true)
 	require.Equal(t, model.Balances[1].CdtLines[1].Type, models.CollateralizedCapacity)
-	require.Equal(t, model.Balances[1].CdtLines[1].Amount.Amount, 316874500000.00)
+	require.Equal(t, model.Balances[1].CdtLines[1].Amount.Amount, models.Decimal("316874500000.00"))
 	require.Equal(t, model.Balances[1].CdtLines[1].Amount.Currency, "USD")
 	require.NotNil(t, model.Balances[1].CdtLines[1].DateTime)
 	require.Equal(t, model.Balances[1].CdtLines[2].Included, true)
 	require.Equal(t, model.Balances[1].CdtLines[2].Type, models.CollateralAvailable)
-	require.Equal(t, model.Balances[1].CdtLines[2].Amount.Amount, 82598573368.44)
+	require.Equal(t, model.Balances[1].CdtLines[2].Amount.Amount, models.Decimal("82598573368.44"))
 	require.Equal(t, model.Balances[1].CdtLines[2].Amount.Currency, "USD")
 	require.NotNil(t, model.Balances[1].CdtLines[2].DateTime)
 	require.Equal(t, model.Balances[1].CdtLines[3].Included, true)
 	require.Equal(t, model.Balances[1].CdtLines[3].Type, models.CollateralizedDaylightOverdrafts)
-	require.Equal(t, model.Balances[1].CdtLines[3].Amount.Amount, 0.00)
+	require.Equal(t, model.Balances[1].CdtLines[3].Amount.Amount, models.Decimal("0.00"))
 	require.Equal(t, model.Balances[1].CdtLines[3].Amount.Currency, "USD")
 	require.NotNil(t, model.Balances[1].CdtLines[3].DateTime)
 	require.Equal(t, model.Balances[1].CdtLines[4].Included, true)
 	require.Equal(t, model.Balances[1].CdtLines[4].Type, models.UncollateralizedDaylightOverdrafts)
-	require.Equal(t, model.Balances[1].CdtLines[4].Amount.Amount, 0.00)
+	require.Equal(t, model.Balances[1].CdtLines[4].Amount.Amount, models.Decimal("0.00"))
 	require.Equal(t, model.Balances[1].CdtLines[4].Amount.Currency, "USD")
 	require.NotNil(t, model.Balances[1].CdtLines[4].DateTime)
-	require.Equal(t, model.Balances[1].Amount.Amount, 270594506052.13)
+	require.Equal(t, model.Balances[1].Amount.Amount, models.Decimal("270594506052.13"))
 	require.Equal(t, model.Balances[1].Amount.Currency, "USD")
 	require.Equal(t, model.Balances[1].CreditDebitIndicator, models.Credit)
 	require.NotNil(t, model.Balances[1].DateTime)
 	require.Equal(t, model.Balances[2].BalanceTypeId, models.AvailableBalanceFromDaylightOverdraft)
-	require.Equal(t, model.Balances[2].Amount.Amount, 610458895930.79)
+	require.Equal(t, model.Balances[2].Amount.Amount, models.Decimal("610458895930.79"))
 	require.Equal(t, model.Balances[2].Amount.Currency, "USD")
 	require.Equal(t, model.Balances[2].CreditDebitIndicator, models.Credit)
 	require.NotNil(t, model.Balances[2].DateTime)
-	require.Equal(t, model.TransactionsSummary[0].TotalNetEntryAmount, 279595877422.72)
+	require.Equal(t, model.TransactionsSummary[0].TotalNetEntryAmount, models.Decimal("279595877422.72"))
 	require.Equal(t, model.TransactionsSummary[0].CreditDebitIndicator, models.Credit)
 	require.Equal(t, model.TransactionsSummary[0].CreditEntries.NumberOfEntries, "16281")
-	require.Equal(t, model.TransactionsSummary[0].CreditEntries.Sum, 420780358976.96)
+	require.Equal(t, model.TransactionsSummary[0].CreditEntries.Sum, models.Decimal("420780358976.96"))
 	require.Equal(t, model.TransactionsSummary[0].DebitEntries.NumberOfEntries, "22134")
-	require.Equal(t, model.TransactionsSummary[0].DebitEntries.Sum, 141184481554.24)
+	require.Equal(t, model.TransactionsSummary[0].DebitEntries.Sum, models.Decimal("141184481554.24"))
 	require.Equal(t, model.TransactionsSummary[0].BankTransactionCode, models.FedwireFundsTransfers)
 	require.NotNil(t, model.TransactionsSummary[0].Date)
-	require.Equal(t, model.TransactionsSummary[1].TotalNetEntryAmount, 608598873.60)
+	require.Equal(t, model.TransactionsSummary[1].TotalNetEntryAmount, models.Decimal("608598873.6"))
 	require.Equal(t, model.TransactionsSummary[1].CreditDebitIndicator, models.Credit)
 	require.Equal(t, model.TransactionsSummary[1].CreditEntries.NumberOfEntries, "4")
-	require.Equal(t, model.TransactionsSummary[1].CreditEntries.Sum, 993425694.01)
+	require.Equal(t, model.TransactionsSummary[1].CreditEntries.Sum, models.Decimal("993425694.01"))
 	require.Equal(t, model.TransactionsSummary[1].DebitEntries.NumberOfEntries, "6")
-	require.Equal(t, model.TransactionsSummary[1].DebitEntries.Sum, 384826820.41)
+	require.Equal(t, model.TransactionsSummary[1].DebitEntries.Sum, models.Decimal("384826820.41"))
 	require.Equal(t, model.TransactionsSummary[1].BankTransactionCode, models.NationalSettlementServiceEntries)
 	require.NotNil(t, model.TransactionsSummary[1].Date)
 
@@ -1085,7 +1085,7 @@ func MasterDataModel() MessageModel {
 		{
 			BalanceTypeId: models.DaylightOverdraftBalance,
 			Amount: models.CurrencyAndAmount{
-				Amount:   270458895930.79,
+				Amount:   "270458895930.79",
 				Currency: "USD",
 			},
 			CreditDebitIndicator: models.Credit,
@@ -1098,7 +1098,7 @@ func MasterDataModel() MessageModel {
 					Included: true,
 					Type:     models.NetDebitCap,
 					Amount: models.CurrencyAndAmount{
-						Amount:   23125500000.00,
+						Amount:   "23125500000.00",
 						Currency: "USD",
 					},
 					DateTime: time.Now(),
@@ -1107,7 +1107,7 @@ func MasterDataModel() MessageModel {
 					Included: true,
 					Type:     models.CollateralizedCapacity,
 					Amount: models.CurrencyAndAmount{
-						Amount:   316874500000.00,
+						Amount:   "316874500000.00",
 						Currency: "USD",
 					},
 					DateTime: time.Now(),
@@ -1116,7 +1116,7 @@ func MasterDataModel() MessageModel {
 					Included: true,
 					Type:     models.CollateralAvailable,
 					Amount: models.CurrencyAndAmount{
-						Amount:   82598573368.44,
+						Amount:   "82598573368.44",
 						Currency: "USD",
 					},
 					DateTime: time.Now(),
@@ -1125,7 +1125,7 @@ func MasterDataModel() MessageModel {
 					Included: true,
 					Type:     models.CollateralizedDaylightOverdrafts,
 					Amount: models.CurrencyAndAmount{
-						Amount:   0.00,
+						Amount:   "0.00",
 						Currency: "USD",
 					},
 					DateTime: time.Now(),
@@ -1134,14 +1134,14 @@ func MasterDataModel() MessageModel {
 					Included: true,
 					Type:     models.UncollateralizedDaylightOverdrafts,
 					Amount: models.CurrencyAndAmount{
-						Amount:   0.00,
+						Amount:   "0.00",
 						Currency: "USD",
 					},
 					DateTime: time.Now(),
 				},
 			},
 			Amount: models.CurrencyAndAmount{
-				Amount:   270594506052.13,
+				Amount:   "270594506052.13",
 				Currency: "USD",
 			},
 			CreditDebitIndicator: models.Credit,
@@ -1150,7 +1150,7 @@ func MasterDataModel() MessageModel {
 		{
 			BalanceTypeId: models.AvailableBalanceFromDaylightOverdraft,
 			Amount: models.CurrencyAndAmount{
-				Amount:   610458895930.79,
+				Amount:   "610458895930.79",
 				Currency: "USD",
 			},
 			CreditDebitIndicator: models.Credit,
@@ -1159,29 +1159,29 @@ func MasterDataModel() MessageModel {
 	}
 	message.TransactionsSummary = []models.TotalsPerBankTransaction{
 		{
-			TotalNetEntryAmount:  279595877422.72,
+			TotalNetEntryAmount:  "279595877422.72",
 			CreditDebitIndicator: models.Credit,
 			CreditEntries: models.NumberAndSumOfTransactions{
 				NumberOfEntries: "16281",
-				Sum:             420780358976.96,
+				Sum:             "420780358976.96",
 			},
 			DebitEntries: models.NumberAndSumOfTransactions{
 				NumberOfEntries: "22134",
-				Sum:             141184481554.24,
+				Sum:             "141184481554.24",
 			},
 			BankTransactionCode: models.FedwireFundsTransfers,
 			Date:                time.Now(),
 		},
 		{
-			TotalNetEntryAmount:  608598873.60,
+			TotalNetEntryAmount:  "608598873.60",
 			CreditDebitIndicator: models.Credit,
 			CreditEntries: models.NumberAndSumOfTransactions{
 				NumberOfEntries: "4",
-				Sum:             993425694.01,
+				Sum:             "993425694.01",
 			},
 			DebitEntries: models.NumberAndSumOfTransactions{
 				NumberOfEntries: "6",
-				Sum:             384826820.41,
+				Sum:             "384826820.41",
 			},
 			BankTransactionCode: models.NationalSettlementServiceEntries,
 			Date:                time.Now(),
@@ -0,0 +1,320 @@
+package MessageReject
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/moov-io/wire20022/pkg/base"
+	"github.com/moov-io/wire20022/pkg/models"
+	"github.com/moov-io/wire20022/pkg/models/BusinessApplicationHeader"
+	"github.com/moov-io/wire20022/pkg/models/MessageReject/admi_002_001_01"
+)
+
+// NewMessageForVersion creates a MessageModel with appropriate version-specific fields initialized
+func NewMessageForVersion(version ADMI_002_001_VERSION) MessageModel {
+	model := MessageModel{
+		// Core fields initialized to zero values
+	}
+
+	// No version-specific fields for MessageReject - single version message
+
+	return model
+}
+
+// ValidateForVersion performs type-safe validation for a specific version
+func (m MessageModel) ValidateForVersion(version ADMI_002_001_VERSION) error {
+	// Base field validation (always required)
+	if err := m.validateCoreFields(); err != nil {
+		return fmt.Errorf("core field validation failed: %w", err)
+	}
+
+	// No version-specific validation needed - single version message
+
+	return nil
+}
+
+// validateCoreFields checks required core fields present in all versions
+func (m MessageModel) validateCoreFields() error {
+	// Direct field access - compile-time verified, no reflection
+	if m.RelationReference == "" {
+		return fmt.Errorf("RelationReference is required")
+	}
+	if m.RejectingPartyReason == "" {
+		return fmt.Errorf("RejectingPartyReason is required")
+	}
+	if m.RejectionDateTime.IsZero() {
+		return fmt.Errorf("RejectionDateTime is required")
+	}
+	return nil
+}
+
+// GetVersionCapabilities returns which version-specific features are available
+func (m MessageModel) GetVersionCapabilities() map[string]bool {
+	// MessageReject has no version-specific features
+	return map[string]bool{}
+}
+
+// MessageModel represents an admi.002 message reject, which Fedwire sends when a message
+// fails schema or header validation
+type MessageModel struct {
+	// Reference of the rejected message, usually its MsgId
+	RelationReference string `json:"relationReference"`
+	// Reason code given by the rejecting party
+	RejectingPartyReason string `json:"rejectingPartyReason"`
+	// Date and time at which the message was rejected
+	RejectionDateTime time.Time `json:"rejectionDateTime"`
+	// Free-form description of the rejection
+	ReasonDescription string `json:"reasonDescription"`
+
+	// Optional business application header, written and read next to the Document
+	AppHdr *BusinessApplicationHeader.MessageModel `json:"appHdr,omitempty"`
+
+	// Document content not covered by the path map, kept when parsed with base.PreserveUnmapped
+	Unmapped *models.Unmapped `json:"unmapped,omitempty"`
+
+	// Version of the document the message was parsed from; empty for messages built in code
+	ParsedVersion ADMI_002_001_VERSION `json:"parsedVersion,omitempty"`
+
+	// Values Fedwire would refuse that the document held, which WriteXML writes back as read
+	ReadValues models.ReadValues `json:"-"`
+}
+
+var RequiredFields = []string{
+	"RelationReference", "RejectingPartyReason", "RejectionDateTime",
+}
+
+// Global processor instance using the base abstraction
+var processor *base.MessageProcessor[MessageModel, ADMI_002_001_VERSION]
+
+// init sets up the processor using base abstractions
+func init() {
+	// Register version using factory registration pattern
+	registrations := []base.FactoryRegistration[models.ISODocument, ADMI_002_001_VERSION]{
+		{
+			Namespace: "urn:iso:std:iso:20022:tech:xsd:admi.002.001.01",
+			Version:   ADMI_002_001_01,
+			Factory: func() models.ISODocument {
+				return &admi_002_001_01.Document{XMLName: xml.Name{Space: VersionNameSpaceMap[ADMI_002_001_01], Local: "Document"}}
+			},
+		},
+	}
+
+	versionedFactory := base.BuildFactoryFromRegistrations(registrations)
+
+	// Create the processor using base abstractions
+	processor = base.NewMessageProcessor[MessageModel, ADMI_002_001_VERSION](
+		versionedFactory.BuildNameSpaceModelMap(),
+		versionedFactory.GetVersionMap(),
+		VersionPathMap,
+		RequiredFields,
+	)
+	processor.SetMessageFactory(NewMessageForVersion)
+}
+
+// ParseXML reads XML data into the MessageModel
+// This is the primary function for parsing XML from byte data
+// An AppHdr sent ahead of the Document is read into the AppHdr field
+// Pass base.PreserveUnmapped() to keep elements the model does not cover for WriteXML
+func ParseXML(data []byte, opts ...base.ParseOption) (*MessageModel, error) {
+	appHdr, data, err := BusinessApplicationHeader.Split(data)
+	if err != nil {
+		return nil, err
+	}
+
+	model, version, unmapped, err := processor.ParseMessage(data, opts...)
+	if err != nil {
+		return nil, err
+	}
+	model.AppHdr = appHdr
+	model.Unmapped = unmapped
+	model.ParsedVersion = version
+	model.ReadValues = models.NewReadValues(&model)
+	return &model, nil
+}
+
+// DocumentWith creates a versioned ISO 20022 document from the MessageModel.
+// This is a lower-level API that returns the raw document structure for advanced use cases.
+//
+// When to use DocumentWith vs WriteXML:
+//   - Use WriteXML for standard XML output to files, network connections, or buffers
+//   - Use DocumentWith when you need to:
+//   - Inspect or modify the document structure before serialization
+//   - Integrate with other XML processing libraries
+//   - Perform custom validation on the document level
+//   - Access version-specific document types directly
+//
+// Example:
+//
+//	doc, err := MessageReject.DocumentWith(model, MessageReject.ADMI_002_001_01)
+//	if err != nil {
+//	    return err
+//	}
+//	// Now you can inspect or modify doc before serializing
+//	xmlBytes, err := xml.Marshal(doc)
+func DocumentWith(model MessageModel, version ADMI_002_001_VERSION) (models.ISODocument, error) {
+	// Validate required fields before creating document
+	if err := processor.ValidateRequiredFields(model); err != nil {
+		return nil, err
+	}
+	return processor.CreateDocumentWith(model, version, model.Unmapped, model.ReadValues)
+}
+
+// EncodeDocument writes a document DocumentWith created from the MessageModel, with each amount
+// in the digits of its Decimal rather than those of the document's binary number
+func EncodeDocument(encoder *xml.Encoder, doc models.ISODocument, model MessageModel, version ADMI_002_001_VERSION) error {
+	return processor.EncodeDocument(encoder, doc, model, version)
+}
+
+// ReadXML reads XML data from an io.Reader into the MessageModel
+func (m *MessageModel) ReadXML(r io.Reader, opts ...base.ParseOption) error {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return fmt.Errorf("reading XML: %w", err)
+	}
+
+	model, err := ParseXML(data, opts...)
+	if err != nil {
+		return err
+	}
+
+	*m = *model
+	return nil
+}
+
+// WriteXML writes the MessageModel as XML to an io.Writer.
+// This is the primary method for XML serialization and handles the complete XML generation process.
+//
+// Features:
+//   - Writes XML declaration (<?xml version="1.0" encoding="UTF-8"?>)
+//   - Properly formatted with indentation
+//   - Automatic namespace handling
+//   - Validates required fields before writing
+//   - Defaults to the version the message was parsed from, else the latest, if not specified
+//   - Writes the AppHdr ahead of the Document when set, with MsgDefIdr matching the version
+//
+// Example:
+//
+//	// Write to file
+//	file, _ := os.Create("reject.xml")
+//	defer file.Close()
+//	err := model.WriteXML(file, MessageReject.ADMI_002_001_01)
+//
+//	// Write to buffer
+//	var buf bytes.Buffer
+//	err := model.WriteXML(&buf)
+//
+// For advanced use cases requiring document inspection before serialization, see DocumentWith.
+func (m *MessageModel) WriteXML(w io.Writer, version ...ADMI_002_001_VERSION) error {
+	// Default to the version the message was read in, else latest
+	ver := m.documentVersion()
+	if len(version) > 0 {
+		ver = version[0]
+	}
+
+	// Create versioned document
+	doc, err := DocumentWith(*m, ver)
+	if err != nil {
+		return fmt.Errorf("creating document: %w", err)
+	}
+
+	// Write XML with proper formatting
+	encoder := xml.NewEncoder(w)
+	defer encoder.Close()
+	encoder.Indent("", "  ")
+
+	// Write XML declaration
+	if _, err := w.Write([]byte(xml.Header)); err != nil {
+		return fmt.Errorf("writing XML header: %w", err)
+	}
+
+	// Write the business application header, if any, ahead of the document
+	if err := BusinessApplicationHeader.Encode(encoder, m.AppHdr, VersionNameSpaceMap[ver]); err != nil {
+		return err
+	}
+
+	// Encode document
+	if err := processor.EncodeDocument(encoder, doc, *m, ver); err != nil {
+		return fmt.Errorf("encoding XML: %w", err)
+	}
+
+	return encoder.Flush()
+}
+
+// GetMessageId returns the business message identifier of the AppHdr, as admi.002 carries no MsgId
+func (m *MessageModel) GetMessageId() string {
+	if m.AppHdr == nil {
+		return ""
+	}
+	return m.AppHdr.BusinessMessageId
+}
+
+// CreatedAt returns when the message was rejected (RjctnDtTm)
+func (m *MessageModel) CreatedAt() time.Time {
+	return m.RejectionDateTime
+}
+
+// ISOMessageName returns the ISO 20022 message the model represents, "admi.002"
+func (m *MessageModel) ISOMessageName() string {
+	return "admi.002"
+}
+
+// Version returns the version WriteDocument writes: the one the message was parsed from or its
+// AppHdr names, otherwise ADMI_002_001_01
+func (m *MessageModel) Version() string {
+	return string(m.documentVersion())
+}
+
+// Validate checks the required fields, the values models.ValidateValues covers and the rules of
+// the message's Version
+func (m *MessageModel) Validate() error {
+	if err := CheckRequiredFields(*m); err != nil {
+		return err
+	}
+	if err := models.ValidateValues(*m); err != nil {
+		return err
+	}
+	return m.ValidateForVersion(m.documentVersion())
+}
+
+// WriteDocument writes the message like WriteXML, in its Version
+func (m *MessageModel) WriteDocument(w io.Writer) error {
+	return m.WriteXML(w, m.documentVersion())
+}
+
+// documentVersion returns the version WriteXML writes when none is given
+func (m *MessageModel) documentVersion() ADMI_002_001_VERSION {
+	if m.ParsedVersion != "" {
+		return m.ParsedVersion
+	}
+	var msgDefIdr string
+	if m.AppHdr != nil {
+		msgDefIdr = m.AppHdr.MessageDefinitionId
+	}
+	return processor.DocumentVersion(m.Unmapped, msgDefIdr, ADMI_002_001_01)
+}
+
+// ConvertToVersion returns a copy of the message for another version of admi.002, e.g. for a
+// counterparty on an older release. Values the version has no element for are dropped and the
+// field groups it needs are added; the report lists both. The message is left unchanged.
+func (m *MessageModel) ConvertToVersion(version ADMI_002_001_VERSION) (*MessageModel, *base.ConversionReport, error) {
+	converted, report, err := processor.ConvertMessage(*m, m.documentVersion(), version)
+	if err != nil {
+		return nil, nil, err
+	}
+	namespace := VersionNameSpaceMap[version]
+	if converted.Unmapped, err = processor.CarryUnmapped(report, converted.Unmapped, version); err != nil {
+		return nil, nil, err
+	}
+	if converted.AppHdr != nil {
+		converted.AppHdr.MessageDefinitionId = BusinessApplicationHeader.MessageDefinitionIdFor(namespace)
+	}
+	converted.ParsedVersion = version
+	return &converted, report, nil
+}
+
+// CheckRequiredFields uses base abstractions to replace 20+ lines with a single call
+func CheckRequiredFields(model MessageModel) error {
+	return processor.ValidateRequiredFields(model)
+}
@@ -0,0 +1,39 @@
+package MessageReject
+
+import "github.com/moov-io/wire20022/pkg/models"
+
+type MessageHelper struct {
+	RelationReference    models.ElementHelper
+	RejectingPartyReason models.ElementHelper
+	RejectionDateTime    models.ElementHelper
+	ReasonDescription    models.ElementHelper
+}
+
+func BuildMessageHelper() MessageHelper {
+	return MessageHelper{
+		RelationReference: models.ElementHelper{
+			Title:         "Relation Reference",
+			Rules:         "",
+			Type:          `Max35Text (based on string) minLength: 1 maxLength: 35`,
+			Documentation: `Reference of the message that is being rejected, usually its message identification.`,
+		},
+		RejectingPartyReason: models.ElementHelper{
+			Title:         "Rejecting Party Reason",
+			Rules:         "",
+			Type:          `Max35Text (based on string) minLength: 1 maxLength: 35`,
+			Documentation: `Reason code given by the rejecting party for the rejection.`,
+		},
+		RejectionDateTime: models.ElementHelper{
+			Title:         "Rejection Date Time",
+			Rules:         "",
+			Type:          `ISODateTime`,
+			Documentation: `Date and time at which the rejection was issued.`,
+		},
+		ReasonDescription: models.ElementHelper{
+			Title:         "Reason Description",
+			Rules:         "",
+			Type:          `Max350Text (based on string) minLength: 1 maxLength: 350`,
+			Documentation: `Further details on the rejection reason.`,
+		},
+	}
+}
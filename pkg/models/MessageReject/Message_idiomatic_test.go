@@ -0,0 +1,55 @@
+package MessageReject
+
+import (
+	"bytes"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestReadXML tests the idiomatic ReadXML method
+func TestReadXML(t *testing.T) {
+	xmlFile, err := os.Open("./swiftSample/MessageReject_Scenario1_Step2_admi.002")
+	require.NoError(t, err)
+	defer xmlFile.Close()
+
+	var model MessageModel
+	err = model.ReadXML(xmlFile)
+	assert.NoError(t, err)
+	assert.Equal(t, "20250310B1QDRCQR000701", model.RelationReference)
+	assert.NotEmpty(t, model.ReasonDescription)
+}
+
+// TestWriteXML tests the idiomatic WriteXML method
+func TestWriteXML(t *testing.T) {
+	model := MessageRejectDataModel()
+
+	var buf bytes.Buffer
+	err := model.WriteXML(&buf)
+	require.NoError(t, err)
+
+	xmlOutput := buf.String()
+	assert.Contains(t, xmlOutput, "<?xml")
+	assert.Contains(t, xmlOutput, "urn:iso:std:iso:20022:tech:xsd:admi.002.001.01")
+	assert.Contains(t, xmlOutput, model.RelationReference)
+
+	var parsedModel MessageModel
+	err = parsedModel.ReadXML(strings.NewReader(xmlOutput))
+	require.NoError(t, err)
+	assert.Equal(t, model.RelationReference, parsedModel.RelationReference)
+	assert.Equal(t, model.RejectingPartyReason, parsedModel.RejectingPartyReason)
+	assert.Equal(t, model.ReasonDescription, parsedModel.ReasonDescription)
+}
+
+// TestWriteXMLWithInvalidModel tests that required fields are enforced before writing
+func TestWriteXMLWithInvalidModel(t *testing.T) {
+	model := MessageModel{}
+
+	var buf bytes.Buffer
+	err := model.WriteXML(&buf)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "creating document")
+}
@@ -0,0 +1,70 @@
+package MessageReject
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/moov-io/wire20022/pkg/models"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDocumentToModel01(t *testing.T) {
+	var sampleXML = filepath.Join("swiftSample", "MessageReject_Scenario1_Step2_admi.002")
+	var xmlData, err = models.ReadXMLFile(sampleXML)
+	require.NoError(t, err, "Failed to read XML file")
+
+	model, err := ParseXML(xmlData)
+	if err != nil {
+		t.Fatal(err)
+	}
+	require.NoError(t, err, "Failed to make XML structure")
+	require.Equal(t, model.RelationReference, "20250310B1QDRCQR000701")
+	require.Equal(t, model.RejectingPartyReason, "TD02")
+	require.False(t, model.RejectionDateTime.IsZero())
+	require.Equal(t, model.ReasonDescription, "Message failed schema validation: unexpected element IntrBkSttlmAmt in CdtTrfTxInf.")
+
+	sampleXML = filepath.Join("swiftSample", "MessageReject_Scenario2_Step3_admi.002")
+	xmlData, err = models.ReadXMLFile(sampleXML)
+	require.NoError(t, err, "Failed to read XML file")
+
+	model, err = ParseXML(xmlData)
+	require.NoError(t, err, "Failed to make XML structure")
+	require.Equal(t, model.RelationReference, "20250310B1QDRCQR000702")
+	require.Equal(t, model.RejectingPartyReason, "TD03")
+}
+
+// Test helper functions for better coverage
+func TestHelperFunctions(t *testing.T) {
+	t.Run("NewMessageForVersion", func(t *testing.T) {
+		model := NewMessageForVersion(ADMI_002_001_01)
+		require.NotNil(t, model)
+		// Basic model should have zero values
+		require.Empty(t, model.RelationReference)
+	})
+
+	t.Run("ValidateForVersion", func(t *testing.T) {
+		model := MessageModel{}
+		err := model.ValidateForVersion(ADMI_002_001_01)
+		require.Error(t, err) // Should fail validation with empty model
+		require.Contains(t, err.Error(), "RelationReference")
+
+		require.NoError(t, MessageRejectDataModel().ValidateForVersion(ADMI_002_001_01))
+	})
+
+	t.Run("GetVersionCapabilities", func(t *testing.T) {
+		model := MessageModel{}
+		capabilities := model.GetVersionCapabilities()
+		require.Empty(t, capabilities) // MessageReject has no version-specific features
+	})
+
+	t.Run("CheckRequiredFields", func(t *testing.T) {
+		model := MessageModel{}
+		err := CheckRequiredFields(model)
+		require.Error(t, err) // Should fail with empty model
+	})
+
+	t.Run("BuildMessageHelper", func(t *testing.T) {
+		helper := BuildMessageHelper()
+		require.Equal(t, "Rejecting Party Reason", helper.RejectingPartyReason.Title)
+	})
+}
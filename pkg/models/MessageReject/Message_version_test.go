@@ -0,0 +1,73 @@
+package MessageReject
+
+import (
+	"encoding/xml"
+	"testing"
+	"time"
+
+	"github.com/moov-io/wire20022/pkg/models"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVersion01(t *testing.T) {
+	modelName := ADMI_002_001_01
+	xmlName := "MessageReject_01.xml"
+
+	dataModel := MessageRejectDataModel()
+	/*Create Document from Model*/
+	var doc01, err = DocumentWith(dataModel, modelName)
+	require.NoError(t, err, "Failed to create document")
+	/*Validate Check for created Document*/
+	vErr := doc01.Validate()
+	require.NoError(t, vErr, "Failed to validate document")
+	/*Create XML file from Document*/
+	xmlData, err := xml.MarshalIndent(doc01, "", "  ")
+	require.NoError(t, err)
+	require.Contains(t, string(xmlData), "<admi.002.001.01")
+	err = models.WriteXMLToGenerate(xmlName, xmlData)
+	require.NoError(t, err)
+
+	/*Create Date Model from XML (Read XML)*/
+	var xmlDoc, xmlErr = models.ReadXMLFile("./generated/" + xmlName)
+	require.NoError(t, xmlErr, "Failed to read XML file")
+
+	/*Compare*/
+	model, err := ParseXML(xmlDoc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	require.NoError(t, err, "Failed to make XML structure")
+	require.Equal(t, model.RelationReference, "20250310B1QDRCQR000701")
+	require.Equal(t, model.RejectingPartyReason, "TD02")
+	require.NotNil(t, model.RejectionDateTime)
+	require.Equal(t, model.ReasonDescription, "Message failed schema validation: unexpected element IntrBkSttlmAmt in CdtTrfTxInf.")
+
+	/*Validation check*/
+	model.RelationReference = "InvalideMessageIdLength5012345678901234567890"
+	_, err = DocumentWith(*model, modelName)
+	require.NotNil(t, err, "Expected error but got nil")
+	require.Equal(t, err.Error(), "field copy MsgRjct.RltdRef.Ref failed: failed to set RelationReference: InvalideMessageIdLength5012345678901234567890 fails validation with length 45 <= required maxLength 35")
+	model.RelationReference = "20250310B1QDRCQR000701"
+
+	/*Require field check*/
+	model.RejectingPartyReason = ""
+	_, err = DocumentWith(*model, modelName)
+	require.NotNil(t, err, "Expected error but got nil")
+	require.Equal(t, err.Error(), "validation failed for field \"RejectingPartyReason\": is required: required field missing")
+	model.RejectingPartyReason = "TD02"
+
+	/*Optional description*/
+	model.ReasonDescription = ""
+	doc01, err = DocumentWith(*model, modelName)
+	require.NoError(t, err)
+	require.NoError(t, doc01.Validate())
+}
+
+func MessageRejectDataModel() MessageModel {
+	message := MessageModel{}
+	message.RelationReference = "20250310B1QDRCQR000701"
+	message.RejectingPartyReason = "TD02"
+	message.RejectionDateTime = time.Now()
+	message.ReasonDescription = "Message failed schema validation: unexpected element IntrBkSttlmAmt in CdtTrfTxInf."
+	return message
+}
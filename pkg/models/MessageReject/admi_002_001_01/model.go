@@ -0,0 +1,44 @@
+// Models for urn:iso:std:iso:20022:tech:xsd:admi.002.001.01
+//
+// github.com/moov-io/fedwire20022 does not generate admi.002, so these types are
+// written by hand from Fedwire_Funds_Service_Release_2025_MessageReject_admi_002_001_01.xsd
+// and follow the layout of the generated packages.
+package admi_002_001_01
+
+import (
+	"encoding/xml"
+
+	"github.com/moov-io/fedwire20022/pkg/fedwire"
+)
+
+// XSD Elements
+
+type Document struct {
+	XMLName xml.Name
+
+	// The schema names the message element after the message identifier itself
+	MsgRjct MessageRejectV01 `xml:"urn:iso:std:iso:20022:tech:xsd:admi.002.001.01 admi.002.001.01"`
+}
+
+// XSD ComplexType declarations
+
+type MessageReference struct {
+	Ref Max35Text `xml:"urn:iso:std:iso:20022:tech:xsd:admi.002.001.01 Ref"`
+}
+
+type MessageRejectV01 struct {
+	RltdRef MessageReference  `xml:"urn:iso:std:iso:20022:tech:xsd:admi.002.001.01 RltdRef"`
+	Rsn     RejectionReason21 `xml:"urn:iso:std:iso:20022:tech:xsd:admi.002.001.01 Rsn"`
+}
+
+type RejectionReason21 struct {
+	RjctgPtyRsn Max35Text           `xml:"urn:iso:std:iso:20022:tech:xsd:admi.002.001.01 RjctgPtyRsn"`
+	RjctnDtTm   fedwire.ISODateTime `xml:"urn:iso:std:iso:20022:tech:xsd:admi.002.001.01 RjctnDtTm"`
+	RsnDesc     *Max350Text         `xml:"urn:iso:std:iso:20022:tech:xsd:admi.002.001.01 RsnDesc,omitempty"`
+}
+
+// XSD SimpleType declarations
+
+type Max350Text string
+
+type Max35Text string
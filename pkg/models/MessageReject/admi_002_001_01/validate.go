@@ -0,0 +1,78 @@
+// Validations for urn:iso:std:iso:20022:tech:xsd:admi.002.001.01
+package admi_002_001_01
+
+import (
+	"github.com/moov-io/base"
+	"github.com/moov-io/fedwire20022/pkg/fedwire"
+)
+
+// XSD Element validations
+
+func (v Document) Validate() error {
+	var errs base.ErrorList = base.ErrorList{}
+	baseName := "Document"
+	fedwire.AddError(&errs, baseName+".MsgRjct", v.MsgRjct.Validate())
+	if errs.Empty() {
+		return nil
+	}
+	return errs
+}
+
+// XSD ComplexType validations
+
+func (v MessageReference) Validate() error {
+	var errs base.ErrorList = base.ErrorList{}
+	baseName := "MessageReference"
+	fedwire.AddError(&errs, baseName+".Ref", v.Ref.Validate())
+	if errs.Empty() {
+		return nil
+	}
+	return errs
+}
+
+func (v MessageRejectV01) Validate() error {
+	var errs base.ErrorList = base.ErrorList{}
+	baseName := "MessageRejectV01"
+	fedwire.AddError(&errs, baseName+".RltdRef", v.RltdRef.Validate())
+	fedwire.AddError(&errs, baseName+".Rsn", v.Rsn.Validate())
+	if errs.Empty() {
+		return nil
+	}
+	return errs
+}
+
+func (v RejectionReason21) Validate() error {
+	var errs base.ErrorList = base.ErrorList{}
+	baseName := "RejectionReason21"
+	fedwire.AddError(&errs, baseName+".RjctgPtyRsn", v.RjctgPtyRsn.Validate())
+	fedwire.AddError(&errs, baseName+".RjctnDtTm", v.RjctnDtTm.Validate())
+	if v.RsnDesc != nil {
+		fedwire.AddError(&errs, baseName+".RsnDesc", v.RsnDesc.Validate())
+	}
+	if errs.Empty() {
+		return nil
+	}
+	return errs
+}
+
+// XSD SimpleType validations
+
+func (v Max350Text) Validate() error {
+	if err := fedwire.ValidateMinLength(string(v), 1); err != nil {
+		return err
+	}
+	if err := fedwire.ValidateMaxLength(string(v), 350); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (v Max35Text) Validate() error {
+	if err := fedwire.ValidateMinLength(string(v), 1); err != nil {
+		return err
+	}
+	if err := fedwire.ValidateMaxLength(string(v), 35); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,10 @@
+package MessageReject
+
+func pathMapV1() map[string]any {
+	return map[string]any{
+		"MsgRjct.RltdRef.Ref":     "RelationReference",
+		"MsgRjct.Rsn.RjctgPtyRsn": "RejectingPartyReason",
+		"MsgRjct.Rsn.RjctnDtTm":   "RejectionDateTime",
+		"MsgRjct.Rsn.RsnDesc":     "ReasonDescription",
+	}
+}
@@ -0,0 +1,19 @@
+package MessageReject
+
+type ADMI_002_001_VERSION string
+
+const (
+	ADMI_002_001_01 ADMI_002_001_VERSION = "admi.002.001.01"
+)
+
+var VersionNameSpaceMap = map[ADMI_002_001_VERSION]string{
+	ADMI_002_001_01: "urn:iso:std:iso:20022:tech:xsd:admi.002.001.01",
+}
+
+var NameSpaceVersionMap = map[string]ADMI_002_001_VERSION{
+	"urn:iso:std:iso:20022:tech:xsd:admi.002.001.01": ADMI_002_001_01,
+}
+
+var VersionPathMap = map[ADMI_002_001_VERSION]map[string]any{
+	ADMI_002_001_01: pathMapV1(),
+}
@@ -3,6 +3,7 @@ package PaymentReturn
 import (
 	"bytes"
 	"encoding/json"
+	"os"
 	"strings"
 	"testing"
 	"time"
@@ -35,12 +36,12 @@ func TestReadWriteXML(t *testing.T) {
 		OriginalInstructionId:    "INST20230914001",
 		OriginalEndToEndId:       "E2E20230914001",
 		ReturnedInterbankSettlementAmount: models.CurrencyAndAmount{
-			Amount:   1000.00,
+			Amount:   "1000.00",
 			Currency: "USD",
 		},
 		InterbankSettlementDate: fedwire.ISODate(civil.DateOf(now)),
 		ReturnedInstructedAmount: models.CurrencyAndAmount{
-			Amount:   1000.00,
+			Amount:   "1000.00",
 			Currency: "USD",
 		},
 		ChargeBearer: models.ChargeBearerSLEV,
@@ -53,13 +54,13 @@ func TestReadWriteXML(t *testing.T) {
 			InstructingAgent: models.Agent{
 				BusinessIdCode:     "BANKUSNY",
 				PaymentSysCode:     "USABA",
-				PaymentSysMemberId: "123456789",
+				PaymentSysMemberId: "011104238",
 				BankName:           "Bank of New York",
 			},
 			InstructedAgent: models.Agent{
 				BusinessIdCode:     "BANKUSLA",
 				PaymentSysCode:     "USABA",
-				PaymentSysMemberId: "987654321",
+				PaymentSysMemberId: "021040078",
 				BankName:           "Bank of Los Angeles",
 			},
 		},
@@ -113,23 +114,23 @@ func TestWriteXMLVersions(t *testing.T) {
 			model.OriginalCreationDateTime = time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
 			model.OriginalInstructionId = "INST_" + string(tc.version)
 			model.ReturnedInterbankSettlementAmount = models.CurrencyAndAmount{
-				Amount:   500.00,
+				Amount:   "500.00",
 				Currency: "USD",
 			}
 			model.InterbankSettlementDate = fedwire.ISODate(civil.Date{Year: 2024, Month: 1, Day: 1})
 			model.ReturnedInstructedAmount = models.CurrencyAndAmount{
-				Amount:   500.00,
+				Amount:   "500.00",
 				Currency: "USD",
 			}
 			model.ReturnReasonInformation = models.Reason{Reason: "AC01"}
 			model.OriginalTransactionRef = models.InstrumentCTRC
 			model.InstructingAgent = models.Agent{
 				BusinessIdCode:     "BANKUSNY",
-				PaymentSysMemberId: "123456789",
+				PaymentSysMemberId: "011104238",
 			}
 			model.InstructedAgent = models.Agent{
 				BusinessIdCode:     "BANKUSLA",
-				PaymentSysMemberId: "987654321",
+				PaymentSysMemberId: "021040078",
 			}
 
 			// Verify version-specific fields are properly initialized
@@ -448,9 +449,9 @@ func TestCheckRequiredFields(t *testing.T) {
 			OriginalCreationDateTime:          time.Now().AddDate(0, 0, -1),
 			OriginalInstructionId:             "INST_REQ001",
 			OriginalEndToEndId:                "E2E_REQ001",
-			ReturnedInterbankSettlementAmount: models.CurrencyAndAmount{Amount: 100.00, Currency: "USD"},
+			ReturnedInterbankSettlementAmount: models.CurrencyAndAmount{Amount: "100.00", Currency: "USD"},
 			InterbankSettlementDate:           fedwire.ISODate(civil.DateOf(time.Now())),
-			ReturnedInstructedAmount:          models.CurrencyAndAmount{Amount: 100.00, Currency: "USD"},
+			ReturnedInstructedAmount:          models.CurrencyAndAmount{Amount: "100.00", Currency: "USD"},
 			ChargeBearer:                      models.ChargeBearerSLEV,
 			ReturnReasonInformation:           models.Reason{Reason: "AC01"},
 			OriginalTransactionRef:            models.InstrumentCTRC,
@@ -458,12 +459,12 @@ func TestCheckRequiredFields(t *testing.T) {
 				InstructingAgent: models.Agent{
 					BusinessIdCode:     "BANKUSNY",
 					PaymentSysCode:     "USABA",
-					PaymentSysMemberId: "123456789",
+					PaymentSysMemberId: "011104238",
 				},
 				InstructedAgent: models.Agent{
 					BusinessIdCode:     "BANKUSLA",
 					PaymentSysCode:     "USABA",
-					PaymentSysMemberId: "987654321",
+					PaymentSysMemberId: "021040078",
 				},
 			},
 		}
@@ -504,12 +505,12 @@ func TestJSONMarshaling(t *testing.T) {
 		OriginalCreationDateTime: time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC),
 		OriginalInstructionId:    "JSON_INST001",
 		ReturnedInterbankSettlementAmount: models.CurrencyAndAmount{
-			Amount:   750.50,
+			Amount:   "750.50",
 			Currency: "USD",
 		},
 		InterbankSettlementDate: fedwire.ISODate(civil.Date{Year: 2024, Month: 1, Day: 1}),
 		ReturnedInstructedAmount: models.CurrencyAndAmount{
-			Amount:   750.50,
+			Amount:   "750.50",
 			Currency: "USD",
 		},
 		ReturnReasonInformation: models.Reason{
@@ -521,7 +522,7 @@ func TestJSONMarshaling(t *testing.T) {
 			InstructingAgent: models.Agent{
 				BusinessIdCode:     "BANKUSNY",
 				PaymentSysCode:     "USABA",
-				PaymentSysMemberId: "123456789",
+				PaymentSysMemberId: "011104238",
 				BankName:           "Bank of New York",
 			},
 		},
@@ -657,9 +658,9 @@ func TestDocumentWithValidation(t *testing.T) {
 			OriginalCreationDateTime:          time.Now().AddDate(0, 0, -1),
 			OriginalInstructionId:             "DOC_INST001",
 			OriginalEndToEndId:                "DOC_E2E001",
-			ReturnedInterbankSettlementAmount: models.CurrencyAndAmount{Amount: 200.00, Currency: "USD"},
+			ReturnedInterbankSettlementAmount: models.CurrencyAndAmount{Amount: "200.00", Currency: "USD"},
 			InterbankSettlementDate:           fedwire.ISODate(civil.DateOf(time.Now())),
-			ReturnedInstructedAmount:          models.CurrencyAndAmount{Amount: 200.00, Currency: "USD"},
+			ReturnedInstructedAmount:          models.CurrencyAndAmount{Amount: "200.00", Currency: "USD"},
 			ChargeBearer:                      models.ChargeBearerSLEV,
 			ReturnReasonInformation:           models.Reason{Reason: "AC01"},
 			OriginalTransactionRef:            models.InstrumentCTRC,
@@ -667,12 +668,12 @@ func TestDocumentWithValidation(t *testing.T) {
 				InstructingAgent: models.Agent{
 					BusinessIdCode:     "BANKUSNY",
 					PaymentSysCode:     "USABA",
-					PaymentSysMemberId: "123456789",
+					PaymentSysMemberId: "011104238",
 				},
 				InstructedAgent: models.Agent{
 					BusinessIdCode:     "BANKUSLA",
 					PaymentSysCode:     "USABA",
-					PaymentSysMemberId: "987654321",
+					PaymentSysMemberId: "021040078",
 				},
 			},
 		}
@@ -733,13 +734,13 @@ func TestAgentPairStructures(t *testing.T) {
 				InstructingAgent: models.Agent{
 					BusinessIdCode:     "BANKUSNY",
 					PaymentSysCode:     "USABA",
-					PaymentSysMemberId: "123456789",
+					PaymentSysMemberId: "011104238",
 					BankName:           "Bank of New York",
 				},
 				InstructedAgent: models.Agent{
 					BusinessIdCode:     "BANKUSLA",
 					PaymentSysCode:     "USABA",
-					PaymentSysMemberId: "987654321",
+					PaymentSysMemberId: "021040078",
 					BankName:           "Bank of Los Angeles",
 				},
 			},
@@ -747,9 +748,9 @@ func TestAgentPairStructures(t *testing.T) {
 
 		// Verify agent fields
 		assert.Equal(t, "BANKUSNY", model.InstructingAgent.BusinessIdCode)
-		assert.Equal(t, "123456789", model.InstructingAgent.PaymentSysMemberId)
+		assert.Equal(t, "011104238", model.InstructingAgent.PaymentSysMemberId)
 		assert.Equal(t, "BANKUSLA", model.InstructedAgent.BusinessIdCode)
-		assert.Equal(t, "987654321", model.InstructedAgent.PaymentSysMemberId)
+		assert.Equal(t, "021040078", model.InstructedAgent.PaymentSysMemberId)
 	})
 }
 
@@ -758,18 +759,18 @@ func TestCurrencyAndAmountFields(t *testing.T) {
 	t.Run("Valid currency and amounts", func(t *testing.T) {
 		model := MessageModel{
 			ReturnedInterbankSettlementAmount: models.CurrencyAndAmount{
-				Amount:   1250.75,
+				Amount:   "1250.75",
 				Currency: "USD",
 			},
 			ReturnedInstructedAmount: models.CurrencyAndAmount{
-				Amount:   1250.75,
+				Amount:   "1250.75",
 				Currency: "USD",
 			},
 		}
 
-		assert.Equal(t, 1250.75, model.ReturnedInterbankSettlementAmount.Amount)
+		assert.Equal(t, models.Decimal("1250.75"), model.ReturnedInterbankSettlementAmount.Amount)
 		assert.Equal(t, "USD", model.ReturnedInterbankSettlementAmount.Currency)
-		assert.Equal(t, 1250.75, model.ReturnedInstructedAmount.Amount)
+		assert.Equal(t, models.Decimal("1250.75"), model.ReturnedInstructedAmount.Amount)
 		assert.Equal(t, "USD", model.ReturnedInstructedAmount.Currency)
 	})
 
@@ -779,7 +780,7 @@ func TestCurrencyAndAmountFields(t *testing.T) {
 		for _, currency := range currencies {
 			model := MessageModel{
 				ReturnedInterbankSettlementAmount: models.CurrencyAndAmount{
-					Amount:   100.00,
+					Amount:   "100.00",
 					Currency: currency,
 				},
 			}
@@ -848,7 +849,7 @@ func BenchmarkWriteXML(b *testing.B) {
 		OriginalMessageNameId:             "pacs.008.001.08",
 		OriginalCreationDateTime:          time.Now(),
 		OriginalInstructionId:             "BENCH_INST001",
-		ReturnedInterbankSettlementAmount: models.CurrencyAndAmount{Amount: 500.00, Currency: "USD"},
+		ReturnedInterbankSettlementAmount: models.CurrencyAndAmount{Amount: "500.00", Currency: "USD"},
 		ChargeBearer:                      models.ChargeBearerSLEV,
 	}
 
@@ -870,14 +871,14 @@ func BenchmarkParseXML(b *testing.B) {
 			<InstgAgt>
 				<FinInstnId>
 					<ClrSysMmbId>
-						<MmbId>123456789</MmbId>
+						<MmbId>011104238</MmbId>
 					</ClrSysMmbId>
 				</FinInstnId>
 			</InstgAgt>
 			<InstdAgt>
 				<FinInstnId>
 					<ClrSysMmbId>
-						<MmbId>987654321</MmbId>
+						<MmbId>021040078</MmbId>
 					</ClrSysMmbId>
 				</FinInstnId>
 			</InstdAgt>
@@ -998,3 +999,26 @@ func TestMessageHelperCompleteness(t *testing.T) {
 	assert.NotEmpty(t, helper.RtrChain.Debtor.Name.Documentation)
 	assert.NotEmpty(t, helper.RtrChain.Creditor.Name.Documentation)
 }
+
+// TestAddressLines tests that the AdrLine lines of the return chain survive a write/read round trip
+func TestAddressLines(t *testing.T) {
+	data, err := os.ReadFile("./swiftSample/PaymentReturn_Scenario1_Step4_pacs.004")
+	require.NoError(t, err)
+	model, err := ParseXML(data)
+	require.NoError(t, err)
+	model.RtrChain.Debtor.Address.AddressLine = []string{"Attn Treasury"}
+	model.RtrChain.CreditorAgent.PostalAddress.AddressLine = []string{"Wire Room", "Floor 2"}
+
+	var buf bytes.Buffer
+	require.NoError(t, model.WriteXML(&buf, PACS_004_001_10))
+	reread, err := ParseXML(buf.Bytes())
+	require.NoError(t, err)
+	assert.Equal(t, model.RtrChain.Debtor.Address, reread.RtrChain.Debtor.Address)
+	assert.Equal(t, []string{"Wire Room", "Floor 2"}, reread.RtrChain.CreditorAgent.PostalAddress.AddressLine)
+
+	// An address of free-text lines only is not compliant
+	model.RtrChain.Debtor.Address = models.PostalAddress{AddressLine: []string{"1 Desert View Street", "Palm Springs, CA 92262"}}
+	err = models.CheckAddressCompliance(model)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "RtrChain.Debtor.Address.AddressLine")
+}
@@ -30,10 +30,10 @@ func TestDocumentToModel0(t *testing.T) {
 	require.Equal(t, model.OriginalEndToEndId, "Scenario01EtoEId001")
 	require.NotNil(t, model.EnhancedTransaction)
 	require.Equal(t, model.EnhancedTransaction.OriginalUETR, "8a562c67-ca16-48ba-b074-65581be6f011")
-	require.Equal(t, model.ReturnedInterbankSettlementAmount.Amount, 151235.88)
+	require.Equal(t, model.ReturnedInterbankSettlementAmount.Amount, models.Decimal("151235.88"))
 	require.Equal(t, model.ReturnedInterbankSettlementAmount.Currency, "USD")
 	require.NotNil(t, model.InterbankSettlementDate)
-	require.Equal(t, model.ReturnedInstructedAmount.Amount, 151235.88)
+	require.Equal(t, model.ReturnedInstructedAmount.Amount, models.Decimal("151235.88"))
 	require.Equal(t, model.ReturnedInstructedAmount.Currency, "USD")
 	require.Equal(t, model.ChargeBearer, models.ChargeBearerSLEV)
 	require.Equal(t, model.InstructingAgent.PaymentSysCode, models.PaymentSysUSABA)
@@ -48,10 +48,10 @@ func TestVersion2(t *testing.T) {
 	require.NotNil(t, model.OriginalCreationDateTime)
 	require.Equal(t, model.OriginalInstructionId, "Scenario01InstrId001")
 	require.Equal(t, model.OriginalEndToEndId, "Scenario01EtoEId001")
-	require.Equal(t, model.ReturnedInterbankSettlementAmount.Amount, 151235.88)
+	require.Equal(t, model.ReturnedInterbankSettlementAmount.Amount, models.Decimal("151235.88"))
 	require.Equal(t, model.ReturnedInterbankSettlementAmount.Currency, "USD")
 	require.NotNil(t, model.InterbankSettlementDate)
-	require.Equal(t, model.ReturnedInstructedAmount.Amount, 151235.88)
+	require.Equal(t, model.ReturnedInstructedAmount.Amount, models.Decimal("151235.88"))
 	require.Equal(t, model.ReturnedInstructedAmount.Currency, "USD")
 	require.Equal(t, model.ChargeBearer, models.ChargeBearerSLEV)
 	require.Equal(t, model.InstructingAgent.PaymentSysCode, models.PaymentSysUSABA)
@@ -111,10 +111,10 @@ func TestVersion3(t *testing.T) {
 	require.NotNil(t, model.OriginalCreationDateTime)
 	require.Equal(t, model.OriginalInstructionId, "Scenario01InstrId001")
 	require.Equal(t, model.OriginalEndToEndId, "Scenario01EtoEId001")
-	require.Equal(t, model.ReturnedInterbankSettlementAmount.Amount, 151235.88)
+	require.Equal(t, model.ReturnedInterbankSettlementAmount.Amount, models.Decimal("151235.88"))
 	require.Equal(t, model.ReturnedInterbankSettlementAmount.Currency, "USD")
 	require.NotNil(t, model.InterbankSettlementDate)
-	require.Equal(t, model.ReturnedInstructedAmount.Amount, 151235.88)
+	require.Equal(t, model.ReturnedInstructedAmount.Amount, models.Decimal("151235.88"))
 	require.Equal(t, model.ReturnedInstructedAmount.Currency, "USD")
 	require.Equal(t, model.ChargeBearer, models.ChargeBearerSLEV)
 	require.Equal(t, model.InstructingAgent.PaymentSysCode, models.PaymentSysUSABA)
@@ -174,10 +174,10 @@ func TestVersion4(t *testing.T) {
 	require.NotNil(t, model.OriginalCreationDateTime)
 	require.Equal(t, model.OriginalInstructionId, "Scenario01InstrId001")
 	require.Equal(t, model.OriginalEndToEndId, "Scenario01EtoEId001")
-	require.Equal(t, model.ReturnedInterbankSettlementAmount.Amount, 151235.88)
+	require.Equal(t, model.ReturnedInterbankSettlementAmount.Amount, models.Decimal("151235.88"))
 	require.Equal(t, model.ReturnedInterbankSettlementAmount.Currency, "USD")
 	require.NotNil(t, model.InterbankSettlementDate)
-	require.Equal(t, model.ReturnedInstructedAmount.Amount, 151235.88)
+	require.Equal(t, model.ReturnedInstructedAmount.Amount, models.Decimal("151235.88"))
 	require.Equal(t, model.ReturnedInstructedAmount.Currency, "USD")
 	require.Equal(t, model.ChargeBearer, models.ChargeBearerSLEV)
 	require.Equal(t, model.InstructingAgent.PaymentSysCode, models.PaymentSysUSABA)
@@ -237,10 +237,10 @@ func TestVersion5(t *testing.T) {
 	require.NotNil(t, model.OriginalCreationDateTime)
 	require.Equal(t, model.OriginalInstructionId, "Scenario01InstrId001")
 	require.Equal(t, model.OriginalEndToEndId, "Scenario01EtoEId001")
-	require.Equal(t, model.ReturnedInterbankSettlementAmount.Amount, 151235.88)
+	require.Equal(t, model.ReturnedInterbankSettlementAmount.Amount, models.Decimal("151235.88"))
 	require.Equal(t, model.ReturnedInterbankSettlementAmount.Currency, "USD")
 	require.NotNil(t, model.InterbankSettlementDate)
-	require.Equal(t, model.ReturnedInstructedAmount.Amount, 151235.88)
+	require.Equal(t, model.ReturnedInstructedAmount.Amount, models.Decimal("151235.88"))
 	require.Equal(t, model.ReturnedInstructedAmount.Currency, "USD")
 	require.Equal(t, model.ChargeBearer, models.ChargeBearerSLEV)
 	require.Equal(t, model.InstructingAgent.PaymentSysCode, models.PaymentSysUSABA)
@@ -300,10 +300,10 @@ func TestVersion6(t *testing.T) {
 	require.NotNil(t, model.OriginalCreationDateTime)
 	require.Equal(t, model.OriginalInstructionId, "Scenario01InstrId001")
 	require.Equal(t, model.OriginalEndToEndId, "Scenario01EtoEId001")
-	require.Equal(t, model.ReturnedInterbankSettlementAmount.Amount, 151235.88)
+	require.Equal(t, model.ReturnedInterbankSettlementAmount.Amount, models.Decimal("151235.88"))
 	require.Equal(t, model.ReturnedInterbankSettlementAmount.Currency, "USD")
 	require.NotNil(t, model.InterbankSettlementDate)
-	require.Equal(t, model.ReturnedInstructedAmount.Amount, 151235.88)
+	require.Equal(t, model.ReturnedInstructedAmount.Amount, models.Decimal("151235.88"))
 	require.Equal(t, model.ReturnedInstructedAmount.Currency, "USD")
 	require.Equal(t, model.ChargeBearer, models.ChargeBearerSLEV)
 	require.Equal(t, model.InstructingAgent.PaymentSysCode, models.PaymentSysUSABA)
@@ -364,10 +364,10 @@ func TestVersion7(t *testing.T) {
 	require.NotNil(t, model.OriginalCreationDateTime)
 	require.Equal(t, model.OriginalInstructionId, "Scenario01InstrId001")
 	require.Equal(t, model.OriginalEndToEndId, "Scenario01EtoEId001")
-	require.Equal(t, model.ReturnedInterbankSettlementAmount.Amount, 151235.88)
+	require.Equal(t, model.ReturnedInterbankSettlementAmount.Amount, models.Decimal("151235.88"))
 	require.Equal(t, model.ReturnedInterbankSettlementAmount.Currency, "USD")
 	require.NotNil(t, model.InterbankSettlementDate)
-	require.Equal(t, model.ReturnedInstructedAmount.Amount, 151235.88)
+	require.Equal(t, model.ReturnedInstructedAmount.Amount, models.Decimal("151235.88"))
 	require.Equal(t, model.ReturnedInstructedAmount.Currency, "USD")
 	require.Equal(t, model.ChargeBearer, models.ChargeBearerSLEV)
 	require.Equal(t, model.InstructingAgent.PaymentSysCode, models.PaymentSysUSABA)
@@ -428,10 +428,10 @@ func TestVersion8(t *testing.T) {
 	require.NotNil(t, model.OriginalCreationDateTime)
 	require.Equal(t, model.OriginalInstructionId, "Scenario01InstrId001")
 	require.Equal(t, model.OriginalEndToEndId, "Scenario01EtoEId001")
-	require.Equal(t, model.ReturnedInterbankSettlementAmount.Amount, 151235.88)
+	require.Equal(t, model.ReturnedInterbankSettlementAmount.Amount, models.Decimal("151235.88"))
 	require.Equal(t, model.ReturnedInterbankSettlementAmount.Currency, "USD")
 	require.NotNil(t, model.InterbankSettlementDate)
-	require.Equal(t, model.ReturnedInstructedAmount.Amount, 151235.88)
+	require.Equal(t, model.ReturnedInstructedAmount.Amount, models.Decimal("151235.88"))
 	require.Equal(t, model.ReturnedInstructedAmount.Currency, "USD")
 	require.Equal(t, model.ChargeBearer, models.ChargeBearerSLEV)
 	require.Equal(t, model.InstructingAgent.PaymentSysCode, models.PaymentSysUSABA)
@@ -525,10 +525,10 @@ func TestVersion9(t *testing.T) {
 	require.Equal(t, model.OriginalEndToEndId, "Scenario01EtoEId001")
 	require.NotNil(t, model.EnhancedTransaction)
 	require.Equal(t, model.EnhancedTransaction.OriginalUETR, "8a562c67-ca16-48ba-b074-65581be6f011")
-	require.Equal(t, model.ReturnedInterbankSettlementAmount.Amount, 151235.88)
+	require.Equal(t, model.ReturnedInterbankSettlementAmount.Amount, models.Decimal("151235.88"))
 	require.Equal(t, model.ReturnedInterbankSettlementAmount.Currency, "USD")
 	require.NotNil(t, model.InterbankSettlementDate)
-	require.Equal(t, model.ReturnedInstructedAmount.Amount, 151235.88)
+	require.Equal(t, model.ReturnedInstructedAmount.Amount, models.Decimal("151235.88"))
 	require.Equal(t, model.ReturnedInstructedAmount.Currency, "USD")
 	require.Equal(t, model.ChargeBearer, models.ChargeBearerSLEV)
 	require.Equal(t, model.InstructingAgent.PaymentSysCode, models.PaymentSysUSABA)
@@ -624,10 +624,10 @@ func TestVersion10(t *testing.T) {
 	require.Equal(t, model.OriginalEndToEndId, "Scenario01EtoEId001")
 	require.NotNil(t, model.EnhancedTransaction)
 	require.Equal(t, model.EnhancedTransaction.OriginalUETR, "8a562c67-ca16-48ba-b074-65581be6f011")
-	require.Equal(t, model.ReturnedInterbankSettlementAmount.Amount, 151235.88)
+	require.Equal(t, model.ReturnedInterbankSettlementAmount.Amount, models.Decimal("151235.88"))
 	require.Equal(t, model.ReturnedInterbankSettlementAmount.Currency, "USD")
 	require.NotNil(t, model.InterbankSettlementDate)
-	require.Equal(t, model.ReturnedInstructedAmount.Amount, 151235.88)
+	require.Equal(t, model.ReturnedInstructedAmount.Amount, models.Decimal("151235.88"))
 	require.Equal(t, model.ReturnedInstructedAmount.Currency, "USD")
 	require.Equal(t, model.ChargeBearer, models.ChargeBearerSLEV)
 	require.Equal(t, model.InstructingAgent.PaymentSysCode, models.PaymentSysUSABA)
@@ -725,10 +725,10 @@ func TestVersion11(t *testing.T) {
 	require.Equal(t, model.OriginalEndToEndId, "Scenario01EtoEId001")
 	require.NotNil(t, model.EnhancedTransaction)
 	require.Equal(t, model.EnhancedTransaction.OriginalUETR, "8a562c67-ca16-48ba-b074-65581be6f011")
-	require.Equal(t, model.ReturnedInterbankSettlementAmount.Amount, 151235.88)
+	require.Equal(t, model.ReturnedInterbankSettlementAmount.Amount, models.Decimal("151235.88"))
 	require.Equal(t, model.ReturnedInterbankSettlementAmount.Currency, "USD")
 	require.NotNil(t, model.InterbankSettlementDate)
-	require.Equal(t, model.ReturnedInstructedAmount.Amount, 151235.88)
+	require.Equal(t, model.ReturnedInstructedAmount.Amount, models.Decimal("151235.88"))
 	require.Equal(t, model.ReturnedInstructedAmount.Currency, "USD")
 	require.Equal(t, model.ChargeBearer, models.ChargeBearerSLEV)
 	require.Equal(t, model.InstructingAgent.PaymentSysCode, models.PaymentSysUSABA)
@@ -826,10 +826,10 @@ func TestVersion12(t *testing.T) {
 	require.Equal(t, model.OriginalEndToEndId, "Scenario01EtoEId001")
 	require.NotNil(t, model.EnhancedTransaction)
 	require.Equal(t, model.EnhancedTransaction.OriginalUETR, "8a562c67-ca16-48ba-b074-65581be6f011")
-	require.Equal(t, model.ReturnedInterbankSettlementAmount.Amount, 151235.88)
+	require.Equal(t, model.ReturnedInterbankSettlementAmount.Amount, models.Decimal("151235.88"))
 	require.Equal(t, model.ReturnedInterbankSettlementAmount.Currency, "USD")
 	require.NotNil(t, model.InterbankSettlementDate)
-	require.Equal(t, model.ReturnedInstructedAmount.Amount, 151235.88)
+	require.Equal(t, model.ReturnedInstructedAmount.Amount, models.Decimal("151235.88"))
 	require.Equal(t, model.ReturnedInstructedAmount.Currency, "USD")
 	require.Equal(t, model.ChargeBearer, models.ChargeBearerSLEV)
 	require.Equal(t, model.InstructingAgent.PaymentSysCode, models.PaymentSysUSABA)
@@ -927,10 +927,10 @@ func TestVersion13(t *testing.T) {
 	require.Equal(t, model.OriginalEndToEndId, "Scenario01EtoEId001")
 	require.NotNil(t, model.EnhancedTransaction)
 	require.Equal(t, model.EnhancedTransaction.OriginalUETR, "8a562c67-ca16-48ba-b074-65581be6f011")
-	require.Equal(t, model.ReturnedInterbankSettlementAmount.Amount, 151235.88)
+	require.Equal(t, model.ReturnedInterbankSettlementAmount.Amount, models.Decimal("151235.88"))
 	require.Equal(t, model.ReturnedInterbankSettlementAmount.Currency, "USD")
 	require.NotNil(t, model.InterbankSettlementDate)
-	require.Equal(t, model.ReturnedInstructedAmount.Amount, 151235.88)
+	require.Equal(t, model.ReturnedInstructedAmount.Amount, models.Decimal("151235.88"))
 	require.Equal(t, model.ReturnedInstructedAmount.Currency, "USD")
 	require.Equal(t, model.ChargeBearer, models.ChargeBearerSLEV)
 	require.Equal(t, model.InstructingAgent.PaymentSysCode, models.PaymentSysUSABA)
@@ -1006,12 +1006,12 @@ func PaymentReturnDataModel() MessageModel {
 		OriginalUETR: "8a562c67-ca16-48ba-b074-65581be6f011",
 	}
 	message.ReturnedInterbankSettlementAmount = models.CurrencyAndAmount{
-		Amount:   151235.88,
+		Amount:   "151235.88",
 		Currency: "USD",
 	}
 	message.InterbankSettlementDate = fedwire.ISODate(civil.DateOf(time.Now()))
 	message.ReturnedInstructedAmount = models.CurrencyAndAmount{
-		Amount:   151235.88,
+		Amount:   "151235.88",
 		Currency: "USD",
 	}
 	message.ChargeBearer = models.ChargeBearerSLEV
@@ -1,5 +1,7 @@
 package PaymentReturn
 
+import "github.com/moov-io/wire20022/pkg/models"
+
 func pathMapV2() map[string]any {
 	return pathMapV7()
 }
@@ -99,6 +101,11 @@ func pathMapV8() map[string]any {
 		"PmtRtr.TxInf[0].RtrRsnInf[0].Rsn.Cd":                                 "ReturnReasonInformation.Reason",
 		"PmtRtr.TxInf[0].RtrRsnInf[0].AddtlInf[0]":                            "ReturnReasonInformation.AdditionalInfo",
 		"PmtRtr.TxInf[0].OrgnlTxRef.PmtTpInf.LclInstrm.Prtry":                 "OriginalTransactionRef",
+
+		"PmtRtr.TxInf[0].RtrChain.Dbtr.Pty.PstlAdr.AdrLine : RtrChain.Debtor.Address.AddressLine":                        models.ListItems,
+		"PmtRtr.TxInf[0].RtrChain.Cdtr.Pty.PstlAdr.AdrLine : RtrChain.Creditor.Address.AddressLine":                      models.ListItems,
+		"PmtRtr.TxInf[0].RtrChain.DbtrAgt.FinInstnId.PstlAdr.AdrLine : RtrChain.DebtorAgent.PostalAddress.AddressLine":   models.ListItems,
+		"PmtRtr.TxInf[0].RtrChain.CdtrAgt.FinInstnId.PstlAdr.AdrLine : RtrChain.CreditorAgent.PostalAddress.AddressLine": models.ListItems,
 	}
 }
 func pathMapV9() map[string]any {
@@ -171,6 +178,11 @@ func pathMapV9() map[string]any {
 		"PmtRtr.TxInf[0].RtrRsnInf[0].Rsn.Cd":                                 "ReturnReasonInformation.Reason",
 		"PmtRtr.TxInf[0].RtrRsnInf[0].AddtlInf[0]":                            "ReturnReasonInformation.AdditionalInfo",
 		"PmtRtr.TxInf[0].OrgnlTxRef.PmtTpInf.LclInstrm.Prtry":                 "OriginalTransactionRef",
+
+		"PmtRtr.TxInf[0].RtrChain.Dbtr.Pty.PstlAdr.AdrLine : RtrChain.Debtor.Address.AddressLine":                        models.ListItems,
+		"PmtRtr.TxInf[0].RtrChain.Cdtr.Pty.PstlAdr.AdrLine : RtrChain.Creditor.Address.AddressLine":                      models.ListItems,
+		"PmtRtr.TxInf[0].RtrChain.DbtrAgt.FinInstnId.PstlAdr.AdrLine : RtrChain.DebtorAgent.PostalAddress.AddressLine":   models.ListItems,
+		"PmtRtr.TxInf[0].RtrChain.CdtrAgt.FinInstnId.PstlAdr.AdrLine : RtrChain.CreditorAgent.PostalAddress.AddressLine": models.ListItems,
 	}
 }
 func pathMapV10() map[string]any {
@@ -245,6 +257,11 @@ func pathMapV10() map[string]any {
 		"PmtRtr.TxInf[0].RtrRsnInf[0].Rsn.Cd":                                 "ReturnReasonInformation.Reason",
 		"PmtRtr.TxInf[0].RtrRsnInf[0].AddtlInf[0]":                            "ReturnReasonInformation.AdditionalInfo",
 		"PmtRtr.TxInf[0].OrgnlTxRef.PmtTpInf.LclInstrm.Prtry":                 "OriginalTransactionRef",
+
+		"PmtRtr.TxInf[0].RtrChain.Dbtr.Pty.PstlAdr.AdrLine : RtrChain.Debtor.Address.AddressLine":                        models.ListItems,
+		"PmtRtr.TxInf[0].RtrChain.Cdtr.Pty.PstlAdr.AdrLine : RtrChain.Creditor.Address.AddressLine":                      models.ListItems,
+		"PmtRtr.TxInf[0].RtrChain.DbtrAgt.FinInstnId.PstlAdr.AdrLine : RtrChain.DebtorAgent.PostalAddress.AddressLine":   models.ListItems,
+		"PmtRtr.TxInf[0].RtrChain.CdtrAgt.FinInstnId.PstlAdr.AdrLine : RtrChain.CreditorAgent.PostalAddress.AddressLine": models.ListItems,
 	}
 }
 func pathMapV11() map[string]any {
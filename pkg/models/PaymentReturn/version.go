@@ -3,18 +3,18 @@ package PaymentReturn
 type PACS_004_001_VERSION string
 
 const (
-	PACS_004_001_02 PACS_004_001_VERSION = "PACS.004.001.02"
-	PACS_004_001_03 PACS_004_001_VERSION = "PACS.004.001.03"
-	PACS_004_001_04 PACS_004_001_VERSION = "PACS.004.001.04"
-	PACS_004_001_05 PACS_004_001_VERSION = "PACS.004.001.05"
-	PACS_004_001_06 PACS_004_001_VERSION = "PACS.004.001.06"
-	PACS_004_001_07 PACS_004_001_VERSION = "PACS.004.001.07"
-	PACS_004_001_08 PACS_004_001_VERSION = "PACS.004.001.08"
-	PACS_004_001_09 PACS_004_001_VERSION = "PACS.004.001.09"
-	PACS_004_001_10 PACS_004_001_VERSION = "PACS.004.001.10"
-	PACS_004_001_11 PACS_004_001_VERSION = "PACS.004.001.11"
-	PACS_004_001_12 PACS_004_001_VERSION = "PACS.004.001.12"
-	PACS_004_001_13 PACS_004_001_VERSION = "PACS.004.001.13"
+	PACS_004_001_02 PACS_004_001_VERSION = "pacs.004.001.02"
+	PACS_004_001_03 PACS_004_001_VERSION = "pacs.004.001.03"
+	PACS_004_001_04 PACS_004_001_VERSION = "pacs.004.001.04"
+	PACS_004_001_05 PACS_004_001_VERSION = "pacs.004.001.05"
+	PACS_004_001_06 PACS_004_001_VERSION = "pacs.004.001.06"
+	PACS_004_001_07 PACS_004_001_VERSION = "pacs.004.001.07"
+	PACS_004_001_08 PACS_004_001_VERSION = "pacs.004.001.08"
+	PACS_004_001_09 PACS_004_001_VERSION = "pacs.004.001.09"
+	PACS_004_001_10 PACS_004_001_VERSION = "pacs.004.001.10"
+	PACS_004_001_11 PACS_004_001_VERSION = "pacs.004.001.11"
+	PACS_004_001_12 PACS_004_001_VERSION = "pacs.004.001.12"
+	PACS_004_001_13 PACS_004_001_VERSION = "pacs.004.001.13"
 )
 
 var VersionNameSpaceMap = map[PACS_004_001_VERSION]string{
@@ -0,0 +1,69 @@
+package RetrievalRequest
+
+import "github.com/moov-io/wire20022/pkg/models"
+
+type MessageHelper struct {
+	MessageId             models.ElementHelper
+	CreatedDateTime       models.ElementHelper
+	RequestType           models.ElementHelper
+	BusinessDate          models.ElementHelper
+	SequenceRange         models.SequenceRangeHelper
+	OriginalMessageNameId models.ElementHelper
+	FileReference         models.ElementHelper
+	RecipientId           models.ElementHelper
+	RecipientIssuer       models.ElementHelper
+}
+
+func BuildMessageHelper() MessageHelper {
+	return MessageHelper{
+		MessageId: models.ElementHelper{
+			Title:         "Message Identification",
+			Rules:         "",
+			Type:          `Max35Text (based on string) minLength: 1 maxLength: 35`,
+			Documentation: `Point to point reference, as assigned by the sender, to unambiguously identify the message.`,
+		},
+		CreatedDateTime: models.ElementHelper{
+			Title:         "Creation Date Time",
+			Rules:         "",
+			Type:          `ISODateTime (based on dateTime)`,
+			Documentation: `Date and time at which the message was created.`,
+		},
+		RequestType: models.ElementHelper{
+			Title:         "Request Type",
+			Rules:         "",
+			Type:          `TrafficTypeFedwireFunds1 (S, R)`,
+			Documentation: `Specifies whether the messages sent (S) or received (R) by the participant are to be resent.`,
+		},
+		BusinessDate: models.ElementHelper{
+			Title:         "Business Date",
+			Rules:         "",
+			Type:          `ISODate`,
+			Documentation: `Business day of the messages that are to be resent.`,
+		},
+		SequenceRange: models.BuildSequenceRangeHelper(),
+		OriginalMessageNameId: models.ElementHelper{
+			Title:         "Original Message Name Identification",
+			Rules:         "",
+			Type:          `MessageNameIdentificationFRS1 (based on string)`,
+			Documentation: `Specifies the message name identifier of the messages that are to be resent.`,
+		},
+		FileReference: models.ElementHelper{
+			Title:         "File Reference",
+			Rules:         "",
+			Type:          `IMADOrOMADFedwireFunds1 (based on string) minLength: 22 maxLength: 34`,
+			Documentation: `Input or output message accountability data of the single message that is to be resent.`,
+		},
+		RecipientId: models.ElementHelper{
+			Title:         "Recipient Identification",
+			Rules:         "",
+			Type:          `EndpointIdentifierFedwireFunds1 (based on string) exactLength: 8`,
+			Documentation: `Endpoint identifier of the party to which the messages are to be resent.`,
+		},
+		RecipientIssuer: models.ElementHelper{
+			Title:         "Recipient Issuer",
+			Rules:         "",
+			Type:          `Max35TextFixed (NA)`,
+			Documentation: `Entity that assigns the identification.`,
+		},
+	}
+}
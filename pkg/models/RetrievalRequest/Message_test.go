@@ -0,0 +1,87 @@
+package RetrievalRequest
+
+import (
+	"path/filepath"
+	"testing"
+
+	"cloud.google.com/go/civil"
+	"github.com/moov-io/fedwire20022/pkg/fedwire"
+	"github.com/moov-io/wire20022/pkg/models"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDocumentToModel01(t *testing.T) {
+	var sampleXML = filepath.Join("swiftSample", "RetrievalRequest_Scenario1_Step1_admi.006")
+	var xmlData, err = models.ReadXMLFile(sampleXML)
+	require.NoError(t, err, "Failed to read XML file")
+
+	model, err := ParseXML(xmlData)
+	if err != nil {
+		t.Fatal(err)
+	}
+	require.NoError(t, err, "Failed to make XML structure")
+	require.Equal(t, model.MessageId, "20250310B1QDRCQR000801")
+	require.False(t, model.CreatedDateTime.IsZero())
+	require.Equal(t, model.RequestType, models.TrafficTypeSent)
+	require.Equal(t, model.BusinessDate, fedwire.ISODate(civil.Date{Year: 2025, Month: 3, Day: 10}))
+	require.Equal(t, model.SequenceRange.FromSeq, "1")
+	require.Equal(t, model.SequenceRange.ToSeq, "100")
+	require.Equal(t, model.OriginalMessageNameId, "pacs.008.001.08")
+	require.Empty(t, model.FileReference)
+	require.Equal(t, model.RecipientId, "B1QDRCQR")
+	require.Equal(t, model.RecipientIssuer, "NA")
+
+	sampleXML = filepath.Join("swiftSample", "RetrievalRequest_Scenario2_Step1_admi.006")
+	xmlData, err = models.ReadXMLFile(sampleXML)
+	require.NoError(t, err, "Failed to read XML file")
+
+	model, err = ParseXML(xmlData)
+	require.NoError(t, err, "Failed to make XML structure")
+	require.Equal(t, model.MessageId, "20250310B1QDRCQR000802")
+	require.Equal(t, model.RequestType, models.TrafficTypeReceived)
+	require.Empty(t, model.SequenceRange.FromSeq)
+	require.Equal(t, model.FileReference, "20250310QMGFT01500000103101201FT03")
+}
+
+// Test helper functions for better coverage
+func TestHelperFunctions(t *testing.T) {
+	t.Run("NewMessageForVersion", func(t *testing.T) {
+		model := NewMessageForVersion(ADMI_006_001_01)
+		require.NotNil(t, model)
+		// Basic model should have zero values
+		require.Empty(t, model.MessageId)
+	})
+
+	t.Run("ValidateForVersion", func(t *testing.T) {
+		model := MessageModel{}
+		err := model.ValidateForVersion(ADMI_006_001_01)
+		require.Error(t, err) // Should fail validation with empty model
+		require.Contains(t, err.Error(), "MessageId")
+
+		model = RetrievalRequestDataModel()
+		require.NoError(t, model.ValidateForVersion(ADMI_006_001_01))
+
+		model.SequenceRange.ToSeq = ""
+		err = model.ValidateForVersion(ADMI_006_001_01)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "SequenceRange")
+	})
+
+	t.Run("GetVersionCapabilities", func(t *testing.T) {
+		model := MessageModel{}
+		capabilities := model.GetVersionCapabilities()
+		require.Empty(t, capabilities) // RetrievalRequest has no version-specific features
+	})
+
+	t.Run("CheckRequiredFields", func(t *testing.T) {
+		model := MessageModel{}
+		err := CheckRequiredFields(model)
+		require.Error(t, err) // Should fail with empty model
+	})
+
+	t.Run("BuildMessageHelper", func(t *testing.T) {
+		helper := BuildMessageHelper()
+		require.Equal(t, "Request Type", helper.RequestType.Title)
+		require.Equal(t, "From Sequence", helper.SequenceRange.FromSeq.Title)
+	})
+}
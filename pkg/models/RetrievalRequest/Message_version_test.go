@@ -0,0 +1,99 @@
+package RetrievalRequest
+
+import (
+	"encoding/xml"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/civil"
+	"github.com/moov-io/fedwire20022/pkg/fedwire"
+	"github.com/moov-io/wire20022/pkg/models"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVersion01(t *testing.T) {
+	modelName := ADMI_006_001_01
+	xmlName := "RetrievalRequest_01.xml"
+
+	dataModel := RetrievalRequestDataModel()
+	/*Create Document from Model*/
+	var doc01, err = DocumentWith(dataModel, modelName)
+	require.NoError(t, err, "Failed to create document")
+	/*Validate Check for created Document*/
+	vErr := doc01.Validate()
+	require.NoError(t, vErr, "Failed to validate document")
+	/*Create XML file from Document*/
+	xmlData, err := xml.MarshalIndent(doc01, "", "  ")
+	require.NoError(t, err)
+	err = models.WriteXMLToGenerate(xmlName, xmlData)
+	require.NoError(t, err)
+
+	/*Create Date Model from XML (Read XML)*/
+	var xmlDoc, xmlErr = models.ReadXMLFile("./generated/" + xmlName)
+	require.NoError(t, xmlErr, "Failed to read XML file")
+
+	/*Compare*/
+	model, err := ParseXML(xmlDoc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	require.NoError(t, err, "Failed to make XML structure")
+	require.Equal(t, model.MessageId, "20250310B1QDRCQR000801")
+	require.NotNil(t, model.CreatedDateTime)
+	require.Equal(t, model.RequestType, models.TrafficTypeSent)
+	require.Equal(t, model.BusinessDate, fedwire.ISODate(civil.Date{Year: 2025, Month: 3, Day: 10}))
+	require.Equal(t, model.SequenceRange.FromSeq, "1")
+	require.Equal(t, model.SequenceRange.ToSeq, "100")
+	require.Equal(t, model.OriginalMessageNameId, "pacs.008.001.08")
+	require.Equal(t, model.RecipientId, "B1QDRCQR")
+	require.Equal(t, model.RecipientIssuer, "NA")
+
+	/*Validation check*/
+	model.MessageId = "InvalideMessageIdLength5012345678901234567890"
+	_, err = DocumentWith(*model, modelName)
+	require.NotNil(t, err, "Expected error but got nil")
+	require.Equal(t, err.Error(), "field copy RsndReq.MsgHdr.MsgId failed: failed to set MessageId: InvalideMessageIdLength5012345678901234567890 fails validation with length 45 <= required maxLength 35")
+	model.MessageId = "20250310B1QDRCQR000801"
+
+	model.SequenceRange.ToSeq = "1000000"
+	doc01, err = DocumentWith(*model, modelName)
+	require.NoError(t, err)
+	require.NotNil(t, doc01.Validate(), "Expected sequence number validation error but got nil")
+	model.SequenceRange.ToSeq = "100"
+
+	/*Require field check*/
+	model.RecipientId = ""
+	_, err = DocumentWith(*model, modelName)
+	require.NotNil(t, err, "Expected error but got nil")
+	require.Equal(t, err.Error(), "validation failed for field \"RecipientId\": is required: required field missing")
+	model.RecipientId = "B1QDRCQR"
+
+	/*Retrieval by IMAD/OMAD instead of sequence range*/
+	model.SequenceRange = models.SequenceRange{}
+	model.OriginalMessageNameId = ""
+	model.RequestType = models.TrafficTypeReceived
+	model.FileReference = "20250310QMGFT01500000103101201FT03"
+	doc01, err = DocumentWith(*model, modelName)
+	require.NoError(t, err)
+	require.NoError(t, doc01.Validate())
+	xmlData, err = xml.Marshal(doc01)
+	require.NoError(t, err)
+	require.NotContains(t, string(xmlData), "SeqRg")
+	require.Contains(t, string(xmlData), "20250310QMGFT01500000103101201FT03</FileRef>")
+}
+
+func RetrievalRequestDataModel() MessageModel {
+	message := MessageModel{}
+	message.MessageId = "20250310B1QDRCQR000801"
+	message.CreatedDateTime = time.Now()
+	message.RequestType = models.TrafficTypeSent
+	message.BusinessDate = fedwire.ISODate(civil.Date{Year: 2025, Month: 3, Day: 10})
+	message.SequenceRange = models.SequenceRange{
+		FromSeq: "1",
+		ToSeq:   "100",
+	}
+	message.OriginalMessageNameId = "pacs.008.001.08"
+	message.RecipientId = "B1QDRCQR"
+	message.RecipientIssuer = "NA"
+	return message
+}
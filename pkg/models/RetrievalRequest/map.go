@@ -0,0 +1,16 @@
+package RetrievalRequest
+
+func pathMapV1() map[string]any {
+	return map[string]any{
+		"RsndReq.MsgHdr.MsgId":                       "MessageId",
+		"RsndReq.MsgHdr.CreDtTm":                     "CreatedDateTime",
+		"RsndReq.MsgHdr.ReqTp.Prtry.Id":              "RequestType",
+		"RsndReq.RsndSchCrit.BizDt":                  "BusinessDate",
+		"RsndReq.RsndSchCrit.SeqRg.FrToSeq[0].FrSeq": "SequenceRange.FromSeq",
+		"RsndReq.RsndSchCrit.SeqRg.FrToSeq[0].ToSeq": "SequenceRange.ToSeq",
+		"RsndReq.RsndSchCrit.OrgnlMsgNmId":           "OriginalMessageNameId",
+		"RsndReq.RsndSchCrit.FileRef":                "FileReference",
+		"RsndReq.RsndSchCrit.Rcpt.Id.PrtryId.Id":     "RecipientId",
+		"RsndReq.RsndSchCrit.Rcpt.Id.PrtryId.Issr":   "RecipientIssuer",
+	}
+}
@@ -0,0 +1,19 @@
+package RetrievalRequest
+
+type ADMI_006_001_VERSION string
+
+const (
+	ADMI_006_001_01 ADMI_006_001_VERSION = "admi.006.001.01"
+)
+
+var VersionNameSpaceMap = map[ADMI_006_001_VERSION]string{
+	ADMI_006_001_01: "urn:iso:std:iso:20022:tech:xsd:admi.006.001.01",
+}
+
+var NameSpaceVersionMap = map[string]ADMI_006_001_VERSION{
+	"urn:iso:std:iso:20022:tech:xsd:admi.006.001.01": ADMI_006_001_01,
+}
+
+var VersionPathMap = map[ADMI_006_001_VERSION]map[string]any{
+	ADMI_006_001_01: pathMapV1(),
+}
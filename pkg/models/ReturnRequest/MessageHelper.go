@@ -0,0 +1,127 @@
+package ReturnRequest
+
+import "github.com/moov-io/wire20022/pkg/models"
+
+type ReasonHelper struct {
+	Originator     models.ElementHelper
+	Reason         models.ElementHelper
+	AdditionalInfo models.ElementHelper
+}
+
+func BuildReasonHelper() ReasonHelper {
+	return ReasonHelper{
+		Originator: models.ElementHelper{
+			Title:         "Originator",
+			Rules:         "",
+			Type:          `Max140Text (based on string) minLength: 1 maxLength: 140`,
+			Documentation: `Party that issues the cancellation request.`,
+		},
+		Reason: models.ElementHelper{
+			Title:         "Reason",
+			Rules:         "",
+			Type:          `ExternalCancellationReason1Code (based on string) minLength: 1 maxLength: 4`,
+			Documentation: `Specifies the reason for the cancellation, for example DUPL, FRAD or TECH.`,
+		},
+		AdditionalInfo: models.ElementHelper{
+			Title:         "Additional Info",
+			Rules:         "",
+			Type:          `Max105Text (based on string) minLength: 1 maxLength: 105`,
+			Documentation: `Further details on the cancellation request reason.`,
+		},
+	}
+}
+
+type MessageHelper struct {
+	AssignmentId                      models.ElementHelper
+	Assigner                          models.AgentHelper
+	Assignee                          models.AgentHelper
+	AssignmentCreateTime              models.ElementHelper
+	CaseId                            models.ElementHelper
+	Creator                           models.AgentHelper
+	OriginalMessageId                 models.ElementHelper
+	OriginalMessageNameId             models.ElementHelper
+	OriginalMessageCreateTime         models.ElementHelper
+	OriginalInstructionId             models.ElementHelper
+	OriginalEndToEndId                models.ElementHelper
+	OriginalTransactionId             models.ElementHelper
+	OriginalUETR                      models.ElementHelper
+	OriginalInterbankSettlementAmount models.CurrencyAndAmountHelper
+	OriginalInterbankSettlementDate   models.ElementHelper
+	CancellationReason                ReasonHelper
+}
+
+func BuildMessageHelper() MessageHelper {
+	return MessageHelper{
+		AssignmentId: models.ElementHelper{
+			Title:         "Assignment Id",
+			Rules:         "",
+			Type:          `IMADFedwireFunds1 (based on string) exactLength: 22 pattern: [0-9]{8}[A-Z0-9]{8}[0-9]{6}`,
+			Documentation: `Uniquely identifies the case assignment.`,
+		},
+		Assigner: models.BuildAgentHelper(),
+		Assignee: models.BuildAgentHelper(),
+		AssignmentCreateTime: models.ElementHelper{
+			Title:         "Assignment Create Time",
+			Rules:         "",
+			Type:          `ISODateTime`,
+			Documentation: `Date and time at which the assignment was created.`,
+		},
+		CaseId: models.ElementHelper{
+			Title:         "Case Id",
+			Rules:         "",
+			Type:          `Max35Text (based on string) minLength: 1 maxLength: 35`,
+			Documentation: `Uniquely identifies the case.`,
+		},
+		Creator: models.BuildAgentHelper(),
+		OriginalMessageId: models.ElementHelper{
+			Title:         "Original Message Id",
+			Rules:         "",
+			Type:          `Max35Text (based on string) minLength: 1 maxLength: 35`,
+			Documentation: `Point to point reference assigned by the original instructing party to unambiguously identify the original message.`,
+		},
+		OriginalMessageNameId: models.ElementHelper{
+			Title:         "Original Message Name Id",
+			Rules:         "",
+			Type:          `MessageNameIdentificationFRS1 (based on string) exactLength: 15`,
+			Documentation: `Specifies the original message name identifier to which the message refers, for example, pacs.008.001.08.`,
+		},
+		OriginalMessageCreateTime: models.ElementHelper{
+			Title:         "Original Message Create Time",
+			Rules:         "",
+			Type:          `ISODateTime`,
+			Documentation: `Original date and time at which the message was created.`,
+		},
+		OriginalInstructionId: models.ElementHelper{
+			Title:         "Original Instruction Id",
+			Rules:         "",
+			Type:          `Max35Text (based on string) minLength: 1 maxLength: 35`,
+			Documentation: `Unique identification, as assigned by the original instructing party for the original instructed party, to unambiguously identify the original instruction.`,
+		},
+		OriginalEndToEndId: models.ElementHelper{
+			Title:         "Original End To End Id",
+			Rules:         "",
+			Type:          `Max35Text (based on string) minLength: 1 maxLength: 35`,
+			Documentation: `Unique identification, as assigned by the original initiating party, to unambiguously identify the original transaction.`,
+		},
+		OriginalTransactionId: models.ElementHelper{
+			Title:         "Original Transaction Id",
+			Rules:         "",
+			Type:          `Max35Text (based on string) minLength: 1 maxLength: 35`,
+			Documentation: `Unique identification, as assigned by the original first instructing agent, to unambiguously identify the transaction.`,
+		},
+		OriginalUETR: models.ElementHelper{
+			Title:         "Original UETR",
+			Rules:         "",
+			Type:          `UUIDv4 (based on string)`,
+			Documentation: `Universally unique identifier to provide the original end-to-end reference of a payment transaction.`,
+		},
+		OriginalInterbankSettlementAmount: models.BuildCurrencyAndAmountHelper(),
+		OriginalInterbankSettlementDate: models.ElementHelper{
+			Title:         "Original Interbank Settlement Date",
+			Rules:         "",
+			Type:          `ISODate (based on string)`,
+			Documentation: `Date, as provided in the original transaction, on which the amount of money ceases to be available to the agent that owes it and when the amount of money becomes available to the agent to which it is due.`,
+		},
+		CancellationReason: BuildReasonHelper(),
+	}
+}
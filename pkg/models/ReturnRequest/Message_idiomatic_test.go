@@ -0,0 +1,73 @@
+package ReturnRequest
+
+import (
+	"bytes"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestReadXML tests the idiomatic ReadXML method
+func TestReadXML(t *testing.T) {
+	xmlFile, err := os.Open("./swiftSample/PaymentReturn_Scenario1_Step2_camt.056")
+	require.NoError(t, err)
+	defer xmlFile.Close()
+
+	var model MessageModel
+	err = model.ReadXML(xmlFile)
+	assert.NoError(t, err)
+
+	assert.Equal(t, "20250310B1QDRCQR000401", model.AssignmentId)
+	assert.Equal(t, "20250310011104238Sc01Step1MsgIdDUPL", model.CaseId)
+	assert.Equal(t, "DUPL", model.CancellationReason.Reason)
+}
+
+// TestWriteXML tests the idiomatic WriteXML method
+func TestWriteXML(t *testing.T) {
+	model := ReturnRequestDataModel()
+
+	var buf bytes.Buffer
+	err := model.WriteXML(&buf)
+	require.NoError(t, err)
+
+	xmlOutput := buf.String()
+	assert.Contains(t, xmlOutput, "<?xml version=\"1.0\" encoding=\"UTF-8\"?>")
+	assert.Contains(t, xmlOutput, "camt.056.001.08")
+	assert.Contains(t, xmlOutput, "FIToFIPmtCxlReq")
+	assert.Contains(t, xmlOutput, model.CaseId)
+
+	var parsedModel MessageModel
+	err = parsedModel.ReadXML(strings.NewReader(xmlOutput))
+	require.NoError(t, err)
+	assert.Equal(t, model.AssignmentId, parsedModel.AssignmentId)
+	assert.Equal(t, model.OriginalUETR, parsedModel.OriginalUETR)
+	assert.Equal(t, model.CancellationReason, parsedModel.CancellationReason)
+
+	// The case creator's LEI is written to Cretr/Agt/FinInstnId/LEI
+	model.Creator.LEI = "5493001KJTIIGC8Y1R12"
+	buf.Reset()
+	require.NoError(t, model.WriteXML(&buf))
+	require.NoError(t, parsedModel.ReadXML(&buf))
+	assert.Equal(t, model.Creator, parsedModel.Creator)
+}
+
+// TestWriteXMLWithInvalidModel tests that required fields are enforced before writing
+func TestWriteXMLWithInvalidModel(t *testing.T) {
+	model := MessageModel{}
+
+	var buf bytes.Buffer
+	err := model.WriteXML(&buf)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "creating document")
+	assert.Empty(t, buf.String())
+}
+
+// TestReadXMLWithErrors tests that malformed input is rejected
+func TestReadXMLWithErrors(t *testing.T) {
+	var model MessageModel
+	err := model.ReadXML(strings.NewReader("<invalid>xml"))
+	assert.Error(t, err)
+}
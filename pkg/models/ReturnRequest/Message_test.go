@@ -0,0 +1,104 @@
+package ReturnRequest
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/moov-io/wire20022/pkg/models"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDocumentToModel08(t *testing.T) {
+	var sampleXML = filepath.Join("swiftSample", "PaymentReturn_Scenario1_Step2_camt.056")
+	var xmlData, err = models.ReadXMLFile(sampleXML)
+	require.NoError(t, err, "Failed to read XML file")
+
+	model, err := ParseXML(xmlData)
+	if err != nil {
+		t.Fatal(err)
+	}
+	require.NoError(t, err, "Failed to make XML structure")
+	require.Equal(t, model.AssignmentId, "20250310B1QDRCQR000401")
+	require.Equal(t, model.Assigner.PaymentSysCode, models.PaymentSysUSABA)
+	require.Equal(t, model.Assigner.PaymentSysMemberId, "011104238")
+	require.Equal(t, model.Assignee.PaymentSysCode, models.PaymentSysUSABA)
+	require.Equal(t, model.Assignee.PaymentSysMemberId, "021040078")
+	require.NotNil(t, model.AssignmentCreateTime)
+	require.Equal(t, model.CaseId, "20250310011104238Sc01Step1MsgIdDUPL")
+	require.Equal(t, model.Creator.PaymentSysCode, models.PaymentSysUSABA)
+	require.Equal(t, model.Creator.PaymentSysMemberId, "011104238")
+	require.Equal(t, model.Creator.BankName, "Bank A")
+	require.Equal(t, model.Creator.PostalAddress.StreetName, "Avenue A")
+	require.Equal(t, model.Creator.PostalAddress.BuildingNumber, "66")
+	require.Equal(t, model.Creator.PostalAddress.PostalCode, "60532")
+	require.Equal(t, model.Creator.PostalAddress.TownName, "Lisle")
+	require.Equal(t, model.Creator.PostalAddress.Subdivision, "IL")
+	require.Equal(t, model.Creator.PostalAddress.Country, "US")
+	require.Equal(t, model.OriginalMessageId, "20250310B1QDRCQR000400")
+	require.Equal(t, model.OriginalMessageNameId, "pacs.008.001.08")
+	require.NotNil(t, model.OriginalMessageCreateTime)
+	require.Equal(t, model.OriginalInstructionId, "Scenario01InstrId001")
+	require.Equal(t, model.OriginalEndToEndId, "Scenario01EtoEId001")
+	require.Equal(t, model.OriginalUETR, "8a562c67-ca16-48ba-b074-65581be6f011")
+	require.Equal(t, model.OriginalInterbankSettlementAmount.Amount, models.Decimal("151235.88"))
+	require.Equal(t, model.OriginalInterbankSettlementAmount.Currency, "USD")
+	require.NotNil(t, model.OriginalInterbankSettlementDate)
+	require.Equal(t, model.CancellationReason.Originator, "Corporation A")
+	require.Equal(t, model.CancellationReason.Reason, "DUPL")
+	require.Equal(t, model.CancellationReason.AdditionalInfo, "Payment was sent twice in error.")
+}
+
+func TestDocumentToModel08Scenario2(t *testing.T) {
+	var sampleXML = filepath.Join("swiftSample", "PaymentReturn_Scenario2_Step2_camt.056")
+	var xmlData, err = models.ReadXMLFile(sampleXML)
+	require.NoError(t, err, "Failed to read XML file")
+
+	model, err := ParseXML(xmlData)
+	if err != nil {
+		t.Fatal(err)
+	}
+	require.NoError(t, err, "Failed to make XML structure")
+	require.Equal(t, model.AssignmentId, "20250310B1QDRCQR000421")
+	require.Equal(t, model.CaseId, "20250310011104238Sc02Step1MsgIdSVNR")
+	require.Equal(t, model.OriginalInstructionId, "Scenario02InstrId001")
+	require.Equal(t, model.OriginalEndToEndId, "Scenario02EtoEId001")
+	require.Equal(t, model.CancellationReason.Reason, "CUST")
+	require.Equal(t, model.CancellationReason.AdditionalInfo, "Corporation B did not deliver the goods and services ordered.")
+}
+
+// Test helper functions for better coverage
+func TestHelperFunctions(t *testing.T) {
+	t.Run("NewMessageForVersion", func(t *testing.T) {
+		model := NewMessageForVersion(CAMT_056_001_08)
+		require.NotNil(t, model)
+		// Basic model should have zero values
+		require.Empty(t, model.AssignmentId)
+	})
+
+	t.Run("ValidateForVersion", func(t *testing.T) {
+		model := MessageModel{}
+		err := model.ValidateForVersion(CAMT_056_001_08)
+		require.Error(t, err) // Should fail validation with empty model
+		require.Contains(t, err.Error(), "AssignmentId")
+
+		require.NoError(t, ReturnRequestDataModel().ValidateForVersion(CAMT_056_001_08))
+	})
+
+	t.Run("GetVersionCapabilities", func(t *testing.T) {
+		model := MessageModel{}
+		capabilities := model.GetVersionCapabilities()
+		require.Empty(t, capabilities) // ReturnRequest has no version-specific features
+	})
+
+	t.Run("CheckRequiredFields", func(t *testing.T) {
+		model := MessageModel{}
+		err := CheckRequiredFields(model)
+		require.Error(t, err) // Should fail with empty model
+	})
+
+	t.Run("BuildMessageHelper", func(t *testing.T) {
+		helper := BuildMessageHelper()
+		require.Equal(t, "Case Id", helper.CaseId.Title)
+		require.Equal(t, "Reason", helper.CancellationReason.Reason.Title)
+	})
+}
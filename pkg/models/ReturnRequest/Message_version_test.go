@@ -0,0 +1,139 @@
+package ReturnRequest
+
+import (
+	"encoding/xml"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/civil"
+	"github.com/moov-io/fedwire20022/pkg/fedwire"
+	"github.com/moov-io/wire20022/pkg/models"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVersion08(t *testing.T) {
+	modelName := CAMT_056_001_08
+	xmlName := "ReturnRequest_08.xml"
+
+	dataModel := ReturnRequestDataModel()
+	/*Create Document from Model*/
+	var doc08, err = DocumentWith(dataModel, modelName)
+	require.NoError(t, err, "Failed to create document")
+	/*Validate Check for created Document*/
+	vErr := doc08.Validate()
+	require.NoError(t, vErr, "Failed to validate document")
+	/*Create XML file from Document*/
+	xmlData, err := xml.MarshalIndent(doc08, "", "  ")
+	require.NoError(t, err)
+	err = models.WriteXMLToGenerate(xmlName, xmlData)
+	require.NoError(t, err)
+
+	/*Create Date Model from XML (Read XML)*/
+	var xmlDoc, xmlErr = models.ReadXMLFile("./generated/" + xmlName)
+	require.NoError(t, xmlErr, "Failed to read XML file")
+
+	/*Compare*/
+	model, err := ParseXML(xmlDoc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	require.NoError(t, err, "Failed to make XML structure")
+	require.Equal(t, model.AssignmentId, "20250310B1QDRCQR000401")
+	require.Equal(t, model.Assigner.PaymentSysCode, models.PaymentSysUSABA)
+	require.Equal(t, model.Assigner.PaymentSysMemberId, "011104238")
+	require.Equal(t, model.Assignee.PaymentSysCode, models.PaymentSysUSABA)
+	require.Equal(t, model.Assignee.PaymentSysMemberId, "021040078")
+	require.NotNil(t, model.AssignmentCreateTime)
+	require.Equal(t, model.CaseId, "20250310011104238Sc01Step1MsgIdDUPL")
+	require.Equal(t, model.Creator.PaymentSysCode, models.PaymentSysUSABA)
+	require.Equal(t, model.Creator.PaymentSysMemberId, "011104238")
+	require.Equal(t, model.Creator.BankName, "Bank A")
+	require.Equal(t, model.Creator.PostalAddress.StreetName, "Avenue A")
+	require.Equal(t, model.Creator.PostalAddress.BuildingNumber, "66")
+	require.Equal(t, model.Creator.PostalAddress.PostalCode, "60532")
+	require.Equal(t, model.Creator.PostalAddress.TownName, "Lisle")
+	require.Equal(t, model.Creator.PostalAddress.Subdivision, "IL")
+	require.Equal(t, model.Creator.PostalAddress.Country, "US")
+	require.Equal(t, model.OriginalMessageId, "20250310B1QDRCQR000400")
+	require.Equal(t, model.OriginalMessageNameId, "pacs.008.001.08")
+	require.NotNil(t, model.OriginalMessageCreateTime)
+	require.Equal(t, model.OriginalInstructionId, "Scenario01InstrId001")
+	require.Equal(t, model.OriginalEndToEndId, "Scenario01EtoEId001")
+	require.Equal(t, model.OriginalUETR, "8a562c67-ca16-48ba-b074-65581be6f011")
+	require.Equal(t, model.OriginalInterbankSettlementAmount.Amount, models.Decimal("151235.88"))
+	require.Equal(t, model.OriginalInterbankSettlementAmount.Currency, "USD")
+	require.Equal(t, model.OriginalInterbankSettlementDate, fedwire.ISODate(civil.Date{Year: 2025, Month: 3, Day: 10}))
+	require.Equal(t, model.CancellationReason.Originator, "Corporation A")
+	require.Equal(t, model.CancellationReason.Reason, "DUPL")
+	require.Equal(t, model.CancellationReason.AdditionalInfo, "Payment was sent twice in error.")
+
+	/*Validation check*/
+	model.AssignmentId = "InvalideMessageIdLength5012345678901234567890"
+	_, err = DocumentWith(*model, modelName)
+	require.NotNil(t, err, "Expected error but got nil")
+	require.Equal(t, err.Error(), "field copy FIToFIPmtCxlReq.Assgnmt.Id failed: failed to set AssignmentId: InvalideMessageIdLength5012345678901234567890 fails validation with length 45 <= required maxLength 22")
+	model.AssignmentId = "20250310B1QDRCQR000401"
+
+	model.CancellationReason.Reason = "DUPLICATE"
+	_, err = DocumentWith(*model, modelName)
+	require.NotNil(t, err, "Expected error but got nil")
+	require.Equal(t, err.Error(), "field copy FIToFIPmtCxlReq.Undrlyg.TxInf.CxlRsnInf.Rsn.Cd failed: failed to set CancellationReason.Reason: DUPLICATE fails validation with length 9 <= required maxLength 4")
+	model.CancellationReason.Reason = "DUPL"
+
+	/*Require field check*/
+	model.AssignmentId = ""
+	_, err = DocumentWith(*model, modelName)
+	require.NotNil(t, err, "Expected error but got nil")
+	require.Equal(t, err.Error(), "validation failed for field \"AssignmentId\": is required: required field missing")
+	model.AssignmentId = "20250310B1QDRCQR000401"
+
+	model.OriginalUETR = ""
+	_, err = DocumentWith(*model, modelName)
+	require.NotNil(t, err, "Expected error but got nil")
+	require.Equal(t, err.Error(), "validation failed for field \"OriginalUETR\": is required: required field missing")
+	model.OriginalUETR = "8a562c67-ca16-48ba-b074-65581be6f011"
+}
+
+func ReturnRequestDataModel() MessageModel {
+	message := MessageModel{}
+	message.AssignmentId = "20250310B1QDRCQR000401"
+	message.Assigner = models.Agent{
+		PaymentSysCode:     models.PaymentSysUSABA,
+		PaymentSysMemberId: "011104238",
+	}
+	message.Assignee = models.Agent{
+		PaymentSysCode:     models.PaymentSysUSABA,
+		PaymentSysMemberId: "021040078",
+	}
+	message.AssignmentCreateTime = time.Now()
+	message.CaseId = "20250310011104238Sc01Step1MsgIdDUPL"
+	message.Creator = models.Agent{
+		PaymentSysCode:     models.PaymentSysUSABA,
+		PaymentSysMemberId: "011104238",
+		BankName:           "Bank A",
+		PostalAddress: models.PostalAddress{
+			StreetName:     "Avenue A",
+			BuildingNumber: "66",
+			PostalCode:     "60532",
+			TownName:       "Lisle",
+			Subdivision:    "IL",
+			Country:        "US",
+		},
+	}
+	message.OriginalMessageId = "20250310B1QDRCQR000400"
+	message.OriginalMessageNameId = "pacs.008.001.08"
+	message.OriginalMessageCreateTime = time.Now()
+	message.OriginalInstructionId = "Scenario01InstrId001"
+	message.OriginalEndToEndId = "Scenario01EtoEId001"
+	message.OriginalUETR = "8a562c67-ca16-48ba-b074-65581be6f011"
+	message.OriginalInterbankSettlementAmount = models.CurrencyAndAmount{
+		Currency: "USD", Amount: "151235.88",
+	}
+	message.OriginalInterbankSettlementDate = fedwire.ISODate(civil.Date{Year: 2025, Month: 3, Day: 10})
+	message.CancellationReason = models.Reason{
+		Originator:     "Corporation A",
+		Reason:         "DUPL",
+		AdditionalInfo: "Payment was sent twice in error.",
+	}
+	return message
+}
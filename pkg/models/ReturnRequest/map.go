@@ -0,0 +1,40 @@
+package ReturnRequest
+
+func pathMapV8() map[string]any {
+	return map[string]any{
+		"FIToFIPmtCxlReq.Assgnmt.Id": "AssignmentId",
+		"FIToFIPmtCxlReq.Assgnmt.Assgnr.Agt.FinInstnId.ClrSysMmbId.ClrSysId.Cd": "Assigner.PaymentSysCode",
+		"FIToFIPmtCxlReq.Assgnmt.Assgnr.Agt.FinInstnId.ClrSysMmbId.MmbId":       "Assigner.PaymentSysMemberId",
+		"FIToFIPmtCxlReq.Assgnmt.Assgne.Agt.FinInstnId.ClrSysMmbId.ClrSysId.Cd": "Assignee.PaymentSysCode",
+		"FIToFIPmtCxlReq.Assgnmt.Assgne.Agt.FinInstnId.ClrSysMmbId.MmbId":       "Assignee.PaymentSysMemberId",
+		"FIToFIPmtCxlReq.Assgnmt.CreDtTm":                                       "AssignmentCreateTime",
+		"FIToFIPmtCxlReq.Case.Id":                                               "CaseId",
+		"FIToFIPmtCxlReq.Case.Cretr.Agt.FinInstnId.BICFI":                       "Creator.BusinessIdCode",
+		"FIToFIPmtCxlReq.Case.Cretr.Agt.FinInstnId.LEI":                         "Creator.LEI",
+		"FIToFIPmtCxlReq.Case.Cretr.Agt.FinInstnId.ClrSysMmbId.ClrSysId.Cd":     "Creator.PaymentSysCode",
+		"FIToFIPmtCxlReq.Case.Cretr.Agt.FinInstnId.ClrSysMmbId.MmbId":           "Creator.PaymentSysMemberId",
+		"FIToFIPmtCxlReq.Case.Cretr.Agt.FinInstnId.Nm":                          "Creator.BankName",
+		"FIToFIPmtCxlReq.Case.Cretr.Agt.FinInstnId.PstlAdr.StrtNm":              "Creator.PostalAddress.StreetName",
+		"FIToFIPmtCxlReq.Case.Cretr.Agt.FinInstnId.PstlAdr.BldgNb":              "Creator.PostalAddress.BuildingNumber",
+		"FIToFIPmtCxlReq.Case.Cretr.Agt.FinInstnId.PstlAdr.BldgNm":              "Creator.PostalAddress.BuildingName",
+		"FIToFIPmtCxlReq.Case.Cretr.Agt.FinInstnId.PstlAdr.Flr":                 "Creator.PostalAddress.Floor",
+		"FIToFIPmtCxlReq.Case.Cretr.Agt.FinInstnId.PstlAdr.Room":                "Creator.PostalAddress.RoomNumber",
+		"FIToFIPmtCxlReq.Case.Cretr.Agt.FinInstnId.PstlAdr.PstCd":               "Creator.PostalAddress.PostalCode",
+		"FIToFIPmtCxlReq.Case.Cretr.Agt.FinInstnId.PstlAdr.TwnNm":               "Creator.PostalAddress.TownName",
+		"FIToFIPmtCxlReq.Case.Cretr.Agt.FinInstnId.PstlAdr.CtrySubDvsn":         "Creator.PostalAddress.Subdivision",
+		"FIToFIPmtCxlReq.Case.Cretr.Agt.FinInstnId.PstlAdr.Ctry":                "Creator.PostalAddress.Country",
+		"FIToFIPmtCxlReq.Undrlyg.TxInf.OrgnlGrpInf.OrgnlMsgId":                  "OriginalMessageId",
+		"FIToFIPmtCxlReq.Undrlyg.TxInf.OrgnlGrpInf.OrgnlMsgNmId":                "OriginalMessageNameId",
+		"FIToFIPmtCxlReq.Undrlyg.TxInf.OrgnlGrpInf.OrgnlCreDtTm":                "OriginalMessageCreateTime",
+		"FIToFIPmtCxlReq.Undrlyg.TxInf.OrgnlInstrId":                            "OriginalInstructionId",
+		"FIToFIPmtCxlReq.Undrlyg.TxInf.OrgnlEndToEndId":                         "OriginalEndToEndId",
+		"FIToFIPmtCxlReq.Undrlyg.TxInf.OrgnlTxId":                               "OriginalTransactionId",
+		"FIToFIPmtCxlReq.Undrlyg.TxInf.OrgnlUETR":                               "OriginalUETR",
+		"FIToFIPmtCxlReq.Undrlyg.TxInf.OrgnlIntrBkSttlmAmt.Value":               "OriginalInterbankSettlementAmount.Amount",
+		"FIToFIPmtCxlReq.Undrlyg.TxInf.OrgnlIntrBkSttlmAmt.Ccy":                 "OriginalInterbankSettlementAmount.Currency",
+		"FIToFIPmtCxlReq.Undrlyg.TxInf.OrgnlIntrBkSttlmDt":                      "OriginalInterbankSettlementDate",
+		"FIToFIPmtCxlReq.Undrlyg.TxInf.CxlRsnInf.Orgtr.Nm":                      "CancellationReason.Originator",
+		"FIToFIPmtCxlReq.Undrlyg.TxInf.CxlRsnInf.Rsn.Cd":                        "CancellationReason.Reason",
+		"FIToFIPmtCxlReq.Undrlyg.TxInf.CxlRsnInf.AddtlInf[0]":                   "CancellationReason.AdditionalInfo",
+	}
+}
@@ -0,0 +1,19 @@
+package ReturnRequest
+
+type CAMT_056_001_VERSION string
+
+const (
+	CAMT_056_001_08 CAMT_056_001_VERSION = "camt.056.001.08"
+)
+
+var VersionNameSpaceMap = map[CAMT_056_001_VERSION]string{
+	CAMT_056_001_08: "urn:iso:std:iso:20022:tech:xsd:camt.056.001.08",
+}
+
+var NameSpaceVersionMap = map[string]CAMT_056_001_VERSION{
+	"urn:iso:std:iso:20022:tech:xsd:camt.056.001.08": CAMT_056_001_08,
+}
+
+var VersionPathMap = map[CAMT_056_001_VERSION]map[string]any{
+	CAMT_056_001_08: pathMapV8(),
+}
@@ -28,19 +28,19 @@ func TestReadWriteXML(t *testing.T) {
 		Assigner: models.Agent{
 			BusinessIdCode:     "BANKUSNY",
 			PaymentSysCode:     "USABA",
-			PaymentSysMemberId: "123456789",
+			PaymentSysMemberId: "011104238",
 			BankName:           "Bank of New York",
 		},
 		Assignee: models.Agent{
 			BusinessIdCode:     "BANKUSLA",
 			PaymentSysCode:     "USABA",
-			PaymentSysMemberId: "987654321",
+			PaymentSysMemberId: "021040078",
 			BankName:           "Bank of Los Angeles",
 		},
 		Creator: models.Agent{
 			BusinessIdCode:     "BANKUSNY",
 			PaymentSysCode:     "USABA",
-			PaymentSysMemberId: "123456789",
+			PaymentSysMemberId: "011104238",
 			BankName:           "Bank of New York",
 		},
 		Status: models.ReturnRequestAccepted,
@@ -386,17 +386,17 @@ func TestCheckRequiredFields(t *testing.T) {
 			Assigner: models.Agent{
 				BusinessIdCode:     "BANKUSNY",
 				PaymentSysCode:     "USABA",
-				PaymentSysMemberId: "123456789",
+				PaymentSysMemberId: "011104238",
 			},
 			Assignee: models.Agent{
 				BusinessIdCode:     "BANKUSLA",
 				PaymentSysCode:     "USABA",
-				PaymentSysMemberId: "987654321",
+				PaymentSysMemberId: "021040078",
 			},
 			Creator: models.Agent{
 				BusinessIdCode:     "BANKUSNY",
 				PaymentSysCode:     "USABA",
-				PaymentSysMemberId: "123456789",
+				PaymentSysMemberId: "011104238",
 			},
 			Status: models.ReturnRequestAccepted,
 			CancellationStatusReasonInfo: models.Reason{
@@ -432,7 +432,7 @@ func TestJSONMarshaling(t *testing.T) {
 		Assigner: models.Agent{
 			BusinessIdCode:     "BANKUSNY",
 			PaymentSysCode:     "USABA",
-			PaymentSysMemberId: "123456789",
+			PaymentSysMemberId: "011104238",
 			BankName:           "Bank of New York",
 		},
 	}
@@ -541,17 +541,17 @@ func TestDocumentWithValidation(t *testing.T) {
 			Assigner: models.Agent{
 				BusinessIdCode:     "BANKUSNY",
 				PaymentSysCode:     "USABA",
-				PaymentSysMemberId: "123456789",
+				PaymentSysMemberId: "011104238",
 			},
 			Assignee: models.Agent{
 				BusinessIdCode:     "BANKUSLA",
 				PaymentSysCode:     "USABA",
-				PaymentSysMemberId: "987654321",
+				PaymentSysMemberId: "021040078",
 			},
 			Creator: models.Agent{
 				BusinessIdCode:     "BANKUSNY",
 				PaymentSysCode:     "USABA",
-				PaymentSysMemberId: "123456789",
+				PaymentSysMemberId: "011104238",
 			},
 			CancellationStatusReasonInfo: models.Reason{
 				Reason: "LEGL",
@@ -612,30 +612,30 @@ func TestAgentStructures(t *testing.T) {
 			Assigner: models.Agent{
 				BusinessIdCode:     "BANKUSNY",
 				PaymentSysCode:     "USABA",
-				PaymentSysMemberId: "123456789",
+				PaymentSysMemberId: "011104238",
 				BankName:           "Bank of New York",
 			},
 			Assignee: models.Agent{
 				BusinessIdCode:     "BANKUSLA",
 				PaymentSysCode:     "USABA",
-				PaymentSysMemberId: "987654321",
+				PaymentSysMemberId: "021040078",
 				BankName:           "Bank of Los Angeles",
 			},
 			Creator: models.Agent{
 				BusinessIdCode:     "BANKUSCH",
 				PaymentSysCode:     "USABA",
-				PaymentSysMemberId: "111222333",
+				PaymentSysMemberId: "021151080",
 				BankName:           "Bank of Chicago",
 			},
 		}
 
 		// Verify agent fields
 		assert.Equal(t, "BANKUSNY", model.Assigner.BusinessIdCode)
-		assert.Equal(t, "123456789", model.Assigner.PaymentSysMemberId)
+		assert.Equal(t, "011104238", model.Assigner.PaymentSysMemberId)
 		assert.Equal(t, "BANKUSLA", model.Assignee.BusinessIdCode)
-		assert.Equal(t, "987654321", model.Assignee.PaymentSysMemberId)
+		assert.Equal(t, "021040078", model.Assignee.PaymentSysMemberId)
 		assert.Equal(t, "BANKUSCH", model.Creator.BusinessIdCode)
-		assert.Equal(t, "111222333", model.Creator.PaymentSysMemberId)
+		assert.Equal(t, "021151080", model.Creator.PaymentSysMemberId)
 	})
 }
 
@@ -701,7 +701,7 @@ func BenchmarkParseXML(b *testing.B) {
 				<Agt>
 					<FinInstnId>
 						<ClrSysMmbId>
-							<MmbId>123456789</MmbId>
+							<MmbId>011104238</MmbId>
 						</ClrSysMmbId>
 					</FinInstnId>
 				</Agt>
@@ -710,7 +710,7 @@ func BenchmarkParseXML(b *testing.B) {
 				<Agt>
 					<FinInstnId>
 						<ClrSysMmbId>
-							<MmbId>987654321</MmbId>
+							<MmbId>021040078</MmbId>
 						</ClrSysMmbId>
 					</FinInstnId>
 				</Agt>
@@ -723,7 +723,7 @@ func BenchmarkParseXML(b *testing.B) {
 				<Agt>
 					<FinInstnId>
 						<ClrSysMmbId>
-							<MmbId>123456789</MmbId>
+							<MmbId>011104238</MmbId>
 						</ClrSysMmbId>
 					</FinInstnId>
 				</Agt>
@@ -0,0 +1,147 @@
+package models
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+
+	"github.com/moov-io/wire20022/pkg/errors"
+)
+
+var postalAddressType = reflect.TypeOf(PostalAddress{})
+
+var (
+	// A street line starts with a building number, e.g. "167565 Avenue of the Fountains" or "12B Main St"
+	streetLinePattern = regexp.MustCompile(`^(\d+[A-Za-z]?(?:-\d+)?)\s+(\D.*)$`)
+	// A US town line is a town, a state and a ZIP code, e.g. "Fountain Hills, AZ 85268-1234"
+	usTownLinePattern = regexp.MustCompile(`^([^,]+),\s*([A-Z]{2})\s+(\d{5}(?:-\d{4})?)$`)
+	// A postal town line starts with a postal code, e.g. "75001 Paris"
+	postalTownLinePattern = regexp.MustCompile(`^(\d{4,6})\s+(\D.*)$`)
+)
+
+// EachAddress calls fn for each PostalAddress reachable from a message model, in field order,
+// with its path, e.g. "Transactions[0].DebtorAddress" or "DebtorAgent.PostalAddress"
+func EachAddress(model any, fn func(path string, address PostalAddress)) {
+	walkType(reflect.ValueOf(model), "", postalAddressType, func(path string, v reflect.Value) {
+		fn(path, v.Interface().(PostalAddress))
+	})
+}
+
+// ValidateAddresses checks the country of every postal address in a message model is an ISO
+// 3166-1 alpha-2 code, reporting each invalid one with its path, e.g. "DebtorAddress.Country"
+func ValidateAddresses(model any) error {
+	var errs []error
+	EachAddress(model, func(path string, address PostalAddress) {
+		if err := CheckIdentifier(path+".Country", address.Country, ValidateCountry); err != nil {
+			errs = append(errs, err)
+		}
+	})
+	return errors.JoinValidationErrors(errs...)
+}
+
+// CheckAddressCompliance checks every postal address in a message model is structured or hybrid,
+// as the migration away from free-text addresses requires. An address made only of AddressLine
+// lines is reported as invalid, e.g. "DebtorAddress.AddressLine", and any other one without a
+// TownName or Country as missing it. Empty addresses are skipped
+func CheckAddressCompliance(model any) error {
+	var errs []error
+	EachAddress(model, func(path string, address PostalAddress) {
+		if reflect.ValueOf(address).IsZero() {
+			return
+		}
+		if !isStructured(address) {
+			errs = append(errs, errors.NewInvalidFieldError(path+".AddressLine", "unstructured address: TownName and Country must be structured fields"))
+			return
+		}
+		if address.TownName == "" {
+			errs = append(errs, errors.NewRequiredFieldError(path+".TownName"))
+		}
+		if address.Country == "" {
+			errs = append(errs, errors.NewRequiredFieldError(path+".Country"))
+		}
+	})
+	return errors.JoinValidationErrors(errs...)
+}
+
+// StructureAddress moves what it recognizes in the AddressLine lines of an address to its empty
+// structured fields and keeps the other lines. It recognizes:
+//   - a US town, state and ZIP code, "Fountain Hills, AZ 85268"
+//   - a postal code and town, "75001 Paris", in the last line or the one before a country, once
+//     another line has given the street, so that "1234 Main Street" is not taken for one
+//   - a building number and street, "167565 Avenue of the Fountains", in the first other line
+//     starting with a number
+//   - a country name or ISO 3166-1 code, "France", "FR" or "FRA", in the last line
+//
+// These are heuristics for the common layouts: review converted addresses before sending them
+func StructureAddress(address PostalAddress) PostalAddress {
+	lines := address.AddressLine
+	if len(lines) == 0 {
+		return address
+	}
+	address.AddressLine = nil
+	countryLine := len(lines) - 1
+	townLine := countryLine
+	if _, ok := countryNamed(strings.TrimSpace(lines[countryLine])); ok {
+		townLine--
+	}
+	for i, line := range lines {
+		text := strings.TrimSpace(line)
+		if text == "" {
+			continue
+		}
+		if m := usTownLinePattern.FindStringSubmatch(text); m != nil && address.TownName == "" && address.PostalCode == "" {
+			address.TownName, address.PostalCode = strings.TrimSpace(m[1]), m[3]
+			if address.Subdivision == "" {
+				address.Subdivision = m[2]
+			}
+			continue
+		}
+		if m := postalTownLinePattern.FindStringSubmatch(text); m != nil && i == townLine && address.StreetName != "" && address.TownName == "" && address.PostalCode == "" {
+			address.PostalCode, address.TownName = m[1], m[2]
+			continue
+		}
+		if m := streetLinePattern.FindStringSubmatch(text); m != nil && address.StreetName == "" && address.BuildingNumber == "" {
+			address.BuildingNumber, address.StreetName = m[1], m[2]
+			continue
+		}
+		if code, ok := countryNamed(text); ok && i == countryLine && address.Country == "" {
+			address.Country = code
+			continue
+		}
+		address.AddressLine = append(address.AddressLine, line)
+	}
+	return address
+}
+
+// StructureAddresses applies StructureAddress to every postal address in a message model, which
+// must be a pointer so that the addresses can be updated
+func StructureAddresses(model any) error {
+	v := reflect.ValueOf(model)
+	if v.Kind() != reflect.Ptr || v.IsNil() {
+		return fmt.Errorf("structuring addresses needs a pointer to a message model, got %T", model)
+	}
+	walkType(v, "", postalAddressType, func(_ string, v reflect.Value) {
+		if v.CanSet() {
+			v.Set(reflect.ValueOf(StructureAddress(v.Interface().(PostalAddress))))
+		}
+	})
+	return nil
+}
+
+// isStructured reports whether an address has any field besides its AddressLine lines
+func isStructured(address PostalAddress) bool {
+	address.AddressLine = nil
+	return !reflect.ValueOf(address).IsZero()
+}
+
+// countryNamed returns the alpha-2 code of the country a line names by its alpha-2 code, alpha-3
+// code or ISO 3166-1 name, in any case
+func countryNamed(line string) (string, bool) {
+	for _, country := range countries {
+		if strings.EqualFold(line, country.Code) || strings.EqualFold(line, country.Alpha3) || strings.EqualFold(line, country.Name) {
+			return country.Code, true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,125 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/moov-io/wire20022/pkg/errors"
+)
+
+// TestCheckAddressCompliance tests that unstructured and incomplete addresses are reported
+func TestCheckAddressCompliance(t *testing.T) {
+	type model struct {
+		DebtorAddress   PostalAddress
+		CreditorAddress PostalAddress
+		DebtorAgent     Agent
+	}
+	m := model{
+		DebtorAddress: PostalAddress{StreetName: "Avenue A", BuildingNumber: "66", TownName: "Lisle", Country: "US"},
+		// A hybrid address keeps free-text lines next to its town and country
+		CreditorAddress: PostalAddress{TownName: "Paris", Country: "FR", AddressLine: []string{"12 Rue de Rivoli"}},
+	}
+	assert.NoError(t, CheckAddressCompliance(&m))
+
+	m.DebtorAddress = PostalAddress{AddressLine: []string{"66 Avenue A", "Lisle, IL 60532"}}
+	m.CreditorAddress.Country = ""
+	m.DebtorAgent.PostalAddress = PostalAddress{StreetName: "Avenue B"}
+	err := CheckAddressCompliance(m)
+	require.Error(t, err)
+	assert.EqualError(t, err, `validation failed for field "DebtorAddress.AddressLine": unstructured address: TownName and Country must be structured fields: invalid field`+"\n"+
+		`validation failed for field "CreditorAddress.Country": is required: required field missing`+"\n"+
+		`validation failed for field "DebtorAgent.PostalAddress.TownName": is required: required field missing`+"\n"+
+		`validation failed for field "DebtorAgent.PostalAddress.Country": is required: required field missing`)
+	assert.ErrorIs(t, err, errors.ErrInvalidField)
+	assert.ErrorIs(t, err, errors.ErrRequiredField)
+}
+
+// TestStructureAddress tests the heuristics that move free-text lines to structured fields
+func TestStructureAddress(t *testing.T) {
+	tests := []struct {
+		name    string
+		address PostalAddress
+		want    PostalAddress
+	}{
+		{
+			name:    "US address",
+			address: PostalAddress{AddressLine: []string{"167565 Avenue of the Fountains", "Fountain Hills, AZ 85268-1234", "United States"}},
+			want:    PostalAddress{StreetName: "Avenue of the Fountains", BuildingNumber: "167565", PostalCode: "85268-1234", TownName: "Fountain Hills", Subdivision: "AZ", Country: "US"},
+		},
+		{
+			name:    "European address",
+			address: PostalAddress{AddressLine: []string{"Attn Treasury", "12B Rue de Rivoli", "75001 Paris", "FRA"}},
+			want:    PostalAddress{StreetName: "Rue de Rivoli", BuildingNumber: "12B", PostalCode: "75001", TownName: "Paris", Country: "FR", AddressLine: []string{"Attn Treasury"}},
+		},
+		{
+			name:    "structured fields are kept",
+			address: PostalAddress{TownName: "Lisle", Country: "US", AddressLine: []string{"66 Avenue A", "Naperville, IL 60540", "gb"}},
+			want:    PostalAddress{StreetName: "Avenue A", BuildingNumber: "66", TownName: "Lisle", Country: "US", AddressLine: []string{"Naperville, IL 60540", "gb"}},
+		},
+		{
+			name:    "number and street alone",
+			address: PostalAddress{AddressLine: []string{"1234 Main Street"}},
+			want:    PostalAddress{StreetName: "Main Street", BuildingNumber: "1234"},
+		},
+		{
+			name:    "postal code and town need a street",
+			address: PostalAddress{StreetName: "Unter den Linden", AddressLine: []string{"10117 Berlin", "DE"}},
+			want:    PostalAddress{StreetName: "Unter den Linden", PostalCode: "10117", TownName: "Berlin", Country: "DE"},
+		},
+		{
+			name:    "country only in the last line",
+			address: PostalAddress{AddressLine: []string{"Canada", "PO Box 12", ""}},
+			want:    PostalAddress{AddressLine: []string{"Canada", "PO Box 12"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, StructureAddress(tt.address))
+		})
+	}
+}
+
+// TestStructureAddresses tests that every address of a model is structured in place
+func TestStructureAddresses(t *testing.T) {
+	type transaction struct {
+		DebtorAddress PostalAddress
+		DebtorAgent   *Agent
+	}
+	type model struct {
+		Transactions []transaction
+	}
+	m := model{Transactions: []transaction{{
+		DebtorAddress: PostalAddress{AddressLine: []string{"77 Unter den Linden", "10117 Berlin", "Germany"}},
+		DebtorAgent:   &Agent{PostalAddress: PostalAddress{AddressLine: []string{"25 Avenue B", "Yardley, PA 19067", "US"}}},
+	}}}
+	require.NoError(t, StructureAddresses(&m))
+	assert.Equal(t, PostalAddress{StreetName: "Unter den Linden", BuildingNumber: "77", PostalCode: "10117", TownName: "Berlin", Country: "DE"}, m.Transactions[0].DebtorAddress)
+	assert.Equal(t, PostalAddress{StreetName: "Avenue B", BuildingNumber: "25", PostalCode: "19067", TownName: "Yardley", Subdivision: "PA", Country: "US"}, m.Transactions[0].DebtorAgent.PostalAddress)
+	assert.NoError(t, CheckAddressCompliance(&m))
+
+	assert.Error(t, StructureAddresses(m))
+}
+
+// TestListItems tests that list mappings map every item to the same index of the model slice
+func TestListItems(t *testing.T) {
+	type address struct {
+		AdrLine []string
+	}
+	type document struct {
+		PstlAdr address
+	}
+	doc := document{PstlAdr: address{AdrLine: []string{"Line 1", "Line 2"}}}
+	pathMap := map[string]any{"PstlAdr.AdrLine : Address.AddressLine": ListItems}
+	assert.Equal(t, map[string]string{
+		"PstlAdr.AdrLine[0]": "Address.AddressLine[0]",
+		"PstlAdr.AdrLine[1]": "Address.AddressLine[1]",
+	}, RemakeMapping(doc, pathMap, true))
+
+	type model struct {
+		Address PostalAddress
+	}
+	m := model{Address: PostalAddress{AddressLine: []string{"Line 1"}}}
+	assert.Equal(t, map[string]string{"Address.AddressLine[0]": "PstlAdr.AdrLine[0]"}, RemakeMapping(m, pathMap, false))
+}
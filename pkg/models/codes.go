@@ -0,0 +1,126 @@
+package models
+
+import (
+	_ "embed"
+	"encoding/csv"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Currency is an active currency of the ISO 4217 list
+type Currency struct {
+	// Code is the alphabetic code, e.g. "USD"
+	Code string
+	// Number is the numeric code, e.g. "840"
+	Number string
+	// MinorUnits is the number of decimal places of an amount, e.g. 2 for USD, or -1 for units
+	// that have none, such as gold (XAU) or special drawing rights (XDR)
+	MinorUnits int
+	Name       string
+}
+
+// Country is a country of the ISO 3166-1 list
+type Country struct {
+	// Code is the alpha-2 code postal addresses hold, e.g. "US"
+	Code string
+	// Alpha3 is the alpha-3 code, e.g. "USA"
+	Alpha3 string
+	// Number is the numeric code, e.g. "840"
+	Number string
+	Name   string
+}
+
+var (
+	//go:embed data/iso4217.csv
+	iso4217 string
+	//go:embed data/iso3166.csv
+	iso3166 string
+
+	currencies = loadCurrencies()
+	countries  = loadCountries()
+)
+
+// LookupCurrency returns the ISO 4217 currency of an alphabetic code, in any case
+func LookupCurrency(code string) (Currency, bool) {
+	currency, ok := currencies[strings.ToUpper(code)]
+	return currency, ok
+}
+
+// LookupCountry returns the ISO 3166-1 country of an alpha-2 code, in any case
+func LookupCountry(code string) (Country, bool) {
+	country, ok := countries[strings.ToUpper(code)]
+	return country, ok
+}
+
+// Currencies returns the ISO 4217 currencies, sorted by code
+func Currencies() []Currency {
+	list := make([]Currency, 0, len(currencies))
+	for _, currency := range currencies {
+		list = append(list, currency)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Code < list[j].Code })
+	return list
+}
+
+// Countries returns the ISO 3166-1 countries, sorted by alpha-2 code
+func Countries() []Country {
+	list := make([]Country, 0, len(countries))
+	for _, country := range countries {
+		list = append(list, country)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Code < list[j].Code })
+	return list
+}
+
+// ValidateCurrency checks a code is the upper case alphabetic code of an active ISO 4217 currency
+func ValidateCurrency(code string) error {
+	if _, ok := currencies[code]; !ok {
+		return fmt.Errorf("currency %q is not an ISO 4217 currency code", code)
+	}
+	return nil
+}
+
+// ValidateCountry checks a code is the upper case alpha-2 code of an ISO 3166-1 country
+func ValidateCountry(code string) error {
+	if _, ok := countries[code]; !ok {
+		return fmt.Errorf("country %q is not an ISO 3166-1 alpha-2 code", code)
+	}
+	return nil
+}
+
+// loadCurrencies reads the embedded ISO 4217 table: code, number, minor units and name
+func loadCurrencies() map[string]Currency {
+	table := make(map[string]Currency)
+	for _, record := range readTable(iso4217) {
+		units := -1
+		if record[2] != "" {
+			n, err := strconv.Atoi(record[2])
+			if err != nil {
+				panic(fmt.Sprintf("iso4217.csv: minor units of %s: %v", record[0], err))
+			}
+			units = n
+		}
+		table[record[0]] = Currency{Code: record[0], Number: record[1], MinorUnits: units, Name: record[3]}
+	}
+	return table
+}
+
+// loadCountries reads the embedded ISO 3166-1 table: alpha-2 code, alpha-3 code, number and name
+func loadCountries() map[string]Country {
+	table := make(map[string]Country)
+	for _, record := range readTable(iso3166) {
+		table[record[0]] = Country{Code: record[0], Alpha3: record[1], Number: record[2], Name: record[3]}
+	}
+	return table
+}
+
+// readTable returns the records of an embedded CSV table without its header
+func readTable(data string) [][]string {
+	records, err := csv.NewReader(strings.NewReader(data)).ReadAll()
+	if err != nil {
+		panic(fmt.Sprintf("reading embedded table: %v", err))
+	}
+	return records[1:]
+}
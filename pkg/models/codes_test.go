@@ -0,0 +1,102 @@
+package models
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/moov-io/wire20022/pkg/errors"
+)
+
+// TestLookupCurrency tests the embedded ISO 4217 table
+func TestLookupCurrency(t *testing.T) {
+	usd, ok := LookupCurrency("usd")
+	require.True(t, ok)
+	assert.Equal(t, Currency{Code: "USD", Number: "840", MinorUnits: 2, Name: "US Dollar"}, usd)
+
+	for code, units := range map[string]int{"JPY": 0, "KWD": 3, "CLF": 4, "EUR": 2, "XAU": -1} {
+		currency, ok := LookupCurrency(code)
+		require.True(t, ok, code)
+		assert.Equal(t, units, currency.MinorUnits, code)
+	}
+
+	// Withdrawn currencies are not listed
+	_, ok = LookupCurrency("HRK")
+	assert.False(t, ok)
+
+	list := Currencies()
+	assert.Len(t, list, 179)
+	assert.Equal(t, "AED", list[0].Code)
+	assert.True(t, slices.IsSortedFunc(list, func(a, b Currency) int { return strings.Compare(a.Code, b.Code) }))
+
+	assert.NoError(t, ValidateCurrency("USD"))
+	assert.EqualError(t, ValidateCurrency("usd"), `currency "usd" is not an ISO 4217 currency code`)
+	assert.EqualError(t, ValidateCurrency("US$"), `currency "US$" is not an ISO 4217 currency code`)
+
+	assert.Equal(t, 2, CurrencyMinorUnits("XAU"))
+	assert.Equal(t, 2, CurrencyMinorUnits("ABC"))
+}
+
+// TestLookupCountry tests the embedded ISO 3166-1 table
+func TestLookupCountry(t *testing.T) {
+	us, ok := LookupCountry("us")
+	require.True(t, ok)
+	assert.Equal(t, Country{Code: "US", Alpha3: "USA", Number: "840", Name: "United States"}, us)
+
+	bo, ok := LookupCountry("BO")
+	require.True(t, ok)
+	assert.Equal(t, "Bolivia, Plurinational State of", bo.Name)
+
+	list := Countries()
+	assert.Len(t, list, 249)
+	assert.Equal(t, "AD", list[0].Code)
+	assert.Equal(t, "ZW", list[len(list)-1].Code)
+
+	assert.NoError(t, ValidateCountry("GB"))
+	assert.EqualError(t, ValidateCountry("UK"), `country "UK" is not an ISO 3166-1 alpha-2 code`)
+	assert.EqualError(t, ValidateCountry("USA"), `country "USA" is not an ISO 3166-1 alpha-2 code`)
+}
+
+// TestCurrencyAndAmountCodes tests that amounts are checked against the currency table
+func TestCurrencyAndAmountCodes(t *testing.T) {
+	assert.NoError(t, CurrencyAndAmount{Currency: "JPY", Amount: "1000"}.Validate())
+	assert.NoError(t, CurrencyAndAmount{Currency: "XAU", Amount: "12.12345"}.Validate())
+
+	err := CurrencyAndAmount{Currency: "JPY", Amount: "1000.5"}.Validate()
+	assert.ErrorIs(t, err, errors.ErrInvalidField)
+
+	err = CurrencyAndAmount{Currency: "USS", Amount: "1000"}.Validate()
+	assert.ErrorIs(t, err, errors.ErrInvalidField)
+	assert.EqualError(t, err, `currency "USS" is not an ISO 4217 currency code: invalid field`)
+}
+
+// TestValidateAddresses tests that invalid countries are reported with the address path
+func TestValidateAddresses(t *testing.T) {
+	type transaction struct {
+		DebtorAddress PostalAddress
+		DebtorAgent   Agent
+	}
+	type model struct {
+		Transactions []transaction
+	}
+	m := model{Transactions: []transaction{{
+		DebtorAddress: PostalAddress{TownName: "Anytown", Country: "US"},
+		DebtorAgent:   Agent{PostalAddress: PostalAddress{TownName: "London"}},
+	}}}
+	assert.NoError(t, ValidateAddresses(&m))
+
+	var paths []string
+	EachAddress(&m, func(path string, _ PostalAddress) {
+		paths = append(paths, path)
+	})
+	assert.Equal(t, []string{"Transactions[0].DebtorAddress", "Transactions[0].DebtorAgent.PostalAddress"}, paths)
+
+	m.Transactions[0].DebtorAddress.Country = "USA"
+	m.Transactions[0].DebtorAgent.PostalAddress.Country = "UK"
+	err := ValidateAddresses(m)
+	assert.EqualError(t, err, `validation failed for field "Transactions[0].DebtorAddress.Country": country "USA" is not an ISO 3166-1 alpha-2 code: invalid field`+"\n"+
+		`validation failed for field "Transactions[0].DebtorAgent.PostalAddress.Country": country "UK" is not an ISO 3166-1 alpha-2 code: invalid field`)
+}
@@ -0,0 +1,445 @@
+package models
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"math/big"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/moov-io/wire20022/pkg/errors"
+)
+
+// Decimal is an exact decimal number such as an amount or an exchange rate. It holds the
+// lexical value, e.g. "510000.74", so that summing and re-serializing never picks up binary
+// floating point rounding. The zero value ("") means the value is absent.
+type Decimal string
+
+// decimalPattern matches the lexical space of xs:decimal
+var decimalPattern = regexp.MustCompile(`^[+-]?(\d+(\.\d*)?|\.\d+)$`)
+
+// defaultMinorUnits applies to unknown currencies and those without minor units
+const defaultMinorUnits = 2
+
+// CurrencyMinorUnits returns the number of decimal places allowed for a currency, e.g. 2 for USD.
+// Unknown currencies and units without minor units, such as XAU, get two.
+func CurrencyMinorUnits(currency string) int {
+	if c, ok := LookupCurrency(currency); ok && c.MinorUnits >= 0 {
+		return c.MinorUnits
+	}
+	return defaultMinorUnits
+}
+
+// ParseDecimal parses the lexical value of a decimal, keeping it exactly as written
+func ParseDecimal(s string) (Decimal, error) {
+	d := Decimal(strings.TrimSpace(s))
+	if err := d.Validate(); err != nil {
+		return "", err
+	}
+	return d, nil
+}
+
+// NewDecimalFromMinorUnits creates a decimal from an integer count of minor units,
+// e.g. 51000074 cents of USD is "510000.74"
+func NewDecimalFromMinorUnits(units int64, currency string) Decimal {
+	return formatDecimal(big.NewInt(units), CurrencyMinorUnits(currency))
+}
+
+// String returns the lexical value
+func (d Decimal) String() string {
+	return string(d)
+}
+
+// Validate checks that a present value is a valid decimal number
+func (d Decimal) Validate() error {
+	if d == "" || decimalPattern.MatchString(string(d)) {
+		return nil
+	}
+	return fmt.Errorf("invalid decimal %q: %w", string(d), errors.ErrInvalidField)
+}
+
+// IsZero reports whether the value is absent or numerically zero
+func (d Decimal) IsZero() bool {
+	if d == "" {
+		return true
+	}
+	unscaled, _, err := d.parts()
+	return err == nil && unscaled.Sign() == 0
+}
+
+// Scale returns the number of significant decimal places, ignoring trailing zeros
+func (d Decimal) Scale() int {
+	_, fraction, _ := strings.Cut(string(d), ".")
+	return len(strings.TrimRight(fraction, "0"))
+}
+
+// Add returns the exact sum d + other
+func (d Decimal) Add(other Decimal) (Decimal, error) {
+	a, b, scale, err := alignDecimals(d, other)
+	if err != nil {
+		return "", err
+	}
+	return formatDecimal(a.Add(a, b), scale), nil
+}
+
+// Sub returns the exact difference d - other
+func (d Decimal) Sub(other Decimal) (Decimal, error) {
+	a, b, scale, err := alignDecimals(d, other)
+	if err != nil {
+		return "", err
+	}
+	return formatDecimal(a.Sub(a, b), scale), nil
+}
+
+// Cmp compares the numeric values of d and other, returning -1, 0 or +1.
+// "1000" and "1000.00" compare as equal.
+func (d Decimal) Cmp(other Decimal) (int, error) {
+	a, b, _, err := alignDecimals(d, other)
+	if err != nil {
+		return 0, err
+	}
+	return a.Cmp(b), nil
+}
+
+// WithMinScale pads the fraction with zeros to at least scale decimal places without rounding,
+// e.g. "50000" with scale 2 is "50000.00"
+func (d Decimal) WithMinScale(scale int) Decimal {
+	unscaled, current, err := d.parts()
+	if err != nil || d == "" || current >= scale {
+		return d
+	}
+	return formatDecimal(unscaled.Mul(unscaled, pow10(scale-current)), scale)
+}
+
+// MarshalJSON writes the value as a JSON number without converting it to a float, or null when
+// the value is absent
+func (d Decimal) MarshalJSON() ([]byte, error) {
+	if d == "" {
+		return []byte("null"), nil
+	}
+	if err := d.Validate(); err != nil {
+		return nil, err
+	}
+	unscaled, scale, _ := d.parts()
+	return []byte(formatDecimal(unscaled, scale)), nil
+}
+
+// UnmarshalJSON accepts a JSON number or a string holding a decimal, keeping the lexical value
+func (d *Decimal) UnmarshalJSON(data []byte) error {
+	text := strings.TrimSpace(string(data))
+	if text == "null" {
+		return nil
+	}
+	text = strings.Trim(text, `"`)
+	parsed, err := ParseDecimal(text)
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
+
+// MarshalText writes the lexical value, used for XML character data and attributes
+func (d Decimal) MarshalText() ([]byte, error) {
+	if err := d.Validate(); err != nil {
+		return nil, err
+	}
+	return []byte(d), nil
+}
+
+// UnmarshalText reads a lexical value
+func (d *Decimal) UnmarshalText(text []byte) error {
+	parsed, err := ParseDecimal(string(text))
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
+
+// parts returns the unscaled integer and the number of decimal places as written
+func (d Decimal) parts() (*big.Int, int, error) {
+	if d == "" {
+		return new(big.Int), 0, nil
+	}
+	if err := d.Validate(); err != nil {
+		return nil, 0, err
+	}
+	whole, fraction, _ := strings.Cut(string(d), ".")
+	digits := strings.TrimPrefix(whole, "+") + fraction
+	unscaled, ok := new(big.Int).SetString(digits, 10)
+	if !ok {
+		return nil, 0, fmt.Errorf("invalid decimal %q: %w", string(d), errors.ErrInvalidField)
+	}
+	return unscaled, len(fraction), nil
+}
+
+// alignDecimals returns the unscaled values of a and b at their common scale
+func alignDecimals(a, b Decimal) (*big.Int, *big.Int, int, error) {
+	x, xScale, err := a.parts()
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	y, yScale, err := b.parts()
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	scale := max(xScale, yScale)
+	x.Mul(x, pow10(scale-xScale))
+	y.Mul(y, pow10(scale-yScale))
+	return x, y, scale, nil
+}
+
+func pow10(n int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
+
+// formatDecimal writes an unscaled integer with the given number of decimal places
+func formatDecimal(unscaled *big.Int, scale int) Decimal {
+	digits := new(big.Int).Abs(unscaled).String()
+	if scale > 0 {
+		if len(digits) <= scale {
+			digits = strings.Repeat("0", scale-len(digits)+1) + digits
+		}
+		digits = digits[:len(digits)-scale] + "." + digits[len(digits)-scale:]
+	}
+	if unscaled.Sign() < 0 {
+		digits = "-" + digits
+	}
+	return Decimal(digits)
+}
+
+// Validate checks the currency is an ISO 4217 code and the amount is a valid decimal with no
+// more decimal places than the currency's minor units, e.g. two for USD
+func (c CurrencyAndAmount) Validate() error {
+	if err := c.Amount.Validate(); err != nil {
+		return err
+	}
+	if c.Currency != "" {
+		if err := ValidateCurrency(c.Currency); err != nil {
+			return fmt.Errorf("%w: %w", err, errors.ErrInvalidField)
+		}
+		if currency, _ := LookupCurrency(c.Currency); currency.MinorUnits < 0 {
+			return nil // any precision, e.g. ounces of gold
+		}
+	}
+	if units := CurrencyMinorUnits(c.Currency); c.Amount.Scale() > units {
+		return fmt.Errorf("amount %s has more than %d decimal places allowed for %s: %w",
+			c.Amount, units, c.Currency, errors.ErrInvalidField)
+	}
+	return nil
+}
+
+var (
+	decimalType           = reflect.TypeOf(Decimal(""))
+	currencyAndAmountType = reflect.TypeOf(CurrencyAndAmount{})
+)
+
+// ValidateAmounts checks every Decimal and CurrencyAndAmount in a message model, reporting each
+// invalid value with its path, e.g. "Transactions[0].InstructedAmount"
+func ValidateAmounts(model any) error {
+	var errs []error
+	_ = walkAmounts(reflect.ValueOf(model), "", func(path string, v reflect.Value) error {
+		var err error
+		switch value := v.Interface().(type) {
+		case Decimal:
+			err = value.Validate()
+		case CurrencyAndAmount:
+			err = value.Validate()
+		}
+		if err != nil {
+			errs = append(errs, errors.NewInvalidFieldError(path, err.Error()))
+		}
+		return nil
+	})
+	return errors.JoinValidationErrors(errs...)
+}
+
+// ScaleAmounts pads every CurrencyAndAmount in a message model to its currency's minor units, e.g.
+// "50000" USD to "50000.00", for callers building amounts to send. Amounts with more decimal places
+// are left unchanged. Parsing does not use it: amounts keep the value the document holds.
+func ScaleAmounts(model any) {
+	_ = walkAmounts(reflect.ValueOf(model), "", func(_ string, v reflect.Value) error {
+		if v.Type() == currencyAndAmountType && v.CanSet() {
+			amount, _ := v.Interface().(CurrencyAndAmount)
+			if amount.Amount == "" && amount.Currency != "" {
+				amount.Amount = "0"
+			}
+			amount.Amount = amount.Amount.WithMinScale(CurrencyMinorUnits(amount.Currency))
+			v.Set(reflect.ValueOf(amount))
+		}
+		return nil
+	})
+}
+
+// walkAmounts calls fn for each Decimal and CurrencyAndAmount reachable from v
+func walkAmounts(v reflect.Value, path string, fn func(path string, v reflect.Value) error) error {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+		return walkAmounts(v.Elem(), path, fn)
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			if err := walkAmounts(v.Index(i), fmt.Sprintf("%s[%d]", path, i), fn); err != nil {
+				return err
+			}
+		}
+	case reflect.Struct:
+		if v.Type() == currencyAndAmountType {
+			return fn(path, v)
+		}
+		for i := 0; i < v.NumField(); i++ {
+			field := v.Type().Field(i)
+			if !field.IsExported() {
+				continue
+			}
+			fieldPath := field.Name
+			if field.Anonymous {
+				fieldPath = ""
+			}
+			switch {
+			case path == "":
+			case fieldPath == "":
+				fieldPath = path
+			default:
+				fieldPath = path + "." + fieldPath
+			}
+			if err := walkAmounts(v.Field(i), fieldPath, fn); err != nil {
+				return err
+			}
+		}
+	case reflect.String:
+		if v.Type() == decimalType {
+			return fn(path, v)
+		}
+	}
+	return nil
+}
+
+// ReadDecimals sets each Decimal of a model that a document value was copied to from the text of
+// its element in data, the document the model was parsed from. Document types hold amounts as
+// binary numbers, which keep neither every digit of a large amount nor the decimal places as
+// written. rePathMap maps document paths to model paths, as RemakeMapping(doc, pathMap, true).
+func ReadDecimals(data []byte, doc any, rePathMap map[string]string, model any) {
+	// A partial scan still restores the amounts before the point the document stops being valid XML
+	text, _ := ScanText(data)
+	for docPath, modelPath := range rePathMap {
+		_, value, err := GetElement(model, modelPath)
+		if err != nil {
+			continue
+		}
+		current, ok := value.(Decimal)
+		if !ok {
+			continue
+		}
+		xpath, ok := DocumentXPath(doc, docPath)
+		if !ok {
+			continue
+		}
+		lexical, err := ParseDecimal(text[indexXPath(xpath)])
+		if err != nil || lexical == "" {
+			continue
+		}
+		// Several document values can feed one field; only the one that was copied is restored.
+		// Zero values are not copied, so an empty field takes a zero the document holds.
+		if sameNumber(current, lexical) || current == "" && lexical.IsZero() {
+			_ = SetElementToModel(model, modelPath, lexical)
+		}
+	}
+}
+
+// sameNumber reports whether two decimals are read as the same binary number
+func sameNumber(a, b Decimal) bool {
+	x, errA := strconv.ParseFloat(string(a), 64)
+	y, errB := strconv.ParseFloat(string(b), 64)
+	return errA == nil && errB == nil && x == y
+}
+
+// DecimalText returns the lexical value of each Decimal of a model that is copied to a document,
+// keyed by the XPath of its element as ScanText keys it, for EncodeDocument. rePathMap maps model
+// paths to document paths, as RemakeMapping(model, pathMap, false).
+func DecimalText(model any, doc any, rePathMap map[string]string) map[string]string {
+	text := map[string]string{}
+	for modelPath, docPath := range rePathMap {
+		_, value, err := GetElement(model, modelPath)
+		if err != nil {
+			continue
+		}
+		d, ok := value.(Decimal)
+		if !ok || d == "" || d.Validate() != nil {
+			continue
+		}
+		if xpath, ok := DocumentXPath(doc, docPath); ok {
+			text[indexXPath(xpath)] = string(d)
+		}
+	}
+	return text
+}
+
+// EncodeDocument encodes doc like e.Encode, writing each element named in text with that text as
+// its value in place of the one the document holds. With DecimalText, amounts keep the digits of
+// the model rather than those of the document's binary number, e.g. "1500000.00" for 1.5e+06.
+func EncodeDocument(e *xml.Encoder, doc any, text map[string]string) error {
+	if len(text) == 0 {
+		return e.Encode(doc)
+	}
+	return e.Encode(lexicalDocument{doc: doc, text: text})
+}
+
+// lexicalDocument replays the XML of a document, replacing the value of the elements in text
+type lexicalDocument struct {
+	doc  any
+	text map[string]string
+}
+
+// MarshalXML writes the document's own root element in place of start
+func (d lexicalDocument) MarshalXML(e *xml.Encoder, _ xml.StartElement) error {
+	data, err := xml.Marshal(d.doc)
+	if err != nil {
+		return err
+	}
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	var elements xpathTracker
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			elements.start(t.Name.Local)
+			// The encoder declares the namespace of the element itself
+			start := xml.StartElement{Name: t.Name}
+			for _, attr := range t.Attr {
+				if attr.Name.Space != "" || attr.Name.Local != "xmlns" {
+					start.Attr = append(start.Attr, attr)
+				}
+			}
+			token = start
+		case xml.CharData:
+			if _, ok := d.text[elements.current()]; ok {
+				continue
+			}
+		case xml.EndElement:
+			if value, ok := d.text[elements.current()]; ok {
+				if err := e.EncodeToken(xml.CharData(value)); err != nil {
+					return err
+				}
+			}
+			elements.end()
+		}
+		if err := e.EncodeToken(xml.CopyToken(token)); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,240 @@
+package models
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/moov-io/wire20022/pkg/errors"
+)
+
+// TestParseDecimal tests that decimals keep their lexical value
+func TestParseDecimal(t *testing.T) {
+	for _, value := range []string{"510000.74", "0.10", "-1.5", "+2", ".5", "7."} {
+		d, err := ParseDecimal(value)
+		require.NoError(t, err, value)
+		assert.Equal(t, value, d.String())
+	}
+
+	for _, value := range []string{"1e5", "NaN", "Inf", "1,000.00", "0x10", "-", "."} {
+		_, err := ParseDecimal(value)
+		assert.ErrorIs(t, err, errors.ErrInvalidField, value)
+	}
+
+	d, err := ParseDecimal(" 12.30 ")
+	require.NoError(t, err)
+	assert.Equal(t, Decimal("12.30"), d)
+}
+
+// TestDecimalArithmetic tests exact addition, subtraction and comparison
+func TestDecimalArithmetic(t *testing.T) {
+	// 0.1 + 0.2 is not 0.3 in float64
+	sum, err := Decimal("0.1").Add("0.2")
+	require.NoError(t, err)
+	assert.Equal(t, Decimal("0.3"), sum)
+
+	total := Decimal("")
+	for _, amount := range []Decimal{"510000.74", "0.26", "1000"} {
+		total, err = total.Add(amount)
+		require.NoError(t, err)
+	}
+	assert.Equal(t, Decimal("511001.00"), total)
+
+	diff, err := Decimal("10").Sub("10.01")
+	require.NoError(t, err)
+	assert.Equal(t, Decimal("-0.01"), diff)
+
+	cmp, err := Decimal("1000").Cmp("1000.00")
+	require.NoError(t, err)
+	assert.Equal(t, 0, cmp)
+	cmp, err = Decimal("999.99").Cmp("1000")
+	require.NoError(t, err)
+	assert.Equal(t, -1, cmp)
+
+	_, err = Decimal("abc").Add("1")
+	assert.Error(t, err)
+
+	assert.True(t, Decimal("").IsZero())
+	assert.True(t, Decimal("0.00").IsZero())
+	assert.False(t, Decimal("0.01").IsZero())
+	assert.Equal(t, 2, Decimal("1.230").Scale())
+	assert.Equal(t, Decimal("50000.00"), Decimal("50000").WithMinScale(2))
+	assert.Equal(t, Decimal("1.234"), Decimal("1.234").WithMinScale(2))
+	assert.Equal(t, Decimal("510000.74"), NewDecimalFromMinorUnits(51000074, "USD"))
+	assert.Equal(t, Decimal("-0.05"), NewDecimalFromMinorUnits(-5, "USD"))
+	assert.Equal(t, Decimal("1500"), NewDecimalFromMinorUnits(1500, "JPY"))
+}
+
+// TestDecimalMarshalling tests that JSON and XML use the lexical value
+func TestDecimalMarshalling(t *testing.T) {
+	amount := CurrencyAndAmount{Currency: "USD", Amount: "510000.70"}
+	data, err := json.Marshal(amount)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"Currency":"USD","Amount":510000.70}`, string(data))
+	assert.Contains(t, string(data), "510000.70")
+
+	var decoded CurrencyAndAmount
+	require.NoError(t, json.Unmarshal([]byte(`{"Currency":"USD","Amount":1000.00}`), &decoded))
+	assert.Equal(t, Decimal("1000.00"), decoded.Amount)
+	require.NoError(t, json.Unmarshal([]byte(`{"Amount":"25.50"}`), &decoded))
+	assert.Equal(t, Decimal("25.50"), decoded.Amount)
+	assert.Error(t, json.Unmarshal([]byte(`{"Amount":"1e3"}`), &decoded))
+
+	data, err = json.Marshal(CurrencyAndAmount{})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"Currency":"","Amount":null}`, string(data))
+
+	type element struct {
+		Value Decimal `xml:",chardata"`
+	}
+	data, err = xml.Marshal(element{Value: "0.10"})
+	require.NoError(t, err)
+	assert.Equal(t, "<element>0.10</element>", string(data))
+
+	var parsed element
+	require.NoError(t, xml.Unmarshal(data, &parsed))
+	assert.Equal(t, Decimal("0.10"), parsed.Value)
+}
+
+// TestCurrencyAndAmountValidate tests currency minor units
+func TestCurrencyAndAmountValidate(t *testing.T) {
+	assert.Equal(t, 2, CurrencyMinorUnits("USD"))
+	assert.Equal(t, 0, CurrencyMinorUnits("jpy"))
+	assert.Equal(t, 3, CurrencyMinorUnits("BHD"))
+
+	assert.NoError(t, CurrencyAndAmount{Currency: "USD", Amount: "510000.74"}.Validate())
+	assert.NoError(t, CurrencyAndAmount{Currency: "USD", Amount: "510000.740"}.Validate())
+	assert.NoError(t, CurrencyAndAmount{Currency: "JPY", Amount: "1500"}.Validate())
+	assert.NoError(t, CurrencyAndAmount{}.Validate())
+
+	err := CurrencyAndAmount{Currency: "USD", Amount: "1.001"}.Validate()
+	assert.ErrorIs(t, err, errors.ErrInvalidField)
+	assert.Contains(t, err.Error(), "2 decimal places")
+	assert.Error(t, CurrencyAndAmount{Currency: "JPY", Amount: "1500.5"}.Validate())
+	assert.Error(t, CurrencyAndAmount{Currency: "USD", Amount: "1.0e2"}.Validate())
+}
+
+// TestValidateAndScaleAmounts tests walking a message model for amounts
+func TestValidateAndScaleAmounts(t *testing.T) {
+	type transaction struct {
+		Amount CurrencyAndAmount
+		Rate   Decimal
+	}
+	type message struct {
+		Totals       NumberAndSumOfTransactions
+		Transactions []transaction
+		Optional     *CurrencyAndAmount
+	}
+
+	model := message{
+		Totals:       NumberAndSumOfTransactions{NumberOfEntries: "2", Sum: "100.5"},
+		Transactions: []transaction{{Amount: CurrencyAndAmount{Currency: "USD", Amount: "50"}, Rate: "1.25"}},
+	}
+	assert.NoError(t, ValidateAmounts(model))
+
+	model.Transactions = append(model.Transactions, transaction{Amount: CurrencyAndAmount{Currency: "USD", Amount: "50.505"}})
+	err := ValidateAmounts(&model)
+	var validationErr *errors.ValidationError
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, "Transactions[1].Amount", validationErr.Field)
+
+	model.Transactions = model.Transactions[:1]
+	model.Transactions[0].Rate = "1,25"
+	require.ErrorAs(t, ValidateAmounts(&model), &validationErr)
+	assert.Equal(t, "Transactions[0].Rate", validationErr.Field)
+
+	model.Optional = &CurrencyAndAmount{Currency: "USD"}
+	ScaleAmounts(&model)
+	assert.Equal(t, Decimal("50.00"), model.Transactions[0].Amount.Amount)
+	assert.Equal(t, Decimal("0.00"), model.Optional.Amount)
+	assert.Equal(t, Decimal("100.5"), model.Totals.Sum)
+}
+
+// TestDecimalCopy tests converting between document numbers and decimals
+func TestDecimalCopy(t *testing.T) {
+	type document struct {
+		Amt float64
+	}
+	type model struct {
+		Amount Decimal
+	}
+
+	doc := &document{Amt: 510000.74}
+	m := &model{}
+	CopyDocumentValueToMessage(doc, "Amt", m, "Amount")
+	assert.Equal(t, Decimal("510000.74"), m.Amount)
+
+	out := &document{}
+	require.NoError(t, SetElementToDocument(out, "Amt", m.Amount))
+	assert.InDelta(t, 510000.74, out.Amt, 0)
+
+	// A binary number would round this amount, so it is refused rather than changed
+	err := SetElementToDocument(out, "Amt", Decimal("12345678901234567.89"))
+	require.Error(t, err)
+	assert.ErrorIs(t, err, errors.ErrInvalidField)
+
+	// Zero amounts are treated as absent, as float zero was before
+	assert.True(t, IsEmpty(Decimal("0.00")))
+	assert.True(t, isEmpty(Decimal("")))
+	assert.False(t, IsEmpty(Decimal("0.01")))
+}
+
+// TestExactDecimalDocument tests reading and writing the lexical value of amounts around the binary
+// numbers a document holds
+func TestExactDecimalDocument(t *testing.T) {
+	type amount struct {
+		Value float64 `xml:",chardata"`
+		Ccy   string  `xml:"Ccy,attr"`
+	}
+	type document struct {
+		XMLName xml.Name `xml:"urn:test:namespace Document"`
+		Amt     amount   `xml:"urn:test:namespace Amt"`
+		Rate    float64  `xml:"urn:test:namespace Rate"`
+	}
+	type model struct {
+		Amount CurrencyAndAmount
+		Rate   Decimal
+	}
+	pathMap := map[string]string{"Amount.Amount": "Amt.Value", "Amount.Currency": "Amt.Ccy", "Rate": "Rate"}
+
+	m := model{Amount: CurrencyAndAmount{Currency: "USD", Amount: "1500000"}, Rate: "0.000001"}
+	doc := &document{}
+	for modelPath, docPath := range pathMap {
+		_, value, err := GetElement(&m, modelPath)
+		require.NoError(t, err)
+		require.NoError(t, SetElementToDocument(doc, docPath, value))
+	}
+	text := DecimalText(&m, doc, pathMap)
+	assert.Equal(t, map[string]string{"/Document[1]/Amt[1]": "1500000", "/Document[1]/Rate[1]": "0.000001"}, text)
+
+	var plain, exact bytes.Buffer
+	require.NoError(t, xml.NewEncoder(&plain).Encode(doc))
+	assert.Contains(t, plain.String(), "1.5e+06")
+	require.NoError(t, EncodeDocument(xml.NewEncoder(&exact), doc, text))
+	assert.Equal(t, `<Document xmlns="urn:test:namespace"><Amt xmlns="urn:test:namespace" Ccy="USD">1500000</Amt>`+
+		`<Rate xmlns="urn:test:namespace">0.000001</Rate></Document>`, exact.String())
+
+	// Without values to replace, the document is encoded as it is
+	exact.Reset()
+	require.NoError(t, EncodeDocument(xml.NewEncoder(&exact), doc, nil))
+	assert.Equal(t, plain.String(), exact.String())
+
+	// Reading restores the digits and decimal places that were written
+	data := []byte(`<Document xmlns="urn:test:namespace"><Amt Ccy="USD">1500000.10</Amt><Rate>1E-6</Rate></Document>`)
+	read := &document{}
+	require.NoError(t, xml.Unmarshal(data, read))
+	var parsed model
+	rePathMap := map[string]string{}
+	for modelPath, docPath := range pathMap {
+		rePathMap[docPath] = modelPath
+		CopyDocumentValueToMessage(read, docPath, &parsed, modelPath)
+	}
+	ReadDecimals(data, read, rePathMap, &parsed)
+	assert.Equal(t, Decimal("1500000.10"), parsed.Amount.Amount)
+	// Text that is not a decimal keeps the value read from the binary number
+	assert.Equal(t, Decimal("0.000001"), parsed.Rate)
+}
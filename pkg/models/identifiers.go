@@ -0,0 +1,137 @@
+package models
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+
+	"github.com/moov-io/wire20022/pkg/errors"
+)
+
+var (
+	// A BIC is a 4-character party prefix, a 2-letter country code, a 2-character party suffix
+	// and an optional 3-character branch code
+	bicPattern = regexp.MustCompile(`^[A-Z0-9]{4}[A-Z]{2}[A-Z0-9]{2}([A-Z0-9]{3})?$`)
+	// An IBAN is a 2-letter country code, 2 check digits and the country's BBAN
+	ibanPattern = regexp.MustCompile(`^[A-Z]{2}[0-9]{2}[A-Z0-9]+$`)
+	// An LEI is an 18-character LOU prefix and entity code followed by 2 check digits
+	leiPattern = regexp.MustCompile(`^[A-Z0-9]{18}[0-9]{2}$`)
+)
+
+// ibanLengths is the IBAN length of each country in the ISO 13616 IBAN registry
+var ibanLengths = map[string]int{
+	"AD": 24, "AE": 23, "AL": 28, "AT": 20, "AZ": 28, "BA": 20, "BE": 16, "BG": 22, "BH": 22, "BI": 27,
+	"BR": 29, "BY": 28, "CH": 21, "CR": 22, "CY": 28, "CZ": 24, "DE": 22, "DJ": 27, "DK": 18, "DO": 28,
+	"EE": 20, "EG": 29, "ES": 24, "FI": 18, "FK": 18, "FO": 18, "FR": 27, "GB": 22, "GE": 22, "GI": 23,
+	"GL": 18, "GR": 27, "GT": 28, "HN": 28, "HR": 21, "HU": 28, "IE": 22, "IL": 23, "IQ": 23, "IS": 26,
+	"IT": 27, "JO": 30, "KW": 30, "KZ": 20, "LB": 28, "LC": 32, "LI": 21, "LT": 20, "LU": 20, "LV": 21,
+	"LY": 25, "MC": 27, "MD": 24, "ME": 22, "MK": 19, "MN": 20, "MR": 27, "MT": 31, "MU": 30, "NI": 28,
+	"NL": 18, "NO": 15, "OM": 23, "PK": 24, "PL": 28, "PS": 29, "PT": 25, "QA": 29, "RO": 24, "RS": 22,
+	"RU": 33, "SA": 24, "SC": 31, "SD": 18, "SE": 24, "SI": 19, "SK": 24, "SM": 27, "SO": 23, "ST": 25,
+	"SV": 28, "TL": 23, "TN": 24, "TR": 26, "UA": 29, "VA": 22, "VG": 24, "XK": 20, "YE": 30,
+}
+
+// ValidateBIC checks the structure of an ISO 9362 business identifier code, such as "BANKUS33"
+// or "BANKUS33XXX"
+func ValidateBIC(bic string) error {
+	if len(bic) != 8 && len(bic) != 11 {
+		return fmt.Errorf("BIC %q must be 8 or 11 characters", bic)
+	}
+	if !bicPattern.MatchString(bic) {
+		return fmt.Errorf("BIC %q must be a 4-character party prefix, a country code, a 2-character party suffix and an optional 3-character branch code, in upper case", bic)
+	}
+	return nil
+}
+
+// ValidateIBAN checks an ISO 13616 international bank account number in electronic format, without
+// spaces: its length must be the one registered for its country and its check digits must pass mod-97
+func ValidateIBAN(iban string) error {
+	if !ibanPattern.MatchString(iban) {
+		return fmt.Errorf("IBAN %q must be a country code, 2 check digits and the account number, in upper case without spaces", iban)
+	}
+	country := iban[:2]
+	length, ok := ibanLengths[country]
+	if !ok {
+		return fmt.Errorf("IBAN %q has country code %s, which does not use IBANs", iban, country)
+	}
+	if len(iban) != length {
+		return fmt.Errorf("IBAN %q must be %d characters for %s", iban, length, country)
+	}
+	// The check digits move to the end
+	if mod97(iban[4:]+iban[:4]) != 1 {
+		return fmt.Errorf("IBAN %q fails the mod-97 check", iban)
+	}
+	return nil
+}
+
+// ValidateLEI checks an ISO 17442 legal entity identifier: 18 characters followed by 2 check
+// digits that pass mod-97
+func ValidateLEI(lei string) error {
+	if !leiPattern.MatchString(lei) {
+		return fmt.Errorf("LEI %q must be 18 characters followed by 2 check digits, in upper case", lei)
+	}
+	if mod97(lei) != 1 {
+		return fmt.Errorf("LEI %q fails the mod-97 check", lei)
+	}
+	return nil
+}
+
+// CheckIdentifier validates an optional identifier of a message model with validate, such as
+// ValidateIBAN, reporting an invalid one as an invalid field error with its model path
+func CheckIdentifier(path, value string, validate func(string) error) error {
+	if value == "" {
+		return nil
+	}
+	if err := validate(value); err != nil {
+		return errors.NewInvalidFieldError(path, err.Error())
+	}
+	return nil
+}
+
+// identifierFields are the validators of the model fields that hold identifiers, by the end of
+// the field name
+var identifierFields = []struct {
+	suffix   string
+	validate func(string) error
+}{
+	{"IBAN", ValidateIBAN},
+	{"BusinessIdCode", ValidateBIC},
+	{"LEI", ValidateLEI},
+}
+
+// ValidateIdentifiers checks every IBAN, BIC and LEI in a message model, found by field name such
+// as "CreditorIBAN", "BusinessIdCode" or "LEI", reporting each invalid one with its path, e.g.
+// "Transactions[0].DebtorAgent.BusinessIdCode"
+func ValidateIdentifiers(model any) error {
+	var errs []error
+	walkValues(reflect.ValueOf(model), "", func(v reflect.Value) bool {
+		return v.Kind() == reflect.String
+	}, func(path string, v reflect.Value) {
+		name := path[strings.LastIndex(path, ".")+1:]
+		for _, field := range identifierFields {
+			if strings.HasSuffix(name, field.suffix) {
+				if err := CheckIdentifier(path, v.String(), field.validate); err != nil {
+					errs = append(errs, err)
+				}
+			}
+		}
+	})
+	return errors.JoinValidationErrors(errs...)
+}
+
+// mod97 returns the ISO 7064 MOD 97-10 remainder of s, an upper case alphanumeric string in
+// which each letter counts as two digits, A = 10 to Z = 35
+func mod97(s string) int {
+	remainder := 0
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c >= 'A' && c <= 'Z' {
+			n := int(c-'A') + 10
+			remainder = (remainder*100 + n) % 97
+		} else {
+			remainder = (remainder*10 + int(c-'0')) % 97
+		}
+	}
+	return remainder
+}
@@ -0,0 +1,124 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/moov-io/wire20022/pkg/errors"
+)
+
+// TestValidateBIC tests the structure of business identifier codes
+func TestValidateBIC(t *testing.T) {
+	for _, bic := range []string{"BANKUS33", "BANKUS33XXX", "BANZBEBB", "DEUTDEFF500", "1BNKGB2L"} {
+		assert.NoError(t, ValidateBIC(bic), bic)
+	}
+
+	tests := []struct {
+		bic  string
+		want string
+	}{
+		{"BANKUS3", `BIC "BANKUS3" must be 8 or 11 characters`},
+		{"BANKUS33XX", `BIC "BANKUS33XX" must be 8 or 11 characters`},
+		{"bankus33", `BIC "bankus33" must be a 4-character party prefix, a country code, a 2-character party suffix and an optional 3-character branch code, in upper case`},
+		{"BANK1233", `BIC "BANK1233" must be a 4-character party prefix, a country code, a 2-character party suffix and an optional 3-character branch code, in upper case`},
+		{"BANKUS33-01", `BIC "BANKUS33-01" must be a 4-character party prefix, a country code, a 2-character party suffix and an optional 3-character branch code, in upper case`},
+	}
+	for _, tt := range tests {
+		assert.EqualError(t, ValidateBIC(tt.bic), tt.want, tt.bic)
+	}
+}
+
+// TestValidateIBAN tests the country length and mod-97 check digits of IBANs
+func TestValidateIBAN(t *testing.T) {
+	for _, iban := range []string{"BE30001216371411", "BE68539007547034", "GB82WEST12345698765432", "DE89370400440532013000", "BR9700360305000010009795493P1", "NO9386011117947"} {
+		assert.NoError(t, ValidateIBAN(iban), iban)
+	}
+
+	tests := []struct {
+		iban string
+		want string
+	}{
+		{"BE34001216371411", `IBAN "BE34001216371411" fails the mod-97 check`},
+		{"GB82WEST12345698765423", `IBAN "GB82WEST12345698765423" fails the mod-97 check`},
+		{"DE8937040044053201300", `IBAN "DE8937040044053201300" must be 22 characters for DE`},
+		{"US64SVBKUS6S3300958879", `IBAN "US64SVBKUS6S3300958879" has country code US, which does not use IBANs`},
+		{"GB82 WEST 1234 5698 7654 32", `IBAN "GB82 WEST 1234 5698 7654 32" must be a country code, 2 check digits and the account number, in upper case without spaces`},
+		{"gb82west12345698765432", `IBAN "gb82west12345698765432" must be a country code, 2 check digits and the account number, in upper case without spaces`},
+		{"GB", `IBAN "GB" must be a country code, 2 check digits and the account number, in upper case without spaces`},
+	}
+	for _, tt := range tests {
+		assert.EqualError(t, ValidateIBAN(tt.iban), tt.want, tt.iban)
+	}
+}
+
+// TestValidateLEI tests the format and mod-97 check digits of legal entity identifiers
+func TestValidateLEI(t *testing.T) {
+	for _, lei := range []string{"5493001KJTIIGC8Y1R12", "HWUPKR0MPOU8FGXBT394"} {
+		assert.NoError(t, ValidateLEI(lei), lei)
+	}
+
+	tests := []struct {
+		lei  string
+		want string
+	}{
+		{"5493001KJTIIGC8Y1R21", `LEI "5493001KJTIIGC8Y1R21" fails the mod-97 check`},
+		{"HWUPKR0MPOU8FGXBT349", `LEI "HWUPKR0MPOU8FGXBT349" fails the mod-97 check`},
+		{"5493001KJTIIGC8Y1R1", `LEI "5493001KJTIIGC8Y1R1" must be 18 characters followed by 2 check digits, in upper case`},
+		{"5493001KJTIIGC8Y1RAB", `LEI "5493001KJTIIGC8Y1RAB" must be 18 characters followed by 2 check digits, in upper case`},
+		{"5493001kjtiigc8y1r12", `LEI "5493001kjtiigc8y1r12" must be 18 characters followed by 2 check digits, in upper case`},
+	}
+	for _, tt := range tests {
+		assert.EqualError(t, ValidateLEI(tt.lei), tt.want, tt.lei)
+	}
+}
+
+// TestCheckIdentifier tests that an invalid identifier is reported with its model path
+func TestCheckIdentifier(t *testing.T) {
+	assert.NoError(t, CheckIdentifier("DebtorIBAN", "", ValidateIBAN))
+	assert.NoError(t, CheckIdentifier("DebtorIBAN", "DE89370400440532013000", ValidateIBAN))
+
+	err := CheckIdentifier("Transactions[0].DebtorIBAN", "DE89370400440532013001", ValidateIBAN)
+	var validationErr *errors.ValidationError
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, "Transactions[0].DebtorIBAN", validationErr.Field)
+	assert.ErrorIs(t, err, errors.ErrInvalidField)
+}
+
+// TestValidateIdentifiers tests that the IBANs, BICs and LEIs of a model are found by field name
+func TestValidateIdentifiers(t *testing.T) {
+	type party struct {
+		Agent     Agent
+		PartyIBAN string
+	}
+	type model struct {
+		InstructingAgent Agent
+		Parties          []*party
+		Name             string
+	}
+	m := model{
+		InstructingAgent: Agent{BusinessIdCode: "BANKUS33"},
+		Parties:          []*party{{Agent: Agent{BusinessIdCode: "DEUTDEFF500"}, PartyIBAN: "DE89370400440532013000"}},
+		Name:             "BE34001216371411",
+	}
+	assert.NoError(t, ValidateIdentifiers(&m))
+
+	m.InstructingAgent.BusinessIdCode = "BANK US33"
+	err := ValidateIdentifiers(m)
+	var validationErr *errors.ValidationError
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, "InstructingAgent.BusinessIdCode", validationErr.Field)
+
+	m.InstructingAgent.BusinessIdCode = ""
+	m.Parties[0].PartyIBAN = "BE34001216371411"
+	err = ValidateIdentifiers(&m)
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, "Parties[0].PartyIBAN", validationErr.Field)
+
+	m.Parties[0].PartyIBAN = ""
+	m.Parties[0].Agent.LEI = "5493001KJTIIGC8Y1R21"
+	err = ValidateIdentifiers(&m)
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, "Parties[0].Agent.LEI", validationErr.Field)
+}
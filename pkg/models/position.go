@@ -0,0 +1,149 @@
+package models
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/moov-io/wire20022/pkg/errors"
+)
+
+// Positions maps the elements of an XML document to where they start. Keys are XPaths with every
+// step indexed, e.g. "/Document[1]/FIToFICstmrCdtTrf[1]/CdtTrfTxInf[2]/PmtId[1]"; attributes
+// share the position of their element.
+type Positions map[string]errors.Location
+
+// ScanPositions records the line, column and byte offset of each element in data
+func ScanPositions(data []byte) (Positions, error) {
+	positions := Positions{}
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	var elements xpathTracker
+	for {
+		line, column := decoder.InputPos()
+		offset := decoder.InputOffset()
+		token, err := decoder.Token()
+		if err == io.EOF {
+			return positions, nil
+		}
+		if err != nil {
+			return positions, DecodeError("XML decode", "document", err, decoder)
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			path := elements.start(t.Name.Local)
+			location := errors.Location{Line: line, Column: column, Offset: offset}
+			positions[path] = location
+			for _, attr := range t.Attr {
+				positions[path+"/@"+attr.Name.Local] = location
+			}
+		case xml.EndElement:
+			elements.end()
+		}
+	}
+}
+
+// ScanText records the character data of each element in data by XPath, keyed like Positions.
+// Elements with child elements are included with the text between them.
+func ScanText(data []byte) (map[string]string, error) {
+	text := map[string]string{}
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	var elements xpathTracker
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			return text, nil
+		}
+		if err != nil {
+			return text, DecodeError("XML decode", "document", err, decoder)
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			elements.start(t.Name.Local)
+		case xml.EndElement:
+			elements.end()
+		case xml.CharData:
+			if path := elements.current(); path != "" {
+				text[path] += string(t)
+			}
+		}
+	}
+}
+
+// xpathTracker follows the elements of a document as it is read token by token, naming each by
+// its XPath with every step indexed
+type xpathTracker struct {
+	stack []openElement
+}
+
+type openElement struct {
+	path     string
+	children map[string]int
+}
+
+// start enters an element and returns its XPath
+func (x *xpathTracker) start(name string) string {
+	if len(x.stack) == 0 {
+		x.stack = append(x.stack, openElement{children: map[string]int{}})
+	}
+	parent := &x.stack[len(x.stack)-1]
+	parent.children[name]++
+	path := fmt.Sprintf("%s/%s[%d]", parent.path, name, parent.children[name])
+	x.stack = append(x.stack, openElement{path: path, children: map[string]int{}})
+	return path
+}
+
+// end leaves the current element
+func (x *xpathTracker) end() {
+	if len(x.stack) > 1 {
+		x.stack = x.stack[:len(x.stack)-1]
+	}
+}
+
+// current returns the XPath of the element being read, or "" outside the root element
+func (x *xpathTracker) current() string {
+	if len(x.stack) == 0 {
+		return ""
+	}
+	return x.stack[len(x.stack)-1].path
+}
+
+// Lookup returns the position of an element by XPath, such as one returned by DocumentXPath.
+// Steps without an index are the first of their name. When the element is not in the document,
+// the position of its nearest ancestor that is, is returned instead.
+func (p Positions) Lookup(xpath string) (errors.Location, bool) {
+	steps := strings.Split(strings.TrimPrefix(indexXPath(xpath), "/"), "/")
+	for n := len(steps); n > 0; n-- {
+		if location, ok := p["/"+strings.Join(steps[:n], "/")]; ok {
+			return location, true
+		}
+	}
+	return errors.Location{}, false
+}
+
+// indexXPath indexes each step of an XPath that has no index as the first of its name, e.g.
+// "/Document/FIToFICstmrCdtTrf" is "/Document[1]/FIToFICstmrCdtTrf[1]"
+func indexXPath(xpath string) string {
+	var steps []string
+	for _, step := range strings.Split(xpath, "/") {
+		if step == "" {
+			continue
+		}
+		if !strings.HasPrefix(step, "@") && !strings.HasSuffix(step, "]") {
+			step += "[1]"
+		}
+		steps = append(steps, step)
+	}
+	return "/" + strings.Join(steps, "/")
+}
+
+// DecodeError wraps an XML decoding error in a ParseError with the position the decoder stopped at
+func DecodeError(operation, content string, err error, decoder *xml.Decoder) *errors.ParseError {
+	parseErr := errors.NewParseError(operation, content, err)
+	line, column := decoder.InputPos()
+	parseErr.Location = &errors.Location{Line: line, Column: column, Offset: decoder.InputOffset()}
+	return parseErr
+}
@@ -0,0 +1,64 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/moov-io/wire20022/pkg/errors"
+)
+
+const positionDocument = `<?xml version="1.0" encoding="UTF-8"?>
+<Document xmlns="urn:test:namespace">
+	<Header>
+		<ID>TEST123</ID>
+	</Header>
+	<Details><Name>First</Name></Details>
+	<Details>
+		<Name>Second</Name>
+		<Amounts Ccy="USD"><Value>1.5</Value></Amounts>
+	</Details>
+</Document>`
+
+// TestScanPositions tests recording and looking up element positions
+func TestScanPositions(t *testing.T) {
+	positions, err := ScanPositions([]byte(positionDocument))
+	require.NoError(t, err)
+
+	loc, ok := positions.Lookup("/Document/Header/ID")
+	require.True(t, ok)
+	assert.Equal(t, errors.Location{Line: 4, Column: 3, Offset: 89}, loc)
+	assert.Equal(t, "<ID>", positionDocument[loc.Offset:loc.Offset+4])
+
+	loc, ok = positions.Lookup("/Document/Details[2]/Amounts[1]/@Ccy")
+	require.True(t, ok)
+	assert.Equal(t, 9, loc.Line)
+
+	// A missing element is placed at its nearest ancestor
+	loc, ok = positions.Lookup("/Document/Details[2]/Address/City")
+	require.True(t, ok)
+	assert.Equal(t, 7, loc.Line)
+	assert.Equal(t, "<Details>\n", positionDocument[loc.Offset:loc.Offset+10])
+
+	_, ok = positions.Lookup("/Other/Header")
+	assert.False(t, ok)
+
+	xpath, ok := DocumentXPath(&TestDocument{}, "Details[1].Amounts[0].Value")
+	require.True(t, ok)
+	assert.Equal(t, "/Document/Details[2]/Amounts[1]/Value", xpath)
+	_, ok = DocumentXPath(&TestDocument{}, "Details[1].Missing")
+	assert.False(t, ok)
+}
+
+// TestDocumentFromLocation tests that decoding errors carry the position decoding stopped at
+func TestDocumentFromLocation(t *testing.T) {
+	factoryMap := map[string]DocumentFactory{
+		"urn:test:namespace": func() ISODocument { return &TestDocument{} },
+	}
+	_, _, err := DocumentFrom([]byte("<Document xmlns=\"urn:test:namespace\">\n\t<Header>\n\t\t<ID>1</Header>\n</Document>"), factoryMap)
+	require.Error(t, err)
+	loc, ok := errors.LocationOf(err)
+	require.True(t, ok)
+	assert.Equal(t, 3, loc.Line)
+}
@@ -0,0 +1,122 @@
+package models
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/moov-io/wire20022/pkg/errors"
+)
+
+// abaWeights are the weights of the ABA routing number check digit, repeated over the nine digits
+var abaWeights = [9]int{3, 7, 1, 3, 7, 1, 3, 7, 1}
+
+// ValidateABARoutingNumber checks an ABA routing number: nine digits whose first two are a
+// Federal Reserve routing symbol prefix and whose 3-7-1 weighted sum is a multiple of ten.
+func ValidateABARoutingNumber(number string) error {
+	if len(number) != 9 {
+		return fmt.Errorf("routing number %q must be 9 digits", number)
+	}
+	sum := 0
+	for i := 0; i < len(number); i++ {
+		c := number[i]
+		if c < '0' || c > '9' {
+			return fmt.Errorf("routing number %q must be 9 digits", number)
+		}
+		sum += int(c-'0') * abaWeights[i]
+	}
+	if !validRoutingSymbolPrefix(number[:2]) {
+		return fmt.Errorf("routing number %q does not start with a Federal Reserve routing symbol", number)
+	}
+	if sum%10 != 0 {
+		last := int(number[8] - '0')
+		expected := (10 - (sum-last)%10) % 10
+		return fmt.Errorf("routing number %q has check digit %d, expected %d", number, last, expected)
+	}
+	return nil
+}
+
+// validRoutingSymbolPrefix reports whether prefix starts a Federal Reserve routing symbol:
+// 00 to 12 for banks, 21 to 32 for thrift institutions, 61 to 72 for electronic transactions
+// and 80 for traveler's checks
+func validRoutingSymbolPrefix(prefix string) bool {
+	n := int(prefix[0]-'0')*10 + int(prefix[1]-'0')
+	switch {
+	case n <= 12, n >= 21 && n <= 32, n >= 61 && n <= 72, n == 80:
+		return true
+	}
+	return false
+}
+
+// ValidateAgents checks the routing number of every agent in a message model identified by
+// USABA, reporting each invalid one with its path, e.g. "DebtorAgent.PaymentSysMemberId"
+func ValidateAgents(model any) error {
+	var errs []error
+	EachAgent(model, func(path string, agent Agent) {
+		if agent.PaymentSysCode != PaymentSysUSABA {
+			return
+		}
+		if err := CheckIdentifier(path+".PaymentSysMemberId", agent.PaymentSysMemberId, ValidateABARoutingNumber); err != nil {
+			errs = append(errs, err)
+		}
+	})
+	return errors.JoinValidationErrors(errs...)
+}
+
+var agentType = reflect.TypeOf(Agent{})
+
+// EachAgent calls fn for each Agent reachable from a message model, in field order, with its
+// path, e.g. "Transactions[0].DebtorAgent"
+func EachAgent(model any, fn func(path string, agent Agent)) {
+	walkType(reflect.ValueOf(model), "", agentType, func(path string, v reflect.Value) {
+		fn(path, v.Interface().(Agent))
+	})
+}
+
+// walkType calls fn for each value of type t reachable from v, flattening embedded structs
+// into their parent's path like walkAmounts
+func walkType(v reflect.Value, path string, t reflect.Type, fn func(path string, v reflect.Value)) {
+	walkValues(v, path, func(v reflect.Value) bool { return v.Type() == t }, fn)
+}
+
+// walkValues calls fn for each value reachable from v that match accepts, without looking
+// inside it
+func walkValues(v reflect.Value, path string, match func(v reflect.Value) bool, fn func(path string, v reflect.Value)) {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if !v.IsNil() {
+			walkValues(v.Elem(), path, match, fn)
+		}
+		return
+	case reflect.Invalid:
+		return
+	}
+	if match(v) {
+		fn(path, v)
+		return
+	}
+	switch v.Kind() {
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			walkValues(v.Index(i), fmt.Sprintf("%s[%d]", path, i), match, fn)
+		}
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			field := v.Type().Field(i)
+			if !field.IsExported() {
+				continue
+			}
+			fieldPath := field.Name
+			if field.Anonymous {
+				fieldPath = ""
+			}
+			switch {
+			case path == "":
+			case fieldPath == "":
+				fieldPath = path
+			default:
+				fieldPath = path + "." + fieldPath
+			}
+			walkValues(v.Field(i), fieldPath, match, fn)
+		}
+	}
+}
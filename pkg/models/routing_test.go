@@ -0,0 +1,79 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/moov-io/wire20022/pkg/errors"
+)
+
+// TestValidateABARoutingNumber tests the length, routing symbol and check digit of routing numbers
+func TestValidateABARoutingNumber(t *testing.T) {
+	for _, number := range []string{"011104238", "021040078", "231981435", "091036164", "122240120", "322271627"} {
+		assert.NoError(t, ValidateABARoutingNumber(number), number)
+	}
+
+	tests := []struct {
+		number string
+		want   string
+	}{
+		{"", `routing number "" must be 9 digits`},
+		{"02104007", `routing number "02104007" must be 9 digits`},
+		{"0210400781", `routing number "0210400781" must be 9 digits`},
+		{"02104OO78", `routing number "02104OO78" must be 9 digits`},
+		{"021040079", `routing number "021040079" has check digit 9, expected 8`},
+		{"012040078", `routing number "012040078" has check digit 8, expected 4`},
+		{"131040071", `routing number "131040071" does not start with a Federal Reserve routing symbol`},
+		{"991040078", `routing number "991040078" does not start with a Federal Reserve routing symbol`},
+	}
+	for _, tt := range tests {
+		assert.EqualError(t, ValidateABARoutingNumber(tt.number), tt.want, tt.number)
+	}
+}
+
+// TestValidateAgents tests that invalid BICs and routing numbers are reported with the agent's path
+func TestValidateAgents(t *testing.T) {
+	type AgentPair struct {
+		InstructingAgent Agent
+		InstructedAgent  Agent
+	}
+	type transaction struct {
+		DebtorAgent   Agent
+		CreditorAgent *Agent
+	}
+	type model struct {
+		AgentPair
+		Transactions []transaction
+	}
+	m := model{
+		AgentPair: AgentPair{
+			InstructingAgent: Agent{PaymentSysCode: PaymentSysUSABA, PaymentSysMemberId: "011104238"},
+			InstructedAgent:  Agent{BusinessIdCode: "BANKUS33"},
+		},
+		Transactions: []transaction{{
+			DebtorAgent:   Agent{PaymentSysCode: PaymentSysUSABA, PaymentSysMemberId: "021040078"},
+			CreditorAgent: &Agent{PaymentSysCode: PaymentSysUSABA, PaymentSysMemberId: "021040078"},
+		}},
+	}
+	assert.NoError(t, ValidateAgents(&m))
+
+	var paths []string
+	EachAgent(&m, func(path string, _ Agent) {
+		paths = append(paths, path)
+	})
+	assert.Equal(t, []string{"InstructingAgent", "InstructedAgent", "Transactions[0].DebtorAgent", "Transactions[0].CreditorAgent"}, paths)
+
+	// Only USABA member identifications are routing numbers
+	m.InstructedAgent = Agent{PaymentSysCode: "GBDSC", PaymentSysMemberId: "123456"}
+	assert.NoError(t, ValidateAgents(m))
+
+	m.Transactions[0].DebtorAgent.PaymentSysMemberId = "021040079"
+	m.Transactions[0].CreditorAgent.PaymentSysMemberId = "21040078"
+	err := ValidateAgents(m)
+	require.Error(t, err)
+	assert.ErrorIs(t, err, errors.ErrInvalidField)
+	assert.EqualError(t, err, `validation failed for field "Transactions[0].DebtorAgent.PaymentSysMemberId": routing number "021040079" has check digit 9, expected 8: invalid field`+"\n"+
+		`validation failed for field "Transactions[0].CreditorAgent.PaymentSysMemberId": routing number "21040078" must be 9 digits: invalid field`)
+}
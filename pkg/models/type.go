@@ -28,7 +28,26 @@ type AccountReportType string
 type BalanceType string
 type CreditLineType string
 type TransactionCode string
+type TrafficType string
+type InvestigationType string
+type InvestigationStatus string
 
+const (
+	InvestigationUnableToApply         InvestigationType = "UTAP" // Payment cannot be applied to the beneficiary
+	InvestigationRequestForInformation InvestigationType = "RQFI" // Further information on the payment is needed
+	InvestigationValueDateAdjustment   InvestigationType = "RQVA" // Value date of the payment should be adjusted
+	InvestigationClaimNonReceipt       InvestigationType = "CLNR" // Expected payment was not received
+	InvestigationDebitAuthorisation    InvestigationType = "RQDA" // Authorisation to debit the beneficiary is requested
+	InvestigationOther                 InvestigationType = "OTHR" // Miscellaneous investigation
+)
+const (
+	InvestigationStatusClosed   InvestigationStatus = "CLSD" // Investigation is closed
+	InvestigationStatusRejected InvestigationStatus = "RJCT" // Investigation is rejected by the responder
+)
+const (
+	TrafficTypeSent     TrafficType = "S" // Messages sent by the participant
+	TrafficTypeReceived TrafficType = "R" // Messages received by the participant
+)
 const (
 	InputMessageAccountabilityData  GapType = "IMAD"
 	OutputMessageAccountabilityData GapType = "OMAD"
@@ -85,6 +104,9 @@ const (
 	InstrumentStraightThroughProcessing InstrumentPropCodeType = "STP"  // Straight Through Processing
 	InstrumentNCT                       InstrumentPropCodeType = "NCT"  // National Credit Transfer
 	InstrumentCTRD                      InstrumentPropCodeType = "CTRD" // National Credit Transfer
+	InstrumentBTRS                      InstrumentPropCodeType = "BTRS" // Bank Transfer (pacs.009)
+	InstrumentBTRD                      InstrumentPropCodeType = "BTRD" // Bank Drawdown Transfer (pacs.009)
+	InstrumentCOVS                      InstrumentPropCodeType = "COVS" // Cover Payment (pacs.009 COV)
 )
 
 const (
@@ -244,9 +266,12 @@ type PostalAddress struct {
 	TownName       string
 	Subdivision    string
 	Country        string
+	// Free-text lines (AdrLine); with TownName and Country they make a hybrid address
+	AddressLine []string
 }
 type Agent struct {
 	BusinessIdCode     string
+	LEI                string
 	PaymentSysCode     PaymentSystemType
 	PaymentSysMemberId string
 	BankName           string
@@ -256,7 +281,7 @@ type Agent struct {
 type CurrencyAndAmount struct {
 	//default: USD
 	Currency string
-	Amount   float64
+	Amount   Decimal
 }
 type TotalsPerBankTransactionCode struct {
 	// NbOfNtries (Number of Entries) specifies the total number of transactions for a given bank transaction code.
@@ -267,7 +292,7 @@ type TotalsPerBankTransactionCode struct {
 }
 
 type TotalsPerBankTransaction struct {
-	TotalNetEntryAmount  float64
+	TotalNetEntryAmount  Decimal
 	CreditDebitIndicator CdtDbtInd
 	CreditEntries        NumberAndSumOfTransactions
 	DebitEntries         NumberAndSumOfTransactions
@@ -289,7 +314,7 @@ type NumberAndSumOfTransactions struct {
 	NumberOfEntries string
 	// Sum represents the total monetary value of all transactions included in the report.
 	// It aggregates the values of individual transactions to provide a summary amount.
-	Sum float64
+	Sum Decimal
 }
 type Entry struct {
 	// Amt (Amount) specifies the transaction amount along with the currency.
@@ -390,3 +415,11 @@ type ReturnChain struct {
 	//Unambiguous identification of the account of the creditor to which a credit entry will be posted as a result of the payment transaction.
 	CreditorAccountOtherTypeId string
 }
+type InvestigationReason struct {
+	//Reason for the investigation.
+	Reason string
+	//Further specifies the reason for the investigation.
+	SubType string
+	//Free-form details of the information requested.
+	Narrative string
+}
@@ -17,6 +17,7 @@ type PostalAddressHelper struct {
 	TownName       ElementHelper
 	Subdivision    ElementHelper
 	Country        ElementHelper
+	AddressLine    ElementHelper
 }
 
 func BuildPostalAddressHelper() PostalAddressHelper {
@@ -75,11 +76,18 @@ func BuildPostalAddressHelper() PostalAddressHelper {
 			Type:          `CountryCode (based on string) pattern: [A-Z]{2,2}`,
 			Documentation: `Nation with its own government.`,
 		},
+		AddressLine: ElementHelper{
+			Title:         "Address Line",
+			Rules:         "",
+			Type:          `Max70Text (based on string) minLength: 1 maxLength: 70`,
+			Documentation: `Information that locates and identifies a specific address, as defined by postal services, presented in free format text.`,
+		},
 	}
 }
 
 type AgentHelper struct {
 	BusinessIdCode     ElementHelper
+	LEI                ElementHelper
 	PaymentSysCode     ElementHelper
 	PaymentSysMemberId ElementHelper
 	BankName           ElementHelper
@@ -95,6 +103,12 @@ func BuildAgentHelper() AgentHelper {
 			Type:          `BICFIDec2014Identifier (based on string) pattern: [A-Z0-9]{4,4}[A-Z]{2,2}[A-Z0-9]{2,2}([A-Z0-9]{3,3}){0,1} identificationScheme: SWIFT; BICIdentifier`,
 			Documentation: `Code allocated to a financial institution by the ISO 9362 Registration Authority as described in ISO 9362 "Banking - Banking telecommunication messages - Business identifier code (BIC)".`,
 		},
+		LEI: ElementHelper{
+			Title:         "LEI",
+			Rules:         "",
+			Type:          `LEIIdentifier (based on string) pattern: [A-Z0-9]{18,18}[0-9]{2,2}`,
+			Documentation: `Legal entity identifier of the financial institution.`,
+		},
 		PaymentSysCode: ElementHelper{
 			Title:         "Clearing System Identification Code",
 			Rules:         "",
@@ -0,0 +1,469 @@
+package models
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/xml"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/moov-io/wire20022/pkg/errors"
+)
+
+// Unmapped holds the content of a parsed ISO document that no path map covers, such as
+// RgltryRptg, InstrForNxtAgt or additional intermediary agents, so that writing the model
+// back out does not strip it. Repeated blocks are restored by position.
+type Unmapped struct {
+	// Namespace of the document the content was captured from
+	Namespace string `json:"namespace"`
+	// XML is the source document with every mapped element removed
+	XML string `json:"xml"`
+}
+
+var indexedSegment = regexp.MustCompile(`^(\w+)\[(\d+)\]$`)
+
+// CaptureUnmapped removes the mapped elements from a parsed document and returns what is left.
+// mappedPaths maps document paths to model paths; a document element is only removed when the
+// model field it was copied to is set, so values that failed to copy are kept as well.
+// It returns nil when the path maps cover the whole document. The document is modified.
+func CaptureUnmapped(doc ISODocument, namespace string, mappedPaths map[string]string, model any) (*Unmapped, error) {
+	for docPath, modelPath := range mappedPaths {
+		if _, value, err := GetElement(model, modelPath); err != nil || isEmpty(value) {
+			continue
+		}
+		if err := clearElement(doc, docPath); err != nil {
+			return nil, err
+		}
+	}
+
+	return remaining(doc, namespace)
+}
+
+// remaining returns the content left in a document, or nil when it is empty
+func remaining(doc ISODocument, namespace string) (*Unmapped, error) {
+	if pruneEmpty(reflect.ValueOf(doc)) {
+		return nil, nil
+	}
+
+	var buf bytes.Buffer
+	encoder := xml.NewEncoder(&buf)
+	root := reflect.ValueOf(doc).Elem()
+	if err := encodePresent(encoder, root, xml.StartElement{Name: documentName(root)}); err != nil {
+		return nil, errors.NewParseError("XML encode", "unmapped content", err)
+	}
+	if err := encoder.Flush(); err != nil {
+		return nil, errors.NewParseError("XML encode", "unmapped content", err)
+	}
+	return &Unmapped{Namespace: namespace, XML: buf.String()}, nil
+}
+
+// DroppedElement is an element of captured content that a document of another version has no
+// place for
+type DroppedElement struct {
+	// XPath of the element with every step indexed, e.g.
+	// "/Document[1]/FIToFICstmrCdtTrf[1]/CdtTrfTxInf[1]/RgltryRptg[1]"
+	XPath string
+	// XML of the element as it was captured
+	XML string
+}
+
+// Carry moves the captured content to doc, an empty document of another namespace, such as a
+// later version of the same message. Each element is kept where doc has an element of that name
+// in the same place; the others are returned. It returns u unchanged when the namespace is the
+// same. doc is modified.
+func (u *Unmapped) Carry(doc ISODocument, namespace string) (*Unmapped, []DroppedElement, error) {
+	if u == nil || u.XML == "" || u.Namespace == namespace {
+		return u, nil, nil
+	}
+
+	var buf bytes.Buffer
+	c := &carrier{
+		decoder: xml.NewDecoder(strings.NewReader(u.XML)),
+		encoder: xml.NewEncoder(&buf),
+		from:    u.Namespace,
+		to:      namespace,
+	}
+	for {
+		token, err := c.decoder.Token()
+		if err != nil {
+			return nil, nil, errors.NewParseError("XML decode", "unmapped content", err)
+		}
+		if start, ok := token.(xml.StartElement); ok {
+			c.elements.start(start.Name.Local)
+			if err := c.element(start, reflect.TypeOf(doc)); err != nil {
+				return nil, nil, errors.NewParseError("XML decode", "unmapped content", err)
+			}
+			break
+		}
+	}
+	if err := c.encoder.Flush(); err != nil {
+		return nil, nil, errors.NewParseError("XML encode", "unmapped content", err)
+	}
+
+	// Values the target elements do not accept drop the content as a whole
+	if err := xml.Unmarshal(buf.Bytes(), doc); err != nil {
+		root := documentName(reflect.ValueOf(doc).Elem())
+		return nil, []DroppedElement{{XPath: "/" + root.Local + "[1]", XML: u.XML}}, nil
+	}
+	carried, err := remaining(doc, namespace)
+	return carried, c.dropped, err
+}
+
+// carrier copies captured content from one namespace to another, following the type of the
+// target document
+type carrier struct {
+	decoder  *xml.Decoder
+	encoder  *xml.Encoder
+	from, to string
+	elements xpathTracker
+	dropped  []DroppedElement
+}
+
+// element copies an element whose start has been read, keeping the children that type t has a
+// field for
+func (c *carrier) element(start xml.StartElement, t reflect.Type) error {
+	for t.Kind() == reflect.Ptr || t.Kind() == reflect.Slice {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct || isXMLValue(t) {
+		return c.copyElement(start)
+	}
+	if err := c.encoder.EncodeToken(c.rename(start)); err != nil {
+		return err
+	}
+	for {
+		token, err := c.decoder.Token()
+		if err != nil {
+			return err
+		}
+		switch token := token.(type) {
+		case xml.StartElement:
+			xpath := c.elements.start(token.Name.Local)
+			if field, ok := elementField(t, token.Name.Local); ok {
+				err = c.element(token, field.Type)
+			} else {
+				err = c.drop(token, xpath)
+			}
+			if err != nil {
+				return err
+			}
+			c.elements.end()
+		case xml.EndElement:
+			return c.encoder.EncodeToken(xml.EndElement{Name: c.rename(start).Name})
+		case xml.CharData:
+			if err := c.encoder.EncodeToken(token.Copy()); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// copyElement copies an element whose start has been read along with everything in it
+func (c *carrier) copyElement(start xml.StartElement) error {
+	if err := c.encoder.EncodeToken(c.rename(start)); err != nil {
+		return err
+	}
+	return c.copyTokens(c.encoder, c.rename)
+}
+
+// drop records an element the target has no place for along with everything in it
+func (c *carrier) drop(start xml.StartElement, xpath string) error {
+	var buf bytes.Buffer
+	encoder := xml.NewEncoder(&buf)
+	keep := func(start xml.StartElement) xml.StartElement {
+		return xml.StartElement{Name: start.Name, Attr: withoutNamespaces(start.Attr)}
+	}
+	if err := encoder.EncodeToken(keep(start)); err != nil {
+		return err
+	}
+	if err := c.copyTokens(encoder, keep); err != nil {
+		return err
+	}
+	if err := encoder.Flush(); err != nil {
+		return err
+	}
+	c.dropped = append(c.dropped, DroppedElement{XPath: xpath, XML: buf.String()})
+	return nil
+}
+
+// copyTokens copies the tokens up to the end of the element whose start was the last one read
+func (c *carrier) copyTokens(encoder *xml.Encoder, rename func(xml.StartElement) xml.StartElement) error {
+	for depth := 1; depth > 0; {
+		token, err := c.decoder.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			depth++
+			token = rename(t)
+		case xml.EndElement:
+			depth--
+			token = xml.EndElement{Name: rename(xml.StartElement{Name: t.Name}).Name}
+		default:
+			token = xml.CopyToken(t)
+		}
+		if err := encoder.EncodeToken(token); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// rename moves an element to the target namespace. The encoder declares the namespace itself.
+func (c *carrier) rename(start xml.StartElement) xml.StartElement {
+	name := start.Name
+	if name.Space == c.from {
+		name.Space = c.to
+	}
+	return xml.StartElement{Name: name, Attr: withoutNamespaces(start.Attr)}
+}
+
+// withoutNamespaces returns the attributes that are not namespace declarations
+func withoutNamespaces(attrs []xml.Attr) []xml.Attr {
+	var kept []xml.Attr
+	for _, attr := range attrs {
+		if attr.Name.Space == "xmlns" || (attr.Name.Space == "" && attr.Name.Local == "xmlns") {
+			continue
+		}
+		kept = append(kept, attr)
+	}
+	return kept
+}
+
+// elementField returns the field of a struct type that holds child elements of a name
+func elementField(t reflect.Type, local string) (reflect.StructField, bool) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name, options, _ := strings.Cut(field.Tag.Get("xml"), ",")
+		if !field.IsExported() || field.Type == xmlNameType || name == "-" ||
+			strings.Contains(options, "attr") || strings.Contains(options, "chardata") ||
+			strings.Contains(options, "innerxml") {
+			continue
+		}
+		if _, after, ok := strings.Cut(name, " "); ok {
+			name = after
+		}
+		if name == "" {
+			name = field.Name
+		}
+		if name == local {
+			return field, true
+		}
+	}
+	return reflect.StructField{}, false
+}
+
+// Restore writes the captured content into an empty document of the same namespace, ahead
+// of the mapped values being copied from the model. A nil Unmapped does nothing.
+func (u *Unmapped) Restore(doc ISODocument, namespace string) error {
+	if u == nil || u.XML == "" {
+		return nil
+	}
+	if u.Namespace != namespace {
+		return errors.NewValidationError("Unmapped",
+			fmt.Sprintf("content captured from %s cannot be written as %s", u.Namespace, namespace))
+	}
+	if err := xml.Unmarshal([]byte(u.XML), doc); err != nil {
+		return errors.NewParseError("XML unmarshal", "unmapped content", err)
+	}
+	return nil
+}
+
+// clearElement sets the element at a dot-notation path to its zero value. Missing elements
+// are ignored.
+func clearElement(item any, path string) error {
+	v := reflect.ValueOf(item)
+	segments := strings.Split(path, ".")
+	for i, segment := range segments {
+		for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+			if v.IsNil() {
+				return nil
+			}
+			v = v.Elem()
+		}
+		if v.Kind() != reflect.Struct {
+			return nil
+		}
+
+		index := -1
+		if matches := indexedSegment.FindStringSubmatch(segment); matches != nil {
+			segment = matches[1]
+			var err error
+			if index, err = strconv.Atoi(matches[2]); err != nil {
+				return errors.NewFieldError(path, "clear", err)
+			}
+		}
+
+		v = v.FieldByName(segment)
+		if !v.IsValid() {
+			return nil
+		}
+		if index >= 0 {
+			if (v.Kind() != reflect.Slice && v.Kind() != reflect.Array) || index >= v.Len() {
+				return nil
+			}
+			v = v.Index(index)
+		}
+
+		if i == len(segments)-1 && v.CanSet() {
+			v.Set(reflect.Zero(v.Type()))
+		}
+	}
+	return nil
+}
+
+// pruneEmpty drops pointers to empty elements and trailing empty repeated blocks, reporting
+// whether the value is empty afterwards. Empty blocks in the middle of a slice are kept so
+// that the blocks after them keep their position.
+func pruneEmpty(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return true
+		}
+		if pruneEmpty(v.Elem()) {
+			if v.CanSet() {
+				v.Set(reflect.Zero(v.Type()))
+			}
+			return true
+		}
+		return false
+	case reflect.Slice:
+		n := v.Len()
+		empty := make([]bool, n)
+		for i := 0; i < n; i++ {
+			empty[i] = pruneEmpty(v.Index(i))
+		}
+		for n > 0 && empty[n-1] {
+			n--
+		}
+		if v.CanSet() {
+			if n == 0 {
+				v.Set(reflect.Zero(v.Type()))
+			} else {
+				v.SetLen(n)
+			}
+		}
+		return n == 0
+	case reflect.Struct:
+		t := v.Type()
+		for i := 0; i < t.NumField(); i++ {
+			if !t.Field(i).IsExported() {
+				// Types such as dates keep their state in unexported fields
+				return v.IsZero()
+			}
+		}
+		empty := true
+		for i := 0; i < t.NumField(); i++ {
+			if t.Field(i).Type == reflect.TypeOf(xml.Name{}) {
+				continue
+			}
+			if !pruneEmpty(v.Field(i)) {
+				empty = false
+			}
+		}
+		return empty
+	default:
+		return v.IsZero()
+	}
+}
+
+var xmlNameType = reflect.TypeOf(xml.Name{})
+
+// documentName returns the root element name of a document, from its XMLName value or tag,
+// or else its type name
+func documentName(v reflect.Value) xml.Name {
+	field, ok := v.Type().FieldByName("XMLName")
+	if !ok || field.Type != xmlNameType {
+		return xml.Name{Local: v.Type().Name()}
+	}
+	if name, ok := v.FieldByIndex(field.Index).Interface().(xml.Name); ok && name.Local != "" {
+		return name
+	}
+	tag, _, _ := strings.Cut(field.Tag.Get("xml"), ",")
+	if space, local, ok := strings.Cut(tag, " "); ok {
+		return xml.Name{Space: space, Local: local}
+	}
+	if tag == "" {
+		return xml.Name{Local: v.Type().Name()}
+	}
+	return xml.Name{Local: tag}
+}
+
+// encodePresent writes an element like encoding/xml but leaves out fields that hold their zero
+// value. Mandatory elements that were mapped are zero after capture, and writing them as
+// zero values (e.g. a "0000-00-00" date) would not read back.
+func encodePresent(encoder *xml.Encoder, v reflect.Value, start xml.StartElement) error {
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct || isXMLValue(v.Type()) {
+		return encoder.EncodeElement(v.Interface(), start)
+	}
+
+	if err := encoder.EncodeToken(start); err != nil {
+		return err
+	}
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("xml")
+		if !field.IsExported() || field.Type == xmlNameType || tag == "-" {
+			continue
+		}
+		name, _, _ := strings.Cut(tag, ",")
+		element := xml.StartElement{Name: xml.Name{Local: field.Name}}
+		if space, local, ok := strings.Cut(name, " "); ok {
+			element.Name = xml.Name{Space: space, Local: local}
+		} else if name != "" {
+			element.Name.Local = name
+		}
+
+		value := v.Field(i)
+		if value.Kind() == reflect.Slice && value.Type().Elem().Kind() != reflect.Uint8 {
+			// Every repeated block is written, empty or not, so later blocks keep their position
+			for j := 0; j < value.Len(); j++ {
+				if err := encodePresent(encoder, value.Index(j), element); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+		if value.IsZero() {
+			continue
+		}
+		if err := encodePresent(encoder, value, element); err != nil {
+			return err
+		}
+	}
+	return encoder.EncodeToken(start.End())
+}
+
+// isXMLValue reports whether encoding/xml writes a struct type as a single value, such as a
+// date or an amount with a currency attribute, rather than as child elements
+func isXMLValue(t reflect.Type) bool {
+	textMarshaler := reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+	xmlMarshaler := reflect.TypeOf((*xml.Marshaler)(nil)).Elem()
+	if t.Implements(textMarshaler) || t.Implements(xmlMarshaler) ||
+		reflect.PointerTo(t).Implements(textMarshaler) || reflect.PointerTo(t).Implements(xmlMarshaler) {
+		return true
+	}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			return true
+		}
+		_, options, _ := strings.Cut(field.Tag.Get("xml"), ",")
+		if strings.Contains(options, "attr") || strings.Contains(options, "chardata") {
+			return true
+		}
+	}
+	return false
+}
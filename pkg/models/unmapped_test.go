@@ -0,0 +1,112 @@
+package models
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type unmappedAgent struct {
+	Id   string  `xml:"Id"`
+	Name *string `xml:"Nm,omitempty"`
+}
+
+type unmappedTransaction struct {
+	Id         string         `xml:"TxId"`
+	Agent      *unmappedAgent `xml:"Agt,omitempty"`
+	Regulatory *string        `xml:"RgltryRptg,omitempty"`
+}
+
+type unmappedDocument struct {
+	XMLName      xml.Name              `xml:"urn:test Document"`
+	MessageId    string                `xml:"MsgId"`
+	Instruction  *string               `xml:"InstrForNxtAgt,omitempty"`
+	Transactions []unmappedTransaction `xml:"Tx"`
+}
+
+func (d *unmappedDocument) Validate() error { return nil }
+
+type unmappedModel struct {
+	MessageId    string
+	Transactions []struct {
+		Id      string
+		AgentId string
+	}
+}
+
+// TestCaptureUnmapped tests that unmapped and uncopied content survives capture and restore
+func TestCaptureUnmapped(t *testing.T) {
+	instruction := "Call before crediting"
+	regulatory := "CRED"
+	name := "Bank A"
+	doc := &unmappedDocument{
+		MessageId:   "MSG001",
+		Instruction: &instruction,
+		Transactions: []unmappedTransaction{
+			{Id: "TX1", Agent: &unmappedAgent{Id: "011104238"}},
+			{Id: "TX2", Agent: &unmappedAgent{Id: "021040078", Name: &name}, Regulatory: &regulatory},
+		},
+	}
+	mapped := map[string]string{
+		"MessageId":                "MessageId",
+		"Transactions[0].Id":       "Transactions[0].Id",
+		"Transactions[0].Agent.Id": "Transactions[0].AgentId",
+		"Transactions[1].Id":       "Transactions[1].Id",
+		"Transactions[1].Agent.Id": "Transactions[1].AgentId",
+	}
+	model := &unmappedModel{MessageId: "MSG001"}
+	model.Transactions = make([]struct {
+		Id      string
+		AgentId string
+	}, 2)
+	model.Transactions[0].Id, model.Transactions[0].AgentId = "TX1", "011104238"
+	// TX2 failed to copy, so it stays with the unmapped content
+	model.Transactions[1].AgentId = "021040078"
+
+	unmapped, err := CaptureUnmapped(doc, "urn:test", mapped, model)
+	require.NoError(t, err)
+	require.NotNil(t, unmapped)
+	assert.Equal(t, "urn:test", unmapped.Namespace)
+	assert.Contains(t, unmapped.XML, "Call before crediting")
+	assert.Contains(t, unmapped.XML, "<RgltryRptg>CRED</RgltryRptg>")
+	assert.Contains(t, unmapped.XML, "<TxId>TX2</TxId>")
+	assert.Contains(t, unmapped.XML, "<Nm>Bank A</Nm>")
+	assert.NotContains(t, unmapped.XML, "MSG001")
+	assert.NotContains(t, unmapped.XML, "011104238")
+	assert.NotContains(t, unmapped.XML, "<Agt><Id></Id></Agt>")
+
+	restored := &unmappedDocument{}
+	require.NoError(t, unmapped.Restore(restored, "urn:test"))
+	require.NotNil(t, restored.Instruction)
+	assert.Equal(t, instruction, *restored.Instruction)
+	require.Len(t, restored.Transactions, 2)
+	assert.Nil(t, restored.Transactions[0].Agent)
+	assert.Equal(t, "CRED", *restored.Transactions[1].Regulatory)
+
+	err = unmapped.Restore(&unmappedDocument{}, "urn:other")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "urn:test")
+
+	var none *Unmapped
+	assert.NoError(t, none.Restore(&unmappedDocument{}, "urn:test"))
+}
+
+// TestCaptureUnmappedFullyMapped tests that nothing is captured when the path map covers the document
+func TestCaptureUnmappedFullyMapped(t *testing.T) {
+	doc := &unmappedDocument{MessageId: "MSG001", Transactions: []unmappedTransaction{{Id: "TX1"}}}
+	model := &unmappedModel{MessageId: "MSG001"}
+	model.Transactions = make([]struct {
+		Id      string
+		AgentId string
+	}, 1)
+	model.Transactions[0].Id = "TX1"
+
+	unmapped, err := CaptureUnmapped(doc, "urn:test", map[string]string{
+		"MessageId":          "MessageId",
+		"Transactions[0].Id": "Transactions[0].Id",
+	}, model)
+	require.NoError(t, err)
+	assert.Nil(t, unmapped)
+}
@@ -1,6 +1,7 @@
 package models
 
 import (
+	"bytes"
 	"encoding/xml"
 	"fmt"
 	"log"
@@ -34,12 +35,13 @@ type ISODocument interface {
 type DocumentFactory func() ISODocument
 
 // DocumentFrom parses XML data and creates an ISODocument using the appropriate factory.
-// Returns ErrInvalidXML if XML parsing fails.
+// Returns ErrInvalidXML if XML parsing fails, with the position where decoding stopped.
 // Returns ErrUnknownNamespace if the XML namespace is not recognized.
 func DocumentFrom(data []byte, factoryMap map[string]DocumentFactory) (ISODocument, string, error) {
 	var root Document
-	if err := xml.Unmarshal(data, &root); err != nil {
-		return nil, "", errors.NewParseError("XML decode", "document", err)
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	if err := decoder.Decode(&root); err != nil {
+		return nil, "", DecodeError("XML decode", "document", err, decoder)
 	}
 
 	var xmlns string
@@ -62,8 +64,9 @@ func DocumentFrom(data []byte, factoryMap map[string]DocumentFactory) (ISODocume
 
 	// Instantiate and unmarshal into actual model
 	doc := factory()
-	if err := xml.Unmarshal(data, doc); err != nil {
-		return nil, "", errors.NewParseError("XML unmarshal", "model structure", err)
+	decoder = xml.NewDecoder(bytes.NewReader(data))
+	if err := decoder.Decode(doc); err != nil {
+		return nil, "", DecodeError("XML unmarshal", "model structure", err, decoder)
 	}
 
 	return doc, xmlns, nil
@@ -446,11 +449,8 @@ func setValue(v reflect.Value, value any) error {
 
 		v.Set(reflect.ValueOf(isoDate))
 	} else if val.Kind() == reflect.String {
-		// Handle string to numeric conversions
-		strVal, ok := val.Interface().(string)
-		if !ok {
-			return fmt.Errorf("cannot convert value to field type %s", v.Type())
-		}
+		// Handle string to numeric conversions, including Decimal amounts
+		strVal := val.String()
 
 		switch v.Kind() {
 		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
@@ -470,6 +470,15 @@ func setValue(v reflect.Value, value any) error {
 			if err != nil {
 				return fmt.Errorf("cannot convert string %q to %s: %w", strVal, v.Type(), err)
 			}
+			if val.Type() == decimalType {
+				// Refuse to round an amount the document's binary number cannot hold exactly
+				exact := Decimal(strconv.FormatFloat(floatVal, 'f', -1, 64))
+				if cmp, err := exact.Cmp(Decimal(strVal)); err != nil {
+					return err
+				} else if cmp != 0 {
+					return fmt.Errorf("%s has more digits than %s can hold exactly: %w", strVal, v.Type(), errors.ErrInvalidField)
+				}
+			}
 			v.SetFloat(floatVal)
 		case reflect.Bool:
 			boolVal, err := strconv.ParseBool(strVal)
@@ -484,6 +493,9 @@ func setValue(v reflect.Value, value any) error {
 			reflect.Map, reflect.Ptr, reflect.Slice, reflect.Struct, reflect.UnsafePointer:
 			return fmt.Errorf("cannot convert string to field type %s", v.Type())
 		}
+	} else if (val.Kind() == reflect.Float32 || val.Kind() == reflect.Float64) && v.Kind() == reflect.String {
+		// Numeric document fields such as sequence numbers map to string model fields
+		v.SetString(strconv.FormatFloat(val.Float(), 'f', -1, 64))
 	} else {
 		return fmt.Errorf("cannot convert value to field type %s", v.Type())
 	}
@@ -511,28 +523,97 @@ func hasValidateMethod(v reflect.Value) bool {
 
 // CopyDocumentValueToMessage copies a field value from a document to a message model.
 // Silently ignores errors to allow partial field mapping when source fields are optional.
-// This function is used during XML-to-Go struct conversion.
+// This function is used during XML-to-Go struct conversion; use CopyDocumentValue to find
+// out which values could not be copied.
 func CopyDocumentValueToMessage(from any, fromPah string, to any, toPath string) {
-	if from == nil || fromPah == "" || toPath == "" {
-		return
+	_ = CopyDocumentValue(from, fromPah, to, toPath)
+}
+
+// CopyDocumentValue copies a field value from a document to a message model like
+// CopyDocumentValueToMessage, but reports a value that could not be copied. An element that is
+// absent from the document is not an error. A path the document type does not have, or a value
+// of the wrong type or failing its Validate method, is returned as a FieldError naming the
+// element's XPath and the target model path.
+func CopyDocumentValue(from any, fromPath string, to any, toPath string) error {
+	if from == nil || fromPath == "" || toPath == "" {
+		return nil
 	}
-	_, value, err := GetElement(from, fromPah)
-	if err != nil {
-		// Log error for debugging but continue processing
-		// This allows partial field mapping when source fields are optional
-		return
+	xpath, ok := DocumentXPath(from, fromPath)
+	if !ok {
+		return errors.NewCopyError(fromPath, toPath,
+			fmt.Errorf("no such element in %T: %w", from, errors.ErrFieldNotFound))
 	}
-	if isEmpty(value) {
-		return
+	_, value, err := GetElement(from, fromPath)
+	if err != nil || value == nil || isEmpty(value) {
+		// The element, or one of its parents, is not in the document
+		return nil
 	}
-	if value == nil {
-		return
+	if err := SetElementToModel(to, toPath, value); err != nil {
+		return errors.NewCopyError(xpath, toPath, err)
 	}
+	return nil
+}
 
-	err = SetElementToModel(to, toPath, value)
-	if err != nil {
-		return
+// DocumentXPath converts a dot-notation document path such as "FIToFICstmrCdtTrf.CdtTrfTxInf[0].PmtId"
+// to the XPath of the element, e.g. "/Document/FIToFICstmrCdtTrf/CdtTrfTxInf[1]/PmtId". It reports
+// false when the document type has no such path.
+func DocumentXPath(doc any, path string) (string, bool) {
+	v := reflect.ValueOf(doc)
+	for v.Kind() == reflect.Ptr && !v.IsNil() {
+		v = v.Elem()
 	}
+	if v.Kind() != reflect.Struct {
+		return "", false
+	}
+
+	t := v.Type()
+	var xpath strings.Builder
+	xpath.WriteString("/" + documentName(v).Local)
+	for _, segment := range strings.Split(path, ".") {
+		index := -1
+		if matches := indexedSegment.FindStringSubmatch(segment); matches != nil {
+			segment = matches[1]
+			index, _ = strconv.Atoi(matches[2])
+		}
+		if t.Kind() != reflect.Struct {
+			return "", false
+		}
+		field, ok := t.FieldByName(segment)
+		if !ok {
+			return "", false
+		}
+
+		name, options, _ := strings.Cut(field.Tag.Get("xml"), ",")
+		if _, local, ok := strings.Cut(name, " "); ok {
+			name = local
+		}
+		switch {
+		case strings.Contains(options, "chardata"):
+			// The value of the parent element
+		case strings.Contains(options, "attr"):
+			xpath.WriteString("/@" + name)
+		case name == "":
+			xpath.WriteString("/" + field.Name)
+		default:
+			xpath.WriteString("/" + name)
+		}
+
+		t = field.Type
+		for t.Kind() == reflect.Ptr {
+			t = t.Elem()
+		}
+		if index >= 0 {
+			if t.Kind() != reflect.Slice && t.Kind() != reflect.Array {
+				return "", false
+			}
+			xpath.WriteString(fmt.Sprintf("[%d]", index+1))
+			t = t.Elem()
+			for t.Kind() == reflect.Ptr {
+				t = t.Elem()
+			}
+		}
+	}
+	return xpath.String(), true
 }
 
 // CopyMessageValueToDocument copies a field value from a message model to an ISO document.
@@ -609,15 +690,30 @@ func processFlatSliceMapping(from any, result map[string]string, key string, map
 		for k1, v1 := range mapping {
 			if toModel {
 				// XML -> Go: XML path as key, Go field as value
-				result[fmt.Sprintf("%s[%d].%s", src, i, k1)] = fmt.Sprintf("%s[%d].%s", dst, i, v1)
+				result[itemPath(src, i, k1)] = itemPath(dst, i, v1)
 			} else {
 				// Go -> XML: Go field as key, XML path as value
-				result[fmt.Sprintf("%s[%d].%s", dst, i, v1)] = fmt.Sprintf("%s[%d].%s", src, i, k1)
+				result[itemPath(dst, i, v1)] = itemPath(src, i, k1)
 			}
 		}
 	}
 }
 
+// ListItems maps every item of a list of values, such as the AdrLine lines of an address, to the
+// item at the same index of a model slice:
+//
+//	"Dbtr.PstlAdr.AdrLine : DebtorAddress.AddressLine": models.ListItems
+var ListItems = map[string]string{"": ""}
+
+// itemPath returns the path of a field of the i-th item of a list, or of the item itself when
+// field is empty
+func itemPath(list string, i int, field string) string {
+	if field == "" {
+		return fmt.Sprintf("%s[%d]", list, i)
+	}
+	return fmt.Sprintf("%s[%d].%s", list, i, field)
+}
+
 func processNestedSliceMapping(from any, result map[string]string, key string, mapping map[string]any, toModel bool) {
 	src, dst := seperateKeyAndValue(key, ":")
 	if src == "" || dst == "" {
@@ -674,16 +770,16 @@ func processNestedSliceMapping(from any, result map[string]string, key string, m
 					continue
 				}
 
+				srcList := fmt.Sprintf("%s[%d].%s", src, i, src2)
+				dstList := fmt.Sprintf("%s[%d].%s", dst, i, dst2)
 				for j := 0; j < valValue2.Len(); j++ {
 					for k2, v2 := range inner {
 						if toModel {
 							// XML -> Go: XML path as key, Go field as value
-							result[fmt.Sprintf("%s[%d].%s[%d].%s", src, i, src2, j, k2)] =
-								fmt.Sprintf("%s[%d].%s[%d].%s", dst, i, dst2, j, v2)
+							result[itemPath(srcList, j, k2)] = itemPath(dstList, j, v2)
 						} else {
 							// Go -> XML: Go field as key, XML path as value
-							result[fmt.Sprintf("%s[%d].%s[%d].%s", dst, i, dst2, j, v2)] =
-								fmt.Sprintf("%s[%d].%s[%d].%s", src, i, src2, j, k2)
+							result[itemPath(dstList, j, v2)] = itemPath(srcList, j, k2)
 						}
 					}
 				}
@@ -735,6 +831,8 @@ func isEmpty(val interface{}) bool {
 		return reflect.ValueOf(v).Uint() == 0
 	case float32, float64:
 		return reflect.ValueOf(v).Float() == 0
+	case Decimal:
+		return v.IsZero()
 	case bool:
 		return !v
 	case time.Time:
@@ -830,6 +928,8 @@ func IsEmpty(value interface{}) bool {
 	switch v := value.(type) {
 	case string:
 		return v == ""
+	case Decimal:
+		return v.IsZero()
 	case time.Time:
 		return v.IsZero()
 	}
@@ -577,6 +577,17 @@ func TestSetValue(t *testing.T) {
 				assert.Equal(t, 3.14159, v.Float())
 			},
 		},
+		{
+			name: "convert float to string",
+			setupValue: func() reflect.Value {
+				var s string
+				return reflect.ValueOf(&s).Elem()
+			},
+			inputValue: float64(100),
+			checkResult: func(t *testing.T, v reflect.Value) {
+				assert.Equal(t, "100", v.String())
+			},
+		},
 		{
 			name: "invalid string to int conversion",
 			setupValue: func() reflect.Value {
@@ -0,0 +1,79 @@
+package models
+
+import (
+	"reflect"
+
+	"github.com/moov-io/wire20022/pkg/errors"
+)
+
+// ValidateValues checks the values of a message model that a document holds as written but
+// Fedwire would refuse: amounts with more decimal places than their currency allows, routing
+// numbers of USABA agents, BICs and IBANs and the countries of postal addresses. Each invalid
+// value is reported with its path. Message models run it in Validate; documents are created with
+// the amount, routing number and country checks, except for the values a message was parsed with,
+// which are recorded as ReadValues.
+func ValidateValues(model any) error {
+	var errs []error
+	for _, validate := range []func(any) error{ValidateAmounts, ValidateAgents, ValidateIdentifiers, ValidateAddresses} {
+		if err := validate(model); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.JoinValidationErrors(errs...)
+}
+
+// ReadValues holds the values of a parsed message model that ValidateValues refuses, by model
+// path, e.g. "Transactions[0].InstructedAmount". Documents are created with them exempt from the
+// checks, so that a message read from a file can be written back the way it was read
+type ReadValues map[string]any
+
+// NewReadValues records the values of a message model that ValidateValues refuses
+func NewReadValues(model any) ReadValues {
+	var read ReadValues
+	eachError(ValidateValues(model), func(err error) {
+		field, ok := err.(*errors.ValidationError)
+		if !ok {
+			return
+		}
+		if _, value, getErr := GetElement(model, field.Field); getErr == nil {
+			if read == nil {
+				read = make(ReadValues)
+			}
+			read[field.Field] = value
+		}
+	})
+	return read
+}
+
+// Except returns err, the result of checking a message model's values, without the errors for
+// fields that still hold the value the model was read with
+func (r ReadValues) Except(model any, err error) error {
+	if len(r) == 0 || err == nil {
+		return err
+	}
+	var errs []error
+	eachError(err, func(err error) {
+		if field, ok := err.(*errors.ValidationError); ok {
+			if read, ok := r[field.Field]; ok {
+				if _, value, getErr := GetElement(model, field.Field); getErr == nil && reflect.DeepEqual(read, value) {
+					return
+				}
+			}
+		}
+		errs = append(errs, err)
+	})
+	return errors.JoinValidationErrors(errs...)
+}
+
+// eachError calls fn for each error joined into err, or for err itself if it joins none
+func eachError(err error, fn func(err error)) {
+	switch e := err.(type) {
+	case nil:
+	case interface{ Unwrap() []error }:
+		for _, inner := range e.Unwrap() {
+			eachError(inner, fn)
+		}
+	default:
+		fn(err)
+	}
+}
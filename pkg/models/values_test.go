@@ -0,0 +1,59 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/moov-io/wire20022/pkg/errors"
+)
+
+// TestValidateValues tests that the amount, routing number and country checks are reported together
+func TestValidateValues(t *testing.T) {
+	type model struct {
+		Amount  CurrencyAndAmount
+		Agent   Agent
+		Address PostalAddress
+	}
+	m := model{
+		Amount:  CurrencyAndAmount{Currency: "USD", Amount: "1500000.00"},
+		Agent:   Agent{PaymentSysCode: PaymentSysUSABA, PaymentSysMemberId: "021040078"},
+		Address: PostalAddress{Country: "US"},
+	}
+	assert.NoError(t, ValidateValues(&m))
+
+	m.Amount.Amount = "1.001"
+	m.Agent.PaymentSysMemberId = "021040079"
+	m.Address.Country = "UK"
+	err := ValidateValues(&m)
+	require.Error(t, err)
+	assert.ErrorIs(t, err, errors.ErrInvalidField)
+	assert.Contains(t, err.Error(), `"Amount"`)
+	assert.Contains(t, err.Error(), `"Agent.PaymentSysMemberId"`)
+	assert.Contains(t, err.Error(), `"Address.Country"`)
+}
+
+// TestReadValues tests that a refused value is exempt from the checks only while it is the value
+// the model was read with
+func TestReadValues(t *testing.T) {
+	type model struct {
+		Amount CurrencyAndAmount
+		Agent  Agent
+	}
+	m := model{
+		Amount: CurrencyAndAmount{Currency: "USD", Amount: "1.001"},
+		Agent:  Agent{PaymentSysCode: PaymentSysUSABA, PaymentSysMemberId: "021040079"},
+	}
+	read := NewReadValues(&m)
+	assert.Len(t, read, 2)
+	assert.NoError(t, read.Except(&m, ValidateValues(&m)))
+
+	m.Amount.Amount = "2.001"
+	err := read.Except(&m, ValidateValues(&m))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `"Amount"`)
+	assert.NotContains(t, err.Error(), `"Agent.PaymentSysMemberId"`)
+
+	assert.Error(t, ReadValues(nil).Except(&m, ValidateAmounts(&m)))
+}
@@ -0,0 +1,230 @@
+package rules
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/civil"
+	"github.com/moov-io/fedwire20022/pkg/fedwire"
+
+	"github.com/moov-io/wire20022/pkg/base"
+	wirerrors "github.com/moov-io/wire20022/pkg/errors"
+	"github.com/moov-io/wire20022/pkg/models"
+)
+
+// field is a value of a message and its model path
+type field[T any] struct {
+	path  string
+	value T
+}
+
+// maxAmount is the largest amount the Fedwire Funds Service accepts
+const maxAmount = models.Decimal("9999999999.99")
+
+var (
+	// An IMAD is the input cycle date, the 8-character input source and a 6-digit sequence number
+	imadPattern = regexp.MustCompile(`^([0-9]{8})[A-Z0-9]{8}[0-9]{6}$`)
+	uetrPattern = regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`)
+)
+
+// Local instrument codes of the Fedwire Funds business functions
+var (
+	customerTransferCodes = []string{"CTRC", "CTRD"}
+	bankTransferCodes     = []string{"BTRS", "BTRD", "COVS", "CKSD", "DEPT", "FFRD", "FFSD"}
+	drawdownRequestCodes  = []string{"DRRC", "DRRB"}
+)
+
+// messageIdRules check the message identification is an IMAD of the business date
+func messageIdRules[M any](messageId func(*M) string) []Rule {
+	return []Rule{
+		newRule("FW001", "MsgId must be an IMAD: the input cycle date (YYYYMMDD), an 8-character input source and a 6-digit sequence number", func(m *M, c *checker) {
+			id := messageId(m)
+			if id == "" {
+				return // reported by the required field checks
+			}
+			match := imadPattern.FindStringSubmatch(id)
+			if match == nil {
+				c.report("MessageId", id, "not an IMAD")
+				return
+			}
+			if _, err := time.Parse("20060102", match[1]); err != nil {
+				c.report("MessageId", id, fmt.Sprintf("input cycle date %s is not a date", match[1]))
+			}
+		}),
+		newRule("FW002", "The input cycle date of the MsgId must be the business date", func(m *M, c *checker) {
+			match := imadPattern.FindStringSubmatch(messageId(m))
+			if match == nil {
+				return
+			}
+			date, err := time.Parse("20060102", match[1])
+			if err == nil && civil.DateOf(date) != c.businessDate {
+				c.report("MessageId", match[0], fmt.Sprintf("input cycle date %s is not the business date %s", civil.DateOf(date), c.businessDate))
+			}
+		}),
+	}
+}
+
+// createdRule checks the message was created on the business day
+func createdRule[M any](created func(*M) time.Time) Rule {
+	return newRule("FW003", "CreDtTm must fall on the business day, which starts at 9:00 p.m. Eastern Time the day before", func(m *M, c *checker) {
+		t := created(m)
+		if t.IsZero() {
+			return
+		}
+		if day := businessDayOf(t); day != c.businessDate {
+			c.report("CreatedDateTime", t.Format(time.RFC3339), fmt.Sprintf("falls on business day %s, not %s", day, c.businessDate))
+		}
+	})
+}
+
+// singleTransactionRule checks the message carries one transaction. entries, if not nil,
+// returns the number of transactions the model holds.
+func singleTransactionRule[M any](count func(*M) field[string], entries func(*M) field[int]) Rule {
+	return newRule("FW004", "NbOfTxs must be 1: Fedwire Funds messages carry a single transaction", func(m *M, c *checker) {
+		if n := count(m); n.value != "" && n.value != "1" {
+			c.report(n.path, n.value, "must be 1")
+		}
+		if entries == nil {
+			return
+		}
+		if n := entries(m); n.value > 1 {
+			c.report(n.path, fmt.Sprint(n.value), fmt.Sprintf("%d transactions, must be 1", n.value))
+		}
+	})
+}
+
+// clearingRules check the message settles through the Fedwire Funds Service
+func clearingRules[M any](core func(*M) base.PaymentCore) []Rule {
+	return []Rule{
+		newRule("FW005", "SttlmMtd must be CLRG", func(m *M, c *checker) {
+			if method := core(m).SettlementMethod; method != models.SettlementCLRG {
+				c.report("SettlementMethod", string(method), "must be CLRG")
+			}
+		}),
+		newRule("FW006", "ClrSys Cd must be FDW", func(m *M, c *checker) {
+			if code := core(m).CommonClearingSysCode; code != models.ClearingSysFDW {
+				c.report("CommonClearingSysCode", string(code), "must be FDW")
+			}
+		}),
+	}
+}
+
+// amountRules check the amounts are US dollars within the Fedwire Funds limit
+func amountRules[M any](amounts func(*M) []field[models.CurrencyAndAmount]) []Rule {
+	return []Rule{
+		newRule("FW007", "The settlement currency must be USD", func(m *M, c *checker) {
+			for _, amount := range amounts(m) {
+				if amount.value.Currency != "USD" {
+					c.report(amount.path+".Currency", amount.value.Currency, "must be USD")
+				}
+			}
+		}),
+		newRule("FW008", "The amount must be greater than zero and at most 9,999,999,999.99", func(m *M, c *checker) {
+			for _, amount := range amounts(m) {
+				value := amount.value.Amount
+				if value == "" {
+					continue
+				}
+				path := amount.path + ".Amount"
+				if value.IsZero() {
+					c.report(path, value.String(), "must be greater than zero")
+					continue
+				}
+				if cmp, err := value.Cmp(maxAmount); err != nil {
+					c.report(path, value.String(), "not a decimal amount")
+				} else if cmp > 0 || strings.HasPrefix(value.String(), "-") {
+					c.report(path, value.String(), "must be greater than zero and at most 9,999,999,999.99")
+				}
+			}
+		}),
+	}
+}
+
+// settlementDateRule checks the interbank settlement dates are the business date
+func settlementDateRule[M any](dates func(*M) []field[fedwire.ISODate]) Rule {
+	return newRule("FW009", "IntrBkSttlmDt must be the business date", func(m *M, c *checker) {
+		for _, date := range dates(m) {
+			value := civil.Date(date.value)
+			if !value.IsZero() && value != c.businessDate {
+				c.report(date.path, value.String(), fmt.Sprintf("not the business date %s", c.businessDate))
+			}
+		}
+	})
+}
+
+// localInstrumentRule checks the local instruments are codes of the message's business functions
+func localInstrumentRule[M any](codes []string, required bool, instruments func(*M) []field[string]) Rule {
+	return newRule("FW010", "LclInstrm Prtry must be a code of a business function the message carries", func(m *M, c *checker) {
+		for _, instrument := range instruments(m) {
+			switch {
+			case instrument.value == "" && !required:
+			case instrument.value == "":
+				c.report(instrument.path, "", "missing; must be one of "+strings.Join(codes, ", "))
+			case !slices.Contains(codes, instrument.value):
+				c.report(instrument.path, instrument.value, "must be one of "+strings.Join(codes, ", "))
+			}
+		}
+	})
+}
+
+// uetrRule checks the UETRs are version 4 UUIDs in lower case, as SWIFT gpi writes them
+func uetrRule[M any](required bool, uetrs func(*M) []field[string]) Rule {
+	return newRule("FW011", "The UETR must be a lowercase version 4 UUID", func(m *M, c *checker) {
+		for _, uetr := range uetrs(m) {
+			switch {
+			case uetr.value == "" && required:
+				c.report(uetr.path, "", "missing")
+			case uetr.value != "" && !uetrPattern.MatchString(uetr.value):
+				c.report(uetr.path, uetr.value, "not a lowercase version 4 UUID")
+			}
+		}
+	})
+}
+
+// routingNumberRule checks the member identification of every agent identified by USABA is a
+// valid ABA routing number
+func routingNumberRule[M any]() Rule {
+	return newRule("FW013", "The MmbId of an agent identified by USABA must be a valid ABA routing number", func(m *M, c *checker) {
+		models.EachAgent(m, func(path string, agent models.Agent) {
+			if agent.PaymentSysCode != models.PaymentSysUSABA || agent.PaymentSysMemberId == "" {
+				return
+			}
+			if err := models.ValidateABARoutingNumber(agent.PaymentSysMemberId); err != nil {
+				c.report(path+".PaymentSysMemberId", agent.PaymentSysMemberId, err.Error())
+			}
+		})
+	})
+}
+
+// addressComplianceRule checks, when Options.AddressCompliance is set, that every postal address
+// is structured or hybrid: besides any AdrLine lines it must have a TownName and a Country
+func addressComplianceRule[M any]() Rule {
+	return newRule("FW014", "A postal address must be structured or hybrid, with at least a TownName and a Country", func(m *M, c *checker) {
+		if !c.addressCompliance {
+			return
+		}
+		for _, err := range fieldErrors(models.CheckAddressCompliance(m)) {
+			c.report(err.Field, "", err.Reason)
+		}
+	})
+}
+
+// fieldErrors returns the field errors of a validation error, which may join several
+func fieldErrors(err error) []*wirerrors.ValidationError {
+	errs := []error{err}
+	if joined, ok := err.(interface{ Unwrap() []error }); ok {
+		errs = joined.Unwrap()
+	}
+	var fields []*wirerrors.ValidationError
+	for _, err := range errs {
+		var field *wirerrors.ValidationError
+		if errors.As(err, &field) {
+			fields = append(fields, field)
+		}
+	}
+	return fields
+}
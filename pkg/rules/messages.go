@@ -0,0 +1,192 @@
+package rules
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/moov-io/fedwire20022/pkg/fedwire"
+
+	"github.com/moov-io/wire20022/pkg/base"
+	"github.com/moov-io/wire20022/pkg/models"
+	CustomerCreditTransferModel "github.com/moov-io/wire20022/pkg/models/CustomerCreditTransfer"
+	DrawdownRequestModel "github.com/moov-io/wire20022/pkg/models/DrawdownRequest"
+	FICreditTransferModel "github.com/moov-io/wire20022/pkg/models/FICreditTransfer"
+	PaymentReturnModel "github.com/moov-io/wire20022/pkg/models/PaymentReturn"
+)
+
+func init() {
+	for version := range CustomerCreditTransferModel.VersionNameSpaceMap {
+		Register(customerCreditTransferRules(string(version)))
+	}
+	for version := range FICreditTransferModel.VersionNameSpaceMap {
+		Register(fiCreditTransferRules(string(version)))
+	}
+	for version := range PaymentReturnModel.VersionNameSpaceMap {
+		Register(paymentReturnRules(string(version)))
+	}
+	for version := range DrawdownRequestModel.VersionNameSpaceMap {
+		Register(drawdownRequestRules(string(version)))
+	}
+}
+
+// versionNumber returns the version of a message definition identifier, e.g. 8 for "pacs.008.001.08"
+func versionNumber(version string) int {
+	n, _ := strconv.Atoi(version[strings.LastIndex(version, ".")+1:])
+	return n
+}
+
+// customerCreditTransferRules are the rules of pacs.008 customer transfers
+func customerCreditTransferRules(version string) *RuleSet {
+	type M = CustomerCreditTransferModel.MessageModel
+
+	var rules []Rule
+	rules = append(rules, messageIdRules(func(m *M) string { return m.MessageId })...)
+	rules = append(rules,
+		createdRule(func(m *M) time.Time { return m.CreatedDateTime }),
+		singleTransactionRule(
+			func(m *M) field[string] { return field[string]{"NumberOfTransactions", m.NumberOfTransactions} },
+			func(m *M) field[int] { return field[int]{"Transactions", len(m.Transactions)} },
+		),
+	)
+	rules = append(rules, clearingRules(func(m *M) base.PaymentCore { return m.PaymentCore })...)
+	rules = append(rules, amountRules(func(m *M) []field[models.CurrencyAndAmount] {
+		return eachTransaction(m.Transactions, "InterBankSettAmount", func(tx CustomerCreditTransferModel.CreditTransferTransaction) models.CurrencyAndAmount {
+			return tx.InterBankSettAmount
+		})
+	})...)
+	rules = append(rules,
+		settlementDateRule(func(m *M) []field[fedwire.ISODate] {
+			return eachTransaction(m.Transactions, "InterBankSettDate", func(tx CustomerCreditTransferModel.CreditTransferTransaction) fedwire.ISODate {
+				return tx.InterBankSettDate
+			})
+		}),
+		localInstrumentRule(customerTransferCodes, true, func(m *M) []field[string] {
+			return eachTransaction(m.Transactions, "InstrumentPropCode", func(tx CustomerCreditTransferModel.CreditTransferTransaction) string {
+				return string(tx.InstrumentPropCode)
+			})
+		}),
+	)
+	// The UETR element was added in pacs.008.001.08
+	if versionNumber(version) >= 8 {
+		rules = append(rules, uetrRule(true, func(m *M) []field[string] {
+			return eachTransaction(m.Transactions, "Transaction.UniqueEndToEndTransactionRef", func(tx CustomerCreditTransferModel.CreditTransferTransaction) string {
+				if tx.Transaction == nil {
+					return ""
+				}
+				return tx.Transaction.UniqueEndToEndTransactionRef
+			})
+		}))
+	}
+	rules = append(rules, routingNumberRule[M](), addressComplianceRule[M]())
+	return &RuleSet{Version: version, Rules: rules}
+}
+
+// eachTransaction returns a value of every transaction with its model path
+func eachTransaction[T, V any](transactions []T, path string, value func(T) V) []field[V] {
+	fields := make([]field[V], len(transactions))
+	for i, tx := range transactions {
+		fields[i] = field[V]{fmt.Sprintf("Transactions[%d].%s", i, path), value(tx)}
+	}
+	return fields
+}
+
+// fiCreditTransferRules are the rules of pacs.009 bank transfers, including cover payments
+func fiCreditTransferRules(version string) *RuleSet {
+	type M = FICreditTransferModel.MessageModel
+
+	var rules []Rule
+	rules = append(rules, messageIdRules(func(m *M) string { return m.MessageId })...)
+	rules = append(rules,
+		createdRule(func(m *M) time.Time { return m.CreatedDateTime }),
+		singleTransactionRule(func(m *M) field[string] { return field[string]{"NumberOfTransactions", m.NumberOfTransactions} }, nil),
+	)
+	rules = append(rules, clearingRules(func(m *M) base.PaymentCore { return m.PaymentCore })...)
+	rules = append(rules, amountRules(func(m *M) []field[models.CurrencyAndAmount] {
+		return []field[models.CurrencyAndAmount]{{"InterBankSettAmount", m.InterBankSettAmount}}
+	})...)
+	rules = append(rules,
+		settlementDateRule(func(m *M) []field[fedwire.ISODate] {
+			return []field[fedwire.ISODate]{{"InterBankSettDate", m.InterBankSettDate}}
+		}),
+		localInstrumentRule(bankTransferCodes, true, func(m *M) []field[string] {
+			return []field[string]{{"InstrumentPropCode", string(m.InstrumentPropCode)}}
+		}),
+		uetrRule(true, func(m *M) []field[string] {
+			return []field[string]{{"UniqueEndToEndTransactionRef", m.UniqueEndToEndTransactionRef}}
+		}),
+		newRule("FW012", "A COVS cover payment must carry the underlying customer credit transfer, and only a cover payment may", func(m *M, c *checker) {
+			underlying := m.UnderlyingCustomerCreditTransfer
+			hasUnderlying := underlying.DebtorName != "" || underlying.CreditorName != ""
+			switch {
+			case m.InstrumentPropCode == models.InstrumentCOVS && !hasUnderlying:
+				c.report("UnderlyingCustomerCreditTransfer", "", "missing for a COVS cover payment")
+			case m.InstrumentPropCode != models.InstrumentCOVS && m.InstrumentPropCode != "" && hasUnderlying:
+				c.report("UnderlyingCustomerCreditTransfer", "", fmt.Sprintf("only allowed for COVS, not %s", m.InstrumentPropCode))
+			}
+		}),
+		routingNumberRule[M](),
+	)
+	return &RuleSet{Version: version, Rules: rules}
+}
+
+// paymentReturnRules are the rules of pacs.004 returns of customer and bank transfers
+func paymentReturnRules(version string) *RuleSet {
+	type M = PaymentReturnModel.MessageModel
+
+	var rules []Rule
+	rules = append(rules, messageIdRules(func(m *M) string { return m.MessageId })...)
+	rules = append(rules,
+		createdRule(func(m *M) time.Time { return m.CreatedDateTime }),
+		singleTransactionRule(func(m *M) field[string] { return field[string]{"NumberOfTransactions", m.NumberOfTransactions} }, nil),
+	)
+	rules = append(rules, clearingRules(func(m *M) base.PaymentCore { return m.PaymentCore })...)
+	rules = append(rules, amountRules(func(m *M) []field[models.CurrencyAndAmount] {
+		return []field[models.CurrencyAndAmount]{{"ReturnedInterbankSettlementAmount", m.ReturnedInterbankSettlementAmount}}
+	})...)
+	rules = append(rules,
+		settlementDateRule(func(m *M) []field[fedwire.ISODate] {
+			return []field[fedwire.ISODate]{{"InterbankSettlementDate", m.InterbankSettlementDate}}
+		}),
+		// The returned transfer may be a customer or a bank transfer
+		localInstrumentRule(slices.Concat(customerTransferCodes, bankTransferCodes), false, func(m *M) []field[string] {
+			return []field[string]{{"OriginalTransactionRef", string(m.OriginalTransactionRef)}}
+		}),
+	)
+	// The OrgnlUETR element was added in pacs.004.001.09
+	if versionNumber(version) >= 9 {
+		rules = append(rules, uetrRule(false, func(m *M) []field[string] {
+			if m.EnhancedTransaction == nil {
+				return nil
+			}
+			return []field[string]{{"EnhancedTransaction.OriginalUETR", m.EnhancedTransaction.OriginalUETR}}
+		}))
+	}
+	rules = append(rules, routingNumberRule[M](), addressComplianceRule[M]())
+	return &RuleSet{Version: version, Rules: rules}
+}
+
+// drawdownRequestRules are the rules of pain.013 drawdown requests
+func drawdownRequestRules(version string) *RuleSet {
+	type M = DrawdownRequestModel.MessageModel
+
+	var rules []Rule
+	rules = append(rules, messageIdRules(func(m *M) string { return m.MessageId })...)
+	rules = append(rules,
+		createdRule(func(m *M) time.Time { return m.CreatedDateTime }),
+		singleTransactionRule(func(m *M) field[string] { return field[string]{"NumberofTransaction", m.NumberofTransaction} }, nil),
+	)
+	rules = append(rules, amountRules(func(m *M) []field[models.CurrencyAndAmount] {
+		return []field[models.CurrencyAndAmount]{{"CreditTransTransaction.Amount", m.CreditTransTransaction.Amount}}
+	})...)
+	rules = append(rules,
+		localInstrumentRule(drawdownRequestCodes, true, func(m *M) []field[string] {
+			return []field[string]{{"CreditTransTransaction.PayRequestType", string(m.CreditTransTransaction.PayRequestType)}}
+		}),
+		routingNumberRule[M](),
+		addressComplianceRule[M](),
+	)
+	return &RuleSet{Version: version, Rules: rules}
+}
@@ -0,0 +1,176 @@
+// Package rules checks messages against the business rules of the Fedwire Funds Service, such as
+// the IMAD format of the message identification, the business date and the maximum amount, so
+// that messages FedLine would reject can be caught before they are sent.
+//
+// Every version of a message definition has its own RuleSet. A check runs all the rules of the
+// set and returns every violation, each with the ID of the rule it breaks:
+//
+//	violations, err := rules.Check(message, rules.Options{})
+//	for _, v := range violations {
+//		log.Printf("%s %s: %s", v.Rule, v.Field, v.Reason)
+//	}
+package rules
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"cloud.google.com/go/civil"
+	"github.com/moov-io/fedwire20022/pkg/fedwire"
+)
+
+// Violation is a breach of a business rule by a message
+type Violation struct {
+	// Rule is the ID of the rule broken, e.g. "FW001"
+	Rule string `json:"rule"`
+	// Field is the model path of the offending value, e.g. "Transactions[0].InterBankSettAmount.Amount"
+	Field string `json:"field,omitempty"`
+	// Value is the offending value, empty when it is missing
+	Value  string `json:"value,omitempty"`
+	Reason string `json:"reason"`
+}
+
+// Error implements the error interface.
+func (v Violation) Error() string {
+	if v.Field == "" {
+		return fmt.Sprintf("rule %s violated: %s", v.Rule, v.Reason)
+	}
+	return fmt.Sprintf("rule %s violated by field %q: %s", v.Rule, v.Field, v.Reason)
+}
+
+// Options configure a check
+type Options struct {
+	// BusinessDate is the Fedwire Funds business day the message is sent on. When zero, the
+	// current business day is used, which starts at 9:00 p.m. Eastern Time the day before.
+	BusinessDate fedwire.ISODate
+	// AddressCompliance turns on the structured address checks of FW014, which report postal
+	// addresses that are only free-text AdrLine lines or miss a TownName or Country
+	AddressCompliance bool
+}
+
+// businessDate returns the business day of the options, or the current one
+func (o Options) businessDate() civil.Date {
+	if date := civil.Date(o.BusinessDate); !date.IsZero() {
+		return date
+	}
+	return businessDayOf(time.Now())
+}
+
+// businessDayOf returns the Fedwire Funds business day t falls on
+func businessDayOf(t time.Time) civil.Date {
+	eastern := t.In(fedwire.Eastern())
+	day := civil.DateOf(eastern)
+	if eastern.Hour() >= businessDayStartHour {
+		day = day.AddDays(1)
+	}
+	return day
+}
+
+// businessDayStartHour is the hour, Eastern Time, the next business day starts at
+const businessDayStartHour = 21
+
+// Rule is a business rule messages of one type are checked against
+type Rule struct {
+	// ID identifies the rule in violations, e.g. "FW001"
+	ID          string
+	Description string
+
+	messageType reflect.Type
+	check       func(message any, c *checker)
+}
+
+// newRule creates a rule for messages of type *M
+func newRule[M any](id, description string, check func(m *M, c *checker)) Rule {
+	return Rule{
+		ID:          id,
+		Description: description,
+		messageType: reflect.TypeOf((*M)(nil)),
+		check: func(message any, c *checker) {
+			check(message.(*M), c)
+		},
+	}
+}
+
+// RuleSet holds the business rules of one version of a message definition
+type RuleSet struct {
+	// Version is the message definition identifier, e.g. "pacs.008.001.08"
+	Version string
+	Rules   []Rule
+}
+
+// Check checks message, a pointer to a message model of the set's type, against every rule of
+// the set and returns all the violations found, in the order of the rules.
+func (s *RuleSet) Check(message any, opts Options) ([]Violation, error) {
+	c := &checker{businessDate: opts.businessDate(), addressCompliance: opts.AddressCompliance}
+	for _, rule := range s.Rules {
+		if reflect.TypeOf(message) != rule.messageType {
+			return nil, fmt.Errorf("rule %s of %s cannot check %T", rule.ID, s.Version, message)
+		}
+		c.rule = rule.ID
+		rule.check(message, c)
+	}
+	return c.violations, nil
+}
+
+// Message is a message model that can be checked by the rule set of its version
+type Message interface {
+	Version() string
+}
+
+// Check checks a message model, such as a UniversalReader's ParsedMessage.Message, against the
+// rule set of its version and returns every violation found.
+func Check(message Message, opts Options) ([]Violation, error) {
+	set, ok := For(message.Version())
+	if !ok {
+		return nil, fmt.Errorf("no business rules for %s", message.Version())
+	}
+	return set.Check(message, opts)
+}
+
+var (
+	registryMu sync.RWMutex
+	registry   = make(map[string]*RuleSet)
+)
+
+// Register adds a rule set, replacing any set for the same version
+func Register(set *RuleSet) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	registry[strings.ToLower(set.Version)] = set
+}
+
+// For returns the rule set of a message definition version, such as "pacs.008.001.08"
+func For(version string) (*RuleSet, bool) {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	set, ok := registry[strings.ToLower(version)]
+	return set, ok
+}
+
+// Versions returns the message definition versions that have a rule set, sorted
+func Versions() []string {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	versions := make([]string, 0, len(registry))
+	for version := range registry {
+		versions = append(versions, version)
+	}
+	sort.Strings(versions)
+	return versions
+}
+
+// checker collects the violations of the rule being checked
+type checker struct {
+	businessDate      civil.Date
+	addressCompliance bool
+	rule              string
+	violations        []Violation
+}
+
+func (c *checker) report(field, value, reason string) {
+	c.violations = append(c.violations, Violation{Rule: c.rule, Field: field, Value: value, Reason: reason})
+}
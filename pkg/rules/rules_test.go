@@ -0,0 +1,227 @@
+package rules
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/civil"
+	"github.com/moov-io/fedwire20022/pkg/fedwire"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/moov-io/wire20022/pkg/messages"
+	"github.com/moov-io/wire20022/pkg/models"
+	CustomerCreditTransferModel "github.com/moov-io/wire20022/pkg/models/CustomerCreditTransfer"
+	FICreditTransferModel "github.com/moov-io/wire20022/pkg/models/FICreditTransfer"
+)
+
+var businessDate = Options{BusinessDate: fedwire.UnmarshalISODate("2025-03-10")}
+
+func readSample(t *testing.T, path string) messages.Message {
+	t.Helper()
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	parsed, err := messages.NewUniversalReader().ReadBytes(data)
+	require.NoError(t, err)
+	return parsed.Message
+}
+
+// ruleIDs returns the rule of each violation
+func ruleIDs(violations []Violation) []string {
+	ids := make([]string, len(violations))
+	for i, v := range violations {
+		ids[i] = v.Rule
+	}
+	return ids
+}
+
+func TestCheckSamples(t *testing.T) {
+	for _, sample := range []string{
+		"../models/CustomerCreditTransfer/swiftSample/CustomerCreditTransfer_Scenario1_Step1_pacs.008",
+		"../models/FICreditTransfer/swiftSample/FICreditTransfer_Scenario1_Step1_pacs.009",
+		"../models/PaymentReturn/swiftSample/FedwireFundsAcknowledgement_Scenario2_Step4_pacs.004",
+		"../models/DrawdownRequest/swiftSample/Drawdowns_Scenario1_Step1_pain.013",
+	} {
+		violations, err := Check(readSample(t, sample), businessDate)
+		require.NoError(t, err, sample)
+		assert.Empty(t, violations, sample)
+	}
+
+	// This sample was created in 2028
+	violations, err := Check(readSample(t, "../models/PaymentReturn/swiftSample/PaymentReturn_Scenario1_Step4_pacs.004"), businessDate)
+	require.NoError(t, err)
+	assert.Equal(t, []Violation{{
+		Rule:   "FW003",
+		Field:  "CreatedDateTime",
+		Value:  "2028-03-10T09:40:00-04:00",
+		Reason: "falls on business day 2028-03-10, not 2025-03-10",
+	}}, violations)
+}
+
+func TestCustomerCreditTransferRules(t *testing.T) {
+	message := CustomerCreditTransferModel.CustomerCreditTransferDataModel()
+	message.MessageId = "20250310B1QDRCQR000001"
+	message.CreatedDateTime = time.Date(2025, 3, 10, 9, 0, 0, 0, fedwire.Eastern())
+	message.Transactions[0].InterBankSettDate = fedwire.UnmarshalISODate("2025-03-10")
+	violations, err := Check(&message, businessDate)
+	require.NoError(t, err)
+	require.Empty(t, violations)
+
+	tx := message.Transactions[0]
+	tx.InterBankSettAmount = models.CurrencyAndAmount{Currency: "EUR", Amount: "10000000000.00"}
+	tx.InstrumentPropCode = models.InstrumentBTRS
+	tx.Transaction = &CustomerCreditTransferModel.TransactionFields{UniqueEndToEndTransactionRef: "8A562C67-CA16-48BA-B074-65581BE6F011"}
+	message.MessageId = "20250309B1QDRCQR000001"
+	message.SettlementMethod = models.SettlementINDA
+	message.CommonClearingSysCode = models.ClearingSysCHIPS
+	message.NumberOfTransactions = "2"
+	message.Transactions = append(message.Transactions, tx)
+
+	// Every violation is reported, in the order of the rules
+	violations, err = Check(&message, businessDate)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"FW002", "FW004", "FW004", "FW005", "FW006", "FW007", "FW008", "FW010", "FW011"}, ruleIDs(violations))
+	assert.Contains(t, violations, Violation{
+		Rule:   "FW008",
+		Field:  "Transactions[1].InterBankSettAmount.Amount",
+		Value:  "10000000000.00",
+		Reason: "must be greater than zero and at most 9,999,999,999.99",
+	})
+	assert.Contains(t, violations, Violation{
+		Rule:   "FW010",
+		Field:  "Transactions[1].InstrumentPropCode",
+		Value:  "BTRS",
+		Reason: "must be one of CTRC, CTRD",
+	})
+
+	message.MessageId = "QA-0001"
+	violations, err = Check(&message, businessDate)
+	require.NoError(t, err)
+	assert.Equal(t, Violation{Rule: "FW001", Field: "MessageId", Value: "QA-0001", Reason: "not an IMAD"}, violations[0])
+	assert.EqualError(t, violations[0], `rule FW001 violated by field "MessageId": not an IMAD`)
+}
+
+func TestFICreditTransferRules(t *testing.T) {
+	message := FICreditTransferModel.FICreditTransferDataModel()
+	message.MessageId = "20250310B1QDRCQR000501"
+	message.CreatedDateTime = time.Date(2025, 3, 10, 9, 0, 0, 0, fedwire.Eastern())
+	message.InterBankSettDate = fedwire.UnmarshalISODate("2025-03-10")
+	message.InstrumentPropCode = models.InstrumentCOVS
+	message.UnderlyingCustomerCreditTransfer = FICreditTransferModel.UnderlyingCustomerCreditTransfer{}
+
+	violations, err := Check(&message, businessDate)
+	require.NoError(t, err)
+	assert.Equal(t, []Violation{{
+		Rule:   "FW012",
+		Field:  "UnderlyingCustomerCreditTransfer",
+		Reason: "missing for a COVS cover payment",
+	}}, violations)
+}
+
+func TestRoutingNumberRule(t *testing.T) {
+	message := CustomerCreditTransferModel.CustomerCreditTransferDataModel()
+	message.MessageId = "20250310B1QDRCQR000001"
+	message.CreatedDateTime = time.Date(2025, 3, 10, 9, 0, 0, 0, fedwire.Eastern())
+	message.Transactions[0].InterBankSettDate = fedwire.UnmarshalISODate("2025-03-10")
+	message.Transactions[0].DebtorAgent.PaymentSysMemberId = "021040079"
+	message.Transactions[0].CreditorAgent.PaymentSysMemberId = "991040078"
+
+	violations, err := Check(&message, businessDate)
+	require.NoError(t, err)
+	assert.Equal(t, []Violation{{
+		Rule:   "FW013",
+		Field:  "Transactions[0].DebtorAgent.PaymentSysMemberId",
+		Value:  "021040079",
+		Reason: `routing number "021040079" has check digit 9, expected 8`,
+	}, {
+		Rule:   "FW013",
+		Field:  "Transactions[0].CreditorAgent.PaymentSysMemberId",
+		Value:  "991040078",
+		Reason: `routing number "991040078" does not start with a Federal Reserve routing symbol`,
+	}}, violations)
+}
+
+func TestAddressComplianceRule(t *testing.T) {
+	message := CustomerCreditTransferModel.CustomerCreditTransferDataModel()
+	message.MessageId = "20250310B1QDRCQR000001"
+	message.CreatedDateTime = time.Date(2025, 3, 10, 9, 0, 0, 0, fedwire.Eastern())
+	message.Transactions[0].InterBankSettDate = fedwire.UnmarshalISODate("2025-03-10")
+	message.Transactions[0].DebtorAddress = models.PostalAddress{AddressLine: []string{"167565 Avenue of the Fountains", "Fountain Hills, AZ 85268", "US"}}
+	message.Transactions[0].CreditorPostalAddress.TownName = ""
+
+	// The checks only run in compliance mode
+	violations, err := Check(&message, businessDate)
+	require.NoError(t, err)
+	assert.Empty(t, violations)
+
+	opts := businessDate
+	opts.AddressCompliance = true
+	violations, err = Check(&message, opts)
+	require.NoError(t, err)
+	assert.Equal(t, []Violation{{
+		Rule:   "FW014",
+		Field:  "Transactions[0].DebtorAddress.AddressLine",
+		Reason: "unstructured address: TownName and Country must be structured fields",
+	}, {
+		Rule:   "FW014",
+		Field:  "Transactions[0].CreditorPostalAddress.TownName",
+		Reason: "is required",
+	}}, violations)
+
+	// Lines converted to structured fields make a compliant address
+	require.NoError(t, models.StructureAddresses(&message))
+	message.Transactions[0].CreditorPostalAddress.TownName = "Palm Springs"
+	violations, err = Check(&message, opts)
+	require.NoError(t, err)
+	assert.Empty(t, violations)
+}
+
+func TestBusinessDayOf(t *testing.T) {
+	eastern := fedwire.Eastern()
+	tests := []struct {
+		at   time.Time
+		want string
+	}{
+		{time.Date(2025, 3, 10, 9, 0, 0, 0, eastern), "2025-03-10"},
+		{time.Date(2025, 3, 9, 20, 59, 0, 0, eastern), "2025-03-09"},
+		{time.Date(2025, 3, 9, 21, 0, 0, 0, eastern), "2025-03-10"},
+		{time.Date(2025, 3, 10, 1, 30, 0, 0, time.UTC), "2025-03-10"}, // 9:30 p.m. Eastern on March 9
+	}
+	for _, tt := range tests {
+		want, err := civil.ParseDate(tt.want)
+		require.NoError(t, err)
+		assert.Equal(t, want, businessDayOf(tt.at), tt.at)
+	}
+}
+
+func TestRuleSets(t *testing.T) {
+	set, ok := For("PACS.009.001.08")
+	require.True(t, ok)
+	assert.Equal(t, "pacs.009.001.08", set.Version)
+
+	// The UETR rule applies from the version that added the element
+	older, ok := For("pacs.008.001.07")
+	require.True(t, ok)
+	newer, ok := For("pacs.008.001.08")
+	require.True(t, ok)
+	assert.NotContains(t, ruleIDsOf(older), "FW011")
+	assert.Contains(t, ruleIDsOf(newer), "FW011")
+
+	assert.Contains(t, Versions(), "pain.013.001.07")
+
+	message := CustomerCreditTransferModel.CustomerCreditTransferDataModel()
+	_, err := set.Check(&message, businessDate)
+	assert.EqualError(t, err, "rule FW001 of pacs.009.001.08 cannot check *CustomerCreditTransfer.MessageModel")
+
+	_, err = Check(readSample(t, "../models/ConnectionCheck/swiftSample/ConnectionCheck_Scenario1_Step1_admi.004"), businessDate)
+	assert.EqualError(t, err, "no business rules for admi.004.001.02")
+}
+
+func ruleIDsOf(set *RuleSet) []string {
+	ids := make([]string, len(set.Rules))
+	for i, rule := range set.Rules {
+		ids[i] = rule.ID
+	}
+	return ids
+}